@@ -29,11 +29,11 @@ VALUES ('10b0d1b8-0e67-4e7e-9f08-124b3e32cce4', 'b184cc4e-78ef-434f-ac88-5084a77
 
 --------------------------------------ПОДПИСКИ-----------------------------------------------------------------------
 INSERT INTO subscription (subscription_id, creator_id, month_cost, title, description)
-VALUES ('1b70e133-36ba-44ec-9d9a-2476442b154b', '10b0d1b8-0e67-4e7e-9f08-124b3e32cce4', 89, 'Простые рецепты',
+VALUES ('1b70e133-36ba-44ec-9d9a-2476442b154b', '10b0d1b8-0e67-4e7e-9f08-124b3e32cce4', 8900, 'Простые рецепты',
         'Для вас будут доступны только самые простые рецепты');
 
 INSERT INTO subscription (subscription_id, creator_id, month_cost, title, description)
-VALUES ('df0dd4ee-0772-43e2-919c-9b059e389b9a', '10b0d1b8-0e67-4e7e-9f08-124b3e32cce4', 199, 'Полный доступ',
+VALUES ('df0dd4ee-0772-43e2-919c-9b059e389b9a', '10b0d1b8-0e67-4e7e-9f08-124b3e32cce4', 19900, 'Полный доступ',
         'Вы можете видеть все мои рецепты');
 --------------------------------------ПОСТЫ-----------------------------------------------------------------------
 INSERT INTO post (post_id, creator_id, creation_date, title, post_text)
@@ -91,7 +91,7 @@ VALUES ('83b1f4df-a232-400e-b71c-5d45b9111f8d', '0b5ce9bf-ba11-415a-ac49-941ec9f
 --------------------------------------------------------------------------------------------------------------
 -----------------------------------Подписки--------------------------------------------------
 INSERT INTO subscription (subscription_id, creator_id, month_cost, title, description)
-VALUES ('aa382710-d873-44f0-940e-b12a6653f7ba', '83b1f4df-a232-400e-b71c-5d45b9111f8d', 50,
+VALUES ('aa382710-d873-44f0-940e-b12a6653f7ba', '83b1f4df-a232-400e-b71c-5d45b9111f8d', 5000,
         'Возможность читать мои текста',
         'Символическая цена для того, чтобы посмотреть на моё творчество');
 --------------------------------------------------------------------------------------------------------------
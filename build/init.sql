@@ -1,19 +1,37 @@
 drop table if exists "like_comment" CASCADE;
 drop table if exists "like_post" CASCADE;
 drop table if exists "donation" CASCADE;
+drop table if exists "donation_info" CASCADE;
+drop table if exists "gift" CASCADE;
+drop table if exists "ledger_entry" CASCADE;
+drop table if exists "refund" CASCADE;
+drop table if exists "revenue_split" CASCADE;
+drop table if exists "commission_rate" CASCADE;
+drop table if exists "payout_schedule" CASCADE;
+drop table if exists "payout_destination" CASCADE;
+drop table if exists "payout" CASCADE;
+drop table if exists "unmatched_payment" CASCADE;
+drop table if exists "payment_event" CASCADE;
 drop table if exists "user_subscription" CASCADE;
 drop table if exists "user_payments" CASCADE;
 drop table if exists "creator_tag" CASCADE;
 drop table if exists "post_subscription" CASCADE;
+drop table if exists "attachment_download" CASCADE;
 drop table if exists "attachment" CASCADE;
+drop table if exists "media_blob" CASCADE;
+drop table if exists "creator_storage" CASCADE;
+drop table if exists "storage_plan" CASCADE;
 drop table if exists "comment" CASCADE;
 drop table if exists "creator" CASCADE;
+drop table if exists "aim" CASCADE;
 drop table if exists "user" CASCADE;
 drop table if exists "post" CASCADE;
+drop table if exists "subscription_billing_option" CASCADE;
 drop table if exists "subscription" CASCADE;
 drop table if exists "tag" CASCADE;
 drop table if exists "follow" CASCADE;
 drop table if exists "statistics" CASCADE;
+drop table if exists "statistics_billing_period" CASCADE;
 
 
 
@@ -29,7 +47,8 @@ create table "user"
     display_name      varchar(40) not null,
     profile_photo     uuid,
     password_hash     varchar(64) not null,
-    registration_date timestamp            default now() not null
+    registration_date timestamp            default now() not null,
+    is_admin          boolean     not null default false ---администратор платформы, например, управляет ставками комиссии
 );
 
 create table creator
@@ -45,14 +64,52 @@ create table creator
     profile_photo   uuid,
     followers_count integer default 0 not null,
     description     varchar(500),
-    posts_count     integer default 0 not null,
-    aim             varchar(100),
-    money_needed    money   default 0,
-    money_got       money   default 0
+    posts_count     integer default 0 not null
 );
 
 ALTER TABLE creator
-    ADD COLUMN balance decimal(10, 2) default 0;
+    ADD COLUMN balance bigint default 0; ---все денежные суммы хранятся в копейках
+
+ALTER TABLE creator
+    ADD COLUMN upload_limit bigint default 2147483648 not null; ---максимальный размер одного загружаемого файла в байтах
+
+---тарифы хранилища: сколько байт вложений может хранить автор
+create table storage_plan
+(
+    name  varchar(32) not null
+        constraint storage_plan_pk
+            primary key,
+    quota bigint      not null
+        constraint storage_plan_quota_check
+            check (quota >= 0)
+);
+
+insert into storage_plan (name, quota)
+values ('free', 5368709120),
+       ('pro', 107374182400);
+
+ALTER TABLE creator
+    ADD COLUMN storage_plan varchar(32) default 'free' not null
+        constraint creator_storage_plan_fk references storage_plan (name);
+
+create table aim
+(
+    aim_id       uuid           not null default gen_random_uuid()
+        constraint aim_pk
+            primary key,
+    creator_id   uuid           not null
+        constraint aim_creator_creator_id_fk
+            references creator (creator_id),
+    description  varchar(100)   not null,
+    money_needed bigint         not null,
+    money_got    bigint         not null default 0,
+    deadline     timestamp,
+    position     int            not null default 0, ---порядок отображения активных целей
+    created_at   timestamp      not null default now(),
+    completed_at timestamp ---null, пока цель не собрана
+);
+
+create index aim_creator_id_index on aim (creator_id);
 
 create table subscription
 (
@@ -62,31 +119,62 @@ create table subscription
     creator_id      uuid        not null
         constraint subscription_creator_creator_id_fk
             references creator (creator_id),
-    month_cost      money       not null,
+    month_cost      bigint      not null, ---в копейках
     title           varchar(40) not null,
     description     varchar(200),
     is_available    bool default true
 );
 
+--Варианты оплаты уровня на несколько месяцев: явная цена за весь период или скидка от month_cost.
+--Оплата на 1 месяц по month_cost доступна всегда, если для неё не задан отдельный вариант.
+create table subscription_billing_option
+(
+    subscription_id  uuid   not null
+        constraint subscription_billing_option_subscription_subscription_id_fk
+            references subscription (subscription_id),
+    month_count      int    not null
+        constraint subscription_billing_option_month_count_check
+            check (month_count in (1, 3, 6, 12)),
+    price            bigint, ---в копейках, за весь период
+    discount_percent int,
+    constraint subscription_billing_option_pk
+        primary key (subscription_id, month_count),
+    constraint subscription_billing_option_price_check
+        check ((price IS NOT NULL AND price > 0 AND discount_percent IS NULL) OR
+               (price IS NULL AND discount_percent between 1 and 99))
+);
+
 create table user_subscription
 (
-    user_id         uuid      not null
+    user_id          uuid      not null
         constraint user_subscription_user_user_id_fk references "user" (user_id),
-    subscription_id uuid      not null
+    subscription_id  uuid      not null
         constraint user_subscription_subscription_subscription_id_fk references subscription (subscription_id),
-    expire_date     timestamp not null default now() + INTERVAL '1 month'
-
+    expire_date      timestamp not null default now() + INTERVAL '1 month',
+    is_expired       bool      not null default false,
+    reminded_before  int, ---за сколько дней до окончания отправлено последнее напоминание
+    user_notified    bool      not null default false, ---получил ли подписчик уведомление об окончании
+    creator_notified bool      not null default false ---получил ли автор уведомление об окончании
 );
 
 create table user_payments
 (
+    payment_id        uuid      not null default gen_random_uuid()
+        constraint user_payments_pk
+            primary key,
     user_id           uuid      not null
         constraint user_payments_user_user_id_fk references "user" (user_id),
     subscription_id   uuid      not null
         constraint user_payments_subscription_subscription_id_fk references subscription (subscription_id),
     payment_timestamp timestamp not null default now(),
+    month_count       int       not null default 1,
     payment_info      text, ---что-то, номер кошелька, что угодно
-    money             money     not null
+    money             bigint    not null,
+    operation_id      text
+        constraint user_payments_operation_id_uindex
+            unique,
+    period_start      timestamp, ---период, на который продлена подписка; заполняется при оплате
+    period_end        timestamp
 );
 
 create table post
@@ -136,7 +224,71 @@ create table attachment
             primary key,
     post_id         uuid not null
         constraint attachment_post_post_id_fk references "post" (post_id),
-    attachment_type varchar(40)
+    attachment_type varchar(40),
+    renditions      jsonb,
+    size            bigint,
+    checksum        varchar(64), ---sha-256 в hex
+    filename        varchar(255),
+    width           integer,
+    height          integer,
+    duration        double precision, ---секунды
+    alt_text        varchar(1000),
+    caption         varchar(2000),
+    downloads       bigint default 0 not null,
+    blob_id         varchar(64), ---sha-256 содержимого, по нему строится ключ файла; null - файл лежит под attachment_id
+    scan_status     varchar(16) default 'clean' not null, ---pending - в карантине до проверки, rejected - файл удалён, failed - проверить не удалось
+    scan_signature  varchar(255), ---что нашла проверка у отклонённого файла
+    scanned_at      timestamp,
+    scan_attempts   integer default 0 not null, ---неудачные попытки проверки
+    scan_error      varchar(255), ---почему не удалась последняя попытка
+    scan_tried_at   timestamp ---время последней неудачной попытки, очередь проверки начинается с давно не проверявшихся
+);
+
+create index attachment_blob_id_index
+    on attachment (blob_id);
+
+create index attachment_scan_queue_index
+    on attachment (scan_tried_at nulls first, attachment_id)
+    where scan_status = 'pending';
+
+---подписи ссылок на скачивание, по которым скачивание уже засчитано: повтор запроса по той же ссылке
+---счётчик не увеличивает; записи удаляются после истечения срока ссылки
+create table attachment_download
+(
+    download_key  varchar(128) not null
+        constraint attachment_download_pk
+            primary key,
+    attachment_id uuid         not null
+        constraint attachment_download_attachment_id_fk references "attachment" (attachment_id) on delete cascade,
+    expires_at    timestamp    not null
+);
+
+create index attachment_download_expires_at_index
+    on attachment_download (expires_at);
+
+---место, которое занимают вложения автора, по типам файлов; поддерживается триггером.
+---одинаковые файлы хранятся один раз, но автору засчитывается каждое вложение
+create table creator_storage
+(
+    creator_id uuid              not null
+        constraint creator_storage_creator_creator_id_fk
+            references creator (creator_id),
+    media_type varchar(40)       not null,
+    files      integer default 0 not null,
+    bytes      bigint  default 0 not null,
+    constraint creator_storage_pk
+        primary key (creator_id, media_type)
+);
+
+---файлы, которые хранятся по содержимому; refs - число вложений с этим blob_id, поддерживается триггером.
+---Запись с refs = 0 удаляется вместе с файлами, held_until - до какого времени файл нужен ещё не созданному вложению
+create table media_blob
+(
+    blob_id    varchar(64) not null
+        constraint media_blob_pk
+            primary key,
+    refs       integer     not null,
+    held_until timestamp
 );
 
 create table tag
@@ -176,14 +328,74 @@ create table like_comment
 );
 create table donation
 (
-    user_id       uuid      not null
+    donation_id   uuid      not null default gen_random_uuid()
+        constraint donation_pk
+            primary key,
+    user_id       uuid ---null для донатов без авторизации
         constraint donation_user_user_id_fk
             references "user" (user_id),
     creator_id    uuid      not null
         constraint donation_creator_creator_id_fk
             references "creator" (creator_id),
-    money_count   money     not null,
-    donation_date timestamp not null default now()
+    money_count   bigint    not null,
+    donation_date timestamp not null default now(),
+    operation_id  text ---повтор уведомления о той же операции не создаст второй донат
+        constraint donation_operation_id_uindex
+            unique,
+    is_anonymous  bool      not null default false,
+    message       varchar(200),
+    aim_id        uuid
+        constraint donation_aim_aim_id_fk
+            references aim (aim_id)
+);
+
+create table donation_info
+(
+    payment_info uuid      not null
+        constraint donation_info_pk
+            primary key,
+    user_id      uuid      not null
+        constraint donation_info_user_user_id_fk
+            references "user" (user_id),
+    creator_id   uuid      not null
+        constraint donation_info_creator_creator_id_fk
+            references "creator" (creator_id),
+    message      varchar(200),
+    is_anonymous bool      not null default false,
+    aim_id       uuid
+        constraint donation_info_aim_aim_id_fk
+            references aim (aim_id),
+    created_at   timestamp not null default now()
+);
+
+create table gift
+(
+    gift_id         uuid      not null
+        constraint gift_pk
+            primary key,
+    buyer_id        uuid      not null
+        constraint gift_user_user_id_fk
+            references "user" (user_id),
+    recipient_id    uuid ---если не указан, подарок активируется по коду
+        constraint gift_recipient_user_id_fk
+            references "user" (user_id),
+    subscription_id uuid      not null
+        constraint gift_subscription_subscription_id_fk
+            references subscription (subscription_id),
+    month_count     int       not null default 1,
+    money           bigint    not null default 0,
+    operation_id    text
+        constraint gift_operation_id_uindex
+            unique,
+    code            text ---обнуляется при возврате, после этого подарок не активировать
+        constraint gift_code_uindex
+            unique,
+    created_at      timestamp not null default now(),
+    paid_at         timestamp,
+    redeemed_by     uuid
+        constraint gift_redeemed_by_user_id_fk
+            references "user" (user_id),
+    redeemed_at     timestamp
 );
 
 create table follow
@@ -196,6 +408,110 @@ create table follow
             references "creator" (creator_id)
 );
 
+create table payout_destination
+(
+    destination_id uuid      not null
+        constraint payout_destination_pk
+            primary key,
+    creator_id     uuid      not null
+        constraint payout_destination_creator_creator_id_fk
+            references creator (creator_id),
+    phone_number   text      not null, ---зашифровано, см. internal/pkg/encryption
+    verified_at    timestamp not null default now()
+);
+
+create index payout_destination_creator_index
+    on payout_destination (creator_id);
+
+create table payout_schedule
+(
+    creator_id     uuid        not null
+        constraint payout_schedule_pk
+            primary key
+        constraint payout_schedule_creator_creator_id_fk
+            references creator (creator_id),
+    destination_id uuid        not null
+        constraint payout_schedule_destination_id_fk
+            references payout_destination (destination_id)
+            on delete cascade,
+    period         varchar(10) not null ---weekly, monthly
+        constraint payout_schedule_period_check
+            check (period in ('weekly', 'monthly')),
+    threshold      bigint      not null
+        constraint payout_schedule_threshold_check
+            check (threshold > 0),
+    next_run_at    timestamp   not null,
+    claimed_until  timestamp ---запуск захвачен джобой; next_run_at сдвигается, только когда выплата создана
+);
+
+create index payout_schedule_next_run_index
+    on payout_schedule (next_run_at);
+
+create table payout
+(
+    payout_id           uuid        not null
+        constraint payout_pk
+            primary key,
+    creator_id          uuid        not null
+        constraint payout_creator_creator_id_fk
+            references creator (creator_id),
+    money               bigint      not null
+        constraint payout_money_check
+            check (money > 0),
+    phone_number        text        not null, ---зашифровано, см. internal/pkg/encryption
+    idempotency_key     varchar(64) not null,
+    status              varchar(20) not null default 'requested', ---requested, processing, succeeded, failed
+    provider_request_id text,
+    attempts            int         not null default 0,
+    last_error          text,
+    next_attempt_at     timestamp   not null default now(),
+    created_at          timestamp   not null default now(),
+    updated_at          timestamp   not null default now(),
+    destination_id      uuid
+        constraint payout_destination_id_fk
+            references payout_destination (destination_id)
+            on delete set null,
+    constraint payout_idempotency_key_uindex
+        unique (creator_id, idempotency_key)
+);
+
+create index payout_pending_index
+    on payout (next_attempt_at)
+    where status in ('requested', 'processing');
+
+create table payment_event
+(
+    operation_id varchar(128) not null
+        constraint payment_event_pk
+            primary key,
+    provider     varchar(32)  not null,
+    kind         varchar(20)  not null, ---subscribe, donate, gift
+    target_id    uuid         not null,
+    money        bigint       not null,
+    sender       varchar(64), ---счёт плательщика у провайдера, на него уходит возврат
+    status       varchar(20)  not null default 'processing', ---processing, processed, failed
+    attempts     int          not null default 1,
+    last_error   text,
+    received_at  timestamp    not null default now(),
+    updated_at   timestamp    not null default now()
+);
+
+create table unmatched_payment
+(
+    operation_id varchar(128) not null
+        constraint unmatched_payment_pk
+            primary key
+        constraint unmatched_payment_payment_event_operation_id_fk
+            references payment_event (operation_id),
+    kind         varchar(20)  not null, ---subscribe, gift
+    target_id    uuid         not null,
+    money        bigint       not null, ---зачислено платформе
+    paid         bigint       not null, ---списано с плательщика
+    price        bigint       not null,
+    reason       varchar(20)  not null, ---underpaid, currency
+    created_at   timestamp    not null default now()
+);
+
 CREATE TEXT SEARCH DICTIONARY russian_ispell (
     TEMPLATE = ispell,
     DictFile = russian,
@@ -237,11 +553,15 @@ CREATE TABLE "statistics"
     posts_per_month          int           default 0,
     subscriptions_bought     int           default 0,
     donations_count          int           default 0,
-    money_from_donations     money         default 0,
-    money_from_subscriptions money         default 0,
+    money_from_donations     bigint        default 0,
+    money_from_subscriptions bigint        default 0,
     new_followers            int           default 0,
     likes_count              int           default 0,
     comments_count           int           default 0,
+    subscriptions_expired    int           default 0,
+    gifts_bought             int           default 0,
+    money_from_gifts         bigint        default 0,
+    commission               bigint        default 0,
     month                    timestamp     default now()
 );
 
@@ -255,6 +575,18 @@ $$ LANGUAGE 'plpgsql' IMMUTABLE;
 
 alter table "statistics"
     add constraint unique_bucket unique (creator_id, month);
+
+--Купленные подписки в разбивке по периоду оплаты
+CREATE TABLE "statistics_billing_period"
+(
+    creator_id           uuid   not null,
+    month                date   not null,
+    month_count          int    not null,
+    subscriptions_bought int    not null default 0,
+    money                bigint not null default 0,
+    constraint statistics_billing_period_pk
+        primary key (creator_id, month, month_count)
+);
 --likes
 CREATE OR REPLACE FUNCTION update_likes_count_statistics() RETURNS TRIGGER AS
 $likes_count_statistics$
@@ -378,6 +710,11 @@ BEGIN
         subscriptions_bought     = subscriptions_bought + 1
     WHERE creator_id = creator
       AND date_trunc('month', month)::date = date_trunc('month', now())::date;
+    INSERT INTO "statistics_billing_period" (creator_id, month, month_count, subscriptions_bought, money)
+    VALUES (creator, date_trunc('month', now())::date, NEW.month_count, 1, NEW.money)
+    ON CONFLICT (creator_id, month, month_count) DO UPDATE
+        SET subscriptions_bought = statistics_billing_period.subscriptions_bought + 1,
+            money                = statistics_billing_period.money + NEW.money;
     RETURN NEW;
 END;
 $subs_statistics$ LANGUAGE plpgsql;
@@ -385,11 +722,69 @@ $subs_statistics$ LANGUAGE plpgsql;
 DROP TRIGGER IF EXISTS subs_statistic ON user_payments;
 
 CREATE TRIGGER subs_statistic
-    AFTER UPDATE
+    AFTER UPDATE OF money
     ON user_payments
     FOR EACH ROW
+    WHEN (OLD.money = 0 AND NEW.money > 0)
 EXECUTE PROCEDURE subs_statistics();
 
+--Expired subscriptions
+CREATE OR REPLACE FUNCTION expired_subs_statistics() RETURNS TRIGGER AS
+$expired_subs_statistics$
+DECLARE
+    creator uuid = null;
+BEGIN
+    creator = (SELECT creator_id FROM subscription WHERE subscription.subscription_id = NEW.subscription_id);
+    IF NOT check_if_bucket_exists(creator,
+                                  date_trunc('month', now())::date) THEN
+        INSERT INTO "statistics" (creator_id, month) VALUES (creator, date_trunc('month', now())::date);
+    END IF;
+    UPDATE "statistics"
+    SET subscriptions_expired = subscriptions_expired + 1
+    WHERE creator_id = creator
+      AND date_trunc('month', month)::date = date_trunc('month', now())::date;
+    RETURN NEW;
+END;
+$expired_subs_statistics$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS expired_subs_statistic ON user_subscription;
+
+CREATE TRIGGER expired_subs_statistic
+    AFTER UPDATE OF is_expired
+    ON user_subscription
+    FOR EACH ROW
+    WHEN (NEW.is_expired AND NOT OLD.is_expired)
+EXECUTE PROCEDURE expired_subs_statistics();
+
+--Gifts
+CREATE OR REPLACE FUNCTION gifts_statistics() RETURNS TRIGGER AS
+$gifts_statistics$
+DECLARE
+    creator uuid = null;
+BEGIN
+    creator = (SELECT creator_id FROM subscription WHERE subscription.subscription_id = NEW.subscription_id);
+    IF NOT check_if_bucket_exists(creator,
+                                  date_trunc('month', now())::date) THEN
+        INSERT INTO "statistics" (creator_id, month) VALUES (creator, date_trunc('month', now())::date);
+    END IF;
+    UPDATE "statistics"
+    SET money_from_gifts = money_from_gifts + NEW.money,
+        gifts_bought     = gifts_bought + 1
+    WHERE creator_id = creator
+      AND date_trunc('month', month)::date = date_trunc('month', now())::date;
+    RETURN NEW;
+END;
+$gifts_statistics$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS gifts_statistic ON gift;
+
+CREATE TRIGGER gifts_statistic
+    AFTER UPDATE OF paid_at
+    ON gift
+    FOR EACH ROW
+    WHEN (OLD.paid_at IS NULL AND NEW.paid_at IS NOT NULL)
+EXECUTE PROCEDURE gifts_statistics();
+
 --Donations
 CREATE OR REPLACE FUNCTION donations_statistics() RETURNS TRIGGER AS
 $donations_statistics$
@@ -453,20 +848,393 @@ CREATE TRIGGER update_posts_count_statistic
     FOR EACH ROW
 EXECUTE PROCEDURE update_posts_count_statistics();
 
-CREATE OR REPLACE FUNCTION update_balance() RETURNS TRIGGER AS
-$update_balance$
+--Ledger
+--Каждая операция - проводка из нескольких записей с общим transaction_id, сумма записей проводки равна нулю.
+--creator.balance - производное значение: сумма записей по счёту creator этого автора.
+create table ledger_entry
+(
+    entry_id       uuid        not null default gen_random_uuid()
+        constraint ledger_entry_pk
+            primary key,
+    transaction_id uuid        not null,
+    account        varchar(20) not null, ---creator, payments, payouts, platform
+    creator_id     uuid
+        constraint ledger_entry_creator_creator_id_fk
+            references creator (creator_id),
+    kind           varchar(20) not null, ---subscription, donation, gift, fee, payout, payout_return, refund
+    amount         bigint      not null, ---в копейках, положительная - приход на счёт
+    reference      text,
+    created_at     timestamp   not null default now(),
+    constraint ledger_entry_creator_account_check
+        check ((account = 'creator') = (creator_id IS NOT NULL))
+);
+
+create index ledger_entry_creator_id_index
+    on ledger_entry (creator_id, created_at);
+
+create index ledger_entry_transaction_id_index
+    on ledger_entry (transaction_id);
+
+CREATE OR REPLACE FUNCTION ledger_immutable() RETURNS TRIGGER AS
+$ledger_immutable$
 BEGIN
+    RAISE EXCEPTION 'ledger entries are immutable';
+END;
+$ledger_immutable$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS ledger_entry_immutable ON ledger_entry;
+
+CREATE TRIGGER ledger_entry_immutable
+    BEFORE UPDATE OR DELETE
+    ON ledger_entry
+    FOR EACH ROW
+EXECUTE PROCEDURE ledger_immutable();
+
+CREATE OR REPLACE FUNCTION ledger_check_balanced() RETURNS TRIGGER AS
+$ledger_check_balanced$
+BEGIN
+    IF (SELECT sum(amount) FROM ledger_entry WHERE transaction_id = NEW.transaction_id) <> 0 THEN
+        RAISE EXCEPTION 'ledger transaction % is not balanced', NEW.transaction_id;
+    END IF;
+    RETURN NULL;
+END;
+$ledger_check_balanced$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS ledger_entry_balanced ON ledger_entry;
+
+CREATE CONSTRAINT TRIGGER ledger_entry_balanced
+    AFTER INSERT
+    ON ledger_entry
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+EXECUTE PROCEDURE ledger_check_balanced();
+
+--Commission
+--Ставка комиссии платформы в сотых долях процента (1000 - 10%). Ставка с creator_id IS NULL действует для всех авторов.
+--Из действующих в момент платежа ставок применяется самая конкретная: ставка автора важнее общей,
+--а ограниченная по времени (промо) важнее бессрочной.
+create table commission_rate
+(
+    rate_id     uuid      not null default gen_random_uuid()
+        constraint commission_rate_pk
+            primary key,
+    creator_id  uuid
+        constraint commission_rate_creator_creator_id_fk
+            references creator (creator_id),
+    rate_bp     int       not null
+        constraint commission_rate_rate_bp_check
+            check (rate_bp between 0 and 10000),
+    starts_at   timestamp not null default now(),
+    ends_at     timestamp, ---null - бессрочно
+    description text,
+    created_at  timestamp not null default now(),
+    constraint commission_rate_period_check
+        check (ends_at IS NULL OR ends_at > starts_at)
+);
+
+create index commission_rate_creator_id_index
+    on commission_rate (creator_id, starts_at);
+
+insert into commission_rate (creator_id, rate_bp, starts_at, description)
+values (NULL, 0, '2023-01-01', 'Базовая ставка платформы');
+
+--Разделение каждого платежа между автором и платформой
+create table revenue_split
+(
+    creator_id uuid        not null
+        constraint revenue_split_creator_creator_id_fk
+            references creator (creator_id),
+    kind       varchar(20) not null, ---subscription, donation, gift
+    reference  text        not null,
+    gross      bigint      not null,
+    fee        bigint      not null,
+    rate_bp      int         not null,
+    refunded     bigint      not null default 0,
+    fee_refunded bigint      not null default 0,
+    created_at   timestamp   not null default now(),
+    constraint revenue_split_pk
+        primary key (kind, reference),
+    constraint revenue_split_fee_check
+        check (fee between 0 and gross),
+    constraint revenue_split_refunded_check
+        check (refunded between 0 and gross AND fee_refunded between 0 and fee)
+);
+
+create index revenue_split_creator_id_index
+    on revenue_split (creator_id, created_at);
+
+CREATE OR REPLACE FUNCTION commission_rate_at(creator uuid, at timestamp) RETURNS int AS
+$commission_rate_at$
+BEGIN
+    RETURN coalesce((SELECT rate_bp
+                     FROM commission_rate
+                     WHERE (creator_id = creator OR creator_id IS NULL)
+                       AND starts_at <= at
+                       AND (ends_at IS NULL OR ends_at > at)
+                     ORDER BY creator_id IS NULL, ends_at IS NULL, starts_at DESC
+                     LIMIT 1), 0);
+END
+$commission_rate_at$ LANGUAGE plpgsql STABLE;
+
+--Зачисление дохода автору: деньги приходят со счёта payments, комиссия по действующей ставке уходит на счёт platform
+DROP FUNCTION IF EXISTS ledger_post_income(uuid, text, text, bigint, bigint);
+
+CREATE OR REPLACE FUNCTION ledger_post_income(creator uuid, income_kind text, ref text, income bigint) RETURNS void AS
+$ledger_post_income$
+DECLARE
+    tx   uuid = gen_random_uuid();
+    rate int;
+    fee  bigint;
+BEGIN
+    IF creator IS NULL OR income <= 0 THEN
+        RETURN;
+    END IF;
+    rate = commission_rate_at(creator, now()::timestamp);
+    fee = income * rate / 10000; ---округление в пользу автора
+    INSERT INTO revenue_split (creator_id, kind, reference, gross, fee, rate_bp)
+    VALUES (creator, income_kind, ref, income, fee, rate);
+    INSERT INTO ledger_entry (transaction_id, account, creator_id, kind, amount, reference)
+    VALUES (tx, 'payments', NULL, income_kind, -income, ref),
+           (tx, 'creator', creator, income_kind, income, ref);
+    IF fee > 0 THEN
+        tx = gen_random_uuid();
+        INSERT INTO ledger_entry (transaction_id, account, creator_id, kind, amount, reference)
+        VALUES (tx, 'creator', creator, 'fee', -fee, ref),
+               (tx, 'platform', NULL, 'fee', fee, ref);
+        IF NOT check_if_bucket_exists(creator, date_trunc('month', now())::date) THEN
+            INSERT INTO "statistics" (creator_id, month) VALUES (creator, date_trunc('month', now())::date);
+        END IF;
+        UPDATE "statistics"
+        SET commission = commission + fee
+        WHERE creator_id = creator
+          AND date_trunc('month', month)::date = date_trunc('month', now())::date;
+    END IF;
     UPDATE creator
-    SET balance = balance + NEW.money
-    WHERE creator_id IN (SELECT creator_id FROM subscription WHERE subscription.subscription_id = OLD.subscription_id);
+    SET balance = balance + income - fee
+    WHERE creator_id = creator;
+END;
+$ledger_post_income$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION subscription_income() RETURNS TRIGGER AS
+$subscription_income$
+BEGIN
+    PERFORM ledger_post_income((SELECT creator_id FROM subscription WHERE subscription_id = NEW.subscription_id),
+                               'subscription', NEW.payment_id::text, NEW.money);
     RETURN NEW;
 END;
-$update_balance$ LANGUAGE plpgsql;
+$subscription_income$ LANGUAGE plpgsql;
 
 DROP TRIGGER IF EXISTS update_creator_balance ON user_payments;
 
 CREATE TRIGGER update_creator_balance
-    AFTER UPDATE
+    AFTER UPDATE OF money
     ON user_payments
     FOR EACH ROW
-EXECUTE PROCEDURE update_balance();
\ No newline at end of file
+    WHEN (OLD.money = 0 AND NEW.money > 0)
+EXECUTE PROCEDURE subscription_income();
+
+CREATE OR REPLACE FUNCTION donation_income() RETURNS TRIGGER AS
+$donation_income$
+BEGIN
+    PERFORM ledger_post_income(NEW.creator_id, 'donation', NEW.donation_id::text, NEW.money_count);
+    RETURN NEW;
+END;
+$donation_income$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS donation_creator_balance ON donation;
+
+CREATE TRIGGER donation_creator_balance
+    AFTER INSERT
+    ON donation
+    FOR EACH ROW
+EXECUTE PROCEDURE donation_income();
+
+CREATE OR REPLACE FUNCTION gift_income() RETURNS TRIGGER AS
+$gift_income$
+BEGIN
+    PERFORM ledger_post_income((SELECT creator_id FROM subscription WHERE subscription_id = NEW.subscription_id),
+                               'gift', NEW.gift_id::text, NEW.money);
+    RETURN NEW;
+END;
+$gift_income$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS gift_creator_balance ON gift;
+
+CREATE TRIGGER gift_creator_balance
+    AFTER UPDATE OF paid_at
+    ON gift
+    FOR EACH ROW
+    WHEN (OLD.paid_at IS NULL AND NEW.paid_at IS NOT NULL)
+EXECUTE PROCEDURE gift_income();
+
+--Refunds
+--Возврат подписки, доната или неактивированного подарка: payment_id - payment_id подписки, donation_id или gift_id.
+--Возврат по решению администратора переводит деньги на счёт плательщика, operation_id - операция этого перевода.
+create table refund
+(
+    refund_id    uuid        not null default gen_random_uuid()
+        constraint refund_pk
+            primary key,
+    payment_type varchar(20) not null, ---subscription, donation, gift
+    payment_id   uuid        not null,
+    creator_id   uuid        not null
+        constraint refund_creator_creator_id_fk
+            references creator (creator_id),
+    user_id      uuid ---null для донатов без авторизации
+        constraint refund_user_user_id_fk
+            references "user" (user_id),
+    money        bigint      not null
+        constraint refund_money_check
+            check (money > 0),
+    reason       varchar(200),
+    source       varchar(20) not null, ---admin, provider
+    operation_id varchar(128)
+        constraint refund_operation_id_uindex
+            unique,
+    created_at   timestamp   not null default now()
+);
+
+create index refund_payment_id_index
+    on refund (payment_id);
+
+--Возврат списывает с автора его долю платежа, комиссия возвращается с платформы пропорционально сумме возврата.
+--Статистика уменьшается в месяце исходного платежа.
+CREATE OR REPLACE FUNCTION refund_reversal() RETURNS TRIGGER AS
+$refund_reversal$
+DECLARE
+    tx           uuid = gen_random_uuid();
+    split        revenue_split;
+    fee_back     bigint = 0;
+    full_refund  bool   = false;
+    payment_date timestamp;
+    months       int;
+BEGIN
+    SELECT * INTO split FROM revenue_split WHERE kind = NEW.payment_type AND reference = NEW.payment_id::text FOR UPDATE;
+    IF FOUND THEN
+        full_refund = split.refunded + NEW.money >= split.gross;
+        IF full_refund THEN
+            fee_back = split.fee - split.fee_refunded;
+        ELSE
+            fee_back = least(split.fee * NEW.money / split.gross, split.fee - split.fee_refunded);
+        END IF;
+        UPDATE revenue_split
+        SET refunded     = refunded + NEW.money,
+            fee_refunded = fee_refunded + fee_back
+        WHERE kind = NEW.payment_type
+          AND reference = NEW.payment_id::text;
+    END IF;
+
+    INSERT INTO ledger_entry (transaction_id, account, creator_id, kind, amount, reference)
+    VALUES (tx, 'payments', NULL, 'refund', NEW.money, NEW.payment_id::text),
+           (tx, 'creator', NEW.creator_id, 'refund', fee_back - NEW.money, NEW.payment_id::text);
+    IF fee_back > 0 THEN
+        INSERT INTO ledger_entry (transaction_id, account, creator_id, kind, amount, reference)
+        VALUES (tx, 'platform', NULL, 'refund', -fee_back, NEW.payment_id::text);
+    END IF;
+    UPDATE creator
+    SET balance = balance - (NEW.money - fee_back)
+    WHERE creator_id = NEW.creator_id;
+
+    IF NEW.payment_type = 'subscription' THEN
+        SELECT payment_timestamp, month_count
+        INTO payment_date, months
+        FROM user_payments
+        WHERE payment_id = NEW.payment_id;
+        UPDATE "statistics"
+        SET money_from_subscriptions = money_from_subscriptions - NEW.money,
+            subscriptions_bought     = subscriptions_bought - CASE WHEN full_refund THEN 1 ELSE 0 END,
+            commission               = commission - fee_back
+        WHERE creator_id = NEW.creator_id
+          AND date_trunc('month', month)::date = date_trunc('month', payment_date)::date;
+        UPDATE "statistics_billing_period"
+        SET money                = money - NEW.money,
+            subscriptions_bought = subscriptions_bought - CASE WHEN full_refund THEN 1 ELSE 0 END
+        WHERE creator_id = NEW.creator_id
+          AND month = date_trunc('month', payment_date)::date
+          AND month_count = months;
+    ELSIF NEW.payment_type = 'gift' THEN
+        payment_date = (SELECT paid_at FROM gift WHERE gift_id = NEW.payment_id);
+        UPDATE "statistics"
+        SET money_from_gifts = money_from_gifts - NEW.money,
+            gifts_bought     = gifts_bought - CASE WHEN full_refund THEN 1 ELSE 0 END,
+            commission       = commission - fee_back
+        WHERE creator_id = NEW.creator_id
+          AND date_trunc('month', month)::date = date_trunc('month', payment_date)::date;
+    ELSE
+        payment_date = (SELECT donation_date FROM donation WHERE donation_id = NEW.payment_id);
+        UPDATE "statistics"
+        SET money_from_donations = money_from_donations - NEW.money,
+            donations_count      = donations_count - CASE WHEN full_refund THEN 1 ELSE 0 END,
+            commission           = commission - fee_back
+        WHERE creator_id = NEW.creator_id
+          AND date_trunc('month', month)::date = date_trunc('month', payment_date)::date;
+    END IF;
+    RETURN NEW;
+END;
+$refund_reversal$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS refund_creator_balance ON refund;
+
+CREATE TRIGGER refund_creator_balance
+    AFTER INSERT
+    ON refund
+    FOR EACH ROW
+EXECUTE PROCEDURE refund_reversal();
+
+--Media blobs
+CREATE OR REPLACE FUNCTION update_media_blob_refs() RETURNS TRIGGER AS
+$media_blob_refs$
+BEGIN
+    IF (TG_OP IN ('DELETE', 'UPDATE') AND OLD.blob_id IS NOT NULL) THEN
+        UPDATE media_blob SET refs = refs - 1 WHERE blob_id = OLD.blob_id;
+    END IF;
+    IF (TG_OP IN ('INSERT', 'UPDATE') AND NEW.blob_id IS NOT NULL) THEN
+        INSERT INTO media_blob (blob_id, refs)
+        VALUES (NEW.blob_id, 1)
+        ON CONFLICT (blob_id) DO UPDATE SET refs = media_blob.refs + 1;
+    END IF;
+    RETURN NULL;
+END;
+$media_blob_refs$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS media_blob_refs ON attachment;
+
+CREATE TRIGGER media_blob_refs
+    AFTER INSERT OR DELETE OR UPDATE OF blob_id
+    ON attachment
+    FOR EACH ROW
+EXECUTE PROCEDURE update_media_blob_refs();
+
+--Creator storage
+CREATE OR REPLACE FUNCTION update_creator_storage() RETURNS TRIGGER AS
+$creator_storage$
+BEGIN
+    ---файлы отклонённого проверкой вложения удаляются, поэтому место освобождается сразу, а не при удалении записи
+    IF ((TG_OP = 'DELETE' AND OLD.scan_status <> 'rejected') OR
+        (TG_OP = 'UPDATE' AND NEW.scan_status = 'rejected' AND OLD.scan_status <> 'rejected')) THEN
+        UPDATE creator_storage cs
+        SET files = cs.files - 1,
+            bytes = cs.bytes - coalesce(OLD.size, 0)
+        FROM post p
+        WHERE p.post_id = OLD.post_id
+          AND cs.creator_id = p.creator_id
+          AND cs.media_type = coalesce(OLD.attachment_type, '');
+    ELSIF (TG_OP = 'INSERT') THEN
+        INSERT INTO creator_storage (creator_id, media_type, files, bytes)
+        SELECT creator_id, coalesce(NEW.attachment_type, ''), 1, coalesce(NEW.size, 0)
+        FROM post
+        WHERE post_id = NEW.post_id
+        ON CONFLICT (creator_id, media_type) DO UPDATE SET files = creator_storage.files + 1,
+                                                         bytes = creator_storage.bytes + excluded.bytes;
+    END IF;
+    RETURN NULL;
+END;
+$creator_storage$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS creator_storage ON attachment;
+
+CREATE TRIGGER creator_storage
+    AFTER INSERT OR DELETE OR UPDATE OF scan_status
+    ON attachment
+    FOR EACH ROW
+EXECUTE PROCEDURE update_creator_storage();
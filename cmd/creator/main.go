@@ -1,19 +1,31 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	attachmentJob "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/attachment/delivery/job"
 	attachmentRepository "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/attachment/repo"
 	attachmentUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/attachment/usecase"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
 	commentRepository "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/comment/repo"
 	commentUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/comment/usecase"
 	grpcCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc"
 	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	creatorJob "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/job"
 	creatorRepository "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/repo"
 	creatorUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/usecase"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/encryption"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/middleware"
+	notificationUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/usecase"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout/fake"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout/yoomoney"
 	postRepository "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/post/repo"
 	postUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/post/usecase"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/scan"
 	subscriptionRepository "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/subscription/repo"
 	subscriptionUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/subscription/usecase"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
@@ -25,6 +37,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 )
 
@@ -71,16 +84,72 @@ func run() error {
 	postUse := postUsecase.NewPostUsecase(postRepo, zapSugar)
 
 	attachmentRepo := attachmentRepository.NewAttachmentRepo(db, zapSugar)
-	attachmentUse := attachmentUsecase.NewAttachmentUsecase(attachmentRepo, zapSugar)
+	blobStore, err := blob.NewBlobStoreFromEnv()
+	if err != nil {
+		return err
+	}
+	mediaTypes, err := media.NewTypeRegistryFromEnv()
+	if err != nil {
+		return err
+	}
+	scanner, err := scan.NewScannerFromEnv()
+	if err != nil {
+		return err
+	}
+	attachmentUse := attachmentUsecase.NewAttachmentUsecase(attachmentRepo, blobStore, mediaTypes, scanner, zapSugar)
 
 	creatorRepo := creatorRepository.NewCreatorRepo(db, zapSugar)
-	creatorUse := creatorUsecase.NewCreatorUsecase(creatorRepo, zapSugar)
+	payoutProvider, err := getPayoutProvider(zapSugar)
+	if err != nil {
+		return err
+	}
+	keyring, err := encryption.NewKeyringFromEnv()
+	if err != nil {
+		return err
+	}
+	creatorUse := creatorUsecase.NewCreatorUsecase(creatorRepo, payoutProvider, keyring, zapSugar)
+	if rateBP, ok, err := getDefaultCommissionRate(); err != nil {
+		return err
+	} else if ok {
+		if err = creatorUse.SetDefaultCommissionRate(context.Background(), rateBP); err != nil {
+			return err
+		}
+	}
 
 	commentRepo := commentRepository.NewCommentRepo(db, zapSugar)
 	commentUse := commentUsecase.NewCommentUsecase(commentRepo, zapSugar)
 
 	service := grpcCreator.NewGrpcCreatorHandler(creatorUse, postUse, attachmentUse, subscriptionUse, commentUse)
 
+	ledgerInterval, err := creatorJob.GetLedgerCheckInterval()
+	if err != nil {
+		return err
+	}
+	ledgerJob := creatorJob.NewLedgerJob(creatorUse, ledgerInterval, zapSugar)
+	go ledgerJob.Run(context.Background())
+
+	payoutInterval, err := creatorJob.GetPayoutRetryInterval()
+	if err != nil {
+		return err
+	}
+	notifApp := notificationUsecase.SetupFirebase(context.Background(), zapSugar)
+	payoutJob := creatorJob.NewPayoutJob(creatorUse, notifApp, payoutInterval, zapSugar)
+	go payoutJob.Run(context.Background())
+
+	scheduleInterval, err := creatorJob.GetPayoutScheduleInterval()
+	if err != nil {
+		return err
+	}
+	scheduleJob := creatorJob.NewPayoutScheduleJob(creatorUse, notifApp, scheduleInterval, zapSugar)
+	go scheduleJob.Run(context.Background())
+
+	scanInterval, err := attachmentJob.GetScanInterval()
+	if err != nil {
+		return err
+	}
+	scanJob := attachmentJob.NewScanJob(attachmentUse, notifApp, scanInterval, zapSugar)
+	go scanJob.Run(context.Background())
+
 	srv, ok := net.Listen("tcp", ":8030")
 	if ok != nil {
 		log.Fatalln("can't listen port", err)
@@ -109,3 +178,33 @@ func run() error {
 	fmt.Print("creator running on: ", srv.Addr())
 	return server.Serve(srv)
 }
+
+// getPayoutProvider выбирает провайдера выплат по PAYOUT_PROVIDER: yoomoney (по умолчанию) или fake для локального запуска
+func getPayoutProvider(logger *zap.SugaredLogger) (payout.PayoutProvider, error) {
+	switch provider := os.Getenv("PAYOUT_PROVIDER"); provider {
+	case "", "yoomoney":
+		paymentToken, flag := os.LookupEnv("PAYMENT_TOKEN")
+		if !flag {
+			return nil, errors.New("no payment token")
+		}
+		return yoomoney.NewProvider(paymentToken, &http.Client{Timeout: 30 * time.Second}, logger), nil
+	case "fake":
+		return fake.NewProvider(), nil
+	default:
+		return nil, errors.New("unknown payout provider " + provider)
+	}
+}
+
+// getDefaultCommissionRate читает общую ставку комиссии из COMMISSION_RATE_BP в сотых долях процента (1000 - 10%).
+// Без переменной ставки в базе не меняются.
+func getDefaultCommissionRate() (int64, bool, error) {
+	value, ok := os.LookupEnv("COMMISSION_RATE_BP")
+	if !ok || len(value) == 0 {
+		return 0, false, nil
+	}
+	rateBP, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("COMMISSION_RATE_BP: %w", err)
+	}
+	return rateBP, true, nil
+}
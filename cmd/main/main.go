@@ -3,16 +3,26 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	generatedAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/grpc/generated"
 	authDelivery "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/http"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
 	commentDelivery "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/comment/delivery/http"
 	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
 	creatorDelivery "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/http"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	mediaDelivery "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media/delivery/http"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/middleware"
 	notificationUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/usecase"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payment"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payment/fake"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payment/yoomoney"
 	postDelivery "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/post/delivery/http"
 	subscriptionDelivery "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/subscription/delivery/http"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload"
+	uploadDelivery "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload/delivery/http"
+	uploadJob "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload/delivery/job"
 	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
 	userDelivery "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/http"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
@@ -25,6 +35,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 )
 
@@ -98,24 +109,52 @@ func run() error {
 		log.Fatalf("cant connect to session grpc")
 	}
 
+	paymentGateway, err := getPaymentGateway()
+	if err != nil {
+		return err
+	}
+
+	blobStore, err := blob.NewBlobStoreFromEnv()
+	if err != nil {
+		return err
+	}
+
+	mediaSigner, err := media.NewURLSignerFromEnv()
+	if err != nil {
+		return err
+	}
+
+	uploadStore, err := upload.NewStoreFromEnv(blobStore)
+	if err != nil {
+		return err
+	}
+	cleanupInterval, err := uploadJob.GetCleanupInterval()
+	if err != nil {
+		return err
+	}
+
 	notifApp := notificationUsecase.SetupFirebase(context.Background(), zapSugar)
 	authClient := generatedAuth.NewAuthServiceClient(authConn)
 	userClient := generatedUser.NewUserServiceClient(userConn)
 	creatorClient := generatedCreator.NewCreatorServiceClient(creatorConn)
 
 	authHandler := authDelivery.NewAuthHandler(authClient, zapSugar)
-	userHandler := userDelivery.NewUserHandler(userClient, authClient, notifApp, zapSugar)
-	creatorHandler := creatorDelivery.NewCreatorHandler(creatorClient, authClient, notifApp, zapSugar)
-	postHandler := postDelivery.NewPostHandler(authClient, creatorClient, zapSugar, notifApp)
+	userHandler := userDelivery.NewUserHandler(userClient, authClient, notifApp, paymentGateway, blobStore, zapSugar)
+	creatorHandler := creatorDelivery.NewCreatorHandler(creatorClient, authClient, notifApp, blobStore, zapSugar)
+	postHandler := postDelivery.NewPostHandler(authClient, creatorClient, zapSugar, notifApp, blobStore, uploadStore)
 	subscriptionHandler := subscriptionDelivery.NewSubscriptionHandler(authClient, creatorClient, userClient, zapSugar)
 	commentHandler := commentDelivery.NewCommentHandler(authClient, userClient, creatorClient, zapSugar)
+	mediaHandler := mediaDelivery.NewMediaHandler(creatorClient, blobStore, mediaSigner, zapSugar)
+	uploadHandler := uploadDelivery.NewUploadHandler(authClient, creatorClient, uploadStore, zapSugar)
+
+	go uploadJob.NewCleanupJob(uploadStore, cleanupInterval, zapSugar).Run(context.Background())
 
 	r1 := mux.NewRouter()
 	r1.HandleFunc("/payment", userHandler.Payment).Methods(http.MethodPost, http.MethodOptions)
 
 	r := r1.PathPrefix("/api").Subrouter()
 
-	r.Use(middleware.CORSMiddleware)
+	r.Use(middleware.NewCORSMiddleware(strings.TrimSuffix(uploadDelivery.UploadPath, "/")))
 
 	logMw := middleware.NewLoggerMiddleware(zapSugar)
 	r.Use(logMw.LogRequest)
@@ -148,6 +187,14 @@ func run() error {
 		user.HandleFunc("/follows", userHandler.UserFollows).Methods(http.MethodOptions, http.MethodGet)
 		user.HandleFunc("/subscribeToNotifications/{creator-uuid}", userHandler.SubscribeUserToNotifications).Methods(http.MethodOptions, http.MethodPut)
 		user.HandleFunc("/unsubscribeFromNotifications/{creator-uuid}", userHandler.UnsubscribeUserNotifications).Methods(http.MethodOptions, http.MethodPut)
+		user.HandleFunc("/subscribeToPersonalNotifications", userHandler.SubscribeToPersonalNotifications).Methods(http.MethodOptions, http.MethodPut)
+		user.HandleFunc("/unsubscribeFromPersonalNotifications", userHandler.UnsubscribeFromPersonalNotifications).Methods(http.MethodOptions, http.MethodPut)
+		user.HandleFunc("/donate/{creator-uuid}", userHandler.AddDonateInfo).Methods(http.MethodPost, http.MethodOptions, http.MethodGet)
+		user.HandleFunc("/gift/redeem", userHandler.RedeemGift).Methods(http.MethodPut, http.MethodOptions, http.MethodGet)
+		user.HandleFunc("/gift/{sub-uuid}", userHandler.AddGiftInfo).Methods(http.MethodPost, http.MethodOptions, http.MethodGet)
+		user.HandleFunc("/gifts/{gift-uuid}", userHandler.GetGift).Methods(http.MethodOptions, http.MethodGet)
+		user.HandleFunc("/payments", userHandler.UserPayments).Methods(http.MethodOptions, http.MethodGet)
+		user.HandleFunc("/payments/{payment-uuid}/receipt", userHandler.PaymentReceipt).Methods(http.MethodOptions, http.MethodGet)
 	}
 
 	creator := r.PathPrefix("/creator").Subrouter()
@@ -156,6 +203,8 @@ func run() error {
 		creator.HandleFunc("/search/{keyword}", creatorHandler.FindCreator).Methods(http.MethodGet, http.MethodOptions)
 		creator.HandleFunc("/page/{creator-uuid}", creatorHandler.GetPage).Methods(http.MethodGet, http.MethodOptions)
 		creator.HandleFunc("/aim/create", creatorHandler.CreateAim).Methods(http.MethodPost, http.MethodOptions)
+		creator.HandleFunc("/aim/{aim-uuid}", creatorHandler.UpdateAim).Methods(http.MethodPut, http.MethodOptions)
+		creator.HandleFunc("/aims/{creator-uuid}", creatorHandler.CreatorAims).Methods(http.MethodGet, http.MethodOptions)
 		creator.HandleFunc("/updateData", creatorHandler.UpdateCreatorData).Methods(http.MethodPut, http.MethodOptions, http.MethodGet)
 		creator.HandleFunc("/updateProfilePhoto", creatorHandler.UpdateProfilePhoto).Methods(http.MethodPut, http.MethodOptions, http.MethodGet)
 		creator.HandleFunc("/deleteProfilePhoto/{image-uuid}", creatorHandler.DeleteProfilePhoto).Methods(http.MethodDelete, http.MethodOptions, http.MethodGet)
@@ -166,14 +215,31 @@ func run() error {
 		creator.HandleFunc("/subscribeToNotifications", creatorHandler.SubscribeCreatorToNotifications).Methods(http.MethodOptions, http.MethodPut)
 		creator.HandleFunc("/unsubscribeFromNotifications", creatorHandler.UnsubscribeCreatorNotifications).Methods(http.MethodOptions, http.MethodPut)
 		creator.HandleFunc("/transferMoney", creatorHandler.TransferMoney).Methods(http.MethodOptions, http.MethodPut)
+		creator.HandleFunc("/payout/destinations", creatorHandler.PayoutDestinations).Methods(http.MethodOptions, http.MethodGet)
+		creator.HandleFunc("/payout/destinations", creatorHandler.AddPayoutDestination).Methods(http.MethodOptions, http.MethodPost)
+		creator.HandleFunc("/payout/destinations/{destination-uuid}", creatorHandler.DeletePayoutDestination).Methods(http.MethodOptions, http.MethodDelete)
+		creator.HandleFunc("/payout/schedule", creatorHandler.GetPayoutSchedule).Methods(http.MethodOptions, http.MethodGet)
+		creator.HandleFunc("/payout/schedule", creatorHandler.SetPayoutSchedule).Methods(http.MethodOptions, http.MethodPut)
+		creator.HandleFunc("/payout/schedule", creatorHandler.DeletePayoutSchedule).Methods(http.MethodOptions, http.MethodDelete)
+		creator.HandleFunc("/payout/{payout-uuid}", creatorHandler.GetPayout).Methods(http.MethodOptions, http.MethodGet)
 		creator.HandleFunc("/balance", creatorHandler.GetBalance).Methods(http.MethodOptions, http.MethodGet)
+		creator.HandleFunc("/storage", creatorHandler.Storage).Methods(http.MethodOptions, http.MethodGet)
+		creator.HandleFunc("/ledger", creatorHandler.Ledger).Methods(http.MethodOptions, http.MethodGet)
+		creator.HandleFunc("/donations", creatorHandler.Donations).Methods(http.MethodOptions, http.MethodGet)
+		creator.HandleFunc("/supporters/{creator-uuid}", creatorHandler.TopSupporters).Methods(http.MethodOptions, http.MethodGet)
 
 	}
+	admin := r.PathPrefix("/admin").Subrouter()
+	{
+		admin.HandleFunc("/commission", creatorHandler.CreateCommissionRate).Methods(http.MethodOptions, http.MethodPost)
+		admin.HandleFunc("/commission/{rate-uuid}/end", creatorHandler.EndCommissionRate).Methods(http.MethodOptions, http.MethodPut)
+	}
 	post := r.PathPrefix("/post").Subrouter()
 	{
 		post.HandleFunc("/create", postHandler.CreatePost).Methods(http.MethodPost, http.MethodOptions, http.MethodGet)
 		post.HandleFunc("/edit/{post-uuid}", postHandler.EditPost).Methods(http.MethodPut, http.MethodOptions, http.MethodGet)
 		post.HandleFunc("/addAttach/{post-uuid}", postHandler.AddAttach).Methods(http.MethodPost, http.MethodOptions, http.MethodGet)
+		post.HandleFunc("/addUpload/{post-uuid}/{upload-uuid}", postHandler.AddUpload).Methods(http.MethodPost, http.MethodOptions, http.MethodGet)
 		post.HandleFunc("/deleteAttach/{post-uuid}", postHandler.DeleteAttach).Methods(http.MethodDelete, http.MethodOptions, http.MethodGet)
 		post.HandleFunc("/addLike", postHandler.AddLike).Methods(http.MethodPut, http.MethodOptions)
 		post.HandleFunc("/removeLike", postHandler.RemoveLike).Methods(http.MethodPut, http.MethodOptions)
@@ -195,9 +261,43 @@ func run() error {
 		comment.HandleFunc("/addLike/{comment-uuid}", commentHandler.AddLike).Methods(http.MethodPut, http.MethodOptions)
 		comment.HandleFunc("/removeLike/{comment-uuid}", commentHandler.RemoveLike).Methods(http.MethodPut, http.MethodOptions)
 	}
+	mediaRouter := r.PathPrefix("/media").Subrouter()
+	{
+		mediaRouter.HandleFunc("/post/{post-uuid}/{attachment-uuid}", mediaHandler.AttachmentURL).Methods(http.MethodGet, http.MethodOptions)
+		mediaRouter.HandleFunc("/attachment/{key:.+}", mediaHandler.Attachment).Methods(http.MethodGet, http.MethodHead, http.MethodOptions)
+		mediaRouter.HandleFunc("/photo/{image-uuid}", mediaHandler.Photo).Methods(http.MethodGet, http.MethodHead, http.MethodOptions)
+		mediaRouter.HandleFunc("/photo/{image-uuid}/{size}", mediaHandler.Photo).Methods(http.MethodGet, http.MethodHead, http.MethodOptions)
+	}
+
+	uploadRouter := r.PathPrefix("/upload").Subrouter()
+	{
+		uploadRouter.HandleFunc("", uploadHandler.Options).Methods(http.MethodOptions)
+		uploadRouter.HandleFunc("", uploadHandler.CreateUpload).Methods(http.MethodPost, http.MethodGet)
+		uploadRouter.HandleFunc("/{upload-uuid}", uploadHandler.Options).Methods(http.MethodOptions)
+		uploadRouter.HandleFunc("/{upload-uuid}", uploadHandler.UploadOffset).Methods(http.MethodHead)
+		uploadRouter.HandleFunc("/{upload-uuid}", uploadHandler.AppendUpload).Methods(http.MethodPatch)
+		uploadRouter.HandleFunc("/{upload-uuid}", uploadHandler.DeleteUpload).Methods(http.MethodDelete)
+	}
 
 	http.Handle("/", r1)
 
 	srv := http.Server{Handler: r1, Addr: ":8000"}
 	return srv.ListenAndServe()
 }
+
+// getPaymentGateway выбирает платёжную систему по PAYMENT_GATEWAY: yoomoney (по умолчанию) или fake для сквозных тестов.
+// Уведомления подписываются секретом PAYMENT_SECRET.
+func getPaymentGateway() (payment.PaymentGateway, error) {
+	paymentSecret, flag := os.LookupEnv("PAYMENT_SECRET")
+	if !flag {
+		return nil, errors.New("no payment secret")
+	}
+	switch gateway := os.Getenv("PAYMENT_GATEWAY"); gateway {
+	case "", "yoomoney":
+		return yoomoney.NewGateway(paymentSecret), nil
+	case "fake":
+		return fake.NewGateway(paymentSecret), nil
+	default:
+		return nil, errors.New("unknown payment gateway " + gateway)
+	}
+}
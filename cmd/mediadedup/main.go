@@ -0,0 +1,70 @@
+// Команда mediadedup переносит файлы вложений, сохранённых до хранения по содержимому, под их контрольную сумму,
+// чтобы одинаковые файлы хранились один раз. Старые файлы удаляются после переноса. Вложения без файлов
+// и с неизвестным типом пропускаются и остаются на старых ключах. Фотографии профилей и обложки переносятся
+// под префикс photo/, откуда их отдаёт публичный адрес фотографий. С флагом -dry-run только печатает,
+// сколько вложений и фотографий осталось перенести. Запускать до mediagc: файлы на старых ключах он считает лишними.
+//
+//	mediadedup -batch 100
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediagc"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
+	_ "github.com/lib/pq"
+	"os"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	batch := flag.Int("batch", 100, "сколько вложений читать за один запрос")
+	dryRun := flag.Bool("dry-run", false, "только посчитать вложения, которые нужно перенести")
+	flag.Parse()
+	if *batch <= 0 {
+		return fmt.Errorf("wrong batch size %d", *batch)
+	}
+
+	str, err := utils.GetConnectionString()
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open("postgres", str)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	blobStore, err := blob.NewBlobStoreFromEnv()
+	if err != nil {
+		return err
+	}
+	mediaTypes, err := media.NewTypeRegistryFromEnv()
+	if err != nil {
+		return err
+	}
+
+	moved, skipped, err := mediagc.Deduplicate(context.Background(), db, blobStore, mediaTypes, *batch, *dryRun)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("attachment: moved %d, skipped %d\n", moved, skipped)
+
+	photos, err := mediagc.MovePhotos(context.Background(), db, blobStore, *dryRun)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("photo: moved %d\n", photos)
+	return nil
+}
@@ -0,0 +1,81 @@
+// Команда mediagc сверяет файлы в хранилище с вложениями, фотографиями профилей и обложками в базе
+// и печатает отчёт в формате JSON: файлы, на которые ничего не ссылается, и записи, файлов которых нет.
+// Файлы без записей удаляются, если не задан -dry-run. Файлы новее -grace не трогаются,
+// чтобы не удалить вложение, запись о котором ещё не сохранена.
+//
+//	mediagc -dry-run -grace 24h
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	mediaGCRepository "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediagc/repo"
+	mediaGCUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediagc/usecase"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
+	_ "github.com/lib/pq"
+	"github.com/mailru/easyjson"
+	"go.uber.org/zap"
+	"os"
+	"time"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	dryRun := flag.Bool("dry-run", false, "только напечатать отчёт, ничего не удаляя")
+	grace := flag.Duration("grace", 24*time.Hour, "не трогать файлы, сохранённые позже, чем столько времени назад")
+	flag.Parse()
+
+	logger, err := utils.FileLogger("/var/log/mediagc.log")
+	if err != nil {
+		return err
+	}
+
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}(logger)
+
+	zapSugar := logger.Sugar()
+
+	str, err := utils.GetConnectionString()
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open("postgres", str)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	blobStore, err := blob.NewBlobStoreFromEnv()
+	if err != nil {
+		return err
+	}
+	mediaTypes, err := media.NewTypeRegistryFromEnv()
+	if err != nil {
+		return err
+	}
+
+	mediaGCRepo := mediaGCRepository.NewMediaGCRepo(db, zapSugar)
+	mediaGCUse := mediaGCUsecase.NewMediaGCUsecase(mediaGCRepo, blobStore, mediaTypes, zapSugar)
+
+	report, err := mediaGCUse.Collect(context.Background(), *grace, *dryRun)
+	if err != nil {
+		return err
+	}
+	_, err = easyjson.MarshalToWriter(report, os.Stdout)
+	return err
+}
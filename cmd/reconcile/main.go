@@ -0,0 +1,103 @@
+// Команда reconcile сверяет историю операций ЮMoney с платежами и выплатами в базе и печатает
+// расхождения в формате JSON. С флагом -fix входящие платежи и возвраты переводом, которых нет в базе, проводятся заново.
+//
+//	reconcile -from 2023-05-01T00:00:00Z -to 2023-05-02T00:00:00Z -fix
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payment/yoomoney"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/reconciliation"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/reconciliation/replayer"
+	reconciliationRepository "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/reconciliation/repo"
+	reconciliationUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/reconciliation/usecase"
+	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
+	_ "github.com/lib/pq"
+	"github.com/mailru/easyjson"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"net/http"
+	"os"
+	"time"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	to := time.Now()
+	fromFlag := flag.String("from", to.Add(-24*time.Hour).Format(time.RFC3339), "начало периода, RFC3339")
+	toFlag := flag.String("to", to.Format(time.RFC3339), "конец периода, RFC3339")
+	fix := flag.Bool("fix", false, "провести заново входящие платежи и возвраты, которых нет в базе")
+	userAddr := flag.String("user", "user:8020", "адрес сервиса пользователей, нужен для -fix")
+	flag.Parse()
+
+	from, err := time.Parse(time.RFC3339, *fromFlag)
+	if err != nil {
+		return err
+	}
+	if to, err = time.Parse(time.RFC3339, *toFlag); err != nil {
+		return err
+	}
+
+	logger, err := utils.FileLogger("/var/log/reconcile.log")
+	if err != nil {
+		return err
+	}
+
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}(logger)
+
+	zapSugar := logger.Sugar()
+
+	str, err := utils.GetConnectionString()
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open("postgres", str)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	paymentToken, ok := os.LookupEnv("PAYMENT_TOKEN")
+	if !ok {
+		return errors.New("no payment token")
+	}
+	history := yoomoney.NewHistory(paymentToken, &http.Client{Timeout: 30 * time.Second}, zapSugar)
+
+	var paymentReplayer reconciliation.PaymentReplayer
+	if *fix {
+		userConn, err := grpc.Dial(*userAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return err
+		}
+		defer userConn.Close()
+		paymentReplayer = replayer.NewReplayer(generatedUser.NewUserServiceClient(userConn))
+	}
+
+	reconciliationRepo := reconciliationRepository.NewReconciliationRepo(db, zapSugar)
+	reconciliationUse := reconciliationUsecase.NewReconciliationUsecase(reconciliationRepo, history, paymentReplayer, zapSugar)
+
+	report, err := reconciliationUse.Reconcile(context.Background(), from, to, *fix)
+	if err != nil {
+		return err
+	}
+	_, err = easyjson.MarshalToWriter(report, os.Stdout)
+	return err
+}
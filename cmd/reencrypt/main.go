@@ -0,0 +1,58 @@
+// Команда reencrypt перешифровывает колонки с персональными данными активным ключом из ENCRYPTION_ACTIVE_KEY
+// и шифрует значения, записанные до включения шифрования. После ротации ключа команду нужно запустить,
+// прежде чем убирать старый ключ из ENCRYPTION_KEYS. С флагом -dry-run только печатает, сколько строк осталось.
+//
+//	reencrypt -batch 500
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/encryption"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
+	_ "github.com/lib/pq"
+	"os"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	batch := flag.Int("batch", 500, "сколько строк перешифровывать за один запрос")
+	dryRun := flag.Bool("dry-run", false, "только посчитать строки, которые нужно перешифровать")
+	flag.Parse()
+	if *batch <= 0 {
+		return fmt.Errorf("wrong batch size %d", *batch)
+	}
+
+	keyring, err := encryption.NewKeyringFromEnv()
+	if err != nil {
+		return err
+	}
+
+	str, err := utils.GetConnectionString()
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open("postgres", str)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for _, column := range encryption.PIIColumns {
+		count, err := encryption.Reencrypt(context.Background(), db, keyring, column, *batch, *dryRun)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s.%s: %d\n", column.Table, column.Name, count)
+	}
+	return nil
+}
@@ -1,11 +1,18 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/middleware"
+	notificationUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/usecase"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout/fake"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout/yoomoney"
 	grpcUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc"
 	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
+	userJob "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/job"
 	userRepository "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/repo"
 	userUsecase "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/usecase"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
@@ -57,10 +64,23 @@ func run() error {
 	db.SetMaxIdleConns(25)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
+	refundProvider, err := getRefundProvider(zapSugar)
+	if err != nil {
+		return err
+	}
+
 	userRepo := userRepository.NewUserRepo(db, zapSugar)
-	userUse := userUsecase.NewUserUsecase(userRepo, zapSugar)
+	userUse := userUsecase.NewUserUsecase(userRepo, refundProvider, zapSugar)
 	service := grpcUser.NewGrpcUserHandler(userUse)
 
+	expirationConfig, err := userJob.GetExpirationConfig()
+	if err != nil {
+		return err
+	}
+	notifApp := notificationUsecase.SetupFirebase(context.Background(), zapSugar)
+	expirationJob := userJob.NewExpirationJob(userUse, notifApp, expirationConfig, zapSugar)
+	go expirationJob.Run(context.Background())
+
 	srv, ok := net.Listen("tcp", ":8020")
 	if ok != nil {
 		log.Fatalln("can't listen port", err)
@@ -89,3 +109,19 @@ func run() error {
 	fmt.Print("user running on: ", srv.Addr())
 	return server.Serve(srv)
 }
+
+// getRefundProvider выбирает, через кого возвращать платежи, по PAYOUT_PROVIDER: yoomoney (по умолчанию) или fake для локального запуска
+func getRefundProvider(logger *zap.SugaredLogger) (payout.RefundProvider, error) {
+	switch provider := os.Getenv("PAYOUT_PROVIDER"); provider {
+	case "", "yoomoney":
+		paymentToken, flag := os.LookupEnv("PAYMENT_TOKEN")
+		if !flag {
+			return nil, errors.New("no payment token")
+		}
+		return yoomoney.NewProvider(paymentToken, &http.Client{Timeout: 30 * time.Second}, logger), nil
+	case "fake":
+		return fake.NewProvider(), nil
+	default:
+		return nil, errors.New("unknown payout provider " + provider)
+	}
+}
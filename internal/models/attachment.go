@@ -1,9 +1,14 @@
 package models
 
 import (
+	"encoding/json"
 	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
 	"github.com/google/uuid"
 	"io"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 const (
@@ -12,13 +17,44 @@ const (
 	MaxFiles    = 10
 	FolderPath  = "/images/"
 	//FolderPath = "./images"
+
+	MaxAltTextLength  = 1000
+	MaxCaptionLength  = 2000
+	MaxFilenameLength = 255
+
+	// MaxDownloadKeyLength - ограничение на длину подписи ссылки на скачивание, по которой засчитывается скачивание
+	MaxDownloadKeyLength = 128
+
+	// BlobHold - сколько файл с тем же содержимым не удаляется, пока загрузка сохраняет вложение, которое на него сошлётся
+	BlobHold = time.Hour
 )
 
 // easyjson -all ./internal/models/attachment.go
 
 type Attachment struct {
-	Id   uuid.UUID `json:"id"`
-	Type string    `json:"type"`
+	Id         uuid.UUID   `json:"id"`
+	Type       string      `json:"type"`
+	Renditions []Rendition `json:"renditions,omitempty"`
+	// BlobId - SHA-256 содержимого, под которым файл лежит в хранилище; одинаковые файлы разных вложений хранятся один раз
+	BlobId string `json:"-"`
+	AttachmentMetadata
+}
+
+// AttachmentMetadata - сведения о файле, извлечённые при загрузке, и подписи автора.
+// Width и Height есть у изображений и видео, Duration (в секундах) - у аудио и видео, если их удалось прочитать из файла.
+type AttachmentMetadata struct {
+	Size     int64   `json:"size"`
+	Checksum string  `json:"checksum,omitempty"`
+	Filename string  `json:"filename,omitempty"`
+	Width    int     `json:"width,omitempty"`
+	Height   int     `json:"height,omitempty"`
+	Duration float64 `json:"duration,omitempty"`
+	AltText  string  `json:"alt_text,omitempty"`
+	Caption  string  `json:"caption,omitempty"`
+	// Downloads - сколько раз файл скачивали, видно только автору поста
+	Downloads int64 `json:"downloads,omitempty"`
+	// ScanStatus - результат проверки файла на вредоносное содержимое
+	ScanStatus string `json:"scan_status,omitempty"`
 }
 
 //easyjson:skip
@@ -26,6 +62,62 @@ type AttachmentData struct {
 	Id   uuid.UUID
 	Data io.Reader
 	Type string
+	// Renditions - размеры изображения, уже сохранённые в хранилище
+	Renditions []Rendition
+	BlobId     string
+	AttachmentMetadata
+}
+
+func (attach *AttachmentData) ToAttachment() Attachment {
+	return Attachment{
+		Id:                 attach.Id,
+		Type:               attach.Type,
+		Renditions:         attach.Renditions,
+		BlobId:             attach.BlobId,
+		AttachmentMetadata: attach.AttachmentMetadata,
+	}
+}
+
+// StorageId - id, по которому строятся ключи файлов вложения в хранилище.
+// Вложения, сохранённые до хранения по содержимому и ещё не перенесённые mediadedup, лежат под своим id.
+func (attachment *Attachment) StorageId() string {
+	if len(attachment.BlobId) != 0 {
+		return attachment.BlobId
+	}
+	return attachment.Id.String()
+}
+
+// IsValid проверяет подписи, которые задаёт автор
+func (metadata *AttachmentMetadata) IsValid() bool {
+	return utf8.RuneCountInString(metadata.AltText) <= MaxAltTextLength &&
+		utf8.RuneCountInString(metadata.Caption) <= MaxCaptionLength
+}
+
+// CleanFilename оставляет от имени файла, присланного клиентом, только последний элемент пути не длиннее MaxFilenameLength байт
+func CleanFilename(filename string) string {
+	filename = strings.TrimSpace(strings.ReplaceAll(filename, "\\", "/"))
+	if filename == "" {
+		return ""
+	}
+	filename = path.Base(filename)
+	if filename == "." || filename == "/" || filename == ".." {
+		return ""
+	}
+	for len(filename) > MaxFilenameLength {
+		_, size := utf8.DecodeLastRuneInString(filename)
+		filename = filename[:len(filename)-size]
+	}
+	return filename
+}
+
+// ParseAttachmentMetadata разбирает сведения о вложении, собранные запросом в json; пустое значение - вложение без сведений
+func ParseAttachmentMetadata(data string) (AttachmentMetadata, error) {
+	var metadata AttachmentMetadata
+	if len(data) == 0 || data == "null" {
+		return metadata, nil
+	}
+	err := json.Unmarshal([]byte(data), &metadata)
+	return metadata, err
 }
 
 func (attachment *Attachment) AttachToModel(attach *generatedCreator.Attachment) error {
@@ -36,5 +128,54 @@ func (attachment *Attachment) AttachToModel(attach *generatedCreator.Attachment)
 
 	attachment.Id = attachID
 	attachment.Type = attach.Type
+	attachment.BlobId = attach.BlobID
+	attachment.AttachmentMetadata = AttachmentMetadata{
+		Size:       attach.Size,
+		Checksum:   attach.Checksum,
+		Filename:   attach.Filename,
+		Width:      int(attach.Width),
+		Height:     int(attach.Height),
+		Duration:   attach.Duration,
+		AltText:    attach.AltText,
+		Caption:    attach.Caption,
+		Downloads:  attach.Downloads,
+		ScanStatus: attach.ScanStatus,
+	}
+	attachment.Renditions = nil
+	for _, rendition := range attach.Renditions {
+		attachment.Renditions = append(attachment.Renditions, Rendition{
+			Name:        rendition.Name,
+			Width:       int(rendition.Width),
+			Height:      int(rendition.Height),
+			ContentType: rendition.ContentType,
+		})
+	}
 	return nil
 }
+
+func (attachment *Attachment) ToProto() *generatedCreator.Attachment {
+	attach := &generatedCreator.Attachment{
+		ID:         attachment.Id.String(),
+		Type:       attachment.Type,
+		BlobID:     attachment.BlobId,
+		Size:       attachment.Size,
+		Checksum:   attachment.Checksum,
+		Filename:   attachment.Filename,
+		Width:      int64(attachment.Width),
+		Height:     int64(attachment.Height),
+		Duration:   attachment.Duration,
+		AltText:    attachment.AltText,
+		Caption:    attachment.Caption,
+		Downloads:  attachment.Downloads,
+		ScanStatus: attachment.ScanStatus,
+	}
+	for _, rendition := range attachment.Renditions {
+		attach.Renditions = append(attach.Renditions, &generatedCreator.Rendition{
+			Name:        rendition.Name,
+			Width:       int64(rendition.Width),
+			Height:      int64(rendition.Height),
+			ContentType: rendition.ContentType,
+		})
+	}
+	return attach
+}
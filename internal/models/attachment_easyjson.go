@@ -17,7 +17,136 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *Attachment) {
+func easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *AttachmentMetadata) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "size":
+			out.Size = int64(in.Int64())
+		case "checksum":
+			out.Checksum = string(in.String())
+		case "filename":
+			out.Filename = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "duration":
+			out.Duration = float64(in.Float64())
+		case "alt_text":
+			out.AltText = string(in.String())
+		case "caption":
+			out.Caption = string(in.String())
+		case "downloads":
+			out.Downloads = int64(in.Int64())
+		case "scan_status":
+			out.ScanStatus = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in AttachmentMetadata) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Size))
+	}
+	if in.Checksum != "" {
+		const prefix string = ",\"checksum\":"
+		out.RawString(prefix)
+		out.String(string(in.Checksum))
+	}
+	if in.Filename != "" {
+		const prefix string = ",\"filename\":"
+		out.RawString(prefix)
+		out.String(string(in.Filename))
+	}
+	if in.Width != 0 {
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	if in.Height != 0 {
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	if in.Duration != 0 {
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Duration))
+	}
+	if in.AltText != "" {
+		const prefix string = ",\"alt_text\":"
+		out.RawString(prefix)
+		out.String(string(in.AltText))
+	}
+	if in.Caption != "" {
+		const prefix string = ",\"caption\":"
+		out.RawString(prefix)
+		out.String(string(in.Caption))
+	}
+	if in.Downloads != 0 {
+		const prefix string = ",\"downloads\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Downloads))
+	}
+	if in.ScanStatus != "" {
+		const prefix string = ",\"scan_status\":"
+		out.RawString(prefix)
+		out.String(string(in.ScanStatus))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AttachmentMetadata) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AttachmentMetadata) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AttachmentMetadata) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AttachmentMetadata) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
+func easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels1(in *jlexer.Lexer, out *Attachment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -42,6 +171,49 @@ func easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels(in *jl
 			}
 		case "type":
 			out.Type = string(in.String())
+		case "renditions":
+			if in.IsNull() {
+				in.Skip()
+				out.Renditions = nil
+			} else {
+				in.Delim('[')
+				if out.Renditions == nil {
+					if !in.IsDelim(']') {
+						out.Renditions = make([]Rendition, 0, 1)
+					} else {
+						out.Renditions = []Rendition{}
+					}
+				} else {
+					out.Renditions = (out.Renditions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Rendition
+					(v1).UnmarshalEasyJSON(in)
+					out.Renditions = append(out.Renditions, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "size":
+			out.Size = int64(in.Int64())
+		case "checksum":
+			out.Checksum = string(in.String())
+		case "filename":
+			out.Filename = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "duration":
+			out.Duration = float64(in.Float64())
+		case "alt_text":
+			out.AltText = string(in.String())
+		case "caption":
+			out.Caption = string(in.String())
+		case "downloads":
+			out.Downloads = int64(in.Int64())
+		case "scan_status":
+			out.ScanStatus = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -52,7 +224,7 @@ func easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels(in *jl
 		in.Consumed()
 	}
 }
-func easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in Attachment) {
+func easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels1(out *jwriter.Writer, in Attachment) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -66,29 +238,93 @@ func easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels(out *j
 		out.RawString(prefix)
 		out.String(string(in.Type))
 	}
+	if len(in.Renditions) != 0 {
+		const prefix string = ",\"renditions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Renditions {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	if in.Checksum != "" {
+		const prefix string = ",\"checksum\":"
+		out.RawString(prefix)
+		out.String(string(in.Checksum))
+	}
+	if in.Filename != "" {
+		const prefix string = ",\"filename\":"
+		out.RawString(prefix)
+		out.String(string(in.Filename))
+	}
+	if in.Width != 0 {
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	if in.Height != 0 {
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	if in.Duration != 0 {
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Duration))
+	}
+	if in.AltText != "" {
+		const prefix string = ",\"alt_text\":"
+		out.RawString(prefix)
+		out.String(string(in.AltText))
+	}
+	if in.Caption != "" {
+		const prefix string = ",\"caption\":"
+		out.RawString(prefix)
+		out.String(string(in.Caption))
+	}
+	if in.Downloads != 0 {
+		const prefix string = ",\"downloads\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Downloads))
+	}
+	if in.ScanStatus != "" {
+		const prefix string = ",\"scan_status\":"
+		out.RawString(prefix)
+		out.String(string(in.ScanStatus))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Attachment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Attachment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+	easyjson76362c5bEncodeGithubComGoParkMailRu202314from5InternalModels1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Attachment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Attachment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+	easyjson76362c5bDecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
 }
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// BlobInfo - сведения о файле в хранилище
+type BlobInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModifiedAt  time.Time
+}
+
+// AttachmentKey - ключ файла вложения в хранилище: {id}.{расширение}
+func AttachmentKey(id string, extension string) string {
+	return id + "." + extension
+}
+
+// PhotoPrefix - фотографии профилей и обложки лежат отдельно от вложений:
+// по публичному адресу фотографии нельзя получить файл вложения
+const PhotoPrefix = "photo/"
+
+// PhotoStorageId - id, по которому строятся ключи размеров фотографии: photo/{id}/{размер}.jpg
+func PhotoStorageId(id string) string {
+	return PhotoPrefix + id
+}
+
+// PhotoKey - ключ фотографии профиля или обложки, сохранённой одним файлом до обработки изображений
+func PhotoKey(id string) string {
+	return PhotoStorageId(id) + ".jpg"
+}
+
+// IsValidBlobKey проверяет, что ключ не выходит за корень хранилища: без пустых частей, "." и ".."
+func IsValidBlobKey(key string) bool {
+	if len(key) == 0 || strings.Contains(key, "\\") {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return false
+		}
+	}
+	return true
+}
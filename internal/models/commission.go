@@ -0,0 +1,77 @@
+package models
+
+// easyjson -all ./internal/models/commission.go
+
+import (
+	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	// MaxCommissionRateBP - ставка 100%, ставки хранятся в сотых долях процента
+	MaxCommissionRateBP        = 10000
+	CommissionDescriptionLimit = 200
+	DefaultCommissionRateTitle = "Базовая ставка платформы"
+)
+
+// CommissionRate - ставка комиссии платформы в сотых долях процента (1000 - 10%) на период.
+// Ставка без CreatorId действует для всех авторов, без EndsAt - бессрочно.
+type CommissionRate struct {
+	Id          uuid.UUID  `json:"rate_id"`
+	CreatorId   uuid.UUID  `json:"creator_id"`
+	RateBP      int64      `json:"rate_bp"`
+	StartsAt    time.Time  `json:"starts_at"`
+	EndsAt      *time.Time `json:"ends_at,omitempty"`
+	Description string     `json:"description"`
+}
+
+func (rate *CommissionRate) IsValid() bool {
+	return rate.RateBP >= 0 && rate.RateBP <= MaxCommissionRateBP && !rate.StartsAt.IsZero() &&
+		(rate.EndsAt == nil || rate.EndsAt.After(rate.StartsAt)) && len([]rune(rate.Description)) <= CommissionDescriptionLimit
+}
+
+func (rate *CommissionRate) ToProto() *generatedCreator.CommissionRate {
+	out := &generatedCreator.CommissionRate{
+		Id:          rate.Id.String(),
+		CreatorID:   rate.CreatorId.String(),
+		RateBP:      rate.RateBP,
+		StartsAt:    rate.StartsAt.Format(time.RFC3339),
+		Description: rate.Description,
+	}
+	if rate.EndsAt != nil {
+		out.EndsAt = rate.EndsAt.Format(time.RFC3339)
+	}
+	return out
+}
+
+// ProtoCommissionRateToModel разбирает ставку; пустые Id и CreatorID - новая и общая ставка,
+// пустой StartsAt - ставка с момента создания
+func (rate *CommissionRate) ProtoCommissionRateToModel(in *generatedCreator.CommissionRate) error {
+	var err error
+	if len(in.Id) != 0 {
+		if rate.Id, err = uuid.Parse(in.Id); err != nil {
+			return err
+		}
+	}
+	if len(in.CreatorID) != 0 {
+		if rate.CreatorId, err = uuid.Parse(in.CreatorID); err != nil {
+			return err
+		}
+	}
+	if len(in.StartsAt) != 0 {
+		if rate.StartsAt, err = time.Parse(time.RFC3339, in.StartsAt); err != nil {
+			return err
+		}
+	}
+	if len(in.EndsAt) != 0 {
+		endsAt, err := time.Parse(time.RFC3339, in.EndsAt)
+		if err != nil {
+			return err
+		}
+		rate.EndsAt = &endsAt
+	}
+	rate.RateBP = in.RateBP
+	rate.Description = in.Description
+	return nil
+}
@@ -0,0 +1,137 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonB44e6f23DecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *CommissionRate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rate_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "creator_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CreatorId).UnmarshalText(data))
+			}
+		case "rate_bp":
+			out.RateBP = int64(in.Int64())
+		case "starts_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StartsAt).UnmarshalJSON(data))
+			}
+		case "ends_at":
+			if in.IsNull() {
+				in.Skip()
+				out.EndsAt = nil
+			} else {
+				if out.EndsAt == nil {
+					out.EndsAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.EndsAt).UnmarshalJSON(data))
+				}
+			}
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB44e6f23EncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in CommissionRate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rate_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.RawText((in.CreatorId).MarshalText())
+	}
+	{
+		const prefix string = ",\"rate_bp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RateBP))
+	}
+	{
+		const prefix string = ",\"starts_at\":"
+		out.RawString(prefix)
+		out.Raw((in.StartsAt).MarshalJSON())
+	}
+	if in.EndsAt != nil {
+		const prefix string = ",\"ends_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.EndsAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CommissionRate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB44e6f23EncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommissionRate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB44e6f23EncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CommissionRate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB44e6f23DecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommissionRate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB44e6f23DecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
@@ -4,6 +4,8 @@ import (
 	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
 	"github.com/google/uuid"
 	"html"
+	"time"
+	"unicode/utf8"
 )
 
 // easyjson -all ./internal/models/creator.go
@@ -11,6 +13,16 @@ import (
 const (
 	RequestPaymentURL = "https://yoomoney.ru/api/request-payment"
 	ProcessPaymentURL = "https://yoomoney.ru/api/process-payment"
+
+	PaymentStatusSuccess    = "success"
+	PaymentStatusRefused    = "refused"
+	PaymentStatusInProgress = "in_progress"
+
+	AimStatusActive    = "active"
+	AimStatusCompleted = "completed"
+	AimStatusExpired   = "expired"
+
+	AimDescriptionMaxLength = 100
 )
 
 type Creator struct {
@@ -24,13 +36,19 @@ type Creator struct {
 	PostsCount     int64     `json:"posts_count"`
 }
 
+// PaymentResponse - ответ ЮMoney на request-payment и process-payment
 type PaymentResponse struct {
+	Status    string `json:"status"`
+	Error     string `json:"error"`
 	RequestID string `json:"request_id"`
+	// PaymentID - идентификатор проведённого перевода в истории операций, есть только в успешном ответе process-payment
+	PaymentID string `json:"payment_id"`
 }
 
 type CreatorPage struct {
 	CreatorInfo   Creator        `json:"creator_info"`
 	Aim           Aim            `json:"aim"`
+	Aims          []Aim          `json:"aims"`
 	IsMyPage      bool           `json:"is_my_page"`
 	Follows       bool           `json:"follows"`
 	Posts         []Post         `json:"posts"`
@@ -38,10 +56,14 @@ type CreatorPage struct {
 }
 
 type Aim struct {
-	Creator     uuid.UUID `json:"creator_id"`
-	Description string    `json:"description"`
-	MoneyNeeded float32   `json:"money_needed"`
-	MoneyGot    float32   `json:"money_got"`
+	Id          uuid.UUID  `json:"id"`
+	Creator     uuid.UUID  `json:"creator_id"`
+	Description string     `json:"description"`
+	MoneyNeeded Money      `json:"money_needed"`
+	MoneyGot    Money      `json:"money_got"`
+	Deadline    *time.Time `json:"deadline,omitempty"`
+	Position    int64      `json:"position"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
 }
 
 type UpdateCreatorInfo struct {
@@ -50,12 +72,6 @@ type UpdateCreatorInfo struct {
 	CreatorID   uuid.UUID `json:"-"`
 }
 
-type CreatorTransfer struct {
-	Money       float32   `json:"money"`
-	CreatorID   uuid.UUID `json:"-"`
-	PhoneNumber string    `json:"phone_number"`
-}
-
 func (creator *Creator) Sanitize() {
 	creator.Name = html.EscapeString(creator.Name)
 	creator.Description = html.EscapeString(creator.Description)
@@ -65,9 +81,21 @@ func (aim *Aim) Sanitize() {
 	aim.Description = html.EscapeString(aim.Description)
 }
 
+func (aim *Aim) IsValid() bool {
+	return len(aim.Description) > 0 && utf8.RuneCountInString(aim.Description) <= AimDescriptionMaxLength &&
+		aim.MoneyNeeded.IsValid() && aim.Position >= 0 && (aim.Deadline == nil || aim.Deadline.After(time.Now()))
+}
+
+func IsAimStatus(status string) bool {
+	return status == "" || status == AimStatusActive || status == AimStatusCompleted || status == AimStatusExpired
+}
+
 func (creatorPage *CreatorPage) Sanitize() {
 	creatorPage.CreatorInfo.Sanitize()
 	creatorPage.Aim.Sanitize()
+	for i := range creatorPage.Aims {
+		creatorPage.Aims[i].Sanitize()
+	}
 	for i := range creatorPage.Posts {
 		creatorPage.Posts[i].Sanitize()
 	}
@@ -82,12 +110,51 @@ func (aim *Aim) AimToModel(aimProto *generatedCreator.Aim) error {
 		return err
 	}
 
+	if len(aimProto.Id) != 0 {
+		if aim.Id, err = uuid.Parse(aimProto.Id); err != nil {
+			return err
+		}
+	}
+	if len(aimProto.Deadline) != 0 {
+		deadline, err := time.Parse(time.RFC3339, aimProto.Deadline)
+		if err != nil {
+			return err
+		}
+		aim.Deadline = &deadline
+	}
+	if len(aimProto.CompletedAt) != 0 {
+		completedAt, err := time.Parse(time.RFC3339, aimProto.CompletedAt)
+		if err != nil {
+			return err
+		}
+		aim.CompletedAt = &completedAt
+	}
+
 	aim.Creator = creatorID
 	aim.Description = aimProto.Description
-	aim.MoneyNeeded = aimProto.MoneyNeeded
-	aim.MoneyGot = aimProto.MoneyGot
+	aim.MoneyNeeded = MoneyFromProto(aimProto.MoneyNeeded)
+	aim.MoneyGot = MoneyFromProto(aimProto.MoneyGot)
+	aim.Position = aimProto.Position
 	return nil
 }
+
+func (aim *Aim) AimToProto() *generatedCreator.Aim {
+	aimProto := &generatedCreator.Aim{
+		Id:          aim.Id.String(),
+		Creator:     aim.Creator.String(),
+		Description: aim.Description,
+		MoneyNeeded: aim.MoneyNeeded.ToProto(),
+		MoneyGot:    aim.MoneyGot.ToProto(),
+		Position:    aim.Position,
+	}
+	if aim.Deadline != nil {
+		aimProto.Deadline = aim.Deadline.Format(time.RFC3339)
+	}
+	if aim.CompletedAt != nil {
+		aimProto.CompletedAt = aim.CompletedAt.Format(time.RFC3339)
+	}
+	return aimProto
+}
 func (creator *Creator) CreatorToModel(creatorInfo *generatedCreator.Creator) error {
 	creatorPhoto, err := uuid.Parse(creatorInfo.CreatorPhoto)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -109,8 +110,14 @@ func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels1(in *j
 			continue
 		}
 		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "error":
+			out.Error = string(in.String())
 		case "request_id":
 			out.RequestID = string(in.String())
+		case "payment_id":
+			out.PaymentID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -126,10 +133,25 @@ func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels1(out *
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"request_id\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"request_id\":"
+		out.RawString(prefix)
 		out.String(string(in.RequestID))
 	}
+	{
+		const prefix string = ",\"payment_id\":"
+		out.RawString(prefix)
+		out.String(string(in.PaymentID))
+	}
 	out.RawByte('}')
 }
 
@@ -156,80 +178,7 @@ func (v *PaymentResponse) UnmarshalJSON(data []byte) error {
 func (v *PaymentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
 }
-func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels2(in *jlexer.Lexer, out *CreatorTransfer) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "money":
-			out.Money = float32(in.Float32())
-		case "phone_number":
-			out.PhoneNumber = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels2(out *jwriter.Writer, in CreatorTransfer) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"money\":"
-		out.RawString(prefix[1:])
-		out.Float32(float32(in.Money))
-	}
-	{
-		const prefix string = ",\"phone_number\":"
-		out.RawString(prefix)
-		out.String(string(in.PhoneNumber))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v CreatorTransfer) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels2(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreatorTransfer) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels2(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreatorTransfer) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels2(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreatorTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels2(l, v)
-}
-func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(in *jlexer.Lexer, out *CreatorPage) {
+func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels2(in *jlexer.Lexer, out *CreatorPage) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -252,6 +201,29 @@ func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(in *j
 			(out.CreatorInfo).UnmarshalEasyJSON(in)
 		case "aim":
 			(out.Aim).UnmarshalEasyJSON(in)
+		case "aims":
+			if in.IsNull() {
+				in.Skip()
+				out.Aims = nil
+			} else {
+				in.Delim('[')
+				if out.Aims == nil {
+					if !in.IsDelim(']') {
+						out.Aims = make([]Aim, 0, 0)
+					} else {
+						out.Aims = []Aim{}
+					}
+				} else {
+					out.Aims = (out.Aims)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Aim
+					(v1).UnmarshalEasyJSON(in)
+					out.Aims = append(out.Aims, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "is_my_page":
 			out.IsMyPage = bool(in.Bool())
 		case "follows":
@@ -272,9 +244,9 @@ func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(in *j
 					out.Posts = (out.Posts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 Post
-					(v1).UnmarshalEasyJSON(in)
-					out.Posts = append(out.Posts, v1)
+					var v2 Post
+					(v2).UnmarshalEasyJSON(in)
+					out.Posts = append(out.Posts, v2)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -295,9 +267,9 @@ func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(in *j
 					out.Subscriptions = (out.Subscriptions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v2 Subscription
-					(v2).UnmarshalEasyJSON(in)
-					out.Subscriptions = append(out.Subscriptions, v2)
+					var v3 Subscription
+					(v3).UnmarshalEasyJSON(in)
+					out.Subscriptions = append(out.Subscriptions, v3)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -312,7 +284,7 @@ func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(in *j
 		in.Consumed()
 	}
 }
-func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(out *jwriter.Writer, in CreatorPage) {
+func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels2(out *jwriter.Writer, in CreatorPage) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -326,6 +298,22 @@ func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(out *
 		out.RawString(prefix)
 		(in.Aim).MarshalEasyJSON(out)
 	}
+	{
+		const prefix string = ",\"aims\":"
+		out.RawString(prefix)
+		if in.Aims == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Aims {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	{
 		const prefix string = ",\"is_my_page\":"
 		out.RawString(prefix)
@@ -343,11 +331,11 @@ func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(out *
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v3, v4 := range in.Posts {
-				if v3 > 0 {
+			for v6, v7 := range in.Posts {
+				if v6 > 0 {
 					out.RawByte(',')
 				}
-				(v4).MarshalEasyJSON(out)
+				(v7).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -359,11 +347,11 @@ func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(out *
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Subscriptions {
-				if v5 > 0 {
+			for v8, v9 := range in.Subscriptions {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalEasyJSON(out)
+				(v9).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -374,27 +362,27 @@ func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(out *
 // MarshalJSON supports json.Marshaler interface
 func (v CreatorPage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(&w, v)
+	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreatorPage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(w, v)
+	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreatorPage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(&r, v)
+	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreatorPage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(l, v)
+	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels2(l, v)
 }
-func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels4(in *jlexer.Lexer, out *Creator) {
+func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(in *jlexer.Lexer, out *Creator) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -447,7 +435,7 @@ func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels4(in *j
 		in.Consumed()
 	}
 }
-func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels4(out *jwriter.Writer, in Creator) {
+func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(out *jwriter.Writer, in Creator) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -497,27 +485,27 @@ func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels4(out *
 // MarshalJSON supports json.Marshaler interface
 func (v Creator) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels4(&w, v)
+	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Creator) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels4(w, v)
+	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Creator) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels4(&r, v)
+	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Creator) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels4(l, v)
+	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels3(l, v)
 }
-func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels5(in *jlexer.Lexer, out *Aim) {
+func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels4(in *jlexer.Lexer, out *Aim) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -536,6 +524,10 @@ func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels5(in *j
 			continue
 		}
 		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
 		case "creator_id":
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.Creator).UnmarshalText(data))
@@ -543,9 +535,35 @@ func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels5(in *j
 		case "description":
 			out.Description = string(in.String())
 		case "money_needed":
-			out.MoneyNeeded = float32(in.Float32())
+			(out.MoneyNeeded).UnmarshalEasyJSON(in)
 		case "money_got":
-			out.MoneyGot = float32(in.Float32())
+			(out.MoneyGot).UnmarshalEasyJSON(in)
+		case "deadline":
+			if in.IsNull() {
+				in.Skip()
+				out.Deadline = nil
+			} else {
+				if out.Deadline == nil {
+					out.Deadline = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Deadline).UnmarshalJSON(data))
+				}
+			}
+		case "position":
+			out.Position = int64(in.Int64())
+		case "completed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CompletedAt = nil
+			} else {
+				if out.CompletedAt == nil {
+					out.CompletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CompletedAt).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -556,13 +574,18 @@ func easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels5(in *j
 		in.Consumed()
 	}
 }
-func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels5(out *jwriter.Writer, in Aim) {
+func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels4(out *jwriter.Writer, in Aim) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"creator_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
 		out.RawText((in.Creator).MarshalText())
 	}
 	{
@@ -573,12 +596,27 @@ func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels5(out *
 	{
 		const prefix string = ",\"money_needed\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.MoneyNeeded))
+		(in.MoneyNeeded).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"money_got\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.MoneyGot))
+		(in.MoneyGot).MarshalEasyJSON(out)
+	}
+	if in.Deadline != nil {
+		const prefix string = ",\"deadline\":"
+		out.RawString(prefix)
+		out.Raw((*in.Deadline).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Position))
+	}
+	if in.CompletedAt != nil {
+		const prefix string = ",\"completed_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.CompletedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
@@ -586,23 +624,23 @@ func easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels5(out *
 // MarshalJSON supports json.Marshaler interface
 func (v Aim) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels5(&w, v)
+	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Aim) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels5(w, v)
+	easyjson7c25d2a6EncodeGithubComGoParkMailRu202314from5InternalModels4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Aim) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels5(&r, v)
+	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Aim) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels5(l, v)
+	easyjson7c25d2a6DecodeGithubComGoParkMailRu202314from5InternalModels4(l, v)
 }
@@ -0,0 +1,114 @@
+package models
+
+// easyjson -all ./internal/models/donation.go
+
+import (
+	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"html"
+	"time"
+)
+
+const (
+	DefaultDonationsLimit  = 20
+	MaxDonationsLimit      = 100
+	DefaultSupportersLimit = 10
+	MaxSupportersLimit     = 50
+)
+
+type Donation struct {
+	Id          uuid.UUID `json:"id"`
+	UserId      uuid.UUID `json:"user_id,omitempty"`
+	UserName    string    `json:"user_name,omitempty"`
+	UserPhoto   uuid.UUID `json:"user_photo,omitempty"`
+	Money       Money     `json:"money"`
+	Message     string    `json:"message,omitempty"`
+	AimId       uuid.UUID `json:"aim_id,omitempty"`
+	Aim         string    `json:"aim,omitempty"`
+	IsAnonymous bool      `json:"is_anonymous"`
+	Date        time.Time `json:"date"`
+}
+
+type Supporter struct {
+	UserId         uuid.UUID `json:"user_id"`
+	Name           string    `json:"name"`
+	ProfilePhoto   uuid.UUID `json:"profile_photo"`
+	Money          Money     `json:"money"`
+	DonationsCount int64     `json:"donations_count"`
+}
+
+// SupportersPeriodStart returns the beginning of the leaderboard window, zero time for all time.
+func SupportersPeriodStart(period string, now time.Time) (time.Time, bool) {
+	switch period {
+	case "week":
+		return now.AddDate(0, 0, -7), true
+	case "month", "":
+		return now.AddDate(0, -1, 0), true
+	case "year":
+		return now.AddDate(-1, 0, 0), true
+	case "all":
+		return time.Time{}, true
+	}
+	return time.Time{}, false
+}
+
+func (donation *Donation) Sanitize() {
+	donation.UserName = html.EscapeString(donation.UserName)
+	donation.Message = html.EscapeString(donation.Message)
+	donation.Aim = html.EscapeString(donation.Aim)
+}
+
+func (supporter *Supporter) Sanitize() {
+	supporter.Name = html.EscapeString(supporter.Name)
+}
+
+func (donation *Donation) ProtoDonationToModel(in *generatedCreator.Donation) error {
+	id, err := uuid.Parse(in.Id)
+	if err != nil {
+		return err
+	}
+	userID, err := uuid.Parse(in.UserID)
+	if err != nil {
+		return err
+	}
+	userPhoto, err := uuid.Parse(in.UserPhoto)
+	if err != nil {
+		return err
+	}
+	date, err := time.Parse(time.RFC3339, in.Date)
+	if err != nil {
+		return err
+	}
+	aimID, err := uuid.Parse(in.AimID)
+	if err != nil {
+		return err
+	}
+	donation.Id = id
+	donation.UserId = userID
+	donation.UserName = in.UserName
+	donation.UserPhoto = userPhoto
+	donation.Money = MoneyFromProto(in.Money)
+	donation.Message = in.Message
+	donation.AimId = aimID
+	donation.Aim = in.Aim
+	donation.IsAnonymous = in.IsAnonymous
+	donation.Date = date
+	return nil
+}
+
+func (supporter *Supporter) ProtoSupporterToModel(in *generatedCreator.Supporter) error {
+	userID, err := uuid.Parse(in.UserID)
+	if err != nil {
+		return err
+	}
+	photo, err := uuid.Parse(in.ProfilePhoto)
+	if err != nil {
+		return err
+	}
+	supporter.UserId = userID
+	supporter.Name = in.Name
+	supporter.ProfilePhoto = photo
+	supporter.Money = MoneyFromProto(in.Money)
+	supporter.DonationsCount = in.DonationsCount
+	return nil
+}
@@ -0,0 +1,256 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonF464aa0aDecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *Supporter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "profile_photo":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProfilePhoto).UnmarshalText(data))
+			}
+		case "money":
+			(out.Money).UnmarshalEasyJSON(in)
+		case "donations_count":
+			out.DonationsCount = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF464aa0aEncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in Supporter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.UserId).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"profile_photo\":"
+		out.RawString(prefix)
+		out.RawText((in.ProfilePhoto).MarshalText())
+	}
+	{
+		const prefix string = ",\"money\":"
+		out.RawString(prefix)
+		(in.Money).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"donations_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DonationsCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Supporter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF464aa0aEncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Supporter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF464aa0aEncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Supporter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF464aa0aDecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Supporter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF464aa0aDecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
+func easyjsonF464aa0aDecodeGithubComGoParkMailRu202314from5InternalModels1(in *jlexer.Lexer, out *Donation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "user_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		case "user_name":
+			out.UserName = string(in.String())
+		case "user_photo":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserPhoto).UnmarshalText(data))
+			}
+		case "money":
+			(out.Money).UnmarshalEasyJSON(in)
+		case "message":
+			out.Message = string(in.String())
+		case "aim_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AimId).UnmarshalText(data))
+			}
+		case "aim":
+			out.Aim = string(in.String())
+		case "is_anonymous":
+			out.IsAnonymous = bool(in.Bool())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF464aa0aEncodeGithubComGoParkMailRu202314from5InternalModels1(out *jwriter.Writer, in Donation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.RawText((in.UserId).MarshalText())
+	}
+	if in.UserName != "" {
+		const prefix string = ",\"user_name\":"
+		out.RawString(prefix)
+		out.String(string(in.UserName))
+	}
+	if true {
+		const prefix string = ",\"user_photo\":"
+		out.RawString(prefix)
+		out.RawText((in.UserPhoto).MarshalText())
+	}
+	{
+		const prefix string = ",\"money\":"
+		out.RawString(prefix)
+		(in.Money).MarshalEasyJSON(out)
+	}
+	if in.Message != "" {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	if true {
+		const prefix string = ",\"aim_id\":"
+		out.RawString(prefix)
+		out.RawText((in.AimId).MarshalText())
+	}
+	if in.Aim != "" {
+		const prefix string = ",\"aim\":"
+		out.RawString(prefix)
+		out.String(string(in.Aim))
+	}
+	{
+		const prefix string = ",\"is_anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAnonymous))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Donation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF464aa0aEncodeGithubComGoParkMailRu202314from5InternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Donation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF464aa0aEncodeGithubComGoParkMailRu202314from5InternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Donation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF464aa0aDecodeGithubComGoParkMailRu202314from5InternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Donation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF464aa0aDecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
+}
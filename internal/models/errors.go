@@ -14,4 +14,18 @@ var (
 	Unauthorized  = errors.New("Unauthorized")
 	Forbbiden     = errors.New("Forbidden")
 	Unsupported   = errors.New("Unsupported")
+	// PayoutRejected - провайдер окончательно отказал в выплате, повторять её бессмысленно
+	PayoutRejected = errors.New("PayoutRejected")
+	// PayoutInProgress - провайдер ещё проводит выплату, её статус нужно запросить позже
+	PayoutInProgress = errors.New("PayoutInProgress")
+	// OffsetMismatch - кусок загрузки начинается не с того места, до которого файл уже загружен
+	OffsetMismatch = errors.New("OffsetMismatch")
+	// TooLarge - файл больше, чем разрешено загружать
+	TooLarge = errors.New("TooLarge")
+	// QuotaExceeded - файл не помещается в хранилище автора по его тарифу
+	QuotaExceeded = errors.New("QuotaExceeded")
+	// CurrencyMismatch - суммы в разных валютах нельзя складывать и вычитать
+	CurrencyMismatch = errors.New("CurrencyMismatch")
+	// Underpaid - платёж меньше цены: он сохранён для возврата или сверки и повторно не проводится
+	Underpaid = errors.New("Underpaid")
 )
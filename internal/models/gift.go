@@ -0,0 +1,73 @@
+package models
+
+// easyjson -all ./internal/models/gift.go
+
+import (
+	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"html"
+	"strings"
+)
+
+type Gift struct {
+	Id               uuid.UUID `json:"id"`
+	SubscriptionId   uuid.UUID `json:"subscription_id"`
+	SubscriptionName string    `json:"subscription_name,omitempty"`
+	CreatorId        uuid.UUID `json:"creator_id"`
+	BuyerId          uuid.UUID `json:"buyer_id,omitempty"`
+	RecipientId      uuid.UUID `json:"recipient_id,omitempty"`
+	MonthCount       int64     `json:"month_count"`
+	Code             string    `json:"code,omitempty"`
+	IsPaid           bool      `json:"is_paid"`
+	IsRedeemed       bool      `json:"is_redeemed"`
+}
+
+type GiftCode struct {
+	Code string `json:"code"`
+}
+
+func (gift *Gift) IsValid() bool {
+	return gift.MonthCount > 0 && gift.RecipientId != gift.BuyerId
+}
+
+func (code *GiftCode) Normalize() {
+	code.Code = strings.ToUpper(strings.TrimSpace(code.Code))
+}
+
+func (gift *Gift) Sanitize() {
+	gift.SubscriptionName = html.EscapeString(gift.SubscriptionName)
+}
+
+func (gift *Gift) ProtoGiftToModel(in *generatedUser.GiftInfo) error {
+	giftID, err := uuid.Parse(in.GiftID)
+	if err != nil {
+		return err
+	}
+	subscriptionID, err := uuid.Parse(in.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return err
+	}
+	buyerID, err := uuid.Parse(in.BuyerID)
+	if err != nil {
+		return err
+	}
+	recipientID, err := uuid.Parse(in.RecipientID)
+	if err != nil {
+		return err
+	}
+	gift.Id = giftID
+	gift.SubscriptionId = subscriptionID
+	gift.SubscriptionName = in.SubscriptionName
+	gift.CreatorId = creatorID
+	gift.BuyerId = buyerID
+	gift.RecipientId = recipientID
+	gift.MonthCount = in.MonthCount
+	gift.Code = in.Code
+	gift.IsPaid = in.IsPaid
+	gift.IsRedeemed = in.IsRedeemed
+	return nil
+}
@@ -0,0 +1,224 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson6df2ac92DecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *GiftCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6df2ac92EncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in GiftCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GiftCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6df2ac92EncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GiftCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6df2ac92EncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GiftCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6df2ac92DecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GiftCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6df2ac92DecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
+func easyjson6df2ac92DecodeGithubComGoParkMailRu202314from5InternalModels1(in *jlexer.Lexer, out *Gift) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "subscription_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SubscriptionId).UnmarshalText(data))
+			}
+		case "subscription_name":
+			out.SubscriptionName = string(in.String())
+		case "creator_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CreatorId).UnmarshalText(data))
+			}
+		case "buyer_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.BuyerId).UnmarshalText(data))
+			}
+		case "recipient_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.RecipientId).UnmarshalText(data))
+			}
+		case "month_count":
+			out.MonthCount = int64(in.Int64())
+		case "code":
+			out.Code = string(in.String())
+		case "is_paid":
+			out.IsPaid = bool(in.Bool())
+		case "is_redeemed":
+			out.IsRedeemed = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6df2ac92EncodeGithubComGoParkMailRu202314from5InternalModels1(out *jwriter.Writer, in Gift) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"subscription_id\":"
+		out.RawString(prefix)
+		out.RawText((in.SubscriptionId).MarshalText())
+	}
+	if in.SubscriptionName != "" {
+		const prefix string = ",\"subscription_name\":"
+		out.RawString(prefix)
+		out.String(string(in.SubscriptionName))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.RawText((in.CreatorId).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"buyer_id\":"
+		out.RawString(prefix)
+		out.RawText((in.BuyerId).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"recipient_id\":"
+		out.RawString(prefix)
+		out.RawText((in.RecipientId).MarshalText())
+	}
+	{
+		const prefix string = ",\"month_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MonthCount))
+	}
+	if in.Code != "" {
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"is_paid\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPaid))
+	}
+	{
+		const prefix string = ",\"is_redeemed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRedeemed))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Gift) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6df2ac92EncodeGithubComGoParkMailRu202314from5InternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Gift) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6df2ac92EncodeGithubComGoParkMailRu202314from5InternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Gift) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6df2ac92DecodeGithubComGoParkMailRu202314from5InternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Gift) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6df2ac92DecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
+}
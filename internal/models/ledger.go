@@ -0,0 +1,126 @@
+package models
+
+// easyjson -all ./internal/models/ledger.go
+
+import (
+	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	LedgerKindSubscription = "subscription"
+	LedgerKindDonation     = "donation"
+	LedgerKindGift         = "gift"
+	LedgerKindFee          = "fee"
+	LedgerKindPayout       = "payout"
+	LedgerKindRefund       = "refund"
+	// возврат на баланс суммы выплаты, которую провайдер не провёл
+	LedgerKindPayoutReturn = "payout_return"
+
+	LedgerAccountCreator  = "creator"
+	LedgerAccountPayouts  = "payouts"
+	LedgerAccountPayments = "payments"
+	LedgerAccountPlatform = "platform"
+
+	DefaultLedgerLimit = 50
+	MaxLedgerLimit     = 200
+)
+
+// LedgerEntry - запись выписки по счёту автора, Amount > 0 - зачисление, Amount < 0 - списание
+type LedgerEntry struct {
+	Id            uuid.UUID `json:"id"`
+	TransactionId uuid.UUID `json:"transaction_id"`
+	Kind          string    `json:"kind"`
+	Amount        Money     `json:"amount"`
+	BalanceAfter  Money     `json:"balance_after"`
+	Reference     string    `json:"reference,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// CreatorBalance - баланс автора и доход за всё время до и после вычета комиссии платформы
+type CreatorBalance struct {
+	Balance     Money `json:"balance"`
+	GrossIncome Money `json:"gross_income"`
+	Commission  Money `json:"commission"`
+	NetIncome   Money `json:"net_income"`
+}
+
+type LedgerStatement struct {
+	Balance Money         `json:"balance"`
+	Entries []LedgerEntry `json:"entries"`
+}
+
+//easyjson:skip
+type LedgerFilter struct {
+	CreatorId uuid.UUID
+	From      time.Time
+	To        time.Time
+	Limit     int64
+	Offset    int64
+}
+
+//easyjson:skip
+type LedgerMismatch struct {
+	CreatorId     uuid.UUID
+	Balance       Money // creator.balance
+	LedgerBalance Money // сумма записей журнала по счёту автора
+}
+
+func (filter *LedgerFilter) IsValid() bool {
+	if filter.Limit < 0 || filter.Limit > MaxLedgerLimit || filter.Offset < 0 {
+		return false
+	}
+	return filter.To.IsZero() || !filter.From.After(filter.To)
+}
+
+func (balance *CreatorBalance) ToProto() *generatedCreator.CreatorBalance {
+	return &generatedCreator.CreatorBalance{
+		Balance:     balance.Balance.ToProto(),
+		GrossIncome: balance.GrossIncome.ToProto(),
+		Commission:  balance.Commission.ToProto(),
+		NetIncome:   balance.NetIncome.ToProto(),
+	}
+}
+
+func (balance *CreatorBalance) ProtoCreatorBalanceToModel(in *generatedCreator.CreatorBalance) {
+	balance.Balance = MoneyFromProto(in.Balance)
+	balance.GrossIncome = MoneyFromProto(in.GrossIncome)
+	balance.Commission = MoneyFromProto(in.Commission)
+	balance.NetIncome = MoneyFromProto(in.NetIncome)
+}
+
+func (entry *LedgerEntry) ToProto() *generatedCreator.LedgerEntry {
+	return &generatedCreator.LedgerEntry{
+		Id:            entry.Id.String(),
+		TransactionID: entry.TransactionId.String(),
+		Kind:          entry.Kind,
+		Amount:        entry.Amount.ToProto(),
+		BalanceAfter:  entry.BalanceAfter.ToProto(),
+		Reference:     entry.Reference,
+		CreatedAt:     entry.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (entry *LedgerEntry) ProtoLedgerEntryToModel(in *generatedCreator.LedgerEntry) error {
+	id, err := uuid.Parse(in.Id)
+	if err != nil {
+		return err
+	}
+	transactionID, err := uuid.Parse(in.TransactionID)
+	if err != nil {
+		return err
+	}
+	createdAt, err := time.Parse(time.RFC3339, in.CreatedAt)
+	if err != nil {
+		return err
+	}
+	entry.Id = id
+	entry.TransactionId = transactionID
+	entry.Kind = in.Kind
+	entry.Amount = MoneyFromProto(in.Amount)
+	entry.BalanceAfter = MoneyFromProto(in.BalanceAfter)
+	entry.Reference = in.Reference
+	entry.CreatedAt = createdAt
+	return nil
+}
@@ -0,0 +1,325 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson5d9943f7DecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *LedgerStatement) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "balance":
+			(out.Balance).UnmarshalEasyJSON(in)
+		case "entries":
+			if in.IsNull() {
+				in.Skip()
+				out.Entries = nil
+			} else {
+				in.Delim('[')
+				if out.Entries == nil {
+					if !in.IsDelim(']') {
+						out.Entries = make([]LedgerEntry, 0, 0)
+					} else {
+						out.Entries = []LedgerEntry{}
+					}
+				} else {
+					out.Entries = (out.Entries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 LedgerEntry
+					(v1).UnmarshalEasyJSON(in)
+					out.Entries = append(out.Entries, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5d9943f7EncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in LedgerStatement) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"balance\":"
+		out.RawString(prefix[1:])
+		(in.Balance).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix)
+		if in.Entries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Entries {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerStatement) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson5d9943f7EncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerStatement) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5d9943f7EncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerStatement) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson5d9943f7DecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerStatement) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5d9943f7DecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
+func easyjson5d9943f7DecodeGithubComGoParkMailRu202314from5InternalModels1(in *jlexer.Lexer, out *LedgerEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "transaction_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.TransactionId).UnmarshalText(data))
+			}
+		case "kind":
+			out.Kind = string(in.String())
+		case "amount":
+			(out.Amount).UnmarshalEasyJSON(in)
+		case "balance_after":
+			(out.BalanceAfter).UnmarshalEasyJSON(in)
+		case "reference":
+			out.Reference = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5d9943f7EncodeGithubComGoParkMailRu202314from5InternalModels1(out *jwriter.Writer, in LedgerEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"transaction_id\":"
+		out.RawString(prefix)
+		out.RawText((in.TransactionId).MarshalText())
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		(in.Amount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"balance_after\":"
+		out.RawString(prefix)
+		(in.BalanceAfter).MarshalEasyJSON(out)
+	}
+	if in.Reference != "" {
+		const prefix string = ",\"reference\":"
+		out.RawString(prefix)
+		out.String(string(in.Reference))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LedgerEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson5d9943f7EncodeGithubComGoParkMailRu202314from5InternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LedgerEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5d9943f7EncodeGithubComGoParkMailRu202314from5InternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LedgerEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson5d9943f7DecodeGithubComGoParkMailRu202314from5InternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LedgerEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5d9943f7DecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
+}
+func easyjson5d9943f7DecodeGithubComGoParkMailRu202314from5InternalModels2(in *jlexer.Lexer, out *CreatorBalance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "balance":
+			(out.Balance).UnmarshalEasyJSON(in)
+		case "gross_income":
+			(out.GrossIncome).UnmarshalEasyJSON(in)
+		case "commission":
+			(out.Commission).UnmarshalEasyJSON(in)
+		case "net_income":
+			(out.NetIncome).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5d9943f7EncodeGithubComGoParkMailRu202314from5InternalModels2(out *jwriter.Writer, in CreatorBalance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"balance\":"
+		out.RawString(prefix[1:])
+		(in.Balance).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"gross_income\":"
+		out.RawString(prefix)
+		(in.GrossIncome).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"commission\":"
+		out.RawString(prefix)
+		(in.Commission).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"net_income\":"
+		out.RawString(prefix)
+		(in.NetIncome).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreatorBalance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson5d9943f7EncodeGithubComGoParkMailRu202314from5InternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreatorBalance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5d9943f7EncodeGithubComGoParkMailRu202314from5InternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreatorBalance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson5d9943f7DecodeGithubComGoParkMailRu202314from5InternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreatorBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5d9943f7DecodeGithubComGoParkMailRu202314from5InternalModels2(l, v)
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// easyjson -all ./internal/models/media.go
+
+const (
+	// RenditionOriginal - исходное изображение без метаданных, уменьшенное до допустимого размера
+	RenditionOriginal = "original"
+	// RenditionFeed - изображение по ширине ленты
+	RenditionFeed = "feed"
+	// RenditionThumbnail - квадратная миниатюра вложения
+	RenditionThumbnail = "thumbnail"
+	RenditionAvatar64  = "avatar_64"
+	RenditionAvatar128 = "avatar_128"
+	RenditionAvatar256 = "avatar_256"
+)
+
+// MediaURL - подписанная ссылка на файл вложения, действительная до ExpiresAt
+type MediaURL struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Rendition - один из размеров обработанного изображения
+type Rendition struct {
+	Name        string `json:"name"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+}
+
+// Key - ключ файла размера в хранилище: {id}/{размер}.{расширение}
+func (rendition Rendition) Key(id string) string {
+	return RenditionKey(id, rendition.Name, RenditionExtension(rendition.ContentType))
+}
+
+// RenditionKey - ключ файла размера изображения в хранилище
+func RenditionKey(id string, name string, extension string) string {
+	return id + "/" + name + "." + extension
+}
+
+// RenditionPrefix - общий префикс всех размеров изображения в хранилище
+func RenditionPrefix(id string) string {
+	return id + "/"
+}
+
+// RenditionExtension - расширение файла размера по его типу, размеры хранятся только в JPEG и WebP
+func RenditionExtension(contentType string) string {
+	if contentType == "image/webp" {
+		return "webp"
+	}
+	return "jpg"
+}
+
+// FindRendition ищет размер по имени
+func FindRendition(renditions []Rendition, name string) (Rendition, bool) {
+	for _, rendition := range renditions {
+		if rendition.Name == name {
+			return rendition, true
+		}
+	}
+	return Rendition{}, false
+}
+
+// RenditionsJSON - размеры для записи в jsonb, у вложения без размеров nil (NULL в базе)
+func RenditionsJSON(renditions []Rendition) (*string, error) {
+	if len(renditions) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(renditions)
+	if err != nil {
+		return nil, err
+	}
+	value := string(data)
+	return &value, nil
+}
+
+// ParseRenditions разбирает размеры, сохранённые в базе в jsonb; пустое значение - вложение без размеров
+func ParseRenditions(data string) ([]Rendition, error) {
+	if len(data) == 0 || data == "null" {
+		return nil, nil
+	}
+	var renditions []Rendition
+	if err := json.Unmarshal([]byte(data), &renditions); err != nil {
+		return nil, err
+	}
+	return renditions, nil
+}
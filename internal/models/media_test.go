@@ -0,0 +1,68 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRenditions_JSON(t *testing.T) {
+	renditions := []Rendition{
+		{Name: RenditionThumbnail, Width: 320, Height: 320, ContentType: "image/webp"},
+		{Name: RenditionOriginal, Width: 800, Height: 600, ContentType: "image/jpeg"},
+	}
+	data, err := RenditionsJSON(renditions)
+	assert.NoError(t, err)
+	parsed, err := ParseRenditions(*data)
+	assert.NoError(t, err)
+	assert.Equal(t, renditions, parsed)
+
+	data, err = RenditionsJSON(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+	parsed, err = ParseRenditions("")
+	assert.NoError(t, err)
+	assert.Nil(t, parsed)
+
+	_, err = ParseRenditions("{")
+	assert.Error(t, err)
+}
+
+func TestRendition_Key(t *testing.T) {
+	assert.Equal(t, "id/thumbnail.webp", Rendition{Name: RenditionThumbnail, ContentType: "image/webp"}.Key("id"))
+	assert.Equal(t, "id/original.jpg", Rendition{Name: RenditionOriginal, ContentType: "image/jpeg"}.Key("id"))
+	assert.True(t, IsValidBlobKey(RenditionKey("id", RenditionFeed, "jpg")))
+
+	rendition, ok := FindRendition([]Rendition{{Name: RenditionFeed}}, RenditionFeed)
+	assert.True(t, ok)
+	assert.Equal(t, RenditionFeed, rendition.Name)
+	_, ok = FindRendition(nil, RenditionFeed)
+	assert.False(t, ok)
+}
+
+func TestCleanFilename(t *testing.T) {
+	assert.Equal(t, "photo.jpg", CleanFilename("photo.jpg"))
+	assert.Equal(t, "photo.jpg", CleanFilename("C:\\Users\\user\\photo.jpg"))
+	assert.Equal(t, "passwd", CleanFilename("../../etc/passwd"))
+	assert.Equal(t, "", CleanFilename(".."))
+	assert.Equal(t, "", CleanFilename("  "))
+	assert.Equal(t, MaxFilenameLength, len(CleanFilename(strings.Repeat("a", 300))))
+	assert.True(t, utf8.ValidString(CleanFilename(strings.Repeat("я", 200))))
+}
+
+func TestAttachmentMetadata(t *testing.T) {
+	metadata := AttachmentMetadata{AltText: strings.Repeat("я", MaxAltTextLength)}
+	assert.True(t, metadata.IsValid())
+	metadata.Caption = strings.Repeat("a", MaxCaptionLength+1)
+	assert.False(t, metadata.IsValid())
+
+	parsed, err := ParseAttachmentMetadata(`{"size": 10, "width": 20, "height": 30, "duration": 1.5, "filename": "a.mp4", "alt_text": null}`)
+	assert.NoError(t, err)
+	assert.Equal(t, AttachmentMetadata{Size: 10, Width: 20, Height: 30, Duration: 1.5, Filename: "a.mp4"}, parsed)
+	parsed, err = ParseAttachmentMetadata("null")
+	assert.NoError(t, err)
+	assert.Equal(t, AttachmentMetadata{}, parsed)
+	_, err = ParseAttachmentMetadata("{")
+	assert.Error(t, err)
+}
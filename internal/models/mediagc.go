@@ -0,0 +1,68 @@
+package models
+
+// easyjson -all ./internal/models/mediagc.go
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	MediaSourceAttachment   = "attachment"
+	MediaSourceUserPhoto    = "user.profile_photo"
+	MediaSourceCreatorPhoto = "creator.profile_photo"
+	MediaSourceCreatorCover = "creator.cover_photo"
+)
+
+// MediaRef - запись в базе, которая ссылается на файлы в хранилище
+//
+//easyjson:skip
+type MediaRef struct {
+	Id     uuid.UUID
+	Source string
+	// Type, Renditions и BlobId есть только у вложений
+	Type       string
+	Renditions []Rendition
+	BlobId     string
+}
+
+// StorageId - id, по которому строятся ключи файлов записи в хранилище
+func (ref *MediaRef) StorageId() string {
+	if len(ref.BlobId) != 0 {
+		return ref.BlobId
+	}
+	if ref.Source != MediaSourceAttachment {
+		return PhotoStorageId(ref.Id.String())
+	}
+	return ref.Id.String()
+}
+
+// OrphanBlob - файл в хранилище, на который не ссылается ни одна запись
+type OrphanBlob struct {
+	Key         string    `json:"key"`
+	Size        int64     `json:"size"`
+	ModifiedAt  time.Time `json:"modified_at"`
+	Deleted     bool      `json:"deleted"`
+	DeleteError string    `json:"delete_error,omitempty"`
+}
+
+// MissingBlob - запись в базе, файла которой нет в хранилище
+type MissingBlob struct {
+	Id     uuid.UUID `json:"id"`
+	Source string    `json:"source"`
+	Key    string    `json:"key"`
+}
+
+type MediaGCReport struct {
+	DryRun     bool      `json:"dry_run"`
+	CheckedAt  time.Time `json:"checked_at"`
+	GraceUntil time.Time `json:"grace_until"`
+	Blobs      int       `json:"blobs"`
+	References int       `json:"references"`
+	// Recent - файлы новее GraceUntil, они могут принадлежать ещё не сохранённой записи и не проверяются
+	Recent     int           `json:"recent"`
+	Orphans    []OrphanBlob  `json:"orphans"`
+	Missing    []MissingBlob `json:"missing"`
+	Deleted    int           `json:"deleted"`
+	FreedBytes int64         `json:"freed_bytes"`
+}
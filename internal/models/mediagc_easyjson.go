@@ -0,0 +1,394 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3083e98cDecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *OrphanBlob) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "key":
+			out.Key = string(in.String())
+		case "size":
+			out.Size = int64(in.Int64())
+		case "modified_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ModifiedAt).UnmarshalJSON(data))
+			}
+		case "deleted":
+			out.Deleted = bool(in.Bool())
+		case "delete_error":
+			out.DeleteError = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3083e98cEncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in OrphanBlob) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Key))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	{
+		const prefix string = ",\"modified_at\":"
+		out.RawString(prefix)
+		out.Raw((in.ModifiedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Deleted))
+	}
+	if in.DeleteError != "" {
+		const prefix string = ",\"delete_error\":"
+		out.RawString(prefix)
+		out.String(string(in.DeleteError))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrphanBlob) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3083e98cEncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrphanBlob) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3083e98cEncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrphanBlob) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3083e98cDecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrphanBlob) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3083e98cDecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
+func easyjson3083e98cDecodeGithubComGoParkMailRu202314from5InternalModels1(in *jlexer.Lexer, out *MissingBlob) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "source":
+			out.Source = string(in.String())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3083e98cEncodeGithubComGoParkMailRu202314from5InternalModels1(out *jwriter.Writer, in MissingBlob) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"source\":"
+		out.RawString(prefix)
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MissingBlob) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3083e98cEncodeGithubComGoParkMailRu202314from5InternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MissingBlob) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3083e98cEncodeGithubComGoParkMailRu202314from5InternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MissingBlob) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3083e98cDecodeGithubComGoParkMailRu202314from5InternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MissingBlob) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3083e98cDecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
+}
+func easyjson3083e98cDecodeGithubComGoParkMailRu202314from5InternalModels2(in *jlexer.Lexer, out *MediaGCReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dry_run":
+			out.DryRun = bool(in.Bool())
+		case "checked_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CheckedAt).UnmarshalJSON(data))
+			}
+		case "grace_until":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.GraceUntil).UnmarshalJSON(data))
+			}
+		case "blobs":
+			out.Blobs = int(in.Int())
+		case "references":
+			out.References = int(in.Int())
+		case "recent":
+			out.Recent = int(in.Int())
+		case "orphans":
+			if in.IsNull() {
+				in.Skip()
+				out.Orphans = nil
+			} else {
+				in.Delim('[')
+				if out.Orphans == nil {
+					if !in.IsDelim(']') {
+						out.Orphans = make([]OrphanBlob, 0, 0)
+					} else {
+						out.Orphans = []OrphanBlob{}
+					}
+				} else {
+					out.Orphans = (out.Orphans)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 OrphanBlob
+					(v1).UnmarshalEasyJSON(in)
+					out.Orphans = append(out.Orphans, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "missing":
+			if in.IsNull() {
+				in.Skip()
+				out.Missing = nil
+			} else {
+				in.Delim('[')
+				if out.Missing == nil {
+					if !in.IsDelim(']') {
+						out.Missing = make([]MissingBlob, 0, 1)
+					} else {
+						out.Missing = []MissingBlob{}
+					}
+				} else {
+					out.Missing = (out.Missing)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 MissingBlob
+					(v2).UnmarshalEasyJSON(in)
+					out.Missing = append(out.Missing, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "deleted":
+			out.Deleted = int(in.Int())
+		case "freed_bytes":
+			out.FreedBytes = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3083e98cEncodeGithubComGoParkMailRu202314from5InternalModels2(out *jwriter.Writer, in MediaGCReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dry_run\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.DryRun))
+	}
+	{
+		const prefix string = ",\"checked_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CheckedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"grace_until\":"
+		out.RawString(prefix)
+		out.Raw((in.GraceUntil).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"blobs\":"
+		out.RawString(prefix)
+		out.Int(int(in.Blobs))
+	}
+	{
+		const prefix string = ",\"references\":"
+		out.RawString(prefix)
+		out.Int(int(in.References))
+	}
+	{
+		const prefix string = ",\"recent\":"
+		out.RawString(prefix)
+		out.Int(int(in.Recent))
+	}
+	{
+		const prefix string = ",\"orphans\":"
+		out.RawString(prefix)
+		if in.Orphans == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.Orphans {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				(v4).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"missing\":"
+		out.RawString(prefix)
+		if in.Missing == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Missing {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"deleted\":"
+		out.RawString(prefix)
+		out.Int(int(in.Deleted))
+	}
+	{
+		const prefix string = ",\"freed_bytes\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.FreedBytes))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MediaGCReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3083e98cEncodeGithubComGoParkMailRu202314from5InternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MediaGCReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3083e98cEncodeGithubComGoParkMailRu202314from5InternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MediaGCReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3083e98cDecodeGithubComGoParkMailRu202314from5InternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MediaGCReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3083e98cDecodeGithubComGoParkMailRu202314from5InternalModels2(l, v)
+}
@@ -0,0 +1,152 @@
+package models
+
+// easyjson -all ./internal/models/money.go
+
+import (
+	"database/sql/driver"
+	"fmt"
+	generatedCommon "github.com/go-park-mail-ru/2023_1_4from5/internal/models/proto"
+	"strconv"
+	"strings"
+)
+
+const (
+	CurrencyRUB = "RUB"
+
+	minorUnitsDigits  = 2
+	minorUnitsInMajor = 100
+)
+
+// Money - денежная сумма в минимальных единицах валюты (копейках).
+// Все вычисления ведутся в целых числах, поэтому округлений при сложении не бывает.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func NewMoney(amount int64) Money {
+	return Money{Amount: amount, Currency: CurrencyRUB}
+}
+
+// ParseMoney разбирает десятичную запись суммы в рублях ("100", "99.9", "12.34") без перевода во float.
+func ParseMoney(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	major, minor, hasMinor := strings.Cut(value, ".")
+	if len(major) == 0 || (hasMinor && (len(minor) == 0 || len(minor) > minorUnitsDigits)) {
+		return Money{}, WrongData
+	}
+	minor += strings.Repeat("0", minorUnitsDigits-len(minor))
+
+	majorAmount, err := strconv.ParseUint(major, 10, 63)
+	if err != nil {
+		return Money{}, WrongData
+	}
+	minorAmount, err := strconv.ParseUint(minor, 10, 63)
+	if err != nil {
+		return Money{}, WrongData
+	}
+	if majorAmount > (1<<63-1)/minorUnitsInMajor-1 {
+		return Money{}, WrongData
+	}
+
+	amount := int64(majorAmount)*minorUnitsInMajor + int64(minorAmount)
+	if negative {
+		amount = -amount
+	}
+	return NewMoney(amount), nil
+}
+
+// String возвращает сумму в рублях с двумя знаками после точки - в таком виде её ждёт API ЮMoney.
+func (m Money) String() string {
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/minorUnitsInMajor, amount%minorUnitsInMajor)
+}
+
+// Add складывает суммы одной валюты, иначе CurrencyMismatch
+func (m Money) Add(other Money) (Money, error) {
+	if m.currency() != other.currency() {
+		return Money{}, CurrencyMismatch
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.currency()}, nil
+}
+
+// Sub вычитает сумму той же валюты, иначе CurrencyMismatch
+func (m Money) Sub(other Money) (Money, error) {
+	if m.currency() != other.currency() {
+		return Money{}, CurrencyMismatch
+	}
+	return Money{Amount: m.Amount - other.Amount, Currency: m.currency()}, nil
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// IsValid проверяет, что сумма положительная и в поддерживаемой валюте
+func (m Money) IsValid() bool {
+	return m.IsPositive() && m.currency() == CurrencyRUB
+}
+
+// Less сравнивает суммы одной валюты, иначе CurrencyMismatch
+func (m Money) Less(other Money) (bool, error) {
+	if m.currency() != other.currency() {
+		return false, CurrencyMismatch
+	}
+	return m.Amount < other.Amount, nil
+}
+
+func (m Money) currency() string {
+	if len(m.Currency) == 0 {
+		return CurrencyRUB
+	}
+	return m.Currency
+}
+
+// Scan читает сумму из bigint-колонки (копейки); агрегаты (sum) приходят строкой numeric.
+func (m *Money) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*m = NewMoney(0)
+	case int64:
+		*m = NewMoney(value)
+	case []byte:
+		return m.scanString(string(value))
+	case string:
+		return m.scanString(value)
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+	return nil
+}
+
+func (m *Money) scanString(value string) error {
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	*m = NewMoney(amount)
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.Amount, nil
+}
+
+func (m Money) ToProto() *generatedCommon.Money {
+	return &generatedCommon.Money{Amount: m.Amount, Currency: m.currency()}
+}
+
+func MoneyFromProto(in *generatedCommon.Money) Money {
+	if in == nil {
+		return NewMoney(0)
+	}
+	return Money{Amount: in.Amount, Currency: in.Currency}
+}
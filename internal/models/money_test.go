@@ -0,0 +1,140 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectedRes Money
+		expectedErr error
+	}{
+		{name: "Integer", input: "100", expectedRes: NewMoney(10000)},
+		{name: "One digit", input: "99.9", expectedRes: NewMoney(9990)},
+		{name: "Two digits", input: "12.34", expectedRes: NewMoney(1234)},
+		{name: "Kopecks", input: "0.01", expectedRes: NewMoney(1)},
+		{name: "Negative", input: "-5.50", expectedRes: NewMoney(-550)},
+		{name: "Spaces", input: " 7.00 ", expectedRes: NewMoney(700)},
+		{name: "Too precise", input: "1.001", expectedErr: WrongData},
+		{name: "Empty fraction", input: "1.", expectedErr: WrongData},
+		{name: "Empty", input: "", expectedErr: WrongData},
+		{name: "Not a number", input: "1e3", expectedErr: WrongData},
+		{name: "Overflow", input: "99999999999999999999", expectedErr: WrongData},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := ParseMoney(test.input)
+			require.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedRes, res)
+		})
+	}
+}
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "0.00", NewMoney(0).String())
+	assert.Equal(t, "0.05", NewMoney(5).String())
+	assert.Equal(t, "123.45", NewMoney(12345).String())
+	assert.Equal(t, "-1.10", NewMoney(-110).String())
+
+	for _, value := range []string{"0.10", "1.00", "19.99", "100500.01"} {
+		money, err := ParseMoney(value)
+		require.NoError(t, err)
+		assert.Equal(t, value, money.String())
+	}
+}
+
+// Сумма десяти донатов по 0.10 ₽ во float32 не равна 1 ₽, в копейках - ровно 100.
+func TestMoney_Reconcile(t *testing.T) {
+	var floatBalance float32
+	balance := NewMoney(0)
+	for i := 0; i < 10; i++ {
+		floatBalance += 0.1
+		donation, err := ParseMoney("0.10")
+		require.NoError(t, err)
+		balance, err = balance.Add(donation)
+		require.NoError(t, err)
+	}
+	assert.NotEqual(t, float32(1), floatBalance)
+	assert.Equal(t, NewMoney(100), balance)
+
+	payments := []string{"149.99", "0.01", "333.33", "333.33", "333.34"}
+	total := NewMoney(0)
+	for _, value := range payments {
+		payment, err := ParseMoney(value)
+		require.NoError(t, err)
+		total, err = total.Add(payment)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "1150.00", total.String())
+
+	transfer, err := ParseMoney("1150.00")
+	require.NoError(t, err)
+	rest, err := total.Sub(transfer)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), rest.Amount)
+	less, err := total.Less(transfer)
+	require.NoError(t, err)
+	assert.False(t, less)
+}
+
+func TestMoney_CurrencyMismatch(t *testing.T) {
+	usd := Money{Amount: 100, Currency: "USD"}
+
+	_, err := NewMoney(100).Add(usd)
+	assert.Equal(t, CurrencyMismatch, err)
+
+	_, err = NewMoney(100).Sub(usd)
+	assert.Equal(t, CurrencyMismatch, err)
+
+	_, err = NewMoney(100).Less(usd)
+	assert.Equal(t, CurrencyMismatch, err)
+
+	// пустая валюта - рубли
+	sum, err := Money{Amount: 100}.Add(NewMoney(50))
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(150), sum)
+}
+
+func TestMoney_Scan(t *testing.T) {
+	tests := []struct {
+		name        string
+		src         interface{}
+		expectedRes Money
+		wantErr     bool
+	}{
+		{name: "Bigint", src: int64(12345), expectedRes: NewMoney(12345)},
+		{name: "Numeric sum", src: []byte("100500"), expectedRes: NewMoney(100500)},
+		{name: "Null", src: nil, expectedRes: NewMoney(0)},
+		{name: "Float", src: 1.5, wantErr: true},
+		{name: "Fraction", src: "1.5", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var money Money
+			err := money.Scan(test.src)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRes, money)
+
+			value, err := money.Value()
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRes.Amount, value)
+		})
+	}
+}
+
+func TestMoney_Proto(t *testing.T) {
+	money := NewMoney(9999)
+	assert.Equal(t, money, MoneyFromProto(money.ToProto()))
+	assert.Equal(t, NewMoney(0), MoneyFromProto(nil))
+	assert.Equal(t, CurrencyRUB, Money{Amount: 1}.ToProto().Currency)
+}
@@ -0,0 +1,245 @@
+package models
+
+// easyjson -all ./internal/models/payment.go
+
+import (
+	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"html"
+	"strings"
+	"time"
+)
+
+const (
+	PaymentTypeSubscription = "subscription"
+	PaymentTypeDonation     = "donation"
+	PaymentTypeGift         = "gift"
+
+	DefaultPaymentsLimit = 20
+	MaxPaymentsLimit     = 100
+
+	// операции, которые оплачивает входящий платёж
+	PaymentKindSubscribe = "subscribe"
+	PaymentKindDonate    = "donate"
+	PaymentKindGift      = "gift"
+	// исходящий перевод автору, метка "payout;{payout-uuid}"
+	PaymentKindPayout = "payout"
+
+	PaymentEventProcessing = "processing"
+	PaymentEventProcessed  = "processed"
+	PaymentEventFailed     = "failed"
+	// PaymentEventClaimed - уведомление захвачено на обработку текущим запросом, в базе не хранится
+	PaymentEventClaimed = "claimed"
+
+	// почему входящий платёж не проведён и сохранён в unmatched_payment
+	UnmatchedReasonUnderpaid = "underpaid"
+	UnmatchedReasonCurrency  = "currency"
+
+	maxOperationIDLength = 128
+	maxSenderLength      = 64
+)
+
+type Payment struct {
+	Id             uuid.UUID `json:"id"`
+	Type           string    `json:"type"`
+	CreatorId      uuid.UUID `json:"creator_id"`
+	CreatorName    string    `json:"creator_name"`
+	SubscriptionId uuid.UUID `json:"subscription_id,omitempty"`
+	Tier           string    `json:"tier,omitempty"`
+	Money          Money     `json:"money"`
+	MonthCount     int64     `json:"month_count,omitempty"`
+	PaymentTime    time.Time `json:"payment_time"`
+	OperationId    string    `json:"operation_id"`
+	// период подписки, продлённый этой оплатой; пуст у донатов, подарков и неоплаченных записей
+	PeriodStart *time.Time `json:"-"`
+	PeriodEnd   *time.Time `json:"-"`
+}
+
+type Receipt struct {
+	Payment     Payment    `json:"payment"`
+	PeriodStart *time.Time `json:"period_start,omitempty"`
+	PeriodEnd   *time.Time `json:"period_end,omitempty"`
+}
+
+// PaymentEvent - уведомление платёжной системы о входящем платеже, приведённое к общему виду.
+// OperationID - идентификатор операции у провайдера, по нему уведомление обрабатывается ровно один раз.
+// Money - сколько зачислено платформе, Paid - сколько списано с плательщика (больше Money на комиссию),
+// с ценой сравнивается Paid.
+//
+//easyjson:skip
+type PaymentEvent struct {
+	Provider    string
+	OperationID string
+	Kind        string
+	TargetID    uuid.UUID // платёжная информация подписки, автор доната или оплачиваемый подарок
+	PaymentInfo uuid.UUID // информация о донате от авторизованного пользователя, если есть
+	Money       Money
+	Paid        Money
+	// Sender - счёт плательщика у провайдера, на него уходит возврат; пуст, если провайдер его не сообщил
+	Sender string
+}
+
+// UnmatchedPayment - входящий платёж, который пришёл, но не оплатил то, на что указывает метка:
+// плательщик заплатил меньше цены или в другой валюте. Деньги уже зачислены, поэтому платёж
+// сохраняется для возврата или ручной сверки, а уведомление считается обработанным.
+//
+//easyjson:skip
+type UnmatchedPayment struct {
+	OperationID string
+	Kind        string
+	TargetID    uuid.UUID
+	Money       Money
+	Paid        Money
+	Price       Money
+	Reason      string
+}
+
+// ParsePaymentLabel разбирает метку платежа "{операция};{uuid}[;{payment-info}]"
+func ParsePaymentLabel(label string) (string, uuid.UUID, uuid.UUID, error) {
+	parts := strings.Split(label, ";")
+	if len(parts) < 2 || len(parts) > 3 {
+		return "", uuid.Nil, uuid.Nil, WrongData
+	}
+	targetID, err := uuid.Parse(parts[1])
+	if err != nil {
+		return "", uuid.Nil, uuid.Nil, WrongData
+	}
+	var paymentInfo uuid.UUID
+	// donate;{creator-uuid};{payment-info} - донат с сообщением от авторизованного пользователя
+	if len(parts) == 3 {
+		if paymentInfo, err = uuid.Parse(parts[2]); err != nil {
+			return "", uuid.Nil, uuid.Nil, WrongData
+		}
+	}
+	return parts[0], targetID, paymentInfo, nil
+}
+
+func (event *PaymentEvent) IsValid() bool {
+	if event.Kind != PaymentKindSubscribe && event.Kind != PaymentKindDonate && event.Kind != PaymentKindGift &&
+		event.Kind != PaymentKindRefund {
+		return false
+	}
+	if len(event.OperationID) == 0 || len(event.OperationID) > maxOperationIDLength || event.TargetID == uuid.Nil || !event.Money.IsValid() ||
+		len(event.Sender) > maxSenderLength {
+		return false
+	}
+	// комиссия может только уменьшить зачисленную сумму
+	lessThanCredited, err := event.Paid.Less(event.Money)
+	return err == nil && !lessThanCredited
+}
+
+func (event *PaymentEvent) ToProto() *generatedUser.PaymentEvent {
+	return &generatedUser.PaymentEvent{
+		Provider:    event.Provider,
+		OperationID: event.OperationID,
+		Kind:        event.Kind,
+		TargetID:    event.TargetID.String(),
+		PaymentInfo: event.PaymentInfo.String(),
+		Money:       event.Money.ToProto(),
+		Paid:        event.Paid.ToProto(),
+		Sender:      event.Sender,
+	}
+}
+
+func (event *PaymentEvent) ProtoPaymentEventToModel(in *generatedUser.PaymentEvent) error {
+	targetID, err := uuid.Parse(in.TargetID)
+	if err != nil {
+		return err
+	}
+	paymentInfo, err := uuid.Parse(in.PaymentInfo)
+	if err != nil {
+		return err
+	}
+	event.Provider = in.Provider
+	event.OperationID = in.OperationID
+	event.Kind = in.Kind
+	event.TargetID = targetID
+	event.PaymentInfo = paymentInfo
+	event.Money = MoneyFromProto(in.Money)
+	event.Paid = MoneyFromProto(in.Paid)
+	event.Sender = in.Sender
+	return nil
+}
+
+//easyjson:skip
+type PaymentsFilter struct {
+	UserId    uuid.UUID
+	CreatorId uuid.UUID
+	Type      string
+	From      time.Time
+	To        time.Time
+	Limit     int64
+	Offset    int64
+}
+
+func (filter *PaymentsFilter) IsValid() bool {
+	if filter.Type != "" && filter.Type != PaymentTypeSubscription && filter.Type != PaymentTypeDonation && filter.Type != PaymentTypeGift {
+		return false
+	}
+	if filter.Limit < 0 || filter.Limit > MaxPaymentsLimit || filter.Offset < 0 {
+		return false
+	}
+	return filter.To.IsZero() || !filter.From.After(filter.To)
+}
+
+// Receipt returns payment with the period covered by it, donations and gifts cover no period for the buyer.
+func (payment Payment) Receipt() Receipt {
+	return Receipt{
+		Payment:     payment,
+		PeriodStart: payment.PeriodStart,
+		PeriodEnd:   payment.PeriodEnd,
+	}
+}
+
+func (payment *Payment) Sanitize() {
+	payment.CreatorName = html.EscapeString(payment.CreatorName)
+	payment.Tier = html.EscapeString(payment.Tier)
+}
+
+func (payment *Payment) ProtoPaymentToModel(in *generatedUser.Payment) error {
+	id, err := uuid.Parse(in.Id)
+	if err != nil {
+		return err
+	}
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return err
+	}
+	subscriptionID, err := uuid.Parse(in.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	paymentTime, err := time.Parse(time.RFC3339, in.PaymentTime)
+	if err != nil {
+		return err
+	}
+	payment.Id = id
+	payment.Type = in.Type
+	payment.CreatorId = creatorID
+	payment.CreatorName = in.CreatorName
+	payment.SubscriptionId = subscriptionID
+	payment.Tier = in.Tier
+	payment.Money = MoneyFromProto(in.Money)
+	payment.MonthCount = in.MonthCount
+	payment.PaymentTime = paymentTime
+	payment.OperationId = in.OperationID
+	if payment.PeriodStart, err = parsePeriodTime(in.PeriodStart); err != nil {
+		return err
+	}
+	if payment.PeriodEnd, err = parsePeriodTime(in.PeriodEnd); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parsePeriodTime разбирает границу периода из proto, пустая строка - периода нет
+func parsePeriodTime(in string) (*time.Time, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, in)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
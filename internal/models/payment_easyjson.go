@@ -0,0 +1,257 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson377dcee4DecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *Receipt) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "payment":
+			(out.Payment).UnmarshalEasyJSON(in)
+		case "period_start":
+			if in.IsNull() {
+				in.Skip()
+				out.PeriodStart = nil
+			} else {
+				if out.PeriodStart == nil {
+					out.PeriodStart = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PeriodStart).UnmarshalJSON(data))
+				}
+			}
+		case "period_end":
+			if in.IsNull() {
+				in.Skip()
+				out.PeriodEnd = nil
+			} else {
+				if out.PeriodEnd == nil {
+					out.PeriodEnd = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PeriodEnd).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson377dcee4EncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in Receipt) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"payment\":"
+		out.RawString(prefix[1:])
+		(in.Payment).MarshalEasyJSON(out)
+	}
+	if in.PeriodStart != nil {
+		const prefix string = ",\"period_start\":"
+		out.RawString(prefix)
+		out.Raw((*in.PeriodStart).MarshalJSON())
+	}
+	if in.PeriodEnd != nil {
+		const prefix string = ",\"period_end\":"
+		out.RawString(prefix)
+		out.Raw((*in.PeriodEnd).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Receipt) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson377dcee4EncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Receipt) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson377dcee4EncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Receipt) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson377dcee4DecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Receipt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson377dcee4DecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
+func easyjson377dcee4DecodeGithubComGoParkMailRu202314from5InternalModels1(in *jlexer.Lexer, out *Payment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "type":
+			out.Type = string(in.String())
+		case "creator_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CreatorId).UnmarshalText(data))
+			}
+		case "creator_name":
+			out.CreatorName = string(in.String())
+		case "subscription_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SubscriptionId).UnmarshalText(data))
+			}
+		case "tier":
+			out.Tier = string(in.String())
+		case "money":
+			(out.Money).UnmarshalEasyJSON(in)
+		case "month_count":
+			out.MonthCount = int64(in.Int64())
+		case "payment_time":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PaymentTime).UnmarshalJSON(data))
+			}
+		case "operation_id":
+			out.OperationId = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson377dcee4EncodeGithubComGoParkMailRu202314from5InternalModels1(out *jwriter.Writer, in Payment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"creator_id\":"
+		out.RawString(prefix)
+		out.RawText((in.CreatorId).MarshalText())
+	}
+	{
+		const prefix string = ",\"creator_name\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatorName))
+	}
+	if true {
+		const prefix string = ",\"subscription_id\":"
+		out.RawString(prefix)
+		out.RawText((in.SubscriptionId).MarshalText())
+	}
+	if in.Tier != "" {
+		const prefix string = ",\"tier\":"
+		out.RawString(prefix)
+		out.String(string(in.Tier))
+	}
+	{
+		const prefix string = ",\"money\":"
+		out.RawString(prefix)
+		(in.Money).MarshalEasyJSON(out)
+	}
+	if in.MonthCount != 0 {
+		const prefix string = ",\"month_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MonthCount))
+	}
+	{
+		const prefix string = ",\"payment_time\":"
+		out.RawString(prefix)
+		out.Raw((in.PaymentTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"operation_id\":"
+		out.RawString(prefix)
+		out.String(string(in.OperationId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Payment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson377dcee4EncodeGithubComGoParkMailRu202314from5InternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Payment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson377dcee4EncodeGithubComGoParkMailRu202314from5InternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Payment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson377dcee4DecodeGithubComGoParkMailRu202314from5InternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Payment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson377dcee4DecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
+}
@@ -0,0 +1,287 @@
+package models
+
+// easyjson -all ./internal/models/payout.go
+
+import (
+	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"regexp"
+	"time"
+)
+
+const (
+	PayoutStatusRequested  = "requested"
+	PayoutStatusProcessing = "processing"
+	PayoutStatusSucceeded  = "succeeded"
+	PayoutStatusFailed     = "failed"
+
+	MaxPayoutAttempts       = 5
+	IdempotencyKeyMaxLength = 64
+	IdempotencyKeyHeader    = "Idempotency-Key"
+
+	PayoutPeriodWeekly  = "weekly"
+	PayoutPeriodMonthly = "monthly"
+
+	MaxPayoutDestinations = 5
+
+	// PayoutScheduleClaim - на сколько джоба захватывает запуск автоматической выплаты
+	PayoutScheduleClaim = 10 * time.Minute
+)
+
+var (
+	// MinPayoutThreshold - наименьший порог автоматической выплаты, 100 рублей
+	MinPayoutThreshold = NewMoney(10000)
+	// PayoutVerificationAmount - сумма, на которую у провайдера регистрируется проверочный перевод на новые реквизиты
+	PayoutVerificationAmount = NewMoney(100)
+)
+
+var phoneNumberRegexp = regexp.MustCompile(`^\+?[0-9]{11,15}$`)
+
+// payoutTransitions - допустимые переходы между состояниями выплаты:
+// requested -> processing -> succeeded, из requested и processing можно перейти в failed.
+var payoutTransitions = map[string][]string{
+	PayoutStatusRequested:  {PayoutStatusProcessing, PayoutStatusFailed},
+	PayoutStatusProcessing: {PayoutStatusSucceeded, PayoutStatusFailed},
+}
+
+// Payout - выплата автору. Деньги списываются с баланса только при переходе в succeeded,
+// до этого сумма выплаты зарезервирована и недоступна для новых выплат.
+type Payout struct {
+	Id                uuid.UUID `json:"id"`
+	CreatorID         uuid.UUID `json:"-"`
+	Money             Money     `json:"money"`
+	PhoneNumber       string    `json:"phone_number"`
+	IdempotencyKey    string    `json:"-"`
+	Status            string    `json:"status"`
+	ProviderRequestID string    `json:"-"`
+	Attempts          int64     `json:"attempts"`
+	LastError         string    `json:"last_error,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+	DestinationID     uuid.UUID `json:"destination_id"`
+}
+
+// IsValid проверяет запрос на выплату: номер телефона задаётся явно или берётся из сохранённых реквизитов DestinationID
+func (payout *Payout) IsValid() bool {
+	if payout.DestinationID != uuid.Nil && len(payout.PhoneNumber) != 0 {
+		return false
+	}
+	return payout.Money.IsValid() &&
+		(payout.DestinationID != uuid.Nil || phoneNumberRegexp.MatchString(payout.PhoneNumber)) &&
+		len(payout.IdempotencyKey) > 0 && len(payout.IdempotencyKey) <= IdempotencyKeyMaxLength
+}
+
+// IsFinal возвращает true для выплат, состояние которых больше не меняется
+func (payout *Payout) IsFinal() bool {
+	return payout.Status == PayoutStatusSucceeded || payout.Status == PayoutStatusFailed
+}
+
+// CanTransitPayout проверяет, что выплату можно перевести из состояния from в состояние to.
+// Незавершённая выплата может остаться в том же состоянии, например, чтобы записать ошибку попытки.
+func CanTransitPayout(from, to string) bool {
+	next, ok := payoutTransitions[from]
+	if !ok {
+		return false
+	}
+	if from == to {
+		return true
+	}
+	for _, status := range next {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (payout *Payout) ToProto() *generatedCreator.Payout {
+	return &generatedCreator.Payout{
+		Id:             payout.Id.String(),
+		CreatorID:      payout.CreatorID.String(),
+		Money:          payout.Money.ToProto(),
+		PhoneNumber:    payout.PhoneNumber,
+		IdempotencyKey: payout.IdempotencyKey,
+		Status:         payout.Status,
+		Attempts:       payout.Attempts,
+		LastError:      payout.LastError,
+		CreatedAt:      payout.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      payout.UpdatedAt.Format(time.RFC3339),
+		DestinationID:  destinationToProto(payout.DestinationID),
+	}
+}
+
+func (payout *Payout) ProtoPayoutToModel(in *generatedCreator.Payout) error {
+	id, err := uuid.Parse(in.Id)
+	if err != nil {
+		return err
+	}
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return err
+	}
+	createdAt, err := time.Parse(time.RFC3339, in.CreatedAt)
+	if err != nil {
+		return err
+	}
+	updatedAt, err := time.Parse(time.RFC3339, in.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	destinationID, err := destinationFromProto(in.DestinationID)
+	if err != nil {
+		return err
+	}
+	payout.Id = id
+	payout.CreatorID = creatorID
+	payout.Money = MoneyFromProto(in.Money)
+	payout.PhoneNumber = in.PhoneNumber
+	payout.IdempotencyKey = in.IdempotencyKey
+	payout.Status = in.Status
+	payout.Attempts = in.Attempts
+	payout.LastError = in.LastError
+	payout.CreatedAt = createdAt
+	payout.UpdatedAt = updatedAt
+	payout.DestinationID = destinationID
+	return nil
+}
+
+// ProtoPayoutRequestToModel разбирает запрос на новую выплату, где заданы только автор, сумма, телефон или реквизиты
+// и ключ идемпотентности
+func ProtoPayoutRequestToModel(in *generatedCreator.Payout) (Payout, error) {
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return Payout{}, err
+	}
+	destinationID, err := destinationFromProto(in.DestinationID)
+	if err != nil {
+		return Payout{}, err
+	}
+	return Payout{
+		CreatorID:      creatorID,
+		Money:          MoneyFromProto(in.Money),
+		PhoneNumber:    in.PhoneNumber,
+		IdempotencyKey: in.IdempotencyKey,
+		DestinationID:  destinationID,
+	}, nil
+}
+
+// destinationToProto передаёт выплату без сохранённых реквизитов пустой строкой
+func destinationToProto(destinationID uuid.UUID) string {
+	if destinationID == uuid.Nil {
+		return ""
+	}
+	return destinationID.String()
+}
+
+func destinationFromProto(destinationID string) (uuid.UUID, error) {
+	if len(destinationID) == 0 {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(destinationID)
+}
+
+// PayoutDestination - сохранённые реквизиты для выплат. Реквизиты сохраняются только после того,
+// как провайдер принял на них проверочный перевод, номер телефона хранится зашифрованным.
+type PayoutDestination struct {
+	Id          uuid.UUID `json:"id"`
+	CreatorID   uuid.UUID `json:"-"`
+	PhoneNumber string    `json:"phone_number"`
+	VerifiedAt  time.Time `json:"verified_at"`
+}
+
+func (destination *PayoutDestination) IsValid() bool {
+	return phoneNumberRegexp.MatchString(destination.PhoneNumber)
+}
+
+func (destination *PayoutDestination) ToProto() *generatedCreator.PayoutDestination {
+	return &generatedCreator.PayoutDestination{
+		Id:          destination.Id.String(),
+		CreatorID:   destination.CreatorID.String(),
+		PhoneNumber: destination.PhoneNumber,
+		VerifiedAt:  destination.VerifiedAt.Format(time.RFC3339),
+	}
+}
+
+func (destination *PayoutDestination) ProtoPayoutDestinationToModel(in *generatedCreator.PayoutDestination) error {
+	id, err := uuid.Parse(in.Id)
+	if err != nil {
+		return err
+	}
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return err
+	}
+	verifiedAt, err := time.Parse(time.RFC3339, in.VerifiedAt)
+	if err != nil {
+		return err
+	}
+	destination.Id = id
+	destination.CreatorID = creatorID
+	destination.PhoneNumber = in.PhoneNumber
+	destination.VerifiedAt = verifiedAt
+	return nil
+}
+
+// PayoutSchedule - автоматические выплаты автора: раз в период на реквизиты DestinationID выводится
+// весь доступный баланс, если он не меньше Threshold
+type PayoutSchedule struct {
+	CreatorID     uuid.UUID `json:"-"`
+	DestinationID uuid.UUID `json:"destination_id"`
+	Period        string    `json:"period"`
+	Threshold     Money     `json:"threshold"`
+	NextRunAt     time.Time `json:"next_run_at"`
+}
+
+func (schedule *PayoutSchedule) IsValid() bool {
+	belowMin, err := schedule.Threshold.Less(MinPayoutThreshold)
+	return (schedule.Period == PayoutPeriodWeekly || schedule.Period == PayoutPeriodMonthly) &&
+		schedule.DestinationID != uuid.Nil && schedule.Threshold.IsValid() && err == nil && !belowMin
+}
+
+// IdempotencyKey - ключ выплаты по расписанию, один на каждый запуск
+func (schedule *PayoutSchedule) IdempotencyKey() string {
+	return "schedule:" + schedule.NextRunAt.UTC().Format("2006-01-02")
+}
+
+// NextPayoutRun возвращает время следующего запуска расписания после after:
+// начало следующего понедельника для weekly и начало следующего месяца для monthly, по UTC
+func NextPayoutRun(period string, after time.Time) time.Time {
+	after = after.UTC()
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, time.UTC)
+	if period == PayoutPeriodMonthly {
+		return time.Date(after.Year(), after.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return day.AddDate(0, 0, 7-(int(day.Weekday())+6)%7)
+}
+
+func (schedule *PayoutSchedule) ToProto() *generatedCreator.PayoutSchedule {
+	return &generatedCreator.PayoutSchedule{
+		CreatorID:     schedule.CreatorID.String(),
+		DestinationID: schedule.DestinationID.String(),
+		Period:        schedule.Period,
+		Threshold:     schedule.Threshold.ToProto(),
+		NextRunAt:     schedule.NextRunAt.Format(time.RFC3339),
+	}
+}
+
+// ProtoPayoutScheduleToModel разбирает расписание; NextRunAt не задаётся в запросе на изменение
+func (schedule *PayoutSchedule) ProtoPayoutScheduleToModel(in *generatedCreator.PayoutSchedule) error {
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return err
+	}
+	destinationID, err := uuid.Parse(in.DestinationID)
+	if err != nil {
+		return err
+	}
+	if len(in.NextRunAt) != 0 {
+		if schedule.NextRunAt, err = time.Parse(time.RFC3339, in.NextRunAt); err != nil {
+			return err
+		}
+	}
+	schedule.CreatorID = creatorID
+	schedule.DestinationID = destinationID
+	schedule.Period = in.Period
+	schedule.Threshold = MoneyFromProto(in.Threshold)
+	return nil
+}
@@ -0,0 +1,329 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson77b271faDecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *PayoutSchedule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "destination_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.DestinationID).UnmarshalText(data))
+			}
+		case "period":
+			out.Period = string(in.String())
+		case "threshold":
+			(out.Threshold).UnmarshalEasyJSON(in)
+		case "next_run_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.NextRunAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson77b271faEncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in PayoutSchedule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"destination_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.DestinationID).MarshalText())
+	}
+	{
+		const prefix string = ",\"period\":"
+		out.RawString(prefix)
+		out.String(string(in.Period))
+	}
+	{
+		const prefix string = ",\"threshold\":"
+		out.RawString(prefix)
+		(in.Threshold).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"next_run_at\":"
+		out.RawString(prefix)
+		out.Raw((in.NextRunAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PayoutSchedule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson77b271faEncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PayoutSchedule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson77b271faEncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PayoutSchedule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson77b271faDecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PayoutSchedule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson77b271faDecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
+func easyjson77b271faDecodeGithubComGoParkMailRu202314from5InternalModels1(in *jlexer.Lexer, out *PayoutDestination) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "phone_number":
+			out.PhoneNumber = string(in.String())
+		case "verified_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.VerifiedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson77b271faEncodeGithubComGoParkMailRu202314from5InternalModels1(out *jwriter.Writer, in PayoutDestination) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"phone_number\":"
+		out.RawString(prefix)
+		out.String(string(in.PhoneNumber))
+	}
+	{
+		const prefix string = ",\"verified_at\":"
+		out.RawString(prefix)
+		out.Raw((in.VerifiedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PayoutDestination) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson77b271faEncodeGithubComGoParkMailRu202314from5InternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PayoutDestination) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson77b271faEncodeGithubComGoParkMailRu202314from5InternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PayoutDestination) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson77b271faDecodeGithubComGoParkMailRu202314from5InternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PayoutDestination) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson77b271faDecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
+}
+func easyjson77b271faDecodeGithubComGoParkMailRu202314from5InternalModels2(in *jlexer.Lexer, out *Payout) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "money":
+			(out.Money).UnmarshalEasyJSON(in)
+		case "phone_number":
+			out.PhoneNumber = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "attempts":
+			out.Attempts = int64(in.Int64())
+		case "last_error":
+			out.LastError = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "destination_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.DestinationID).UnmarshalText(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson77b271faEncodeGithubComGoParkMailRu202314from5InternalModels2(out *jwriter.Writer, in Payout) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"money\":"
+		out.RawString(prefix)
+		(in.Money).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"phone_number\":"
+		out.RawString(prefix)
+		out.String(string(in.PhoneNumber))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"attempts\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Attempts))
+	}
+	if in.LastError != "" {
+		const prefix string = ",\"last_error\":"
+		out.RawString(prefix)
+		out.String(string(in.LastError))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"destination_id\":"
+		out.RawString(prefix)
+		out.RawText((in.DestinationID).MarshalText())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Payout) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson77b271faEncodeGithubComGoParkMailRu202314from5InternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Payout) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson77b271faEncodeGithubComGoParkMailRu202314from5InternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Payout) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson77b271faDecodeGithubComGoParkMailRu202314from5InternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Payout) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson77b271faDecodeGithubComGoParkMailRu202314from5InternalModels2(l, v)
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCanTransitPayout(t *testing.T) {
+	assert.True(t, CanTransitPayout(PayoutStatusRequested, PayoutStatusProcessing))
+	assert.True(t, CanTransitPayout(PayoutStatusRequested, PayoutStatusFailed))
+	assert.True(t, CanTransitPayout(PayoutStatusRequested, PayoutStatusRequested))
+	assert.True(t, CanTransitPayout(PayoutStatusProcessing, PayoutStatusSucceeded))
+	assert.True(t, CanTransitPayout(PayoutStatusProcessing, PayoutStatusFailed))
+
+	assert.False(t, CanTransitPayout(PayoutStatusRequested, PayoutStatusSucceeded))
+	assert.False(t, CanTransitPayout(PayoutStatusProcessing, PayoutStatusRequested))
+	assert.False(t, CanTransitPayout(PayoutStatusSucceeded, PayoutStatusFailed))
+	assert.False(t, CanTransitPayout(PayoutStatusFailed, PayoutStatusFailed))
+	assert.False(t, CanTransitPayout("", PayoutStatusRequested))
+}
+
+func TestPayout_IsValid(t *testing.T) {
+	payout := Payout{Money: NewMoney(100), PhoneNumber: "+79999999999", IdempotencyKey: "key"}
+	assert.True(t, payout.IsValid())
+
+	wrong := payout
+	wrong.PhoneNumber = "8999"
+	assert.False(t, wrong.IsValid())
+
+	wrong = payout
+	wrong.Money = NewMoney(0)
+	assert.False(t, wrong.IsValid())
+
+	wrong = payout
+	wrong.IdempotencyKey = string(make([]byte, IdempotencyKeyMaxLength+1))
+	assert.False(t, wrong.IsValid())
+
+	saved := payout
+	saved.PhoneNumber = ""
+	saved.DestinationID = uuid.New()
+	assert.True(t, saved.IsValid())
+
+	// номер и сохранённые реквизиты одновременно не задаются
+	wrong = saved
+	wrong.PhoneNumber = payout.PhoneNumber
+	assert.False(t, wrong.IsValid())
+}
+
+func TestNextPayoutRun(t *testing.T) {
+	// 10.05.2023 - среда
+	wednesday := time.Date(2023, 5, 10, 15, 30, 0, 0, time.UTC)
+	monday := time.Date(2023, 5, 15, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, monday, NextPayoutRun(PayoutPeriodWeekly, wednesday))
+	assert.Equal(t, monday.AddDate(0, 0, 7), NextPayoutRun(PayoutPeriodWeekly, monday))
+	assert.Equal(t, monday, NextPayoutRun(PayoutPeriodWeekly, monday.Add(-time.Second)))
+
+	assert.Equal(t, time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), NextPayoutRun(PayoutPeriodMonthly, wednesday))
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), NextPayoutRun(PayoutPeriodMonthly, time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC)))
+}
+
+func TestPayoutSchedule_IsValid(t *testing.T) {
+	schedule := PayoutSchedule{DestinationID: uuid.New(), Period: PayoutPeriodWeekly, Threshold: MinPayoutThreshold}
+	assert.True(t, schedule.IsValid())
+
+	wrong := schedule
+	wrong.Period = "daily"
+	assert.False(t, wrong.IsValid())
+
+	wrong = schedule
+	wrong.Threshold = NewMoney(MinPayoutThreshold.Amount - 1)
+	assert.False(t, wrong.IsValid())
+
+	wrong = schedule
+	wrong.DestinationID = uuid.Nil
+	assert.False(t, wrong.IsValid())
+}
@@ -0,0 +1,86 @@
+package models
+
+// easyjson -all ./internal/models/reconciliation.go
+
+import (
+	"time"
+)
+
+const (
+	OperationHistoryURL = "https://yoomoney.ru/api/operation-history"
+
+	OperationDirectionIn  = "in"
+	OperationDirectionOut = "out"
+
+	// MismatchMissingLocal - платёжная система провела операцию, а у нас её нет
+	MismatchMissingLocal = "missing_local"
+	// MismatchMissingProvider - операция есть у нас, но платёжная система её не проводила
+	MismatchMissingProvider = "missing_provider"
+	MismatchAmount          = "amount_mismatch"
+	// MismatchStatus - выплата проведена платёжной системой, но у нас не завершена успешно
+	MismatchStatus = "status_mismatch"
+	// MismatchUnrecognized - входящий платёж с меткой, которую мы не выставляли
+	MismatchUnrecognized = "unrecognized"
+)
+
+// ProviderOperation - успешная операция из истории платёжной системы
+//
+//easyjson:skip
+type ProviderOperation struct {
+	Provider    string
+	OperationID string
+	Direction   string
+	Label       string
+	Money       Money
+	Datetime    time.Time
+}
+
+// LocalOperation - проведённый у нас платёж или выплата.
+// Reference - платёжная информация подписки, id доната, подарка или выплаты.
+//
+//easyjson:skip
+type LocalOperation struct {
+	Kind        string
+	Reference   string
+	OperationID string
+	Money       Money
+	Status      string
+	CreatedAt   time.Time
+}
+
+type ReconciliationMismatch struct {
+	Type          string `json:"type"`
+	Kind          string `json:"kind,omitempty"`
+	OperationID   string `json:"operation_id,omitempty"`
+	Reference     string `json:"reference,omitempty"`
+	ProviderMoney Money  `json:"provider_money"`
+	LocalMoney    Money  `json:"local_money"`
+	LocalStatus   string `json:"local_status,omitempty"`
+	Fixed         bool   `json:"fixed"`
+	FixError      string `json:"fix_error,omitempty"`
+}
+
+// ReconciliationReport - расхождения между историей платёжной системы и нашими платежами за период
+type ReconciliationReport struct {
+	From               time.Time                `json:"from"`
+	To                 time.Time                `json:"to"`
+	ProviderOperations int64                    `json:"provider_operations"`
+	LocalOperations    int64                    `json:"local_operations"`
+	Mismatches         []ReconciliationMismatch `json:"mismatches"`
+}
+
+// OperationHistoryResponse - ответ ЮMoney на operation-history
+type OperationHistoryResponse struct {
+	Error      string             `json:"error"`
+	NextRecord string             `json:"next_record"`
+	Operations []HistoryOperation `json:"operations"`
+}
+
+type HistoryOperation struct {
+	OperationID string    `json:"operation_id"`
+	Status      string    `json:"status"`
+	Datetime    time.Time `json:"datetime"`
+	Direction   string    `json:"direction"`
+	Amount      string    `json:"amount"`
+	Label       string    `json:"label"`
+}
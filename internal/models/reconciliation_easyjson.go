@@ -0,0 +1,486 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *ReconciliationReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.From).UnmarshalJSON(data))
+			}
+		case "to":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.To).UnmarshalJSON(data))
+			}
+		case "provider_operations":
+			out.ProviderOperations = int64(in.Int64())
+		case "local_operations":
+			out.LocalOperations = int64(in.Int64())
+		case "mismatches":
+			if in.IsNull() {
+				in.Skip()
+				out.Mismatches = nil
+			} else {
+				in.Delim('[')
+				if out.Mismatches == nil {
+					if !in.IsDelim(']') {
+						out.Mismatches = make([]ReconciliationMismatch, 0, 0)
+					} else {
+						out.Mismatches = []ReconciliationMismatch{}
+					}
+				} else {
+					out.Mismatches = (out.Mismatches)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ReconciliationMismatch
+					(v1).UnmarshalEasyJSON(in)
+					out.Mismatches = append(out.Mismatches, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in ReconciliationReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.From).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.Raw((in.To).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"provider_operations\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ProviderOperations))
+	}
+	{
+		const prefix string = ",\"local_operations\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LocalOperations))
+	}
+	{
+		const prefix string = ",\"mismatches\":"
+		out.RawString(prefix)
+		if in.Mismatches == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Mismatches {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReconciliationReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReconciliationReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReconciliationReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReconciliationReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
+func easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels1(in *jlexer.Lexer, out *ReconciliationMismatch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "kind":
+			out.Kind = string(in.String())
+		case "operation_id":
+			out.OperationID = string(in.String())
+		case "reference":
+			out.Reference = string(in.String())
+		case "provider_money":
+			(out.ProviderMoney).UnmarshalEasyJSON(in)
+		case "local_money":
+			(out.LocalMoney).UnmarshalEasyJSON(in)
+		case "local_status":
+			out.LocalStatus = string(in.String())
+		case "fixed":
+			out.Fixed = bool(in.Bool())
+		case "fix_error":
+			out.FixError = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels1(out *jwriter.Writer, in ReconciliationMismatch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if in.Kind != "" {
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	if in.OperationID != "" {
+		const prefix string = ",\"operation_id\":"
+		out.RawString(prefix)
+		out.String(string(in.OperationID))
+	}
+	if in.Reference != "" {
+		const prefix string = ",\"reference\":"
+		out.RawString(prefix)
+		out.String(string(in.Reference))
+	}
+	{
+		const prefix string = ",\"provider_money\":"
+		out.RawString(prefix)
+		(in.ProviderMoney).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"local_money\":"
+		out.RawString(prefix)
+		(in.LocalMoney).MarshalEasyJSON(out)
+	}
+	if in.LocalStatus != "" {
+		const prefix string = ",\"local_status\":"
+		out.RawString(prefix)
+		out.String(string(in.LocalStatus))
+	}
+	{
+		const prefix string = ",\"fixed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Fixed))
+	}
+	if in.FixError != "" {
+		const prefix string = ",\"fix_error\":"
+		out.RawString(prefix)
+		out.String(string(in.FixError))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReconciliationMismatch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReconciliationMismatch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReconciliationMismatch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReconciliationMismatch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
+}
+func easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels2(in *jlexer.Lexer, out *OperationHistoryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "error":
+			out.Error = string(in.String())
+		case "next_record":
+			out.NextRecord = string(in.String())
+		case "operations":
+			if in.IsNull() {
+				in.Skip()
+				out.Operations = nil
+			} else {
+				in.Delim('[')
+				if out.Operations == nil {
+					if !in.IsDelim(']') {
+						out.Operations = make([]HistoryOperation, 0, 0)
+					} else {
+						out.Operations = []HistoryOperation{}
+					}
+				} else {
+					out.Operations = (out.Operations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 HistoryOperation
+					(v4).UnmarshalEasyJSON(in)
+					out.Operations = append(out.Operations, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels2(out *jwriter.Writer, in OperationHistoryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"error\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"next_record\":"
+		out.RawString(prefix)
+		out.String(string(in.NextRecord))
+	}
+	{
+		const prefix string = ",\"operations\":"
+		out.RawString(prefix)
+		if in.Operations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Operations {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OperationHistoryResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OperationHistoryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OperationHistoryResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OperationHistoryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels2(l, v)
+}
+func easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels3(in *jlexer.Lexer, out *HistoryOperation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "operation_id":
+			out.OperationID = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "datetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Datetime).UnmarshalJSON(data))
+			}
+		case "direction":
+			out.Direction = string(in.String())
+		case "amount":
+			out.Amount = string(in.String())
+		case "label":
+			out.Label = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels3(out *jwriter.Writer, in HistoryOperation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"operation_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.OperationID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"datetime\":"
+		out.RawString(prefix)
+		out.Raw((in.Datetime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"direction\":"
+		out.RawString(prefix)
+		out.String(string(in.Direction))
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.String(string(in.Amount))
+	}
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix)
+		out.String(string(in.Label))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HistoryOperation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HistoryOperation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEae9a35fEncodeGithubComGoParkMailRu202314from5InternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HistoryOperation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HistoryOperation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEae9a35fDecodeGithubComGoParkMailRu202314from5InternalModels3(l, v)
+}
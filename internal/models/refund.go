@@ -0,0 +1,103 @@
+package models
+
+// easyjson -all ./internal/models/refund.go
+
+import (
+	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
+	"github.com/google/uuid"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// возврат плательщику, метка "refund;{payment-uuid}". Это исходящий перевод с кошелька платформы:
+	// его отправляет возврат от администратора, а переводы, не записанные сервисом, находит сверка по истории операций
+	PaymentKindRefund = "refund"
+
+	RefundSourceAdmin    = "admin"
+	RefundSourceProvider = "provider"
+
+	// RefundReasonTransfer - деньги вернули переводом плательщику из кошелька платформы
+	RefundReasonTransfer  = "transfer"
+	RefundReasonMaxLength = 200
+)
+
+// Refund - возврат платежа за подписку, доната или неактивированного подарка, в сумме возвраты не превышают исходный платёж.
+// Подарок возвращается только целиком и после этого не активируется.
+type Refund struct {
+	Id          uuid.UUID `json:"id"`
+	PaymentId   uuid.UUID `json:"payment_id"`
+	PaymentType string    `json:"payment_type"`
+	CreatorId   uuid.UUID `json:"creator_id"`
+	UserId      uuid.UUID `json:"user_id,omitempty"`
+	Money       Money     `json:"money"`
+	Reason      string    `json:"reason,omitempty"`
+	Source      string    `json:"source"`
+	OperationID string    `json:"operation_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	// AdminId - администратор, оформивший возврат с Source = admin
+	AdminId uuid.UUID `json:"-"`
+}
+
+func (refund *Refund) IsValid() bool {
+	if refund.Source != RefundSourceAdmin && refund.Source != RefundSourceProvider {
+		return false
+	}
+	// возврат от платёжной системы обрабатывается ровно один раз по её operation_id
+	if refund.Source == RefundSourceProvider && len(refund.OperationID) == 0 {
+		return false
+	}
+	if refund.Source == RefundSourceAdmin && refund.AdminId == uuid.Nil {
+		return false
+	}
+	return refund.PaymentId != uuid.Nil && refund.Money.IsValid() && len(refund.OperationID) <= maxOperationIDLength &&
+		utf8.RuneCountInString(refund.Reason) <= RefundReasonMaxLength
+}
+
+func (refund *Refund) ToProto() *generatedUser.Refund {
+	return &generatedUser.Refund{
+		Id:          refund.Id.String(),
+		PaymentID:   refund.PaymentId.String(),
+		PaymentType: refund.PaymentType,
+		CreatorID:   refund.CreatorId.String(),
+		UserID:      refund.UserId.String(),
+		Money:       refund.Money.ToProto(),
+		Reason:      refund.Reason,
+		Source:      refund.Source,
+		OperationID: refund.OperationID,
+		CreatedAt:   refund.CreatedAt.Format(time.RFC3339),
+		AdminID:     refund.AdminId.String(),
+	}
+}
+
+// ProtoRefundToModel разбирает возврат, в запросе на возврат заполнены только платёж, сумма, причина, источник,
+// операция и администратор
+func (refund *Refund) ProtoRefundToModel(in *generatedUser.Refund) error {
+	paymentID, err := uuid.Parse(in.PaymentID)
+	if err != nil {
+		return err
+	}
+	refund.PaymentId = paymentID
+	for _, v := range []struct {
+		value string
+		field *uuid.UUID
+	}{{in.Id, &refund.Id}, {in.CreatorID, &refund.CreatorId}, {in.UserID, &refund.UserId}, {in.AdminID, &refund.AdminId}} {
+		if len(v.value) == 0 {
+			continue
+		}
+		if *v.field, err = uuid.Parse(v.value); err != nil {
+			return err
+		}
+	}
+	if len(in.CreatedAt) != 0 {
+		if refund.CreatedAt, err = time.Parse(time.RFC3339, in.CreatedAt); err != nil {
+			return err
+		}
+	}
+	refund.PaymentType = in.PaymentType
+	refund.Money = MoneyFromProto(in.Money)
+	refund.Reason = in.Reason
+	refund.Source = in.Source
+	refund.OperationID = in.OperationID
+	return nil
+}
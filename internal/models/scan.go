@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// Статусы проверки вложения. Пока файл в карантине (ScanPending), вложение видно в посте только автору,
+// а сам файл не отдаётся никому, в том числе автору. ScanSkipped - вложение загружено без проверки, когда она выключена.
+// ScanFailed - файл так и не удалось проверить, например, он больше, чем принимает антивирус; такой файл тоже не отдаётся.
+const (
+	ScanPending  = "pending"
+	ScanClean    = "clean"
+	ScanRejected = "rejected"
+	ScanSkipped  = "skipped"
+	ScanFailed   = "failed"
+)
+
+// MaxScanAttempts - после стольких неудачных попыток проверки вложение получает ScanFailed
+const MaxScanAttempts = 5
+
+// ScanVerdict - результат проверки файла; Signature - что нашла проверка у отклонённого файла
+type ScanVerdict struct {
+	Status    string
+	Signature string
+}
+
+// QuarantinedAttachment - вложение, ожидающее проверки, с постом и автором, которому сообщить о результате.
+// Attempts и LastError - неудачные попытки проверки и ошибка последней из них.
+type QuarantinedAttachment struct {
+	Attachment
+	PostID    uuid.UUID
+	PostTitle string
+	CreatorID uuid.UUID
+	Verdict   ScanVerdict
+	Attempts  int
+	LastError string
+}
+
+// ScanPassed - можно ли показывать вложение читателям. Пустой статус у вложений без сведений о файле.
+func (metadata *AttachmentMetadata) ScanPassed() bool {
+	switch metadata.ScanStatus {
+	case "", ScanClean, ScanSkipped:
+		return true
+	}
+	return false
+}
+
+// ScannedAttachments оставляет вложения, прошедшие проверку
+func ScannedAttachments(attachments []Attachment) []Attachment {
+	if attachments == nil {
+		return nil
+	}
+	passed := make([]Attachment, 0, len(attachments))
+	for _, attach := range attachments {
+		if attach.ScanPassed() {
+			passed = append(passed, attach)
+		}
+	}
+	return passed
+}
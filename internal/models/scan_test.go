@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestScannedAttachments(t *testing.T) {
+	attachments := make([]Attachment, 0)
+	for _, status := range []string{"", ScanClean, ScanPending, ScanSkipped, ScanRejected, ScanFailed} {
+		attach := Attachment{Id: uuid.New()}
+		attach.ScanStatus = status
+		attachments = append(attachments, attach)
+	}
+	passed := ScannedAttachments(attachments)
+	assert.Equal(t, []Attachment{attachments[0], attachments[1], attachments[3]}, passed)
+	assert.Nil(t, ScannedAttachments(nil))
+
+	metadata, err := ParseAttachmentMetadata(`{"size": 10, "scan_status": "pending"}`)
+	assert.NoError(t, err)
+	assert.False(t, metadata.ScanPassed())
+}
@@ -9,15 +9,29 @@ import (
 // easyjson -all ./internal/models/statistics.go
 
 type Statistics struct {
-	CreatorId              uuid.UUID `json:"creator_id"`
-	PostsPerMonth          int64     `json:"posts_per_month"`
-	SubscriptionsBought    int64     `json:"subscriptions_bought"`
-	DonationsCount         int64     `json:"donations_count"`
-	MoneyFromDonations     float64   `json:"money_from_donations"`
-	MoneyFromSubscriptions float64   `json:"money_from_subscriptions"`
-	NewFollowers           int64     `json:"new_followers"`
-	LikesCount             int64     `json:"likes_count"`
-	CommentsCount          int64     `json:"comments_count"`
+	CreatorId              uuid.UUID           `json:"creator_id"`
+	PostsPerMonth          int64               `json:"posts_per_month"`
+	SubscriptionsBought    int64               `json:"subscriptions_bought"`
+	DonationsCount         int64               `json:"donations_count"`
+	MoneyFromDonations     Money               `json:"money_from_donations"`
+	MoneyFromSubscriptions Money               `json:"money_from_subscriptions"`
+	NewFollowers           int64               `json:"new_followers"`
+	LikesCount             int64               `json:"likes_count"`
+	CommentsCount          int64               `json:"comments_count"`
+	SubscriptionsExpired   int64               `json:"subscriptions_expired"`
+	GiftsBought            int64               `json:"gifts_bought"`
+	MoneyFromGifts         Money               `json:"money_from_gifts"`
+	GrossIncome            Money               `json:"gross_income"` // подписки, донаты и подарки до вычета комиссии
+	Commission             Money               `json:"commission"`
+	NetIncome              Money               `json:"net_income"`
+	BillingPeriods         []BillingPeriodStat `json:"billing_periods"`
+}
+
+// BillingPeriodStat - купленные подписки с оплатой на MonthCount месяцев
+type BillingPeriodStat struct {
+	MonthCount          int64 `json:"month_count"`
+	SubscriptionsBought int64 `json:"subscriptions_bought"`
+	Money               Money `json:"money"`
 }
 
 type StatisticsDates struct {
@@ -36,10 +50,23 @@ func (statistics *Statistics) StatToModel(statInfo *generatedCreator.Stat) error
 	statistics.PostsPerMonth = statInfo.PostsPerMonth
 	statistics.SubscriptionsBought = statInfo.SubscriptionsBought
 	statistics.DonationsCount = statInfo.DonationsCount
-	statistics.MoneyFromDonations = statInfo.MoneyFromDonations
-	statistics.MoneyFromSubscriptions = statInfo.MoneyFromSubscriptions
+	statistics.MoneyFromDonations = MoneyFromProto(statInfo.MoneyFromDonations)
+	statistics.MoneyFromSubscriptions = MoneyFromProto(statInfo.MoneyFromSubscriptions)
 	statistics.NewFollowers = statInfo.NewFollowers
 	statistics.LikesCount = statInfo.LikesCount
 	statistics.CommentsCount = statInfo.CommentsCount
+	statistics.SubscriptionsExpired = statInfo.SubscriptionsExpired
+	statistics.GiftsBought = statInfo.GiftsBought
+	statistics.MoneyFromGifts = MoneyFromProto(statInfo.MoneyFromGifts)
+	statistics.GrossIncome = MoneyFromProto(statInfo.GrossIncome)
+	statistics.Commission = MoneyFromProto(statInfo.Commission)
+	statistics.NetIncome = MoneyFromProto(statInfo.NetIncome)
+	for _, period := range statInfo.BillingPeriods {
+		statistics.BillingPeriods = append(statistics.BillingPeriods, BillingPeriodStat{
+			MonthCount:          period.MonthCount,
+			SubscriptionsBought: period.SubscriptionsBought,
+			Money:               MoneyFromProto(period.Money),
+		})
+	}
 	return nil
 }
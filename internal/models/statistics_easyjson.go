@@ -139,15 +139,50 @@ func easyjson957004edDecodeGithubComGoParkMailRu202314from5InternalModels1(in *j
 		case "donations_count":
 			out.DonationsCount = int64(in.Int64())
 		case "money_from_donations":
-			out.MoneyFromDonations = float64(in.Float64())
+			(out.MoneyFromDonations).UnmarshalEasyJSON(in)
 		case "money_from_subscriptions":
-			out.MoneyFromSubscriptions = float64(in.Float64())
+			(out.MoneyFromSubscriptions).UnmarshalEasyJSON(in)
 		case "new_followers":
 			out.NewFollowers = int64(in.Int64())
 		case "likes_count":
 			out.LikesCount = int64(in.Int64())
 		case "comments_count":
 			out.CommentsCount = int64(in.Int64())
+		case "subscriptions_expired":
+			out.SubscriptionsExpired = int64(in.Int64())
+		case "gifts_bought":
+			out.GiftsBought = int64(in.Int64())
+		case "money_from_gifts":
+			(out.MoneyFromGifts).UnmarshalEasyJSON(in)
+		case "gross_income":
+			(out.GrossIncome).UnmarshalEasyJSON(in)
+		case "commission":
+			(out.Commission).UnmarshalEasyJSON(in)
+		case "net_income":
+			(out.NetIncome).UnmarshalEasyJSON(in)
+		case "billing_periods":
+			if in.IsNull() {
+				in.Skip()
+				out.BillingPeriods = nil
+			} else {
+				in.Delim('[')
+				if out.BillingPeriods == nil {
+					if !in.IsDelim(']') {
+						out.BillingPeriods = make([]BillingPeriodStat, 0, 1)
+					} else {
+						out.BillingPeriods = []BillingPeriodStat{}
+					}
+				} else {
+					out.BillingPeriods = (out.BillingPeriods)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 BillingPeriodStat
+					(v1).UnmarshalEasyJSON(in)
+					out.BillingPeriods = append(out.BillingPeriods, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -185,12 +220,12 @@ func easyjson957004edEncodeGithubComGoParkMailRu202314from5InternalModels1(out *
 	{
 		const prefix string = ",\"money_from_donations\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.MoneyFromDonations))
+		(in.MoneyFromDonations).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"money_from_subscriptions\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.MoneyFromSubscriptions))
+		(in.MoneyFromSubscriptions).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"new_followers\":"
@@ -207,6 +242,52 @@ func easyjson957004edEncodeGithubComGoParkMailRu202314from5InternalModels1(out *
 		out.RawString(prefix)
 		out.Int64(int64(in.CommentsCount))
 	}
+	{
+		const prefix string = ",\"subscriptions_expired\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SubscriptionsExpired))
+	}
+	{
+		const prefix string = ",\"gifts_bought\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.GiftsBought))
+	}
+	{
+		const prefix string = ",\"money_from_gifts\":"
+		out.RawString(prefix)
+		(in.MoneyFromGifts).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"gross_income\":"
+		out.RawString(prefix)
+		(in.GrossIncome).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"commission\":"
+		out.RawString(prefix)
+		(in.Commission).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"net_income\":"
+		out.RawString(prefix)
+		(in.NetIncome).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"billing_periods\":"
+		out.RawString(prefix)
+		if in.BillingPeriods == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.BillingPeriods {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -233,3 +314,83 @@ func (v *Statistics) UnmarshalJSON(data []byte) error {
 func (v *Statistics) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson957004edDecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
 }
+func easyjson957004edDecodeGithubComGoParkMailRu202314from5InternalModels2(in *jlexer.Lexer, out *BillingPeriodStat) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "month_count":
+			out.MonthCount = int64(in.Int64())
+		case "subscriptions_bought":
+			out.SubscriptionsBought = int64(in.Int64())
+		case "money":
+			(out.Money).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson957004edEncodeGithubComGoParkMailRu202314from5InternalModels2(out *jwriter.Writer, in BillingPeriodStat) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"month_count\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.MonthCount))
+	}
+	{
+		const prefix string = ",\"subscriptions_bought\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SubscriptionsBought))
+	}
+	{
+		const prefix string = ",\"money\":"
+		out.RawString(prefix)
+		(in.Money).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BillingPeriodStat) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson957004edEncodeGithubComGoParkMailRu202314from5InternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BillingPeriodStat) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson957004edEncodeGithubComGoParkMailRu202314from5InternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BillingPeriodStat) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson957004edDecodeGithubComGoParkMailRu202314from5InternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BillingPeriodStat) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson957004edDecodeGithubComGoParkMailRu202314from5InternalModels2(l, v)
+}
@@ -0,0 +1,58 @@
+package models
+
+// easyjson -all ./internal/models/storage.go
+
+import (
+	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+)
+
+// MediaTypeUsage - сколько файлов одного типа загрузил автор и сколько места они занимают
+type MediaTypeUsage struct {
+	Type  string `json:"type"`
+	Files int64  `json:"files"`
+	Bytes int64  `json:"bytes"`
+}
+
+// StorageUsage - место, которое занимают вложения автора, и квота по его тарифу в байтах
+type StorageUsage struct {
+	Plan  string           `json:"plan"`
+	Quota int64            `json:"quota"`
+	Used  int64            `json:"used"`
+	Types []MediaTypeUsage `json:"types"`
+}
+
+// Fits - поместится ли ещё size байт в квоту
+func (usage *StorageUsage) Fits(size int64) bool {
+	return size >= 0 && usage.Used+size <= usage.Quota
+}
+
+func (usage *StorageUsage) ToProto() *generatedCreator.StorageUsage {
+	types := make([]*generatedCreator.MediaTypeUsage, 0, len(usage.Types))
+	for _, v := range usage.Types {
+		types = append(types, &generatedCreator.MediaTypeUsage{
+			Type:  v.Type,
+			Files: v.Files,
+			Bytes: v.Bytes,
+		})
+	}
+	return &generatedCreator.StorageUsage{
+		Plan:  usage.Plan,
+		Quota: usage.Quota,
+		Used:  usage.Used,
+		Types: types,
+	}
+}
+
+func (usage *StorageUsage) ProtoStorageUsageToModel(in *generatedCreator.StorageUsage) {
+	usage.Plan = in.Plan
+	usage.Quota = in.Quota
+	usage.Used = in.Used
+	usage.Types = make([]MediaTypeUsage, 0, len(in.Types))
+	for _, v := range in.Types {
+		usage.Types = append(usage.Types, MediaTypeUsage{
+			Type:  v.Type,
+			Files: v.Files,
+			Bytes: v.Bytes,
+		})
+	}
+}
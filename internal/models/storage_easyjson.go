@@ -0,0 +1,218 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson31304c5DecodeGithubComGoParkMailRu202314from5InternalModels(in *jlexer.Lexer, out *StorageUsage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "plan":
+			out.Plan = string(in.String())
+		case "quota":
+			out.Quota = int64(in.Int64())
+		case "used":
+			out.Used = int64(in.Int64())
+		case "types":
+			if in.IsNull() {
+				in.Skip()
+				out.Types = nil
+			} else {
+				in.Delim('[')
+				if out.Types == nil {
+					if !in.IsDelim(']') {
+						out.Types = make([]MediaTypeUsage, 0, 2)
+					} else {
+						out.Types = []MediaTypeUsage{}
+					}
+				} else {
+					out.Types = (out.Types)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 MediaTypeUsage
+					(v1).UnmarshalEasyJSON(in)
+					out.Types = append(out.Types, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson31304c5EncodeGithubComGoParkMailRu202314from5InternalModels(out *jwriter.Writer, in StorageUsage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"plan\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Plan))
+	}
+	{
+		const prefix string = ",\"quota\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Quota))
+	}
+	{
+		const prefix string = ",\"used\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Used))
+	}
+	{
+		const prefix string = ",\"types\":"
+		out.RawString(prefix)
+		if in.Types == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Types {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StorageUsage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson31304c5EncodeGithubComGoParkMailRu202314from5InternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StorageUsage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson31304c5EncodeGithubComGoParkMailRu202314from5InternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StorageUsage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson31304c5DecodeGithubComGoParkMailRu202314from5InternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StorageUsage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson31304c5DecodeGithubComGoParkMailRu202314from5InternalModels(l, v)
+}
+func easyjson31304c5DecodeGithubComGoParkMailRu202314from5InternalModels1(in *jlexer.Lexer, out *MediaTypeUsage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "files":
+			out.Files = int64(in.Int64())
+		case "bytes":
+			out.Bytes = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson31304c5EncodeGithubComGoParkMailRu202314from5InternalModels1(out *jwriter.Writer, in MediaTypeUsage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Files))
+	}
+	{
+		const prefix string = ",\"bytes\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Bytes))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MediaTypeUsage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson31304c5EncodeGithubComGoParkMailRu202314from5InternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MediaTypeUsage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson31304c5EncodeGithubComGoParkMailRu202314from5InternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MediaTypeUsage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson31304c5DecodeGithubComGoParkMailRu202314from5InternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MediaTypeUsage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson31304c5DecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
+}
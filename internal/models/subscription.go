@@ -6,16 +6,32 @@ import (
 	generatedCommon "github.com/go-park-mail-ru/2023_1_4from5/internal/models/proto"
 	"github.com/google/uuid"
 	"html"
+	"time"
 )
 
+const MaxDiscountPercent = 99
+
+// BillingPeriods - на сколько месяцев можно оплатить подписку
+var BillingPeriods = []int64{1, 3, 6, 12}
+
 type Subscription struct {
-	Id           uuid.UUID `json:"id,omitempty"`
-	Creator      uuid.UUID `json:"creator,omitempty"`
-	CreatorName  string    `json:"creator_name,omitempty"`
-	CreatorPhoto uuid.UUID `json:"creator_photo,omitempty"`
-	MonthCost    int64     `json:"month_cost"`
-	Title        string    `json:"title"`
-	Description  string    `json:"description,omitempty"`
+	Id             uuid.UUID       `json:"id,omitempty"`
+	Creator        uuid.UUID       `json:"creator,omitempty"`
+	CreatorName    string          `json:"creator_name,omitempty"`
+	CreatorPhoto   uuid.UUID       `json:"creator_photo,omitempty"`
+	MonthCost      Money           `json:"month_cost"`
+	Title          string          `json:"title"`
+	Description    string          `json:"description,omitempty"`
+	BillingOptions []BillingOption `json:"billing_options,omitempty"`
+}
+
+// BillingOption - вариант оплаты уровня сразу на несколько месяцев.
+// Автор задаёт либо цену за весь период, либо скидку в процентах от month_cost;
+// в ответах Price - итоговая цена за период в обоих случаях.
+type BillingOption struct {
+	MonthCount      int64 `json:"month_count"`
+	Price           Money `json:"price"`
+	DiscountPercent int64 `json:"discount_percent,omitempty"`
 }
 
 type Follow struct {
@@ -33,13 +49,16 @@ type SubscriptionDetails struct {
 	PaymentInfo uuid.UUID `json:"payment_info"`
 }
 
-type PaymentDetails struct {
-	Operation  string    `json:"operation"`
-	CreatorId  uuid.UUID `json:"creator_id"`
-	Id         uuid.UUID `json:"id,omitempty"`
-	UserID     uuid.UUID `json:"user_id,omitempty"`
-	MonthCount int64     `json:"month_count,omitempty"`
-	Money      float32   `json:"money,omitempty"`
+//easyjson:skip
+type ExpiringSubscription struct {
+	UserID         uuid.UUID
+	SubscriptionID uuid.UUID
+	CreatorID      uuid.UUID
+	Title          string
+	ExpireDate     time.Time
+	// UserNotified и CreatorNotified - кто уже получил уведомление об окончании подписки
+	UserNotified    bool
+	CreatorNotified bool
 }
 
 func (subscription *Subscription) Sanitize() {
@@ -53,9 +72,92 @@ func (follow *Follow) Sanitize() {
 }
 
 func (subscription *Subscription) IsValid() bool {
+	periods := make(map[int64]bool, len(subscription.BillingOptions))
+	for _, option := range subscription.BillingOptions {
+		if !option.IsValid() || periods[option.MonthCount] {
+			return false
+		}
+		periods[option.MonthCount] = true
+	}
+	if subscription.MonthCost.Amount < 0 || subscription.MonthCost.currency() != CurrencyRUB {
+		return false
+	}
 	return 0 < len(subscription.Title) && len(subscription.Title) < 41 && len(subscription.Description) < 201
 }
 
+// IsValid проверяет период и цену; при заданной скидке цена игнорируется и пересчитывается из month_cost
+func (option *BillingOption) IsValid() bool {
+	if !IsBillingPeriod(option.MonthCount) {
+		return false
+	}
+	if option.DiscountPercent != 0 {
+		return 0 < option.DiscountPercent && option.DiscountPercent <= MaxDiscountPercent
+	}
+	return option.Price.IsValid()
+}
+
+func IsBillingPeriod(monthCount int64) bool {
+	for _, period := range BillingPeriods {
+		if period == monthCount {
+			return true
+		}
+	}
+	return false
+}
+
+// Price возвращает цену оплаты подписки на monthCount месяцев и false, если такого варианта у уровня нет.
+// Оплата на месяц по month_cost доступна всегда, если для неё не задан отдельный вариант.
+func (subscription *Subscription) Price(monthCount int64) (Money, bool) {
+	for _, option := range subscription.BillingOptions {
+		if option.MonthCount != monthCount {
+			continue
+		}
+		if option.DiscountPercent != 0 {
+			// скидка округляет цену вниз до копейки
+			return Money{
+				Amount:   subscription.MonthCost.Amount * monthCount * (100 - option.DiscountPercent) / 100,
+				Currency: subscription.MonthCost.currency(),
+			}, true
+		}
+		return option.Price, true
+	}
+	if monthCount == 1 {
+		return subscription.MonthCost, true
+	}
+	return Money{}, false
+}
+
+// SetBillingPrices заполняет итоговые цены вариантов оплаты со скидкой
+func (subscription *Subscription) SetBillingPrices() {
+	for i := range subscription.BillingOptions {
+		subscription.BillingOptions[i].Price, _ = subscription.Price(subscription.BillingOptions[i].MonthCount)
+	}
+}
+
+func BillingOptionsToProto(options []BillingOption) []*generatedCommon.BillingOption {
+	var out []*generatedCommon.BillingOption
+	for _, option := range options {
+		out = append(out, &generatedCommon.BillingOption{
+			MonthCount:      option.MonthCount,
+			Price:           option.Price.ToProto(),
+			DiscountPercent: option.DiscountPercent,
+		})
+	}
+	return out
+}
+
+func BillingOptionsFromProto(options []*generatedCommon.BillingOption) []BillingOption {
+	var out []BillingOption
+	for _, option := range options {
+		out = append(out, BillingOption{
+			MonthCount:      option.MonthCount,
+			Price:           MoneyFromProto(option.Price),
+			DiscountPercent: option.DiscountPercent,
+		})
+	}
+	return out
+}
+
 func (subscription *Subscription) ProtoSubscriptionToModel(sub *generatedCommon.Subscription) error {
 	subID, err := uuid.Parse(sub.Id)
 	if err != nil {
@@ -73,8 +175,9 @@ func (subscription *Subscription) ProtoSubscriptionToModel(sub *generatedCommon.
 	subscription.Creator = creatorID
 	subscription.CreatorName = sub.CreatorName
 	subscription.CreatorPhoto = creatorPhoto
-	subscription.MonthCost = sub.MonthCost
+	subscription.MonthCost = MoneyFromProto(sub.MonthCost)
 	subscription.Title = sub.Title
 	subscription.Description = sub.Description
+	subscription.BillingOptions = BillingOptionsFromProto(sub.BillingOptions)
 	return nil
 }
@@ -153,11 +153,34 @@ func easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels1(in *j
 				in.AddError((out.CreatorPhoto).UnmarshalText(data))
 			}
 		case "month_cost":
-			out.MonthCost = int64(in.Int64())
+			(out.MonthCost).UnmarshalEasyJSON(in)
 		case "title":
 			out.Title = string(in.String())
 		case "description":
 			out.Description = string(in.String())
+		case "billing_options":
+			if in.IsNull() {
+				in.Skip()
+				out.BillingOptions = nil
+			} else {
+				in.Delim('[')
+				if out.BillingOptions == nil {
+					if !in.IsDelim(']') {
+						out.BillingOptions = make([]BillingOption, 0, 1)
+					} else {
+						out.BillingOptions = []BillingOption{}
+					}
+				} else {
+					out.BillingOptions = (out.BillingOptions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 BillingOption
+					(v1).UnmarshalEasyJSON(in)
+					out.BillingOptions = append(out.BillingOptions, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -216,7 +239,7 @@ func easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels1(out *
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int64(int64(in.MonthCost))
+		(in.MonthCost).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"title\":"
@@ -228,6 +251,20 @@ func easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels1(out *
 		out.RawString(prefix)
 		out.String(string(in.Description))
 	}
+	if len(in.BillingOptions) != 0 {
+		const prefix string = ",\"billing_options\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.BillingOptions {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -254,7 +291,7 @@ func (v *Subscription) UnmarshalJSON(data []byte) error {
 func (v *Subscription) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels1(l, v)
 }
-func easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels2(in *jlexer.Lexer, out *PaymentDetails) {
+func easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels2(in *jlexer.Lexer, out *Follow) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -273,24 +310,18 @@ func easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels2(in *j
 			continue
 		}
 		switch key {
-		case "operation":
-			out.Operation = string(in.String())
-		case "creator_id":
-			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.CreatorId).UnmarshalText(data))
-			}
-		case "id":
+		case "creator":
 			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.Id).UnmarshalText(data))
+				in.AddError((out.Creator).UnmarshalText(data))
 			}
-		case "user_id":
+		case "creator_name":
+			out.CreatorName = string(in.String())
+		case "creator_photo":
 			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.UserID).UnmarshalText(data))
+				in.AddError((out.CreatorPhoto).UnmarshalText(data))
 			}
-		case "month_count":
-			out.MonthCount = int64(in.Int64())
-		case "money":
-			out.Money = float32(in.Float32())
+		case "description":
+			out.Description = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -301,67 +332,73 @@ func easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels2(in *j
 		in.Consumed()
 	}
 }
-func easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels2(out *jwriter.Writer, in PaymentDetails) {
+func easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels2(out *jwriter.Writer, in Follow) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"operation\":"
+	if true {
+		const prefix string = ",\"creator\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Operation))
-	}
-	{
-		const prefix string = ",\"creator_id\":"
-		out.RawString(prefix)
-		out.RawText((in.CreatorId).MarshalText())
+		out.RawText((in.Creator).MarshalText())
 	}
-	if true {
-		const prefix string = ",\"id\":"
-		out.RawString(prefix)
-		out.RawText((in.Id).MarshalText())
+	if in.CreatorName != "" {
+		const prefix string = ",\"creator_name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CreatorName))
 	}
 	if true {
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
-		out.RawText((in.UserID).MarshalText())
-	}
-	if in.MonthCount != 0 {
-		const prefix string = ",\"month_count\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.MonthCount))
+		const prefix string = ",\"creator_photo\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawText((in.CreatorPhoto).MarshalText())
 	}
-	if in.Money != 0 {
-		const prefix string = ",\"money\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.Money))
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentDetails) MarshalJSON() ([]byte, error) {
+func (v Follow) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentDetails) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Follow) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentDetails) UnmarshalJSON(data []byte) error {
+func (v *Follow) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentDetails) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Follow) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels2(l, v)
 }
-func easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels3(in *jlexer.Lexer, out *Follow) {
+func easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels3(in *jlexer.Lexer, out *BillingOption) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -380,18 +417,12 @@ func easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels3(in *j
 			continue
 		}
 		switch key {
-		case "creator":
-			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.Creator).UnmarshalText(data))
-			}
-		case "creator_name":
-			out.CreatorName = string(in.String())
-		case "creator_photo":
-			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.CreatorPhoto).UnmarshalText(data))
-			}
-		case "description":
-			out.Description = string(in.String())
+		case "month_count":
+			out.MonthCount = int64(in.Int64())
+		case "price":
+			(out.Price).UnmarshalEasyJSON(in)
+		case "discount_percent":
+			out.DiscountPercent = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -402,69 +433,48 @@ func easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels3(in *j
 		in.Consumed()
 	}
 }
-func easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels3(out *jwriter.Writer, in Follow) {
+func easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels3(out *jwriter.Writer, in BillingOption) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if true {
-		const prefix string = ",\"creator\":"
-		first = false
+	{
+		const prefix string = ",\"month_count\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.Creator).MarshalText())
-	}
-	if in.CreatorName != "" {
-		const prefix string = ",\"creator_name\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.CreatorName))
+		out.Int64(int64(in.MonthCount))
 	}
-	if true {
-		const prefix string = ",\"creator_photo\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.RawText((in.CreatorPhoto).MarshalText())
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		(in.Price).MarshalEasyJSON(out)
 	}
-	if in.Description != "" {
-		const prefix string = ",\"description\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Description))
+	if in.DiscountPercent != 0 {
+		const prefix string = ",\"discount_percent\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DiscountPercent))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Follow) MarshalJSON() ([]byte, error) {
+func (v BillingOption) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Follow) MarshalEasyJSON(w *jwriter.Writer) {
+func (v BillingOption) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonFfbd3743EncodeGithubComGoParkMailRu202314from5InternalModels3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Follow) UnmarshalJSON(data []byte) error {
+func (v *BillingOption) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Follow) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *BillingOption) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonFfbd3743DecodeGithubComGoParkMailRu202314from5InternalModels3(l, v)
 }
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSubscription_Price(t *testing.T) {
+	subscription := Subscription{MonthCost: NewMoney(30000), BillingOptions: []BillingOption{
+		{MonthCount: 3, DiscountPercent: 10},
+		{MonthCount: 12, Price: NewMoney(250000)},
+	}}
+
+	price, ok := subscription.Price(1)
+	assert.True(t, ok)
+	assert.Equal(t, NewMoney(30000), price)
+
+	price, ok = subscription.Price(3)
+	assert.True(t, ok)
+	assert.Equal(t, NewMoney(81000), price)
+
+	price, ok = subscription.Price(12)
+	assert.True(t, ok)
+	assert.Equal(t, NewMoney(250000), price)
+
+	_, ok = subscription.Price(6)
+	assert.False(t, ok)
+
+	subscription.BillingOptions = append(subscription.BillingOptions, BillingOption{MonthCount: 1, Price: NewMoney(29900)})
+	price, ok = subscription.Price(1)
+	assert.True(t, ok)
+	assert.Equal(t, NewMoney(29900), price)
+}
+
+func TestSubscription_IsValid(t *testing.T) {
+	subscription := Subscription{Title: "test", MonthCost: NewMoney(30000), BillingOptions: []BillingOption{
+		{MonthCount: 3, DiscountPercent: 10},
+		{MonthCount: 12, Price: NewMoney(250000)},
+	}}
+	assert.True(t, subscription.IsValid())
+
+	wrong := subscription
+	wrong.BillingOptions = []BillingOption{{MonthCount: 2, DiscountPercent: 10}}
+	assert.False(t, wrong.IsValid())
+
+	wrong.BillingOptions = []BillingOption{{MonthCount: 3, DiscountPercent: 100}}
+	assert.False(t, wrong.IsValid())
+
+	wrong.BillingOptions = []BillingOption{{MonthCount: 3}}
+	assert.False(t, wrong.IsValid())
+
+	wrong.BillingOptions = []BillingOption{{MonthCount: 3, DiscountPercent: 10}, {MonthCount: 3, Price: NewMoney(100)}}
+	assert.False(t, wrong.IsValid())
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// easyjson -all ./internal/models/upload.go
+
+const (
+	// DefaultUploadLimit - размер файла, который автор может загрузить по умолчанию, если лимит не изменён
+	DefaultUploadLimit = 2 << 30
+	// UploadMetadataCreator - ключ Upload-Metadata с id автора, для которого загружается файл
+	UploadMetadataCreator = "creator"
+	// UploadMetadataFilename - ключ Upload-Metadata с исходным именем файла
+	UploadMetadataFilename = "filename"
+	// UploadMetadataAltText и UploadMetadataCaption - ключи Upload-Metadata с подписями автора к вложению
+	UploadMetadataAltText = "alt_text"
+	UploadMetadataCaption = "caption"
+)
+
+// Upload - докачиваемая загрузка файла по протоколу tus. Offset не хранится, а считается по загруженным кускам.
+type Upload struct {
+	Id        uuid.UUID         `json:"id"`
+	UserId    uuid.UUID         `json:"user_id"`
+	CreatorId uuid.UUID         `json:"creator_id"`
+	Length    int64             `json:"length"`
+	Offset    int64             `json:"-"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
+
+func (upload *Upload) IsComplete() bool {
+	return upload.Offset == upload.Length
+}
+
+func (upload *Upload) IsExpired(now time.Time) bool {
+	return !now.Before(upload.ExpiresAt)
+}
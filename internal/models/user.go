@@ -5,6 +5,7 @@ import (
 	"html"
 	"time"
 	"unicode"
+	"unicode/utf8"
 )
 
 // easyjson -all ./internal/models/user.go
@@ -99,8 +100,20 @@ type UpdateProfileInfo struct {
 }
 
 type Donate struct {
-	CreatorID  uuid.UUID `json:"creator_id"`
-	MoneyCount float32   `json:"money_count"`
+	CreatorID   uuid.UUID `json:"creator_id"`
+	MoneyCount  Money     `json:"money_count"`
+	OperationId string    `json:"operation_id,omitempty"`
+	UserId      uuid.UUID `json:"user_id,omitempty"`
+	PaymentInfo uuid.UUID `json:"payment_info,omitempty"`
+	Message     string    `json:"message,omitempty"`
+	IsAnonymous bool      `json:"is_anonymous"`
+	AimId       uuid.UUID `json:"aim_id,omitempty"`
+}
+
+const DonateMessageMaxLength = 200
+
+func (donate *Donate) IsValid() bool {
+	return utf8.RuneCountInString(donate.Message) <= DonateMessageMaxLength
 }
 
 func (becameCreatorInfo *BecameCreatorInfo) IsValid() bool {
@@ -481,7 +481,25 @@ func easyjson9e1087fdDecodeGithubComGoParkMailRu202314from5InternalModels5(in *j
 				in.AddError((out.CreatorID).UnmarshalText(data))
 			}
 		case "money_count":
-			out.MoneyCount = float32(in.Float32())
+			(out.MoneyCount).UnmarshalEasyJSON(in)
+		case "operation_id":
+			out.OperationId = string(in.String())
+		case "user_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		case "payment_info":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PaymentInfo).UnmarshalText(data))
+			}
+		case "message":
+			out.Message = string(in.String())
+		case "is_anonymous":
+			out.IsAnonymous = bool(in.Bool())
+		case "aim_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AimId).UnmarshalText(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -504,7 +522,37 @@ func easyjson9e1087fdEncodeGithubComGoParkMailRu202314from5InternalModels5(out *
 	{
 		const prefix string = ",\"money_count\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.MoneyCount))
+		(in.MoneyCount).MarshalEasyJSON(out)
+	}
+	if in.OperationId != "" {
+		const prefix string = ",\"operation_id\":"
+		out.RawString(prefix)
+		out.String(string(in.OperationId))
+	}
+	if true {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.RawText((in.UserId).MarshalText())
+	}
+	if true {
+		const prefix string = ",\"payment_info\":"
+		out.RawString(prefix)
+		out.RawText((in.PaymentInfo).MarshalText())
+	}
+	if in.Message != "" {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"is_anonymous\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAnonymous))
+	}
+	if true {
+		const prefix string = ",\"aim_id\":"
+		out.RawString(prefix)
+		out.RawText((in.AimId).MarshalText())
 	}
 	out.RawByte('}')
 }
@@ -0,0 +1,92 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/attachment"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification"
+	"go.uber.org/zap"
+	"os"
+	"time"
+)
+
+const (
+	defaultScanInterval = time.Minute
+	scanBatch           = 100
+)
+
+// GetScanInterval reads UPLOAD_SCAN_INTERVAL (time.Duration string) from the environment.
+func GetScanInterval() (time.Duration, error) {
+	interval, flag := os.LookupEnv("UPLOAD_SCAN_INTERVAL")
+	if !flag {
+		return defaultScanInterval, nil
+	}
+	tmp, err := time.ParseDuration(interval)
+	if err != nil || tmp <= 0 {
+		return 0, errors.New("wrong UPLOAD_SCAN_INTERVAL value")
+	}
+	return tmp, nil
+}
+
+// ScanJob периодически проверяет вложения из карантина и сообщает автору об отклонённых
+// и о тех, что так и не удалось проверить
+type ScanJob struct {
+	uc              attachment.AttachmentUsecase
+	notificationApp notification.NotificationApp
+	interval        time.Duration
+	logger          *zap.SugaredLogger
+}
+
+func NewScanJob(uc attachment.AttachmentUsecase, na notification.NotificationApp, interval time.Duration, logger *zap.SugaredLogger) *ScanJob {
+	return &ScanJob{
+		uc:              uc,
+		notificationApp: na,
+		interval:        interval,
+		logger:          logger,
+	}
+}
+
+func (j *ScanJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	j.Process(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.Process(ctx)
+		}
+	}
+}
+
+func (j *ScanJob) Process(ctx context.Context) []models.QuarantinedAttachment {
+	finished, err := j.uc.ScanPending(ctx, scanBatch)
+	if err != nil {
+		j.logger.Error(err)
+	}
+	for _, attach := range finished {
+		name := attach.Filename
+		if len(name) == 0 {
+			name = attach.Id.String()
+		}
+		notification := models.Notification{
+			Topic: fmt.Sprintf("%s-%s", attach.CreatorID, "creator"),
+			Title: "Вложение не прошло проверку",
+			Body:  fmt.Sprintf("Файл %s в посте %s не опубликован: в нём найдено запрещённое содержимое", name, attach.PostTitle),
+		}
+		if attach.Verdict.Status == models.ScanFailed {
+			j.logger.Infof("attachment %s of post %s failed scan after %d attempts: %s", attach.Id, attach.PostID, attach.Attempts, attach.LastError)
+			notification.Title = "Вложение не удалось проверить"
+			notification.Body = fmt.Sprintf("Файл %s в посте %s не опубликован: его не удалось проверить. Удалите вложение и загрузите файл заново, "+
+				"большие файлы лучше разделить на части", name, attach.PostTitle)
+		} else {
+			j.logger.Infof("attachment %s of post %s rejected: %s", attach.Id, attach.PostID, attach.Verdict.Signature)
+		}
+		_ = j.notificationApp.SendUserNotification(notification, ctx)
+	}
+	return finished
+}
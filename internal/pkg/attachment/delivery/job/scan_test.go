@@ -0,0 +1,79 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	mockAttachment "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/attachment/mocks"
+	mockNotification "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestGetScanInterval(t *testing.T) {
+	os.Setenv("UPLOAD_SCAN_INTERVAL", "30s")
+	interval, err := GetScanInterval()
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, interval)
+
+	os.Setenv("UPLOAD_SCAN_INTERVAL", "0s")
+	_, err = GetScanInterval()
+	require.Error(t, err)
+
+	os.Unsetenv("UPLOAD_SCAN_INTERVAL")
+	interval, err = GetScanInterval()
+	require.NoError(t, err)
+	require.Equal(t, defaultScanInterval, interval)
+}
+
+func TestScanJob_Process(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	attachmentUsecase := mockAttachment.NewMockAttachmentUsecase(ctl)
+	notificationApp := mockNotification.NewMockNotificationApp(ctl)
+
+	rejected := []models.QuarantinedAttachment{
+		{
+			Attachment: models.Attachment{Id: uuid.New(), Type: "application/zip"},
+			PostID:     uuid.New(),
+			PostTitle:  "test",
+			CreatorID:  uuid.New(),
+			Verdict:    models.ScanVerdict{Status: models.ScanRejected, Signature: "Win.Test.EICAR_HDB-1"},
+		},
+		{
+			Attachment: models.Attachment{Id: uuid.New(), Type: "image/jpeg"},
+			PostID:     uuid.New(),
+			CreatorID:  uuid.New(),
+			Verdict:    models.ScanVerdict{Status: models.ScanRejected, Signature: "Win.Test.EICAR_HDB-1"},
+		},
+		{
+			Attachment: models.Attachment{Id: uuid.New(), Type: "video/mp4"},
+			PostID:     uuid.New(),
+			CreatorID:  uuid.New(),
+			Verdict:    models.ScanVerdict{Status: models.ScanFailed},
+			Attempts:   1,
+			LastError:  "clamav: INSTREAM size limit exceeded. ERROR: TooLarge",
+		},
+	}
+	rejected[0].Filename = "archive.zip"
+	j := NewScanJob(attachmentUsecase, notificationApp, time.Minute, zap.NewNop().Sugar())
+
+	attachmentUsecase.EXPECT().ScanPending(gomock.Any(), scanBatch).Return(rejected, nil)
+	for _, attach := range rejected {
+		notificationApp.EXPECT().SendUserNotification(mockNotification.Topic(attach.CreatorID.String()+"-creator"), gomock.Any()).Return(nil)
+	}
+	require.Equal(t, rejected, j.Process(context.Background()))
+
+	// об отклонённых до ошибки вложениях автору всё равно сообщают
+	attachmentUsecase.EXPECT().ScanPending(gomock.Any(), scanBatch).Return(rejected[:1], errors.New("test"))
+	notificationApp.EXPECT().SendUserNotification(mockNotification.Topic(rejected[0].CreatorID.String()+"-creator"), gomock.Any()).Return(nil)
+	require.Equal(t, rejected[:1], j.Process(context.Background()))
+
+	attachmentUsecase.EXPECT().ScanPending(gomock.Any(), scanBatch).Return(nil, errors.New("test"))
+	require.Empty(t, j.Process(context.Background()))
+}
@@ -3,7 +3,9 @@ package attachment
 import (
 	"context"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
 	"github.com/google/uuid"
+	"time"
 )
 
 //go:generate mockgen -source=interfaces.go -destination=./mocks/attachment_mock.go -package=mock
@@ -14,10 +16,24 @@ type AttachmentUsecase interface {
 	DeleteAttachment(ctx context.Context, postID uuid.UUID, attach models.Attachment) error
 	AddAttach(ctx context.Context, postID uuid.UUID, attachment models.Attachment) error
 	GetFileExtension(ctx context.Context, key string) (string, bool)
+	GetMediaType(ctx context.Context, contentType string) (media.MediaType, bool)
+	GetAttachment(ctx context.Context, postID, attachmentID uuid.UUID) (models.Attachment, error)
+	// AddDownload засчитывает скачивание по ссылке key один раз, пока ссылка действует (до expiresAt)
+	AddDownload(ctx context.Context, postID, attachmentID uuid.UUID, key string, expiresAt time.Time) error
+	HoldBlob(ctx context.Context, blobID string) error
+	UploadScanStatus(ctx context.Context) string
+	ScanPending(ctx context.Context, limit int) ([]models.QuarantinedAttachment, error)
 }
 
 type AttachmentRepo interface {
-	CreateAttachment(ctx context.Context, postID uuid.UUID, attachmentID uuid.UUID, attachmentType string) error
+	CreateAttachment(ctx context.Context, postID uuid.UUID, attachment models.Attachment) error
 	DeleteAttachmentsByPostID(ctx context.Context, postID uuid.UUID) ([]models.Attachment, error)
 	DeleteAttachment(ctx context.Context, attachmentID, postID uuid.UUID) error
+	GetAttachment(ctx context.Context, attachmentID, postID uuid.UUID) (models.Attachment, error)
+	AddDownload(ctx context.Context, attachmentID, postID uuid.UUID, key string, expiresAt time.Time) error
+	HoldBlob(ctx context.Context, blobID string, hold time.Duration) error
+	DeleteUnusedBlob(ctx context.Context, blobID string, deleteFiles func() error) (bool, error)
+	PendingScans(ctx context.Context, limit int) ([]models.QuarantinedAttachment, error)
+	SetScanVerdict(ctx context.Context, attachmentID uuid.UUID, verdict models.ScanVerdict) error
+	SetScanError(ctx context.Context, attachmentID uuid.UUID, scanErr string, maxAttempts int) (string, int, error)
 }
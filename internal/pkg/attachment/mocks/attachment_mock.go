@@ -7,8 +7,10 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	media "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -37,7 +39,7 @@ func (m *MockAttachmentUsecase) EXPECT() *MockAttachmentUsecaseMockRecorder {
 }
 
 // AddAttach mocks base method.
-func (m *MockAttachmentUsecase) AddAttach(ctx context.Context, postID uuid.UUID, attachment models.AttachmentData) error {
+func (m *MockAttachmentUsecase) AddAttach(ctx context.Context, postID uuid.UUID, attachment models.Attachment) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddAttach", ctx, postID, attachment)
 	ret0, _ := ret[0].(error)
@@ -50,27 +52,22 @@ func (mr *MockAttachmentUsecaseMockRecorder) AddAttach(ctx, postID, attachment i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttach", reflect.TypeOf((*MockAttachmentUsecase)(nil).AddAttach), ctx, postID, attachment)
 }
 
-// CreateAttachments mocks base method.
-func (m *MockAttachmentUsecase) CreateAttachments(ctx context.Context, attachments ...models.AttachmentData) error {
+// AddDownload mocks base method.
+func (m *MockAttachmentUsecase) AddDownload(ctx context.Context, postID, attachmentID uuid.UUID, key string, expiresAt time.Time) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx}
-	for _, a := range attachments {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "CreateAttachments", varargs...)
+	ret := m.ctrl.Call(m, "AddDownload", ctx, postID, attachmentID, key, expiresAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateAttachments indicates an expected call of CreateAttachments.
-func (mr *MockAttachmentUsecaseMockRecorder) CreateAttachments(ctx interface{}, attachments ...interface{}) *gomock.Call {
+// AddDownload indicates an expected call of AddDownload.
+func (mr *MockAttachmentUsecaseMockRecorder) AddDownload(ctx, postID, attachmentID, key, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx}, attachments...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachments", reflect.TypeOf((*MockAttachmentUsecase)(nil).CreateAttachments), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDownload", reflect.TypeOf((*MockAttachmentUsecase)(nil).AddDownload), ctx, postID, attachmentID, key, expiresAt)
 }
 
 // DeleteAttachment mocks base method.
-func (m *MockAttachmentUsecase) DeleteAttachment(ctx context.Context, postID uuid.UUID, attach models.AttachmentData) error {
+func (m *MockAttachmentUsecase) DeleteAttachment(ctx context.Context, postID uuid.UUID, attach models.Attachment) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, postID, attach)
 	ret0, _ := ret[0].(error)
@@ -98,7 +95,7 @@ func (mr *MockAttachmentUsecaseMockRecorder) DeleteAttachmentsByPostID(ctx, post
 }
 
 // DeleteAttachmentsFiles mocks base method.
-func (m *MockAttachmentUsecase) DeleteAttachmentsFiles(ctx context.Context, attachments ...models.AttachmentData) error {
+func (m *MockAttachmentUsecase) DeleteAttachmentsFiles(ctx context.Context, attachments ...models.Attachment) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx}
 	for _, a := range attachments {
@@ -116,6 +113,94 @@ func (mr *MockAttachmentUsecaseMockRecorder) DeleteAttachmentsFiles(ctx interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachmentsFiles", reflect.TypeOf((*MockAttachmentUsecase)(nil).DeleteAttachmentsFiles), varargs...)
 }
 
+// GetAttachment mocks base method.
+func (m *MockAttachmentUsecase) GetAttachment(ctx context.Context, postID, attachmentID uuid.UUID) (models.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, postID, attachmentID)
+	ret0, _ := ret[0].(models.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockAttachmentUsecaseMockRecorder) GetAttachment(ctx, postID, attachmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockAttachmentUsecase)(nil).GetAttachment), ctx, postID, attachmentID)
+}
+
+// GetFileExtension mocks base method.
+func (m *MockAttachmentUsecase) GetFileExtension(ctx context.Context, key string) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileExtension", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetFileExtension indicates an expected call of GetFileExtension.
+func (mr *MockAttachmentUsecaseMockRecorder) GetFileExtension(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileExtension", reflect.TypeOf((*MockAttachmentUsecase)(nil).GetFileExtension), ctx, key)
+}
+
+// GetMediaType mocks base method.
+func (m *MockAttachmentUsecase) GetMediaType(ctx context.Context, contentType string) (media.MediaType, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMediaType", ctx, contentType)
+	ret0, _ := ret[0].(media.MediaType)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetMediaType indicates an expected call of GetMediaType.
+func (mr *MockAttachmentUsecaseMockRecorder) GetMediaType(ctx, contentType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMediaType", reflect.TypeOf((*MockAttachmentUsecase)(nil).GetMediaType), ctx, contentType)
+}
+
+// HoldBlob mocks base method.
+func (m *MockAttachmentUsecase) HoldBlob(ctx context.Context, blobID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldBlob", ctx, blobID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HoldBlob indicates an expected call of HoldBlob.
+func (mr *MockAttachmentUsecaseMockRecorder) HoldBlob(ctx, blobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldBlob", reflect.TypeOf((*MockAttachmentUsecase)(nil).HoldBlob), ctx, blobID)
+}
+
+// ScanPending mocks base method.
+func (m *MockAttachmentUsecase) ScanPending(ctx context.Context, limit int) ([]models.QuarantinedAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanPending", ctx, limit)
+	ret0, _ := ret[0].([]models.QuarantinedAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanPending indicates an expected call of ScanPending.
+func (mr *MockAttachmentUsecaseMockRecorder) ScanPending(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanPending", reflect.TypeOf((*MockAttachmentUsecase)(nil).ScanPending), ctx, limit)
+}
+
+// UploadScanStatus mocks base method.
+func (m *MockAttachmentUsecase) UploadScanStatus(ctx context.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadScanStatus", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// UploadScanStatus indicates an expected call of UploadScanStatus.
+func (mr *MockAttachmentUsecaseMockRecorder) UploadScanStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadScanStatus", reflect.TypeOf((*MockAttachmentUsecase)(nil).UploadScanStatus), ctx)
+}
+
 // MockAttachmentRepo is a mock of AttachmentRepo interface.
 type MockAttachmentRepo struct {
 	ctrl     *gomock.Controller
@@ -139,18 +224,32 @@ func (m *MockAttachmentRepo) EXPECT() *MockAttachmentRepoMockRecorder {
 	return m.recorder
 }
 
+// AddDownload mocks base method.
+func (m *MockAttachmentRepo) AddDownload(ctx context.Context, attachmentID, postID uuid.UUID, key string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDownload", ctx, attachmentID, postID, key, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDownload indicates an expected call of AddDownload.
+func (mr *MockAttachmentRepoMockRecorder) AddDownload(ctx, attachmentID, postID, key, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDownload", reflect.TypeOf((*MockAttachmentRepo)(nil).AddDownload), ctx, attachmentID, postID, key, expiresAt)
+}
+
 // CreateAttachment mocks base method.
-func (m *MockAttachmentRepo) CreateAttachment(ctx context.Context, postID, attachmentID uuid.UUID, attachmentType string) error {
+func (m *MockAttachmentRepo) CreateAttachment(ctx context.Context, postID uuid.UUID, attachment models.Attachment) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAttachment", ctx, postID, attachmentID, attachmentType)
+	ret := m.ctrl.Call(m, "CreateAttachment", ctx, postID, attachment)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateAttachment indicates an expected call of CreateAttachment.
-func (mr *MockAttachmentRepoMockRecorder) CreateAttachment(ctx, postID, attachmentID, attachmentType interface{}) *gomock.Call {
+func (mr *MockAttachmentRepoMockRecorder) CreateAttachment(ctx, postID, attachment interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockAttachmentRepo)(nil).CreateAttachment), ctx, postID, attachmentID, attachmentType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockAttachmentRepo)(nil).CreateAttachment), ctx, postID, attachment)
 }
 
 // DeleteAttachment mocks base method.
@@ -168,10 +267,10 @@ func (mr *MockAttachmentRepoMockRecorder) DeleteAttachment(ctx, attachmentID, po
 }
 
 // DeleteAttachmentsByPostID mocks base method.
-func (m *MockAttachmentRepo) DeleteAttachmentsByPostID(ctx context.Context, postID uuid.UUID) ([]models.AttachmentData, error) {
+func (m *MockAttachmentRepo) DeleteAttachmentsByPostID(ctx context.Context, postID uuid.UUID) ([]models.Attachment, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteAttachmentsByPostID", ctx, postID)
-	ret0, _ := ret[0].([]models.AttachmentData)
+	ret0, _ := ret[0].([]models.Attachment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -181,3 +280,92 @@ func (mr *MockAttachmentRepoMockRecorder) DeleteAttachmentsByPostID(ctx, postID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachmentsByPostID", reflect.TypeOf((*MockAttachmentRepo)(nil).DeleteAttachmentsByPostID), ctx, postID)
 }
+
+// DeleteUnusedBlob mocks base method.
+func (m *MockAttachmentRepo) DeleteUnusedBlob(ctx context.Context, blobID string, deleteFiles func() error) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnusedBlob", ctx, blobID, deleteFiles)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUnusedBlob indicates an expected call of DeleteUnusedBlob.
+func (mr *MockAttachmentRepoMockRecorder) DeleteUnusedBlob(ctx, blobID, deleteFiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnusedBlob", reflect.TypeOf((*MockAttachmentRepo)(nil).DeleteUnusedBlob), ctx, blobID, deleteFiles)
+}
+
+// GetAttachment mocks base method.
+func (m *MockAttachmentRepo) GetAttachment(ctx context.Context, attachmentID, postID uuid.UUID) (models.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, attachmentID, postID)
+	ret0, _ := ret[0].(models.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockAttachmentRepoMockRecorder) GetAttachment(ctx, attachmentID, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockAttachmentRepo)(nil).GetAttachment), ctx, attachmentID, postID)
+}
+
+// HoldBlob mocks base method.
+func (m *MockAttachmentRepo) HoldBlob(ctx context.Context, blobID string, hold time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldBlob", ctx, blobID, hold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HoldBlob indicates an expected call of HoldBlob.
+func (mr *MockAttachmentRepoMockRecorder) HoldBlob(ctx, blobID, hold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldBlob", reflect.TypeOf((*MockAttachmentRepo)(nil).HoldBlob), ctx, blobID, hold)
+}
+
+// PendingScans mocks base method.
+func (m *MockAttachmentRepo) PendingScans(ctx context.Context, limit int) ([]models.QuarantinedAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingScans", ctx, limit)
+	ret0, _ := ret[0].([]models.QuarantinedAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingScans indicates an expected call of PendingScans.
+func (mr *MockAttachmentRepoMockRecorder) PendingScans(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingScans", reflect.TypeOf((*MockAttachmentRepo)(nil).PendingScans), ctx, limit)
+}
+
+// SetScanError mocks base method.
+func (m *MockAttachmentRepo) SetScanError(ctx context.Context, attachmentID uuid.UUID, scanErr string, maxAttempts int) (string, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScanError", ctx, attachmentID, scanErr, maxAttempts)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SetScanError indicates an expected call of SetScanError.
+func (mr *MockAttachmentRepoMockRecorder) SetScanError(ctx, attachmentID, scanErr, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScanError", reflect.TypeOf((*MockAttachmentRepo)(nil).SetScanError), ctx, attachmentID, scanErr, maxAttempts)
+}
+
+// SetScanVerdict mocks base method.
+func (m *MockAttachmentRepo) SetScanVerdict(ctx context.Context, attachmentID uuid.UUID, verdict models.ScanVerdict) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScanVerdict", ctx, attachmentID, verdict)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetScanVerdict indicates an expected call of SetScanVerdict.
+func (mr *MockAttachmentRepoMockRecorder) SetScanVerdict(ctx, attachmentID, verdict interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScanVerdict", reflect.TypeOf((*MockAttachmentRepo)(nil).SetScanVerdict), ctx, attachmentID, verdict)
+}
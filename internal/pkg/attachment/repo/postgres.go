@@ -7,13 +7,26 @@ import (
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"time"
 )
 
 const (
-	InsertAttach         = `INSERT INTO "attachment"(attachment_id, post_id, attachment_type) VALUES ($1,$2,$3)`
+	InsertAttach         = `INSERT INTO "attachment"(attachment_id, post_id, attachment_type, renditions, size, checksum, filename, width, height, duration, alt_text, caption, blob_id, scan_status) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,NULLIF($13, ''),coalesce(NULLIF($14, ''), 'pending'))`
 	DeleteAttachByID     = `DELETE FROM "attachment" WHERE attachment_id = $1`
-	DeleteAttachByPostID = `DELETE FROM "attachment" WHERE post_id = $1 RETURNING attachment_id, attachment_type`
+	DeleteAttachByPostID = `DELETE FROM "attachment" WHERE post_id = $1 RETURNING attachment_id, attachment_type, renditions, coalesce(blob_id, '')`
 	DeleteAttach         = `DELETE FROM "attachment" WHERE attachment_id = $1 AND post_id = $2 RETURNING attachment_id`
+	DeleteExpiredLinks   = `DELETE FROM "attachment_download" WHERE expires_at < now()`
+	AddDownloadLink      = `INSERT INTO "attachment_download"(download_key, attachment_id, expires_at) VALUES ($1, $2, $3) ON CONFLICT (download_key) DO NOTHING RETURNING download_key`
+	AddDownload          = `UPDATE "attachment" SET downloads = downloads + 1 WHERE attachment_id = $1 AND post_id = $2 RETURNING attachment_id`
+	HoldBlob             = `INSERT INTO "media_blob"(blob_id, refs, held_until) VALUES ($1, 0, now() + $2 * interval '1 second') ON CONFLICT (blob_id) DO UPDATE SET held_until = greatest(media_blob.held_until, EXCLUDED.held_until)`
+	LockUnusedBlob       = `SELECT blob_id FROM "media_blob" WHERE blob_id = $1 AND refs <= 0 AND (held_until IS NULL OR held_until < now()) FOR UPDATE`
+	DeleteBlob           = `DELETE FROM "media_blob" WHERE blob_id = $1`
+	GetAttach            = `SELECT attachment_id, attachment_type, renditions, coalesce(blob_id, ''), coalesce(size, 0), coalesce(checksum, ''), coalesce(filename, ''), coalesce(width, 0), coalesce(height, 0), coalesce(duration, 0), coalesce(alt_text, ''), coalesce(caption, ''), scan_status FROM "attachment" WHERE attachment_id = $1 AND post_id = $2`
+	PendingScans         = `SELECT a.attachment_id, a.attachment_type, a.renditions, coalesce(a.blob_id, ''), coalesce(a.filename, ''), a.post_id, p.creator_id, p.title, a.scan_attempts, coalesce(a.scan_error, '') FROM "attachment" a JOIN "post" p ON p.post_id = a.post_id WHERE a.scan_status = 'pending' ORDER BY a.scan_tried_at NULLS FIRST, a.attachment_id LIMIT $1`
+	SetScanError         = `UPDATE "attachment" SET scan_attempts = scan_attempts + 1, scan_error = left($1, 255), scan_tried_at = now(), scan_status = CASE WHEN scan_attempts + 1 >= $2 THEN 'failed' ELSE scan_status END WHERE attachment_id = $3 AND scan_status = 'pending' RETURNING scan_status, scan_attempts`
+	LockStorageQuota     = `SELECT sp.quota FROM "post" p JOIN "creator" c ON c.creator_id = p.creator_id JOIN "storage_plan" sp ON sp.name = c.storage_plan WHERE p.post_id = $1 FOR UPDATE OF c`
+	StorageUsed          = `SELECT coalesce(sum(cs.bytes), 0) FROM "creator_storage" cs JOIN "post" p ON p.creator_id = cs.creator_id WHERE p.post_id = $1`
+	SetScanVerdict       = `UPDATE "attachment" SET scan_status = $1, scan_signature = NULLIF($2, ''), scanned_at = now(), blob_id = CASE WHEN $3 THEN NULL ELSE blob_id END WHERE attachment_id = $4 AND scan_status = 'pending' RETURNING attachment_id`
 )
 
 type AttachmentRepo struct {
@@ -28,14 +41,53 @@ func NewAttachmentRepo(db *sql.DB, logger *zap.SugaredLogger) *AttachmentRepo {
 	}
 }
 
-func (repo *AttachmentRepo) CreateAttachment(ctx context.Context, postID uuid.UUID, attachmentID uuid.UUID, attachmentType string) error {
-	row := repo.db.QueryRowContext(ctx, InsertAttach, attachmentID, postID, attachmentType)
+// CreateAttachment добавляет вложение к посту, если оно помещается в квоту тарифа автора, иначе - models.QuotaExceeded.
+// Пока проверяется квота и добавляется вложение, запись автора заблокирована, поэтому одновременные загрузки
+// не превысят квоту вместе. Поста нет - models.WrongData.
+func (repo *AttachmentRepo) CreateAttachment(ctx context.Context, postID uuid.UUID, attachment models.Attachment) error {
+	renditions, err := models.RenditionsJSON(attachment.Renditions)
+	if err != nil {
+		repo.logger.Error(err)
+		return models.InternalError
+	}
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		repo.logger.Error(err)
+		return models.InternalError
+	}
 
-	if err := row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	var usage models.StorageUsage
+	row := tx.QueryRowContext(ctx, LockStorageQuota, postID)
+	if err = row.Scan(&usage.Quota); errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		return models.WrongData
+	} else if err != nil {
+		_ = tx.Rollback()
+		repo.logger.Error(err)
+		return models.InternalError
+	}
+	// использованное место читается уже после блокировки, поэтому в нём учтены вложения, добавленные до неё
+	row = tx.QueryRowContext(ctx, StorageUsed, postID)
+	if err = row.Scan(&usage.Used); err != nil {
+		_ = tx.Rollback()
 		repo.logger.Error(err)
 		return models.InternalError
 	}
+	if !usage.Fits(attachment.Size) {
+		_ = tx.Rollback()
+		return models.QuotaExceeded
+	}
 
+	if _, err = tx.ExecContext(ctx, InsertAttach, attachment.Id, postID, attachment.Type, renditions, attachment.Size, attachment.Checksum,
+		attachment.Filename, attachment.Width, attachment.Height, attachment.Duration, attachment.AltText, attachment.Caption, attachment.BlobId, attachment.ScanStatus); err != nil {
+		_ = tx.Rollback()
+		repo.logger.Error(err)
+		return models.InternalError
+	}
+	if err = tx.Commit(); err != nil {
+		repo.logger.Error(err)
+		return models.InternalError
+	}
 	return nil
 }
 
@@ -51,6 +103,176 @@ func (r *AttachmentRepo) DeleteAttachment(ctx context.Context, attachmentID, pos
 	return nil
 }
 
+func (r *AttachmentRepo) GetAttachment(ctx context.Context, attachmentID, postID uuid.UUID) (models.Attachment, error) {
+	var attach models.Attachment
+	var renditions sql.NullString
+	row := r.db.QueryRowContext(ctx, GetAttach, attachmentID, postID)
+	if err := row.Scan(&attach.Id, &attach.Type, &renditions, &attach.BlobId, &attach.Size, &attach.Checksum, &attach.Filename,
+		&attach.Width, &attach.Height, &attach.Duration, &attach.AltText, &attach.Caption, &attach.ScanStatus); errors.Is(err, sql.ErrNoRows) {
+		return models.Attachment{}, models.NotFound
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.Attachment{}, models.InternalError
+	}
+	var err error
+	if attach.Renditions, err = models.ParseRenditions(renditions.String); err != nil {
+		r.logger.Error(err)
+		return models.Attachment{}, models.InternalError
+	}
+	return attach, nil
+}
+
+// AddDownload засчитывает скачивание, если по ссылке key его ещё не засчитывали: повтор запроса
+// по той же подписанной ссылке счётчик не увеличивает. Ключи истёкших ссылок удаляются здесь же.
+func (r *AttachmentRepo) AddDownload(ctx context.Context, attachmentID, postID uuid.UUID, key string, expiresAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	if _, err = tx.ExecContext(ctx, DeleteExpiredLinks); err != nil {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	}
+
+	var keyTmp string
+	row := tx.QueryRowContext(ctx, AddDownloadLink, key, attachmentID, expiresAt)
+	if err = row.Scan(&keyTmp); errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		return nil
+	} else if err != nil {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	}
+
+	var attachmentIDtmp uuid.UUID
+	row = tx.QueryRowContext(ctx, AddDownload, attachmentID, postID)
+	if err = row.Scan(&attachmentIDtmp); errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		return models.NotFound
+	} else if err != nil {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+// HoldBlob не даёт удалить файл с этим содержимым в течение hold, даже если на него ещё нет ссылок.
+// Загрузка берёт его до того, как решить, записывать ли файл, а ссылку создаёт вложение.
+func (r *AttachmentRepo) HoldBlob(ctx context.Context, blobID string, hold time.Duration) error {
+	if _, err := r.db.ExecContext(ctx, HoldBlob, blobID, int64(hold.Seconds())); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+// DeleteUnusedBlob удаляет файлы deleteFiles и запись о них, если на файл не ссылается ни одно вложение
+// и его никто не держит. Запись заблокирована, пока удаляются файлы, поэтому новая ссылка или HoldBlob
+// дождутся удаления и запишут файл заново. Возвращает, были ли удалены файлы.
+func (r *AttachmentRepo) DeleteUnusedBlob(ctx context.Context, blobID string, deleteFiles func() error) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return false, models.InternalError
+	}
+	var blobIDtmp string
+	row := tx.QueryRowContext(ctx, LockUnusedBlob, blobID)
+	if err = row.Scan(&blobIDtmp); errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		return false, nil
+	} else if err != nil {
+		_ = tx.Rollback()
+		r.logger.Error(err)
+		return false, models.InternalError
+	}
+	if err = deleteFiles(); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	if _, err = tx.ExecContext(ctx, DeleteBlob, blobID); err != nil {
+		_ = tx.Rollback()
+		r.logger.Error(err)
+		return false, models.InternalError
+	}
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return false, models.InternalError
+	}
+	return true, nil
+}
+
+// PendingScans возвращает до limit вложений из карантина вместе с постом и автором. Первыми идут ещё не проверявшиеся,
+// затем те, что дольше всех ждут повторной попытки, поэтому файлы, которые не удаётся проверить, не задерживают остальные.
+func (r *AttachmentRepo) PendingScans(ctx context.Context, limit int) ([]models.QuarantinedAttachment, error) {
+	rows, err := r.db.QueryContext(ctx, PendingScans, limit)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+
+	pending := make([]models.QuarantinedAttachment, 0)
+	for rows.Next() {
+		var attach models.QuarantinedAttachment
+		var attachType, renditions sql.NullString
+		if err = rows.Scan(&attach.Id, &attachType, &renditions, &attach.BlobId, &attach.Filename, &attach.PostID, &attach.CreatorID, &attach.PostTitle,
+			&attach.Attempts, &attach.LastError); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		attach.Type = attachType.String
+		attach.ScanStatus = models.ScanPending
+		if attach.Renditions, err = models.ParseRenditions(renditions.String); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		pending = append(pending, attach)
+	}
+	if err = rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	return pending, nil
+}
+
+// SetScanVerdict выпускает вложение из карантина. У отклонённого вложения убирается ссылка на файл,
+// чтобы файл можно было удалить. Вложение, которое уже удалили или проверили, - models.NotFound.
+func (r *AttachmentRepo) SetScanVerdict(ctx context.Context, attachmentID uuid.UUID, verdict models.ScanVerdict) error {
+	var attachmentIDtmp uuid.UUID
+	row := r.db.QueryRowContext(ctx, SetScanVerdict, verdict.Status, verdict.Signature, verdict.Status == models.ScanRejected, attachmentID)
+	if err := row.Scan(&attachmentIDtmp); errors.Is(err, sql.ErrNoRows) {
+		return models.NotFound
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+// SetScanError записывает неудачную попытку проверки. После maxAttempts попыток вложение получает models.ScanFailed
+// и больше не проверяется. Возвращает новый статус и число попыток; вложение, которое уже удалили или проверили, - models.NotFound.
+func (r *AttachmentRepo) SetScanError(ctx context.Context, attachmentID uuid.UUID, scanErr string, maxAttempts int) (string, int, error) {
+	var status string
+	var attempts int
+	row := r.db.QueryRowContext(ctx, SetScanError, scanErr, maxAttempts, attachmentID)
+	if err := row.Scan(&status, &attempts); errors.Is(err, sql.ErrNoRows) {
+		return "", 0, models.NotFound
+	} else if err != nil {
+		r.logger.Error(err)
+		return "", 0, models.InternalError
+	}
+	return status, attempts, nil
+}
+
 func (repo *AttachmentRepo) DeleteAttachmentByID(ctx context.Context, attachID uuid.UUID) error {
 	row := repo.db.QueryRowContext(ctx, DeleteAttachByID, attachID)
 
@@ -70,9 +292,14 @@ func (repo *AttachmentRepo) DeleteAttachmentsByPostID(ctx context.Context, postI
 		return nil, models.InternalError
 	}
 	defer rows.Close()
-	tmp := models.Attachment{}
 	for rows.Next() {
-		if err := rows.Scan(&tmp.Id, &tmp.Type); err != nil {
+		tmp := models.Attachment{}
+		var renditions sql.NullString
+		if err := rows.Scan(&tmp.Id, &tmp.Type, &renditions, &tmp.BlobId); err != nil {
+			repo.logger.Error(err)
+			return nil, models.InternalError
+		}
+		if tmp.Renditions, err = models.ParseRenditions(renditions.String); err != nil {
 			repo.logger.Error(err)
 			return nil, models.InternalError
 		}
@@ -2,37 +2,41 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/attachment"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/scan"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"os"
+	"time"
 )
 
 type AttachmentUsecase struct {
-	repo   attachment.AttachmentRepo
-	logger *zap.SugaredLogger
+	repo    attachment.AttachmentRepo
+	store   blob.BlobStore
+	types   *media.TypeRegistry
+	scanner scan.UploadScanner
+	logger  *zap.SugaredLogger
 }
 
-var types = map[string]string{
-	"image/jpeg": "jpeg",
-	"image/png":  "png",
-	"image/webp": "webp",
-	"video/mpeg": "mpeg",
-	"video/mp4":  "mp4",
-	"audio/mp4":  "mp3",
-	"audio/mpeg": "mp3",
+func (u *AttachmentUsecase) GetFileExtension(ctx context.Context, key string) (string, bool) {
+	mediaType, ok := u.types.Lookup(key)
+	return mediaType.Extension, ok
 }
 
-func (u *AttachmentUsecase) GetFileExtension(ctx context.Context, key string) (string, bool) {
-	val, ok := types[key]
-	return val, ok
+func (u *AttachmentUsecase) GetMediaType(ctx context.Context, contentType string) (media.MediaType, bool) {
+	return u.types.Lookup(contentType)
 }
 
-func NewAttachmentUsecase(repo attachment.AttachmentRepo, logger *zap.SugaredLogger) *AttachmentUsecase {
+func NewAttachmentUsecase(repo attachment.AttachmentRepo, store blob.BlobStore, types *media.TypeRegistry, scanner scan.UploadScanner, logger *zap.SugaredLogger) *AttachmentUsecase {
 	return &AttachmentUsecase{
-		repo:   repo,
-		logger: logger,
+		repo:    repo,
+		store:   store,
+		types:   types,
+		scanner: scanner,
+		logger:  logger,
 	}
 }
 
@@ -45,24 +49,158 @@ func (u *AttachmentUsecase) DeleteAttachmentsByPostID(ctx context.Context, postI
 }
 
 func (u *AttachmentUsecase) AddAttach(ctx context.Context, postID uuid.UUID, attachment models.Attachment) error {
-	return u.repo.CreateAttachment(ctx, postID, attachment.Id, attachment.Type)
+	attachment.ScanStatus = u.UploadScanStatus(ctx)
+	return u.repo.CreateAttachment(ctx, postID, attachment)
+}
+
+// UploadScanStatus - статус нового вложения: в карантине до проверки или сразу видно, если проверка выключена
+func (u *AttachmentUsecase) UploadScanStatus(ctx context.Context) string {
+	if scan.Quarantines(u.scanner) {
+		return models.ScanPending
+	}
+	return models.ScanSkipped
+}
+
+// ScanPending проверяет до limit вложений из карантина и возвращает отклонённые и те, что не удалось проверить,
+// чтобы сообщить о них авторам. Файлы отклонённого вложения удаляются. Вложение, которое проверить не удалось,
+// остаётся в карантине до следующего раза, а после models.MaxScanAttempts попыток получает models.ScanFailed.
+// Файл, который антивирус не принимает или тип которого неизвестен, сразу получает models.ScanFailed.
+func (u *AttachmentUsecase) ScanPending(ctx context.Context, limit int) ([]models.QuarantinedAttachment, error) {
+	pending, err := u.repo.PendingScans(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	finished := make([]models.QuarantinedAttachment, 0)
+	for _, attach := range pending {
+		verdict, scanErr := u.scanFiles(ctx, attach.Attachment)
+		if scanErr != nil {
+			u.logger.Errorf("scan attachment %s: %s", attach.Id, scanErr)
+			maxAttempts := models.MaxScanAttempts
+			if errors.Is(scanErr, models.TooLarge) || errors.Is(scanErr, models.Unsupported) {
+				maxAttempts = 1
+			}
+			status, attempts, err := u.repo.SetScanError(ctx, attach.Id, scanErr.Error(), maxAttempts)
+			if err == models.NotFound {
+				continue
+			} else if err != nil {
+				return finished, err
+			}
+			if status != models.ScanFailed {
+				continue
+			}
+			attach.ScanStatus = status
+			attach.Verdict = models.ScanVerdict{Status: status}
+			attach.Attempts = attempts
+			attach.LastError = scanErr.Error()
+			finished = append(finished, attach)
+			continue
+		}
+		if err = u.repo.SetScanVerdict(ctx, attach.Id, verdict); err == models.NotFound {
+			continue
+		} else if err != nil {
+			return finished, err
+		}
+		if verdict.Status != models.ScanRejected {
+			continue
+		}
+		// запись уже не ссылается на файл, поэтому файл удаляется, если он не нужен другим вложениям
+		_ = u.DeleteAttachmentsFiles(ctx, attach.Attachment)
+		attach.ScanStatus = verdict.Status
+		attach.Verdict = verdict
+		finished = append(finished, attach)
+	}
+	return finished, nil
+}
+
+// scanFiles проверяет все файлы вложения, у обработанного изображения - каждый размер
+func (u *AttachmentUsecase) scanFiles(ctx context.Context, attach models.Attachment) (models.ScanVerdict, error) {
+	keys := make([]string, 0, len(attach.Renditions))
+	for _, rendition := range attach.Renditions {
+		keys = append(keys, rendition.Key(attach.StorageId()))
+	}
+	if len(keys) == 0 {
+		extension, ok := u.GetFileExtension(ctx, attach.Type)
+		if !ok {
+			return models.ScanVerdict{}, models.Unsupported
+		}
+		keys = append(keys, models.AttachmentKey(attach.StorageId(), extension))
+	}
+
+	verdict := models.ScanVerdict{Status: models.ScanClean}
+	for _, key := range keys {
+		body, err := u.store.Get(ctx, key)
+		if err != nil {
+			return models.ScanVerdict{}, err
+		}
+		verdict, err = u.scanner.Scan(ctx, body)
+		body.Close()
+		if err != nil {
+			return models.ScanVerdict{}, err
+		}
+		if verdict.Status == models.ScanRejected {
+			return verdict, nil
+		}
+	}
+	return verdict, nil
+}
+
+func (u *AttachmentUsecase) GetAttachment(ctx context.Context, postID, attachmentID uuid.UUID) (models.Attachment, error) {
+	return u.repo.GetAttachment(ctx, attachmentID, postID)
 }
 
+func (u *AttachmentUsecase) AddDownload(ctx context.Context, postID, attachmentID uuid.UUID, key string, expiresAt time.Time) error {
+	if len(key) == 0 || len(key) > models.MaxDownloadKeyLength {
+		return models.WrongData
+	}
+	return u.repo.AddDownload(ctx, attachmentID, postID, key, expiresAt)
+}
+
+// HoldBlob не даёт удалить уже сохранённый файл с этим содержимым, пока загрузка не создаст вложение
+func (u *AttachmentUsecase) HoldBlob(ctx context.Context, blobID string) error {
+	if len(blobID) == 0 {
+		return models.WrongData
+	}
+	return u.repo.HoldBlob(ctx, blobID, models.BlobHold)
+}
+
+// DeleteAttachmentsFiles удаляет файлы всех вложений, даже если часть из них удалить не удалось.
+// Оставшиеся файлы потом удалит mediagc. Файл с тем же содержимым, что и у другого вложения,
+// остаётся, пока на него есть ссылки, поэтому записи вложений удаляются раньше файлов.
 func (u *AttachmentUsecase) DeleteAttachmentsFiles(ctx context.Context, attachments ...models.Attachment) error {
+	var failed bool
 	for _, file := range attachments {
-		if err := u.DeleteAttachmentFile(ctx, file); err != nil {
+		file := file
+		var err error
+		if len(file.BlobId) != 0 {
+			_, err = u.repo.DeleteUnusedBlob(ctx, file.BlobId, func() error {
+				return u.DeleteAttachmentFile(ctx, file)
+			})
+		} else {
+			err = u.DeleteAttachmentFile(ctx, file)
+		}
+		if err != nil {
 			u.logger.Error(err)
-			return models.InternalError
+			failed = true
 		}
 	}
+	if failed {
+		return models.InternalError
+	}
 	return nil
 }
 
 func (u *AttachmentUsecase) DeleteAttachment(ctx context.Context, postID uuid.UUID, attach models.Attachment) error {
-	if err := u.DeleteAttachmentsFiles(ctx, attach); err != nil {
+	// ключ файла зависит от содержимого, поэтому берётся из сохранённой записи
+	stored, err := u.repo.GetAttachment(ctx, attach.Id, postID)
+	if err == models.NotFound {
+		return models.WrongData
+	} else if err != nil {
 		return err
 	}
-	return u.repo.DeleteAttachment(ctx, attach.Id, postID)
+	if err = u.repo.DeleteAttachment(ctx, attach.Id, postID); err != nil {
+		return err
+	}
+	return u.DeleteAttachmentsFiles(ctx, stored)
 }
 
 func (u *AttachmentUsecase) DeleteAttachmentFile(ctx context.Context, attachment models.Attachment) error {
@@ -70,8 +208,12 @@ func (u *AttachmentUsecase) DeleteAttachmentFile(ctx context.Context, attachment
 	if !ok {
 		return models.WrongData
 	}
-	filename := models.FolderPath + attachment.Id.String() + "." + val
-	if err := os.Remove(filename); err != nil {
+	if err := u.store.Delete(ctx, models.AttachmentKey(attachment.StorageId(), val)); err != nil {
+		u.logger.Error(err)
+		return models.InternalError
+	}
+	// размеры обработанного изображения лежат отдельно от исходного ключа
+	if err := blob.DeletePrefix(ctx, u.store, models.RenditionPrefix(attachment.StorageId())); err != nil {
 		u.logger.Error(err)
 		return models.InternalError
 	}
@@ -0,0 +1,23 @@
+package blob
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/blob_mock.go -package=mock
+
+// BlobStore - хранилище файлов вложений и фотографий. Ключ - путь файла относительно корня хранилища
+// с разделителем "/". Отсутствующий файл - models.NotFound, удаление отсутствующего файла ошибкой не считается.
+type BlobStore interface {
+	// Put сохраняет файл целиком, size < 0 означает, что размер заранее неизвестен
+	Put(ctx context.Context, key string, data io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// GetRange читает length байт файла начиная с offset, нужен для ответов на Range-запросы
+	GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	Stat(ctx context.Context, key string) (models.BlobInfo, error)
+	// List возвращает файлы, ключ которых начинается с prefix
+	List(ctx context.Context, prefix string) ([]models.BlobInfo, error)
+}
@@ -0,0 +1,173 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// tmpPrefix - префикс недописанных файлов, List их не возвращает
+const tmpPrefix = ".tmp-"
+
+// Store хранит файлы в каталоге на диске. Файл сначала пишется во временный и переименовывается,
+// поэтому читатели не видят недописанных файлов. Тип содержимого определяется по расширению ключа.
+type Store struct {
+	root string
+}
+
+func NewStore(root string) (*Store, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+	return &Store{root: root}, nil
+}
+
+func (s *Store) Put(_ context.Context, key string, data io.Reader, _ int64, _ string) error {
+	filename, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(filename), tmpPrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err = io.Copy(f, data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(f.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filename)
+}
+
+func (s *Store) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	filename, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, models.NotFound
+	}
+	return f, err
+}
+
+func (s *Store) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 || length < 0 {
+		return nil, models.WrongData
+	}
+	body, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	f := body.(*os.File)
+	if _, err = f.Seek(offset, io.SeekStart); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return readCloser{Reader: io.LimitReader(f, length), Closer: f}, nil
+}
+
+func (s *Store) Delete(_ context.Context, key string) error {
+	filename, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(filename); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *Store) Stat(_ context.Context, key string) (models.BlobInfo, error) {
+	filename, err := s.path(key)
+	if err != nil {
+		return models.BlobInfo{}, err
+	}
+	info, err := os.Stat(filename)
+	if errors.Is(err, fs.ErrNotExist) || err == nil && info.IsDir() {
+		return models.BlobInfo{}, models.NotFound
+	} else if err != nil {
+		return models.BlobInfo{}, err
+	}
+	return blobInfo(key, info), nil
+}
+
+func (s *Store) List(_ context.Context, prefix string) ([]models.BlobInfo, error) {
+	var blobs = make([]models.BlobInfo, 0)
+	// обходим только каталог, в котором лежат файлы с таким префиксом
+	start := s.root
+	if i := strings.LastIndex(prefix, "/"); i > 0 && models.IsValidBlobKey(prefix[:i]) {
+		start = filepath.Join(s.root, filepath.FromSlash(prefix[:i]))
+	}
+	err := filepath.WalkDir(start, func(filename string, entry fs.DirEntry, err error) error {
+		if filename == start && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), tmpPrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, filename)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			// файл удалили во время обхода
+			return nil
+		} else if err != nil {
+			return err
+		}
+		blobs = append(blobs, blobInfo(key, info))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return blobs, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func (s *Store) path(key string) (string, error) {
+	if !models.IsValidBlobKey(key) {
+		return "", models.WrongData
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+func blobInfo(key string, info fs.FileInfo) models.BlobInfo {
+	return models.BlobInfo{
+		Key:         key,
+		Size:        info.Size(),
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+		ModifiedAt:  info.ModTime(),
+	}
+}
@@ -0,0 +1,172 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func newTestStore(t *testing.T) *Store {
+	store, err := NewStore(filepath.Join(t.TempDir(), "images"))
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	return store
+}
+
+func TestStore_RoundTrip(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	if err := store.Put(ctx, "a/photo.jpg", strings.NewReader("jpeg"), 4, "image/jpeg"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	body, err := store.Get(ctx, "a/photo.jpg")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	data, err := io.ReadAll(body)
+	body.Close()
+	if err != nil || string(data) != "jpeg" {
+		t.Errorf("Get() = %q, %v", data, err)
+	}
+
+	info, err := store.Stat(ctx, "a/photo.jpg")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Key != "a/photo.jpg" || info.Size != 4 || info.ContentType != "image/jpeg" || info.ModifiedAt.IsZero() {
+		t.Errorf("Stat() = %+v", info)
+	}
+	stat, err := os.Stat(filepath.Join(store.root, "a", "photo.jpg"))
+	if err != nil || stat.Mode().Perm() != 0644 {
+		t.Errorf("file mode = %v, %v", stat, err)
+	}
+
+	if err = store.Put(ctx, "a/photo.jpg", strings.NewReader("png"), 3, ""); err != nil {
+		t.Fatalf("Put() overwrite error = %v", err)
+	}
+	if info, _ = store.Stat(ctx, "a/photo.jpg"); info.Size != 3 {
+		t.Errorf("Stat() after overwrite = %+v", info)
+	}
+
+	if err = store.Delete(ctx, "a/photo.jpg"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err = store.Get(ctx, "a/photo.jpg"); !errors.Is(err, models.NotFound) {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, models.NotFound)
+	}
+}
+
+func TestStore_NotFound(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	if _, err := store.Get(ctx, "missing.jpg"); !errors.Is(err, models.NotFound) {
+		t.Errorf("Get() error = %v, want %v", err, models.NotFound)
+	}
+	if _, err := store.Stat(ctx, "missing.jpg"); !errors.Is(err, models.NotFound) {
+		t.Errorf("Stat() error = %v, want %v", err, models.NotFound)
+	}
+	if err := store.Delete(ctx, "missing.jpg"); err != nil {
+		t.Errorf("Delete() error = %v, want nil", err)
+	}
+}
+
+func TestStore_List(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"a/1.jpg", "a/2.jpg", "b/1.jpg", "c.jpg"} {
+		if err := store.Put(ctx, key, strings.NewReader(key), -1, ""); err != nil {
+			t.Fatalf("Put(%s) error = %v", key, err)
+		}
+	}
+	// недописанный файл в список не попадает
+	if err := os.WriteFile(filepath.Join(store.root, "a", tmpPrefix+"123"), []byte("tmp"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{prefix: "a/", want: "a/1.jpg,a/2.jpg"},
+		{prefix: "", want: "a/1.jpg,a/2.jpg,b/1.jpg,c.jpg"},
+		{prefix: "d", want: ""},
+		{prefix: "a/1", want: "a/1.jpg"},
+		{prefix: "d/", want: ""},
+	}
+	for _, test := range tests {
+		blobs, err := store.List(ctx, test.prefix)
+		if err != nil {
+			t.Fatalf("List(%q) error = %v", test.prefix, err)
+		}
+		keys := make([]string, 0, len(blobs))
+		for _, blob := range blobs {
+			keys = append(keys, blob.Key)
+		}
+		sort.Strings(keys)
+		if got := strings.Join(keys, ","); got != test.want {
+			t.Errorf("List(%q) = %s, want %s", test.prefix, got, test.want)
+		}
+	}
+}
+
+func TestStore_InvalidKey(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"", "../a.jpg", "/a.jpg", "a//b.jpg", "a\\b.jpg"} {
+		if err := store.Put(ctx, key, strings.NewReader("a"), 1, ""); !errors.Is(err, models.WrongData) {
+			t.Errorf("Put(%q) error = %v, want %v", key, err, models.WrongData)
+		}
+		if err := store.Delete(ctx, key); !errors.Is(err, models.WrongData) {
+			t.Errorf("Delete(%q) error = %v, want %v", key, err, models.WrongData)
+		}
+	}
+}
+
+func TestStore_GetRange(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+	if err := store.Put(ctx, "a.mp3", strings.NewReader("0123456789"), 10, ""); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	tests := []struct {
+		offset int64
+		length int64
+		want   string
+	}{
+		{offset: 0, length: 10, want: "0123456789"},
+		{offset: 2, length: 3, want: "234"},
+		{offset: 8, length: 5, want: "89"},
+		{offset: 12, length: 1, want: ""},
+	}
+	for _, test := range tests {
+		body, err := store.GetRange(ctx, "a.mp3", test.offset, test.length)
+		if err != nil {
+			t.Fatalf("GetRange(%d, %d) error = %v", test.offset, test.length, err)
+		}
+		data, err := io.ReadAll(body)
+		body.Close()
+		if err != nil || string(data) != test.want {
+			t.Errorf("GetRange(%d, %d) = %q, %v, want %q", test.offset, test.length, data, err, test.want)
+		}
+	}
+
+	if _, err := store.GetRange(ctx, "missing.mp3", 0, 1); !errors.Is(err, models.NotFound) {
+		t.Errorf("GetRange() missing error = %v, want %v", err, models.NotFound)
+	}
+	if _, err := store.GetRange(ctx, "a.mp3", -1, 1); !errors.Is(err, models.WrongData) {
+		t.Errorf("GetRange() negative offset error = %v, want %v", err, models.WrongData)
+	}
+}
@@ -0,0 +1,17 @@
+package blob
+
+import "context"
+
+// DeletePrefix удаляет все файлы, ключ которых начинается с prefix
+func DeletePrefix(ctx context.Context, store BlobStore, prefix string) error {
+	blobs, err := store.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	for _, blob := range blobs {
+		if err = store.Delete(ctx, blob.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/local"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReader_ServeContent(t *testing.T) {
+	store, err := local.NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := []byte(strings.Repeat("0123456789", 100))
+	if err = store.Put(context.Background(), "a.mp4", bytes.NewReader(content), int64(len(content)), "video/mp4"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		rangeValue string
+		wantStatus int
+		wantBody   []byte
+	}{
+		{name: "Full", wantStatus: http.StatusOK, wantBody: content},
+		{name: "Range", rangeValue: "bytes=10-19", wantStatus: http.StatusPartialContent, wantBody: content[10:20]},
+		{name: "Suffix", rangeValue: "bytes=-5", wantStatus: http.StatusPartialContent, wantBody: content[995:]},
+		{name: "Open end", rangeValue: "bytes=990-", wantStatus: http.StatusPartialContent, wantBody: content[990:]},
+		{name: "Not satisfiable", rangeValue: "bytes=2000-", wantStatus: http.StatusRequestedRangeNotSatisfiable},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/a.mp4", nil)
+			if len(test.rangeValue) != 0 {
+				r.Header.Set("Range", test.rangeValue)
+			}
+			w := httptest.NewRecorder()
+			reader := NewReader(r.Context(), store, "a.mp4", int64(len(content)))
+			defer reader.Close()
+
+			w.Header().Set("Content-Type", "video/mp4")
+			http.ServeContent(w, r, "", time.Time{}, reader)
+			if w.Code != test.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, test.wantStatus)
+			}
+			if test.wantBody != nil && !bytes.Equal(w.Body.Bytes(), test.wantBody) {
+				t.Errorf("body = %q, want %q", w.Body.Bytes(), test.wantBody)
+			}
+		})
+	}
+}
+
+func TestReader_Seek(t *testing.T) {
+	store, err := local.NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Put(context.Background(), "a.txt", strings.NewReader("0123456789"), 10, ""); err != nil {
+		t.Fatal(err)
+	}
+	reader := NewReader(context.Background(), store, "a.txt", 10)
+	defer reader.Close()
+
+	buf := make([]byte, 3)
+	if _, err = io.ReadFull(reader, buf); err != nil || string(buf) != "012" {
+		t.Fatalf("Read() = %q, %v", buf, err)
+	}
+	if pos, err := reader.Seek(-2, io.SeekEnd); err != nil || pos != 8 {
+		t.Fatalf("Seek() = %d, %v", pos, err)
+	}
+	rest, err := io.ReadAll(reader)
+	if err != nil || string(rest) != "89" {
+		t.Errorf("Read() after Seek() = %q, %v", rest, err)
+	}
+	if _, err = reader.Seek(-1, io.SeekStart); err == nil {
+		t.Errorf("Seek() to negative position returned no error")
+	}
+}
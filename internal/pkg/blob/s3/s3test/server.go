@@ -0,0 +1,220 @@
+// Package s3test - S3-совместимый сервер в памяти для тестов, аналог локального MinIO.
+// Сервер проверяет подпись Signature V4 и поддерживает PutObject, GetObject (в том числе с Range вида bytes=a-b),
+// HeadObject, DeleteObject и ListObjectsV2 в path-style адресах.
+package s3test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	AccessKey = "minioadmin"
+	SecretKey = "minioadmin-secret"
+	Region    = "us-east-1"
+)
+
+// Signer подписывает запрос заново, чтобы сервер сверил подпись клиента
+type Signer func(req *http.Request, now time.Time)
+
+type object struct {
+	data        []byte
+	contentType string
+	modifiedAt  time.Time
+}
+
+type Server struct {
+	*httptest.Server
+	mu       sync.Mutex
+	buckets  map[string]map[string]object
+	signer   Signer
+	pageSize int
+}
+
+// NewServer запускает сервер с пустым бакетом bucket. Если signer задан, запросы с неверной подписью получают 403.
+func NewServer(bucket string, signer Signer) *Server {
+	s := &Server{
+		buckets:  map[string]map[string]object{bucket: {}},
+		signer:   signer,
+		pageSize: 1000,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// SetPageSize задаёт размер страницы ListObjectsV2, чтобы проверить постраничный обход
+func (s *Server) SetPageSize(size int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pageSize = size
+}
+
+// Object возвращает содержимое объекта и признак его наличия
+func (s *Server) Object(bucket, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.buckets[bucket][key]
+	return obj.data, ok
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if !s.checkSignature(r) {
+		writeError(w, http.StatusForbidden, "SignatureDoesNotMatch")
+		return
+	}
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	objects, ok := s.buckets[bucket]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	if len(key) == 0 {
+		if r.Method != http.MethodGet || r.URL.Query().Get("list-type") != "2" {
+			writeError(w, http.StatusNotImplemented, "NotImplemented")
+			return
+		}
+		s.list(w, r, objects)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if r.ContentLength < 0 {
+			writeError(w, http.StatusLengthRequired, "MissingContentLength")
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		objects[key] = object{data: data, contentType: r.Header.Get("Content-Type"), modifiedAt: time.Now().UTC().Truncate(time.Second)}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		obj, ok := objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		data, status := obj.data, http.StatusOK
+		if rng := r.Header.Get("Range"); len(rng) != 0 && r.Method == http.MethodGet {
+			var start, end int
+			if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil || start > end {
+				writeError(w, http.StatusBadRequest, "InvalidArgument")
+				return
+			}
+			if start >= len(data) {
+				writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+				return
+			}
+			if end >= len(data) {
+				end = len(data) - 1
+			}
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+			data, status = data[start:end+1], http.StatusPartialContent
+		}
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("Last-Modified", obj.modifiedAt.Format(http.TimeFormat))
+		w.WriteHeader(status)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+type listObject struct {
+	Key          string `xml:"Key"`
+	Size         int    `xml:"Size"`
+	LastModified string `xml:"LastModified"`
+}
+
+type listResult struct {
+	XMLName               xml.Name     `xml:"ListBucketResult"`
+	Contents              []listObject `xml:"Contents"`
+	IsTruncated           bool         `xml:"IsTruncated"`
+	NextContinuationToken string       `xml:"NextContinuationToken,omitempty"`
+}
+
+// list отдаёт ключи по порядку, continuation-token - последний ключ предыдущей страницы
+func (s *Server) list(w http.ResponseWriter, r *http.Request, objects map[string]object) {
+	prefix := r.URL.Query().Get("prefix")
+	after := r.URL.Query().Get("continuation-token")
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		if strings.HasPrefix(key, prefix) && key > after {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var result listResult
+	if len(keys) > s.pageSize {
+		keys = keys[:s.pageSize]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[len(keys)-1]
+	}
+	for _, key := range keys {
+		result.Contents = append(result.Contents, listObject{
+			Key:          key,
+			Size:         len(objects[key].data),
+			LastModified: objects[key].modifiedAt.Format(time.RFC3339),
+		})
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+// checkSignature подписывает копию запроса с теми же подписанными заголовками и сравнивает результат
+func (s *Server) checkSignature(r *http.Request) bool {
+	if s.signer == nil {
+		return true
+	}
+	authorization := r.Header.Get("Authorization")
+	_, signed, ok := strings.Cut(authorization, "SignedHeaders=")
+	if !ok {
+		return false
+	}
+	signed, _, _ = strings.Cut(signed, ",")
+	now, err := time.Parse("20060102T150405Z", r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		return false
+	}
+
+	req := r.Clone(r.Context())
+	req.URL.Scheme = "http"
+	req.URL.Host = r.Host
+	req.Header = http.Header{}
+	for _, name := range strings.Split(signed, ";") {
+		if name != "host" && name != "x-amz-date" && name != "x-amz-content-sha256" {
+			req.Header[http.CanonicalHeaderKey(name)] = r.Header.Values(name)
+		}
+	}
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	s.signer(req, now)
+	return req.Header.Get("Authorization") == authorization
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, "<Error><Code>"+code+"</Code></Error>")
+}
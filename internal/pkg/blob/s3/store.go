@@ -0,0 +1,233 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Config - параметры S3-совместимого хранилища (S3, MinIO и т.п.)
+type Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// Store хранит файлы в бакете S3-совместимого хранилища. Запросы подписываются AWS Signature V4,
+// адреса объектов в path-style: {endpoint}/{bucket}/{key}, как их понимает MinIO.
+type Store struct {
+	config Config
+	client *http.Client
+}
+
+func NewStore(config Config, client *http.Client) *Store {
+	config.Endpoint = strings.TrimSuffix(config.Endpoint, "/")
+	return &Store{
+		config: config,
+		client: client,
+	}
+}
+
+func (s *Store) Put(ctx context.Context, key string, data io.Reader, size int64, contentType string) error {
+	if !models.IsValidBlobKey(key) {
+		return models.WrongData
+	}
+	// без Content-Length S3 не принимает объект, поэтому файл неизвестного размера читается в память
+	if size < 0 {
+		buf, err := io.ReadAll(data)
+		if err != nil {
+			return err
+		}
+		data, size = bytes.NewReader(buf), int64(len(buf))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), io.NopCloser(data))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	if len(contentType) != 0 {
+		req.Header.Set("Content-Type", contentType)
+	}
+	res, err := s.do(req, unsignedPayload)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return checkResponse(res)
+}
+
+func (s *Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if !models.IsValidBlobKey(key) {
+		return nil, models.WrongData
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.do(req, emptyPayload)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(res); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+func (s *Store) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	if !models.IsValidBlobKey(key) || offset < 0 || length < 0 {
+		return nil, models.WrongData
+	}
+	if length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	res, err := s.do(req, emptyPayload)
+	if err != nil {
+		return nil, err
+	}
+	// диапазон за концом файла - пустой ответ, как у локального хранилища
+	if res.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		res.Body.Close()
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+	if err = checkResponse(res); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+func (s *Store) Delete(ctx context.Context, key string) error {
+	if !models.IsValidBlobKey(key) {
+		return models.WrongData
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+	res, err := s.do(req, emptyPayload)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if err = checkResponse(res); err != nil && !errors.Is(err, models.NotFound) {
+		return err
+	}
+	return nil
+}
+
+func (s *Store) Stat(ctx context.Context, key string) (models.BlobInfo, error) {
+	if !models.IsValidBlobKey(key) {
+		return models.BlobInfo{}, models.WrongData
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key), nil)
+	if err != nil {
+		return models.BlobInfo{}, err
+	}
+	res, err := s.do(req, emptyPayload)
+	if err != nil {
+		return models.BlobInfo{}, err
+	}
+	defer res.Body.Close()
+	if err = checkResponse(res); err != nil {
+		return models.BlobInfo{}, err
+	}
+
+	info := models.BlobInfo{Key: key, Size: res.ContentLength, ContentType: res.Header.Get("Content-Type")}
+	if modified := res.Header.Get("Last-Modified"); len(modified) != 0 {
+		if info.ModifiedAt, err = http.ParseTime(modified); err != nil {
+			return models.BlobInfo{}, err
+		}
+	}
+	return info, nil
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List перебирает страницы ListObjectsV2. Тип содержимого в списке S3 не возвращает, при необходимости его даёт Stat.
+func (s *Store) List(ctx context.Context, prefix string) ([]models.BlobInfo, error) {
+	var blobs = make([]models.BlobInfo, 0)
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if len(token) != 0 {
+			query.Set("continuation-token", token)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.config.Endpoint+"/"+s.config.Bucket+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.do(req, emptyPayload)
+		if err != nil {
+			return nil, err
+		}
+
+		var result listBucketResult
+		if err = checkResponse(res); err == nil {
+			err = xml.NewDecoder(res.Body).Decode(&result)
+		}
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range result.Contents {
+			blobs = append(blobs, models.BlobInfo{Key: object.Key, Size: object.Size, ModifiedAt: object.LastModified})
+		}
+		if !result.IsTruncated || len(result.NextContinuationToken) == 0 {
+			return blobs, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *Store) objectURL(key string) string {
+	return s.config.Endpoint + "/" + s.config.Bucket + encodePath(key)
+}
+
+func (s *Store) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	sign(req, s.config, payloadHash, time.Now())
+	return s.client.Do(req)
+}
+
+// checkResponse переводит ответ S3 в ошибку, тело ошибки S3 попадает в её текст
+func checkResponse(res *http.Response) error {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return models.NotFound
+	}
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return fmt.Errorf("s3: status %d: %s", res.StatusCode, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,194 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/s3/s3test"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testBucket = "media"
+
+var testConfig = Config{
+	Region:    s3test.Region,
+	Bucket:    testBucket,
+	AccessKey: s3test.AccessKey,
+	SecretKey: s3test.SecretKey,
+}
+
+func newTestStore(t *testing.T) (*Store, *s3test.Server) {
+	server := s3test.NewServer(testBucket, func(req *http.Request, now time.Time) {
+		sign(req, testConfig, req.Header.Get("X-Amz-Content-Sha256"), now)
+	})
+	t.Cleanup(server.Close)
+
+	config := testConfig
+	config.Endpoint = server.URL + "/"
+	return NewStore(config, server.Client()), server
+}
+
+func TestStore_RoundTrip(t *testing.T) {
+	store, server := newTestStore(t)
+	ctx := context.Background()
+	content := []byte("jpeg content")
+
+	if err := store.Put(ctx, "photo 1.jpg", bytes.NewReader(content), int64(len(content)), "image/jpeg"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if data, ok := server.Object(testBucket, "photo 1.jpg"); !ok || !bytes.Equal(data, content) {
+		t.Fatalf("object in bucket = %q, %v", data, ok)
+	}
+
+	body, err := store.Get(ctx, "photo 1.jpg")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	data, err := io.ReadAll(body)
+	body.Close()
+	if err != nil || !bytes.Equal(data, content) {
+		t.Errorf("Get() = %q, %v, want %q", data, err, content)
+	}
+
+	info, err := store.Stat(ctx, "photo 1.jpg")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Key != "photo 1.jpg" || info.Size != int64(len(content)) || info.ContentType != "image/jpeg" || info.ModifiedAt.IsZero() {
+		t.Errorf("Stat() = %+v", info)
+	}
+
+	if err = store.Delete(ctx, "photo 1.jpg"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, ok := server.Object(testBucket, "photo 1.jpg"); ok {
+		t.Errorf("object is still in bucket after Delete()")
+	}
+}
+
+func TestStore_PutUnknownSize(t *testing.T) {
+	store, server := newTestStore(t)
+	ctx := context.Background()
+
+	if err := store.Put(ctx, "a.txt", strings.NewReader("unknown size"), -1, "text/plain"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if data, _ := server.Object(testBucket, "a.txt"); string(data) != "unknown size" {
+		t.Errorf("object in bucket = %q", data)
+	}
+
+	if err := store.Put(ctx, "empty.txt", strings.NewReader(""), 0, ""); err != nil {
+		t.Fatalf("Put() empty error = %v", err)
+	}
+	if data, ok := server.Object(testBucket, "empty.txt"); !ok || len(data) != 0 {
+		t.Errorf("empty object in bucket = %q, %v", data, ok)
+	}
+}
+
+func TestStore_NotFound(t *testing.T) {
+	store, _ := newTestStore(t)
+	ctx := context.Background()
+
+	if _, err := store.Get(ctx, "missing.jpg"); !errors.Is(err, models.NotFound) {
+		t.Errorf("Get() error = %v, want %v", err, models.NotFound)
+	}
+	if _, err := store.Stat(ctx, "missing.jpg"); !errors.Is(err, models.NotFound) {
+		t.Errorf("Stat() error = %v, want %v", err, models.NotFound)
+	}
+	if err := store.Delete(ctx, "missing.jpg"); err != nil {
+		t.Errorf("Delete() error = %v, want nil", err)
+	}
+}
+
+func TestStore_List(t *testing.T) {
+	store, server := newTestStore(t)
+	server.SetPageSize(2)
+	ctx := context.Background()
+
+	for _, key := range []string{"a/1.jpg", "a/2.jpg", "a/3.jpg", "b/1.jpg"} {
+		if err := store.Put(ctx, key, strings.NewReader(key), int64(len(key)), ""); err != nil {
+			t.Fatalf("Put(%s) error = %v", key, err)
+		}
+	}
+
+	blobs, err := store.List(ctx, "a/")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	keys := make([]string, 0, len(blobs))
+	for _, blob := range blobs {
+		keys = append(keys, blob.Key)
+		if blob.Size != int64(len(blob.Key)) {
+			t.Errorf("List() size of %s = %d", blob.Key, blob.Size)
+		}
+	}
+	sort.Strings(keys)
+	if strings.Join(keys, ",") != "a/1.jpg,a/2.jpg,a/3.jpg" {
+		t.Errorf("List() keys = %v", keys)
+	}
+}
+
+func TestStore_WrongCredentials(t *testing.T) {
+	store, _ := newTestStore(t)
+	store.config.SecretKey = "wrong"
+
+	err := store.Put(context.Background(), "a.txt", strings.NewReader("a"), 1, "")
+	if err == nil || errors.Is(err, models.NotFound) {
+		t.Errorf("Put() with wrong secret error = %v", err)
+	}
+}
+
+func TestStore_InvalidKey(t *testing.T) {
+	store, _ := newTestStore(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"", "../a.jpg", "/a.jpg"} {
+		if err := store.Put(ctx, key, strings.NewReader("a"), 1, ""); !errors.Is(err, models.WrongData) {
+			t.Errorf("Put(%q) error = %v, want %v", key, err, models.WrongData)
+		}
+		if _, err := store.Get(ctx, key); !errors.Is(err, models.WrongData) {
+			t.Errorf("Get(%q) error = %v, want %v", key, err, models.WrongData)
+		}
+	}
+}
+
+func TestStore_GetRange(t *testing.T) {
+	store, _ := newTestStore(t)
+	ctx := context.Background()
+	if err := store.Put(ctx, "a.mp3", strings.NewReader("0123456789"), 10, "audio/mpeg"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	tests := []struct {
+		offset int64
+		length int64
+		want   string
+	}{
+		{offset: 0, length: 10, want: "0123456789"},
+		{offset: 2, length: 3, want: "234"},
+		{offset: 8, length: 5, want: "89"},
+		{offset: 12, length: 1, want: ""},
+		{offset: 3, length: 0, want: ""},
+	}
+	for _, test := range tests {
+		body, err := store.GetRange(ctx, "a.mp3", test.offset, test.length)
+		if err != nil {
+			t.Fatalf("GetRange(%d, %d) error = %v", test.offset, test.length, err)
+		}
+		data, err := io.ReadAll(body)
+		body.Close()
+		if err != nil || string(data) != test.want {
+			t.Errorf("GetRange(%d, %d) = %q, %v, want %q", test.offset, test.length, data, err, test.want)
+		}
+	}
+
+	if _, err := store.GetRange(ctx, "missing.mp3", 0, 1); !errors.Is(err, models.NotFound) {
+		t.Errorf("GetRange() missing error = %v, want %v", err, models.NotFound)
+	}
+}
@@ -0,0 +1,47 @@
+package blob
+
+import (
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/local"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/s3"
+	"net/http"
+	"os"
+	"time"
+)
+
+const s3Timeout = 30 * time.Second
+
+// NewBlobStoreFromEnv выбирает хранилище по BLOB_STORE: local (по умолчанию) - каталог BLOB_LOCAL_ROOT,
+// s3 - бакет S3_BUCKET в S3-совместимом хранилище S3_ENDPOINT с ключами S3_ACCESS_KEY и S3_SECRET_KEY.
+func NewBlobStoreFromEnv() (BlobStore, error) {
+	kind, _ := os.LookupEnv("BLOB_STORE")
+	switch kind {
+	case "", "local":
+		root, ok := os.LookupEnv("BLOB_LOCAL_ROOT")
+		if !ok {
+			root = models.FolderPath
+		}
+		return local.NewStore(root)
+	case "s3":
+		config := s3.Config{Region: "us-east-1"}
+		if region, ok := os.LookupEnv("S3_REGION"); ok {
+			config.Region = region
+		}
+		for name, value := range map[string]*string{
+			"S3_ENDPOINT":   &config.Endpoint,
+			"S3_BUCKET":     &config.Bucket,
+			"S3_ACCESS_KEY": &config.AccessKey,
+			"S3_SECRET_KEY": &config.SecretKey,
+		} {
+			env, ok := os.LookupEnv(name)
+			if !ok || len(env) == 0 {
+				return nil, fmt.Errorf("%s is not set", name)
+			}
+			*value = env
+		}
+		return s3.NewStore(config, &http.Client{Timeout: s3Timeout}), nil
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", kind)
+	}
+}
@@ -136,16 +136,23 @@ type Stat struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatorId              string  `protobuf:"bytes,1,opt,name=CreatorId,proto3" json:"CreatorId,omitempty"`
-	PostsPerMonth          int64   `protobuf:"varint,2,opt,name=PostsPerMonth,proto3" json:"PostsPerMonth,omitempty"`
-	SubscriptionsBought    int64   `protobuf:"varint,3,opt,name=SubscriptionsBought,proto3" json:"SubscriptionsBought,omitempty"`
-	DonationsCount         int64   `protobuf:"varint,4,opt,name=DonationsCount,proto3" json:"DonationsCount,omitempty"`
-	MoneyFromDonations     float64 `protobuf:"fixed64,5,opt,name=MoneyFromDonations,proto3" json:"MoneyFromDonations,omitempty"`
-	MoneyFromSubscriptions float64 `protobuf:"fixed64,6,opt,name=MoneyFromSubscriptions,proto3" json:"MoneyFromSubscriptions,omitempty"`
-	NewFollowers           int64   `protobuf:"varint,7,opt,name=NewFollowers,proto3" json:"NewFollowers,omitempty"`
-	LikesCount             int64   `protobuf:"varint,8,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
-	CommentsCount          int64   `protobuf:"varint,9,opt,name=CommentsCount,proto3" json:"CommentsCount,omitempty"`
-	Error                  string  `protobuf:"bytes,10,opt,name=Error,proto3" json:"Error,omitempty"`
+	CreatorId              string               `protobuf:"bytes,1,opt,name=CreatorId,proto3" json:"CreatorId,omitempty"`
+	PostsPerMonth          int64                `protobuf:"varint,2,opt,name=PostsPerMonth,proto3" json:"PostsPerMonth,omitempty"`
+	SubscriptionsBought    int64                `protobuf:"varint,3,opt,name=SubscriptionsBought,proto3" json:"SubscriptionsBought,omitempty"`
+	DonationsCount         int64                `protobuf:"varint,4,opt,name=DonationsCount,proto3" json:"DonationsCount,omitempty"`
+	MoneyFromDonations     *proto.Money         `protobuf:"bytes,5,opt,name=MoneyFromDonations,proto3" json:"MoneyFromDonations,omitempty"`
+	MoneyFromSubscriptions *proto.Money         `protobuf:"bytes,6,opt,name=MoneyFromSubscriptions,proto3" json:"MoneyFromSubscriptions,omitempty"`
+	NewFollowers           int64                `protobuf:"varint,7,opt,name=NewFollowers,proto3" json:"NewFollowers,omitempty"`
+	LikesCount             int64                `protobuf:"varint,8,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
+	CommentsCount          int64                `protobuf:"varint,9,opt,name=CommentsCount,proto3" json:"CommentsCount,omitempty"`
+	Error                  string               `protobuf:"bytes,10,opt,name=Error,proto3" json:"Error,omitempty"`
+	SubscriptionsExpired   int64                `protobuf:"varint,11,opt,name=SubscriptionsExpired,proto3" json:"SubscriptionsExpired,omitempty"`
+	GiftsBought            int64                `protobuf:"varint,12,opt,name=GiftsBought,proto3" json:"GiftsBought,omitempty"`
+	MoneyFromGifts         *proto.Money         `protobuf:"bytes,13,opt,name=MoneyFromGifts,proto3" json:"MoneyFromGifts,omitempty"`
+	GrossIncome            *proto.Money         `protobuf:"bytes,14,opt,name=GrossIncome,proto3" json:"GrossIncome,omitempty"`
+	Commission             *proto.Money         `protobuf:"bytes,15,opt,name=Commission,proto3" json:"Commission,omitempty"`
+	NetIncome              *proto.Money         `protobuf:"bytes,16,opt,name=NetIncome,proto3" json:"NetIncome,omitempty"`
+	BillingPeriods         []*BillingPeriodStat `protobuf:"bytes,17,rep,name=BillingPeriods,proto3" json:"BillingPeriods,omitempty"`
 }
 
 func (x *Stat) Reset() {
@@ -208,18 +215,18 @@ func (x *Stat) GetDonationsCount() int64 {
 	return 0
 }
 
-func (x *Stat) GetMoneyFromDonations() float64 {
+func (x *Stat) GetMoneyFromDonations() *proto.Money {
 	if x != nil {
 		return x.MoneyFromDonations
 	}
-	return 0
+	return nil
 }
 
-func (x *Stat) GetMoneyFromSubscriptions() float64 {
+func (x *Stat) GetMoneyFromSubscriptions() *proto.Money {
 	if x != nil {
 		return x.MoneyFromSubscriptions
 	}
-	return 0
+	return nil
 }
 
 func (x *Stat) GetNewFollowers() int64 {
@@ -250,6 +257,118 @@ func (x *Stat) GetError() string {
 	return ""
 }
 
+func (x *Stat) GetSubscriptionsExpired() int64 {
+	if x != nil {
+		return x.SubscriptionsExpired
+	}
+	return 0
+}
+
+func (x *Stat) GetGiftsBought() int64 {
+	if x != nil {
+		return x.GiftsBought
+	}
+	return 0
+}
+
+func (x *Stat) GetMoneyFromGifts() *proto.Money {
+	if x != nil {
+		return x.MoneyFromGifts
+	}
+	return nil
+}
+
+func (x *Stat) GetGrossIncome() *proto.Money {
+	if x != nil {
+		return x.GrossIncome
+	}
+	return nil
+}
+
+func (x *Stat) GetCommission() *proto.Money {
+	if x != nil {
+		return x.Commission
+	}
+	return nil
+}
+
+func (x *Stat) GetNetIncome() *proto.Money {
+	if x != nil {
+		return x.NetIncome
+	}
+	return nil
+}
+
+func (x *Stat) GetBillingPeriods() []*BillingPeriodStat {
+	if x != nil {
+		return x.BillingPeriods
+	}
+	return nil
+}
+
+type BillingPeriodStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MonthCount          int64        `protobuf:"varint,1,opt,name=MonthCount,proto3" json:"MonthCount,omitempty"`
+	SubscriptionsBought int64        `protobuf:"varint,2,opt,name=SubscriptionsBought,proto3" json:"SubscriptionsBought,omitempty"`
+	Money               *proto.Money `protobuf:"bytes,3,opt,name=Money,proto3" json:"Money,omitempty"`
+}
+
+func (x *BillingPeriodStat) Reset() {
+	*x = BillingPeriodStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillingPeriodStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingPeriodStat) ProtoMessage() {}
+
+func (x *BillingPeriodStat) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingPeriodStat.ProtoReflect.Descriptor instead.
+func (*BillingPeriodStat) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BillingPeriodStat) GetMonthCount() int64 {
+	if x != nil {
+		return x.MonthCount
+	}
+	return 0
+}
+
+func (x *BillingPeriodStat) GetSubscriptionsBought() int64 {
+	if x != nil {
+		return x.SubscriptionsBought
+	}
+	return 0
+}
+
+func (x *BillingPeriodStat) GetMoney() *proto.Money {
+	if x != nil {
+		return x.Money
+	}
+	return nil
+}
+
 type Creator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -268,7 +387,7 @@ type Creator struct {
 func (x *Creator) Reset() {
 	*x = Creator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creator_proto_msgTypes[3]
+		mi := &file_creator_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -281,7 +400,7 @@ func (x *Creator) String() string {
 func (*Creator) ProtoMessage() {}
 
 func (x *Creator) ProtoReflect() protoreflect.Message {
-	mi := &file_creator_proto_msgTypes[3]
+	mi := &file_creator_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +413,7 @@ func (x *Creator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Creator.ProtoReflect.Descriptor instead.
 func (*Creator) Descriptor() ([]byte, []int) {
-	return file_creator_proto_rawDescGZIP(), []int{3}
+	return file_creator_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Creator) GetId() string {
@@ -365,7 +484,7 @@ type CreatorsMessage struct {
 func (x *CreatorsMessage) Reset() {
 	*x = CreatorsMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creator_proto_msgTypes[4]
+		mi := &file_creator_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -378,7 +497,7 @@ func (x *CreatorsMessage) String() string {
 func (*CreatorsMessage) ProtoMessage() {}
 
 func (x *CreatorsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_creator_proto_msgTypes[4]
+	mi := &file_creator_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,7 +510,7 @@ func (x *CreatorsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatorsMessage.ProtoReflect.Descriptor instead.
 func (*CreatorsMessage) Descriptor() ([]byte, []int) {
-	return file_creator_proto_rawDescGZIP(), []int{4}
+	return file_creator_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreatorsMessage) GetCreators() []*Creator {
@@ -421,7 +540,7 @@ type NotificationCreatorInfo struct {
 func (x *NotificationCreatorInfo) Reset() {
 	*x = NotificationCreatorInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creator_proto_msgTypes[5]
+		mi := &file_creator_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -434,7 +553,7 @@ func (x *NotificationCreatorInfo) String() string {
 func (*NotificationCreatorInfo) ProtoMessage() {}
 
 func (x *NotificationCreatorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_creator_proto_msgTypes[5]
+	mi := &file_creator_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +566,7 @@ func (x *NotificationCreatorInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationCreatorInfo.ProtoReflect.Descriptor instead.
 func (*NotificationCreatorInfo) Descriptor() ([]byte, []int) {
-	return file_creator_proto_rawDescGZIP(), []int{5}
+	return file_creator_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NotificationCreatorInfo) GetName() string {
@@ -483,7 +602,7 @@ type UserCreatorMessage struct {
 func (x *UserCreatorMessage) Reset() {
 	*x = UserCreatorMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creator_proto_msgTypes[6]
+		mi := &file_creator_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -496,7 +615,7 @@ func (x *UserCreatorMessage) String() string {
 func (*UserCreatorMessage) ProtoMessage() {}
 
 func (x *UserCreatorMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_creator_proto_msgTypes[6]
+	mi := &file_creator_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +628,7 @@ func (x *UserCreatorMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserCreatorMessage.ProtoReflect.Descriptor instead.
 func (*UserCreatorMessage) Descriptor() ([]byte, []int) {
-	return file_creator_proto_rawDescGZIP(), []int{6}
+	return file_creator_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UserCreatorMessage) GetUserID() string {
@@ -538,7 +657,7 @@ type SubscriptionCreatorMessage struct {
 func (x *SubscriptionCreatorMessage) Reset() {
 	*x = SubscriptionCreatorMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creator_proto_msgTypes[7]
+		mi := &file_creator_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -551,7 +670,7 @@ func (x *SubscriptionCreatorMessage) String() string {
 func (*SubscriptionCreatorMessage) ProtoMessage() {}
 
 func (x *SubscriptionCreatorMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_creator_proto_msgTypes[7]
+	mi := &file_creator_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +683,7 @@ func (x *SubscriptionCreatorMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscriptionCreatorMessage.ProtoReflect.Descriptor instead.
 func (*SubscriptionCreatorMessage) Descriptor() ([]byte, []int) {
-	return file_creator_proto_rawDescGZIP(), []int{7}
+	return file_creator_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SubscriptionCreatorMessage) GetSubscriptionID() string {
@@ -593,7 +712,7 @@ type PostUserMessage struct {
 func (x *PostUserMessage) Reset() {
 	*x = PostUserMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creator_proto_msgTypes[8]
+		mi := &file_creator_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -606,7 +725,7 @@ func (x *PostUserMessage) String() string {
 func (*PostUserMessage) ProtoMessage() {}
 
 func (x *PostUserMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_creator_proto_msgTypes[8]
+	mi := &file_creator_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +738,7 @@ func (x *PostUserMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostUserMessage.ProtoReflect.Descriptor instead.
 func (*PostUserMessage) Descriptor() ([]byte, []int) {
-	return file_creator_proto_rawDescGZIP(), []int{8}
+	return file_creator_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PostUserMessage) GetUserID() string {
@@ -649,7 +768,7 @@ type UpdateCreatorInfo struct {
 func (x *UpdateCreatorInfo) Reset() {
 	*x = UpdateCreatorInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creator_proto_msgTypes[9]
+		mi := &file_creator_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -662,7 +781,7 @@ func (x *UpdateCreatorInfo) String() string {
 func (*UpdateCreatorInfo) ProtoMessage() {}
 
 func (x *UpdateCreatorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_creator_proto_msgTypes[9]
+	mi := &file_creator_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +794,7 @@ func (x *UpdateCreatorInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCreatorInfo.ProtoReflect.Descriptor instead.
 func (*UpdateCreatorInfo) Descriptor() ([]byte, []int) {
-	return file_creator_proto_rawDescGZIP(), []int{9}
+	return file_creator_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateCreatorInfo) GetCreatorName() string {
@@ -699,32 +818,41 @@ func (x *UpdateCreatorInfo) GetCreatorID() string {
 	return ""
 }
 
-type CreatorTransfer struct {
+type Payout struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatorID string  `protobuf:"bytes,1,opt,name=creatorID,proto3" json:"creatorID,omitempty"`
-	Money     float32 `protobuf:"fixed32,2,opt,name=Money,proto3" json:"Money,omitempty"`
-}
-
-func (x *CreatorTransfer) Reset() {
-	*x = CreatorTransfer{}
+	Id             string       `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	CreatorID      string       `protobuf:"bytes,2,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	Money          *proto.Money `protobuf:"bytes,3,opt,name=Money,proto3" json:"Money,omitempty"`
+	PhoneNumber    string       `protobuf:"bytes,4,opt,name=PhoneNumber,proto3" json:"PhoneNumber,omitempty"`
+	IdempotencyKey string       `protobuf:"bytes,5,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
+	Status         string       `protobuf:"bytes,6,opt,name=Status,proto3" json:"Status,omitempty"`
+	Attempts       int64        `protobuf:"varint,7,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	LastError      string       `protobuf:"bytes,8,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	CreatedAt      string       `protobuf:"bytes,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt      string       `protobuf:"bytes,10,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	DestinationID  string       `protobuf:"bytes,11,opt,name=DestinationID,proto3" json:"DestinationID,omitempty"`
+}
+
+func (x *Payout) Reset() {
+	*x = Payout{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creator_proto_msgTypes[10]
+		mi := &file_creator_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreatorTransfer) String() string {
+func (x *Payout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatorTransfer) ProtoMessage() {}
+func (*Payout) ProtoMessage() {}
 
-func (x *CreatorTransfer) ProtoReflect() protoreflect.Message {
-	mi := &file_creator_proto_msgTypes[10]
+func (x *Payout) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,133 +863,99 @@ func (x *CreatorTransfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatorTransfer.ProtoReflect.Descriptor instead.
-func (*CreatorTransfer) Descriptor() ([]byte, []int) {
-	return file_creator_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Payout.ProtoReflect.Descriptor instead.
+func (*Payout) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreatorTransfer) GetCreatorID() string {
+func (x *Payout) GetId() string {
 	if x != nil {
-		return x.CreatorID
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreatorTransfer) GetMoney() float32 {
+func (x *Payout) GetCreatorID() string {
 	if x != nil {
-		return x.Money
+		return x.CreatorID
 	}
-	return 0
-}
-
-type CreatorPage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CreatorInfo   *Creator              `protobuf:"bytes,1,opt,name=CreatorInfo,proto3" json:"CreatorInfo,omitempty"`
-	AimInfo       *Aim                  `protobuf:"bytes,2,opt,name=AimInfo,proto3" json:"AimInfo,omitempty"`
-	IsMyPage      bool                  `protobuf:"varint,3,opt,name=IsMyPage,proto3" json:"IsMyPage,omitempty"`
-	Follows       bool                  `protobuf:"varint,4,opt,name=Follows,proto3" json:"Follows,omitempty"`
-	Posts         []*Post               `protobuf:"bytes,5,rep,name=Posts,proto3" json:"Posts,omitempty"`
-	Subscriptions []*proto.Subscription `protobuf:"bytes,6,rep,name=Subscriptions,proto3" json:"Subscriptions,omitempty"`
-	Error         string                `protobuf:"bytes,7,opt,name=Error,proto3" json:"Error,omitempty"`
+	return ""
 }
 
-func (x *CreatorPage) Reset() {
-	*x = CreatorPage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_creator_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Payout) GetMoney() *proto.Money {
+	if x != nil {
+		return x.Money
 	}
+	return nil
 }
 
-func (x *CreatorPage) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreatorPage) ProtoMessage() {}
-
-func (x *CreatorPage) ProtoReflect() protoreflect.Message {
-	mi := &file_creator_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Payout) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreatorPage.ProtoReflect.Descriptor instead.
-func (*CreatorPage) Descriptor() ([]byte, []int) {
-	return file_creator_proto_rawDescGZIP(), []int{11}
+	return ""
 }
 
-func (x *CreatorPage) GetCreatorInfo() *Creator {
+func (x *Payout) GetIdempotencyKey() string {
 	if x != nil {
-		return x.CreatorInfo
+		return x.IdempotencyKey
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatorPage) GetAimInfo() *Aim {
+func (x *Payout) GetStatus() string {
 	if x != nil {
-		return x.AimInfo
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatorPage) GetIsMyPage() bool {
+func (x *Payout) GetAttempts() int64 {
 	if x != nil {
-		return x.IsMyPage
+		return x.Attempts
 	}
-	return false
+	return 0
 }
 
-func (x *CreatorPage) GetFollows() bool {
+func (x *Payout) GetLastError() string {
 	if x != nil {
-		return x.Follows
+		return x.LastError
 	}
-	return false
+	return ""
 }
 
-func (x *CreatorPage) GetPosts() []*Post {
+func (x *Payout) GetCreatedAt() string {
 	if x != nil {
-		return x.Posts
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatorPage) GetSubscriptions() []*proto.Subscription {
+func (x *Payout) GetUpdatedAt() string {
 	if x != nil {
-		return x.Subscriptions
+		return x.UpdatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatorPage) GetError() string {
+func (x *Payout) GetDestinationID() string {
 	if x != nil {
-		return x.Error
+		return x.DestinationID
 	}
 	return ""
 }
 
-type Aim struct {
+type PayoutMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator     string  `protobuf:"bytes,1,opt,name=Creator,proto3" json:"Creator,omitempty"`
-	Description string  `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	MoneyNeeded float32 `protobuf:"fixed32,3,opt,name=MoneyNeeded,proto3" json:"MoneyNeeded,omitempty"`
-	MoneyGot    float32 `protobuf:"fixed32,4,opt,name=MoneyGot,proto3" json:"MoneyGot,omitempty"`
+	Payout *Payout `protobuf:"bytes,1,opt,name=Payout,proto3" json:"Payout,omitempty"`
+	Error  string  `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *Aim) Reset() {
-	*x = Aim{}
+func (x *PayoutMessage) Reset() {
+	*x = PayoutMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,13 +963,13 @@ func (x *Aim) Reset() {
 	}
 }
 
-func (x *Aim) String() string {
+func (x *PayoutMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Aim) ProtoMessage() {}
+func (*PayoutMessage) ProtoMessage() {}
 
-func (x *Aim) ProtoReflect() protoreflect.Message {
+func (x *PayoutMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -887,61 +981,36 @@ func (x *Aim) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Aim.ProtoReflect.Descriptor instead.
-func (*Aim) Descriptor() ([]byte, []int) {
+// Deprecated: Use PayoutMessage.ProtoReflect.Descriptor instead.
+func (*PayoutMessage) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Aim) GetCreator() string {
+func (x *PayoutMessage) GetPayout() *Payout {
 	if x != nil {
-		return x.Creator
+		return x.Payout
 	}
-	return ""
+	return nil
 }
 
-func (x *Aim) GetDescription() string {
+func (x *PayoutMessage) GetError() string {
 	if x != nil {
-		return x.Description
+		return x.Error
 	}
 	return ""
 }
 
-func (x *Aim) GetMoneyNeeded() float32 {
-	if x != nil {
-		return x.MoneyNeeded
-	}
-	return 0
-}
-
-func (x *Aim) GetMoneyGot() float32 {
-	if x != nil {
-		return x.MoneyGot
-	}
-	return 0
-}
-
-type Post struct {
+type PayoutCreatorMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string                `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	CreatorID       string                `protobuf:"bytes,2,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
-	Creation        string                `protobuf:"bytes,3,opt,name=Creation,proto3" json:"Creation,omitempty"`
-	LikesCount      int64                 `protobuf:"varint,4,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
-	CommentsCount   int64                 `protobuf:"varint,5,opt,name=CommentsCount,proto3" json:"CommentsCount,omitempty"`
-	CreatorPhoto    string                `protobuf:"bytes,6,opt,name=CreatorPhoto,proto3" json:"CreatorPhoto,omitempty"`
-	CreatorName     string                `protobuf:"bytes,7,opt,name=CreatorName,proto3" json:"CreatorName,omitempty"`
-	Title           string                `protobuf:"bytes,8,opt,name=Title,proto3" json:"Title,omitempty"`
-	Text            string                `protobuf:"bytes,9,opt,name=Text,proto3" json:"Text,omitempty"`
-	IsAvailable     bool                  `protobuf:"varint,10,opt,name=IsAvailable,proto3" json:"IsAvailable,omitempty"`
-	IsLiked         bool                  `protobuf:"varint,11,opt,name=IsLiked,proto3" json:"IsLiked,omitempty"`
-	PostAttachments []*Attachment         `protobuf:"bytes,12,rep,name=PostAttachments,proto3" json:"PostAttachments,omitempty"`
-	Subscriptions   []*proto.Subscription `protobuf:"bytes,13,rep,name=Subscriptions,proto3" json:"Subscriptions,omitempty"`
+	PayoutID  string `protobuf:"bytes,1,opt,name=PayoutID,proto3" json:"PayoutID,omitempty"`
+	CreatorID string `protobuf:"bytes,2,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
+func (x *PayoutCreatorMessage) Reset() {
+	*x = PayoutCreatorMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,13 +1018,13 @@ func (x *Post) Reset() {
 	}
 }
 
-func (x *Post) String() string {
+func (x *PayoutCreatorMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*PayoutCreatorMessage) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
+func (x *PayoutCreatorMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,121 +1036,38 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
+// Deprecated: Use PayoutCreatorMessage.ProtoReflect.Descriptor instead.
+func (*PayoutCreatorMessage) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Post) GetId() string {
+func (x *PayoutCreatorMessage) GetPayoutID() string {
 	if x != nil {
-		return x.Id
+		return x.PayoutID
 	}
 	return ""
 }
 
-func (x *Post) GetCreatorID() string {
+func (x *PayoutCreatorMessage) GetCreatorID() string {
 	if x != nil {
 		return x.CreatorID
 	}
 	return ""
 }
 
-func (x *Post) GetCreation() string {
-	if x != nil {
-		return x.Creation
-	}
-	return ""
-}
-
-func (x *Post) GetLikesCount() int64 {
-	if x != nil {
-		return x.LikesCount
-	}
-	return 0
-}
-
-func (x *Post) GetCommentsCount() int64 {
-	if x != nil {
-		return x.CommentsCount
-	}
-	return 0
-}
-
-func (x *Post) GetCreatorPhoto() string {
-	if x != nil {
-		return x.CreatorPhoto
-	}
-	return ""
-}
-
-func (x *Post) GetCreatorName() string {
-	if x != nil {
-		return x.CreatorName
-	}
-	return ""
-}
-
-func (x *Post) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Post) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-func (x *Post) GetIsAvailable() bool {
-	if x != nil {
-		return x.IsAvailable
-	}
-	return false
-}
-
-func (x *Post) GetIsLiked() bool {
-	if x != nil {
-		return x.IsLiked
-	}
-	return false
-}
-
-func (x *Post) GetPostAttachments() []*Attachment {
-	if x != nil {
-		return x.PostAttachments
-	}
-	return nil
-}
-
-func (x *Post) GetSubscriptions() []*proto.Subscription {
-	if x != nil {
-		return x.Subscriptions
-	}
-	return nil
-}
-
-type Comment struct {
+type PayoutDestination struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	UserId     string `protobuf:"bytes,2,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	UserPhoto  string `protobuf:"bytes,3,opt,name=UserPhoto,proto3" json:"UserPhoto,omitempty"`
-	PostID     string `protobuf:"bytes,4,opt,name=PostID,proto3" json:"PostID,omitempty"`
-	Text       string `protobuf:"bytes,5,opt,name=Text,proto3" json:"Text,omitempty"`
-	Creation   string `protobuf:"bytes,6,opt,name=Creation,proto3" json:"Creation,omitempty"`
-	LikesCount int64  `protobuf:"varint,7,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
-	Username   string `protobuf:"bytes,8,opt,name=Username,proto3" json:"Username,omitempty"`
-	IsOwner    bool   `protobuf:"varint,9,opt,name=IsOwner,proto3" json:"IsOwner,omitempty"`
-	IsLiked    bool   `protobuf:"varint,10,opt,name=IsLiked,proto3" json:"IsLiked,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	CreatorID   string `protobuf:"bytes,2,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	PhoneNumber string `protobuf:"bytes,3,opt,name=PhoneNumber,proto3" json:"PhoneNumber,omitempty"`
+	VerifiedAt  string `protobuf:"bytes,4,opt,name=VerifiedAt,proto3" json:"VerifiedAt,omitempty"`
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
+func (x *PayoutDestination) Reset() {
+	*x = PayoutDestination{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1089,13 +1075,13 @@ func (x *Comment) Reset() {
 	}
 }
 
-func (x *Comment) String() string {
+func (x *PayoutDestination) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*PayoutDestination) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
+func (x *PayoutDestination) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1107,93 +1093,50 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
+// Deprecated: Use PayoutDestination.ProtoReflect.Descriptor instead.
+func (*PayoutDestination) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Comment) GetId() string {
+func (x *PayoutDestination) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Comment) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *Comment) GetUserPhoto() string {
-	if x != nil {
-		return x.UserPhoto
-	}
-	return ""
-}
-
-func (x *Comment) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
-}
-
-func (x *Comment) GetText() string {
+func (x *PayoutDestination) GetCreatorID() string {
 	if x != nil {
-		return x.Text
+		return x.CreatorID
 	}
 	return ""
 }
 
-func (x *Comment) GetCreation() string {
+func (x *PayoutDestination) GetPhoneNumber() string {
 	if x != nil {
-		return x.Creation
+		return x.PhoneNumber
 	}
 	return ""
 }
 
-func (x *Comment) GetLikesCount() int64 {
-	if x != nil {
-		return x.LikesCount
-	}
-	return 0
-}
-
-func (x *Comment) GetUsername() string {
+func (x *PayoutDestination) GetVerifiedAt() string {
 	if x != nil {
-		return x.Username
+		return x.VerifiedAt
 	}
 	return ""
 }
 
-func (x *Comment) GetIsOwner() bool {
-	if x != nil {
-		return x.IsOwner
-	}
-	return false
-}
-
-func (x *Comment) GetIsLiked() bool {
-	if x != nil {
-		return x.IsLiked
-	}
-	return false
-}
-
-type PostWithComments struct {
+type PayoutDestinationMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post     *Post      `protobuf:"bytes,1,opt,name=Post,proto3" json:"Post,omitempty"`
-	Comments []*Comment `protobuf:"bytes,2,rep,name=Comments,proto3" json:"Comments,omitempty"`
-	Error    string     `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Destination *PayoutDestination `protobuf:"bytes,1,opt,name=Destination,proto3" json:"Destination,omitempty"`
+	Error       string             `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *PostWithComments) Reset() {
-	*x = PostWithComments{}
+func (x *PayoutDestinationMessage) Reset() {
+	*x = PayoutDestinationMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1201,13 +1144,13 @@ func (x *PostWithComments) Reset() {
 	}
 }
 
-func (x *PostWithComments) String() string {
+func (x *PayoutDestinationMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostWithComments) ProtoMessage() {}
+func (*PayoutDestinationMessage) ProtoMessage() {}
 
-func (x *PostWithComments) ProtoReflect() protoreflect.Message {
+func (x *PayoutDestinationMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1219,43 +1162,36 @@ func (x *PostWithComments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostWithComments.ProtoReflect.Descriptor instead.
-func (*PostWithComments) Descriptor() ([]byte, []int) {
+// Deprecated: Use PayoutDestinationMessage.ProtoReflect.Descriptor instead.
+func (*PayoutDestinationMessage) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PostWithComments) GetPost() *Post {
-	if x != nil {
-		return x.Post
-	}
-	return nil
-}
-
-func (x *PostWithComments) GetComments() []*Comment {
+func (x *PayoutDestinationMessage) GetDestination() *PayoutDestination {
 	if x != nil {
-		return x.Comments
+		return x.Destination
 	}
 	return nil
 }
 
-func (x *PostWithComments) GetError() string {
+func (x *PayoutDestinationMessage) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type PostsMessage struct {
+type PayoutDestinationsMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts []*Post `protobuf:"bytes,1,rep,name=Posts,proto3" json:"Posts,omitempty"`
-	Error string  `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	Destinations []*PayoutDestination `protobuf:"bytes,1,rep,name=Destinations,proto3" json:"Destinations,omitempty"`
+	Error        string               `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *PostsMessage) Reset() {
-	*x = PostsMessage{}
+func (x *PayoutDestinationsMessage) Reset() {
+	*x = PayoutDestinationsMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1263,13 +1199,13 @@ func (x *PostsMessage) Reset() {
 	}
 }
 
-func (x *PostsMessage) String() string {
+func (x *PayoutDestinationsMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostsMessage) ProtoMessage() {}
+func (*PayoutDestinationsMessage) ProtoMessage() {}
 
-func (x *PostsMessage) ProtoReflect() protoreflect.Message {
+func (x *PayoutDestinationsMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1281,36 +1217,36 @@ func (x *PostsMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostsMessage.ProtoReflect.Descriptor instead.
-func (*PostsMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use PayoutDestinationsMessage.ProtoReflect.Descriptor instead.
+func (*PayoutDestinationsMessage) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PostsMessage) GetPosts() []*Post {
+func (x *PayoutDestinationsMessage) GetDestinations() []*PayoutDestination {
 	if x != nil {
-		return x.Posts
+		return x.Destinations
 	}
 	return nil
 }
 
-func (x *PostsMessage) GetError() string {
+func (x *PayoutDestinationsMessage) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type PostMessage struct {
+type PayoutDestinationCreatorMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post  *Post  `protobuf:"bytes,1,opt,name=Post,proto3" json:"Post,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	DestinationID string `protobuf:"bytes,1,opt,name=DestinationID,proto3" json:"DestinationID,omitempty"`
+	CreatorID     string `protobuf:"bytes,2,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
 }
 
-func (x *PostMessage) Reset() {
-	*x = PostMessage{}
+func (x *PayoutDestinationCreatorMessage) Reset() {
+	*x = PayoutDestinationCreatorMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1318,13 +1254,13 @@ func (x *PostMessage) Reset() {
 	}
 }
 
-func (x *PostMessage) String() string {
+func (x *PayoutDestinationCreatorMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostMessage) ProtoMessage() {}
+func (*PayoutDestinationCreatorMessage) ProtoMessage() {}
 
-func (x *PostMessage) ProtoReflect() protoreflect.Message {
+func (x *PayoutDestinationCreatorMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1336,36 +1272,39 @@ func (x *PostMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostMessage.ProtoReflect.Descriptor instead.
-func (*PostMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use PayoutDestinationCreatorMessage.ProtoReflect.Descriptor instead.
+func (*PayoutDestinationCreatorMessage) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PostMessage) GetPost() *Post {
+func (x *PayoutDestinationCreatorMessage) GetDestinationID() string {
 	if x != nil {
-		return x.Post
+		return x.DestinationID
 	}
-	return nil
+	return ""
 }
 
-func (x *PostMessage) GetError() string {
+func (x *PayoutDestinationCreatorMessage) GetCreatorID() string {
 	if x != nil {
-		return x.Error
+		return x.CreatorID
 	}
 	return ""
 }
 
-type CreatorBalance struct {
+type PayoutSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Balance float32 `protobuf:"fixed32,1,opt,name=Balance,proto3" json:"Balance,omitempty"`
-	Error   string  `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	CreatorID     string       `protobuf:"bytes,1,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	DestinationID string       `protobuf:"bytes,2,opt,name=DestinationID,proto3" json:"DestinationID,omitempty"`
+	Period        string       `protobuf:"bytes,3,opt,name=Period,proto3" json:"Period,omitempty"`
+	Threshold     *proto.Money `protobuf:"bytes,4,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	NextRunAt     string       `protobuf:"bytes,5,opt,name=NextRunAt,proto3" json:"NextRunAt,omitempty"`
 }
 
-func (x *CreatorBalance) Reset() {
-	*x = CreatorBalance{}
+func (x *PayoutSchedule) Reset() {
+	*x = PayoutSchedule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1373,13 +1312,13 @@ func (x *CreatorBalance) Reset() {
 	}
 }
 
-func (x *CreatorBalance) String() string {
+func (x *PayoutSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatorBalance) ProtoMessage() {}
+func (*PayoutSchedule) ProtoMessage() {}
 
-func (x *CreatorBalance) ProtoReflect() protoreflect.Message {
+func (x *PayoutSchedule) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1391,36 +1330,57 @@ func (x *CreatorBalance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatorBalance.ProtoReflect.Descriptor instead.
-func (*CreatorBalance) Descriptor() ([]byte, []int) {
+// Deprecated: Use PayoutSchedule.ProtoReflect.Descriptor instead.
+func (*PayoutSchedule) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreatorBalance) GetBalance() float32 {
+func (x *PayoutSchedule) GetCreatorID() string {
 	if x != nil {
-		return x.Balance
+		return x.CreatorID
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatorBalance) GetError() string {
+func (x *PayoutSchedule) GetDestinationID() string {
 	if x != nil {
-		return x.Error
+		return x.DestinationID
 	}
 	return ""
 }
 
-type Attachment struct {
+func (x *PayoutSchedule) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *PayoutSchedule) GetThreshold() *proto.Money {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *PayoutSchedule) GetNextRunAt() string {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return ""
+}
+
+type PayoutScheduleMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID   string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Schedule *PayoutSchedule `protobuf:"bytes,1,opt,name=Schedule,proto3" json:"Schedule,omitempty"`
+	Error    string          `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *Attachment) Reset() {
-	*x = Attachment{}
+func (x *PayoutScheduleMessage) Reset() {
+	*x = PayoutScheduleMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1428,13 +1388,13 @@ func (x *Attachment) Reset() {
 	}
 }
 
-func (x *Attachment) String() string {
+func (x *PayoutScheduleMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attachment) ProtoMessage() {}
+func (*PayoutScheduleMessage) ProtoMessage() {}
 
-func (x *Attachment) ProtoReflect() protoreflect.Message {
+func (x *PayoutScheduleMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1446,36 +1406,40 @@ func (x *Attachment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
-func (*Attachment) Descriptor() ([]byte, []int) {
+// Deprecated: Use PayoutScheduleMessage.ProtoReflect.Descriptor instead.
+func (*PayoutScheduleMessage) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Attachment) GetID() string {
+func (x *PayoutScheduleMessage) GetSchedule() *PayoutSchedule {
 	if x != nil {
-		return x.ID
+		return x.Schedule
 	}
-	return ""
+	return nil
 }
 
-func (x *Attachment) GetType() string {
+func (x *PayoutScheduleMessage) GetError() string {
 	if x != nil {
-		return x.Type
+		return x.Error
 	}
 	return ""
 }
 
-type FirstDate struct {
+type CommissionRate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Date  string `protobuf:"bytes,1,opt,name=Date,proto3" json:"Date,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	CreatorID   string `protobuf:"bytes,2,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	RateBP      int64  `protobuf:"varint,3,opt,name=RateBP,proto3" json:"RateBP,omitempty"`
+	StartsAt    string `protobuf:"bytes,4,opt,name=StartsAt,proto3" json:"StartsAt,omitempty"`
+	EndsAt      string `protobuf:"bytes,5,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	Description string `protobuf:"bytes,6,opt,name=Description,proto3" json:"Description,omitempty"`
 }
 
-func (x *FirstDate) Reset() {
-	*x = FirstDate{}
+func (x *CommissionRate) Reset() {
+	*x = CommissionRate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1483,13 +1447,13 @@ func (x *FirstDate) Reset() {
 	}
 }
 
-func (x *FirstDate) String() string {
+func (x *CommissionRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FirstDate) ProtoMessage() {}
+func (*CommissionRate) ProtoMessage() {}
 
-func (x *FirstDate) ProtoReflect() protoreflect.Message {
+func (x *CommissionRate) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1501,35 +1465,64 @@ func (x *FirstDate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FirstDate.ProtoReflect.Descriptor instead.
-func (*FirstDate) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommissionRate.ProtoReflect.Descriptor instead.
+func (*CommissionRate) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *FirstDate) GetDate() string {
+func (x *CommissionRate) GetId() string {
 	if x != nil {
-		return x.Date
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FirstDate) GetError() string {
+func (x *CommissionRate) GetCreatorID() string {
 	if x != nil {
-		return x.Error
+		return x.CreatorID
 	}
 	return ""
 }
 
-type Attachments struct {
+func (x *CommissionRate) GetRateBP() int64 {
+	if x != nil {
+		return x.RateBP
+	}
+	return 0
+}
+
+func (x *CommissionRate) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CommissionRate) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *CommissionRate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CommissionRateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Attachments []*Attachment `protobuf:"bytes,1,rep,name=Attachments,proto3" json:"Attachments,omitempty"`
+	AdminID string          `protobuf:"bytes,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	Rate    *CommissionRate `protobuf:"bytes,2,opt,name=Rate,proto3" json:"Rate,omitempty"`
 }
 
-func (x *Attachments) Reset() {
-	*x = Attachments{}
+func (x *CommissionRateRequest) Reset() {
+	*x = CommissionRateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1537,13 +1530,13 @@ func (x *Attachments) Reset() {
 	}
 }
 
-func (x *Attachments) String() string {
+func (x *CommissionRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attachments) ProtoMessage() {}
+func (*CommissionRateRequest) ProtoMessage() {}
 
-func (x *Attachments) ProtoReflect() protoreflect.Message {
+func (x *CommissionRateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1555,29 +1548,36 @@ func (x *Attachments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attachments.ProtoReflect.Descriptor instead.
-func (*Attachments) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommissionRateRequest.ProtoReflect.Descriptor instead.
+func (*CommissionRateRequest) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Attachments) GetAttachments() []*Attachment {
+func (x *CommissionRateRequest) GetAdminID() string {
 	if x != nil {
-		return x.Attachments
+		return x.AdminID
+	}
+	return ""
+}
+
+func (x *CommissionRateRequest) GetRate() *CommissionRate {
+	if x != nil {
+		return x.Rate
 	}
 	return nil
 }
 
-type FlagMessage struct {
+type CommissionRateMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flag  bool   `protobuf:"varint,1,opt,name=Flag,proto3" json:"Flag,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	Rate  *CommissionRate `protobuf:"bytes,1,opt,name=Rate,proto3" json:"Rate,omitempty"`
+	Error string          `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *FlagMessage) Reset() {
-	*x = FlagMessage{}
+func (x *CommissionRateMessage) Reset() {
+	*x = CommissionRateMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1585,13 +1585,13 @@ func (x *FlagMessage) Reset() {
 	}
 }
 
-func (x *FlagMessage) String() string {
+func (x *CommissionRateMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FlagMessage) ProtoMessage() {}
+func (*CommissionRateMessage) ProtoMessage() {}
 
-func (x *FlagMessage) ProtoReflect() protoreflect.Message {
+func (x *CommissionRateMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1603,36 +1603,37 @@ func (x *FlagMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlagMessage.ProtoReflect.Descriptor instead.
-func (*FlagMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommissionRateMessage.ProtoReflect.Descriptor instead.
+func (*CommissionRateMessage) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FlagMessage) GetFlag() bool {
+func (x *CommissionRateMessage) GetRate() *CommissionRate {
 	if x != nil {
-		return x.Flag
+		return x.Rate
 	}
-	return false
+	return nil
 }
 
-func (x *FlagMessage) GetError() string {
+func (x *CommissionRateMessage) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type Extension struct {
+type EndCommissionRateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Extension string `protobuf:"bytes,1,opt,name=Extension,proto3" json:"Extension,omitempty"`
-	Flag      bool   `protobuf:"varint,2,opt,name=Flag,proto3" json:"Flag,omitempty"`
+	AdminID string `protobuf:"bytes,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	RateID  string `protobuf:"bytes,2,opt,name=RateID,proto3" json:"RateID,omitempty"`
+	EndsAt  string `protobuf:"bytes,3,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
 }
 
-func (x *Extension) Reset() {
-	*x = Extension{}
+func (x *EndCommissionRateRequest) Reset() {
+	*x = EndCommissionRateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1640,13 +1641,13 @@ func (x *Extension) Reset() {
 	}
 }
 
-func (x *Extension) String() string {
+func (x *EndCommissionRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Extension) ProtoMessage() {}
+func (*EndCommissionRateRequest) ProtoMessage() {}
 
-func (x *Extension) ProtoReflect() protoreflect.Message {
+func (x *EndCommissionRateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1658,40 +1659,49 @@ func (x *Extension) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Extension.ProtoReflect.Descriptor instead.
-func (*Extension) Descriptor() ([]byte, []int) {
+// Deprecated: Use EndCommissionRateRequest.ProtoReflect.Descriptor instead.
+func (*EndCommissionRateRequest) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Extension) GetExtension() string {
+func (x *EndCommissionRateRequest) GetAdminID() string {
 	if x != nil {
-		return x.Extension
+		return x.AdminID
 	}
 	return ""
 }
 
-func (x *Extension) GetFlag() bool {
+func (x *EndCommissionRateRequest) GetRateID() string {
 	if x != nil {
-		return x.Flag
+		return x.RateID
 	}
-	return false
+	return ""
 }
 
-type PostCreationData struct {
+func (x *EndCommissionRateRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+type CreatorPage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                     string        `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Creator                string        `protobuf:"bytes,2,opt,name=Creator,proto3" json:"Creator,omitempty"`
-	Title                  string        `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
-	Text                   string        `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
-	Attachments            []*Attachment `protobuf:"bytes,5,rep,name=Attachments,proto3" json:"Attachments,omitempty"`
-	AvailableSubscriptions []string      `protobuf:"bytes,6,rep,name=AvailableSubscriptions,proto3" json:"AvailableSubscriptions,omitempty"`
+	CreatorInfo   *Creator              `protobuf:"bytes,1,opt,name=CreatorInfo,proto3" json:"CreatorInfo,omitempty"`
+	AimInfo       *Aim                  `protobuf:"bytes,2,opt,name=AimInfo,proto3" json:"AimInfo,omitempty"`
+	IsMyPage      bool                  `protobuf:"varint,3,opt,name=IsMyPage,proto3" json:"IsMyPage,omitempty"`
+	Follows       bool                  `protobuf:"varint,4,opt,name=Follows,proto3" json:"Follows,omitempty"`
+	Posts         []*Post               `protobuf:"bytes,5,rep,name=Posts,proto3" json:"Posts,omitempty"`
+	Subscriptions []*proto.Subscription `protobuf:"bytes,6,rep,name=Subscriptions,proto3" json:"Subscriptions,omitempty"`
+	Error         string                `protobuf:"bytes,7,opt,name=Error,proto3" json:"Error,omitempty"`
+	Aims          []*Aim                `protobuf:"bytes,8,rep,name=Aims,proto3" json:"Aims,omitempty"`
 }
 
-func (x *PostCreationData) Reset() {
-	*x = PostCreationData{}
+func (x *CreatorPage) Reset() {
+	*x = CreatorPage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1699,13 +1709,13 @@ func (x *PostCreationData) Reset() {
 	}
 }
 
-func (x *PostCreationData) String() string {
+func (x *CreatorPage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostCreationData) ProtoMessage() {}
+func (*CreatorPage) ProtoMessage() {}
 
-func (x *PostCreationData) ProtoReflect() protoreflect.Message {
+func (x *CreatorPage) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1717,66 +1727,84 @@ func (x *PostCreationData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostCreationData.ProtoReflect.Descriptor instead.
-func (*PostCreationData) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatorPage.ProtoReflect.Descriptor instead.
+func (*CreatorPage) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *PostCreationData) GetId() string {
+func (x *CreatorPage) GetCreatorInfo() *Creator {
 	if x != nil {
-		return x.Id
+		return x.CreatorInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *PostCreationData) GetCreator() string {
+func (x *CreatorPage) GetAimInfo() *Aim {
 	if x != nil {
-		return x.Creator
+		return x.AimInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *PostCreationData) GetTitle() string {
+func (x *CreatorPage) GetIsMyPage() bool {
 	if x != nil {
-		return x.Title
+		return x.IsMyPage
 	}
-	return ""
+	return false
 }
 
-func (x *PostCreationData) GetText() string {
+func (x *CreatorPage) GetFollows() bool {
 	if x != nil {
-		return x.Text
+		return x.Follows
 	}
-	return ""
+	return false
 }
 
-func (x *PostCreationData) GetAttachments() []*Attachment {
+func (x *CreatorPage) GetPosts() []*Post {
 	if x != nil {
-		return x.Attachments
+		return x.Posts
 	}
 	return nil
 }
 
-func (x *PostCreationData) GetAvailableSubscriptions() []string {
+func (x *CreatorPage) GetSubscriptions() []*proto.Subscription {
 	if x != nil {
-		return x.AvailableSubscriptions
+		return x.Subscriptions
 	}
 	return nil
 }
 
-type PostEditData struct {
+func (x *CreatorPage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CreatorPage) GetAims() []*Aim {
+	if x != nil {
+		return x.Aims
+	}
+	return nil
+}
+
+type Aim struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                     string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Title                  string   `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Text                   string   `protobuf:"bytes,3,opt,name=Text,proto3" json:"Text,omitempty"`
-	AvailableSubscriptions []string `protobuf:"bytes,4,rep,name=AvailableSubscriptions,proto3" json:"AvailableSubscriptions,omitempty"`
+	Creator     string       `protobuf:"bytes,1,opt,name=Creator,proto3" json:"Creator,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	MoneyNeeded *proto.Money `protobuf:"bytes,3,opt,name=MoneyNeeded,proto3" json:"MoneyNeeded,omitempty"`
+	MoneyGot    *proto.Money `protobuf:"bytes,4,opt,name=MoneyGot,proto3" json:"MoneyGot,omitempty"`
+	Id          string       `protobuf:"bytes,5,opt,name=Id,proto3" json:"Id,omitempty"`
+	Deadline    string       `protobuf:"bytes,6,opt,name=Deadline,proto3" json:"Deadline,omitempty"`
+	Position    int64        `protobuf:"varint,7,opt,name=Position,proto3" json:"Position,omitempty"`
+	CompletedAt string       `protobuf:"bytes,8,opt,name=CompletedAt,proto3" json:"CompletedAt,omitempty"`
 }
 
-func (x *PostEditData) Reset() {
-	*x = PostEditData{}
+func (x *Aim) Reset() {
+	*x = Aim{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1784,13 +1812,13 @@ func (x *PostEditData) Reset() {
 	}
 }
 
-func (x *PostEditData) String() string {
+func (x *Aim) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostEditData) ProtoMessage() {}
+func (*Aim) ProtoMessage() {}
 
-func (x *PostEditData) ProtoReflect() protoreflect.Message {
+func (x *Aim) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1802,50 +1830,78 @@ func (x *PostEditData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostEditData.ProtoReflect.Descriptor instead.
-func (*PostEditData) Descriptor() ([]byte, []int) {
+// Deprecated: Use Aim.ProtoReflect.Descriptor instead.
+func (*Aim) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PostEditData) GetId() string {
+func (x *Aim) GetCreator() string {
 	if x != nil {
-		return x.Id
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *PostEditData) GetTitle() string {
+func (x *Aim) GetDescription() string {
 	if x != nil {
-		return x.Title
+		return x.Description
 	}
 	return ""
 }
 
-func (x *PostEditData) GetText() string {
+func (x *Aim) GetMoneyNeeded() *proto.Money {
 	if x != nil {
-		return x.Text
+		return x.MoneyNeeded
 	}
-	return ""
+	return nil
 }
 
-func (x *PostEditData) GetAvailableSubscriptions() []string {
+func (x *Aim) GetMoneyGot() *proto.Money {
 	if x != nil {
-		return x.AvailableSubscriptions
+		return x.MoneyGot
 	}
 	return nil
 }
 
-type PostAttachMessage struct {
+func (x *Aim) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Aim) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *Aim) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Aim) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type AimsFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID     string      `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
-	Attachment *Attachment `protobuf:"bytes,2,opt,name=Attachment,proto3" json:"Attachment,omitempty"`
+	CreatorID string `protobuf:"bytes,1,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	Status    string `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
 }
 
-func (x *PostAttachMessage) Reset() {
-	*x = PostAttachMessage{}
+func (x *AimsFilter) Reset() {
+	*x = AimsFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1853,13 +1909,13 @@ func (x *PostAttachMessage) Reset() {
 	}
 }
 
-func (x *PostAttachMessage) String() string {
+func (x *AimsFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostAttachMessage) ProtoMessage() {}
+func (*AimsFilter) ProtoMessage() {}
 
-func (x *PostAttachMessage) ProtoReflect() protoreflect.Message {
+func (x *AimsFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1871,37 +1927,36 @@ func (x *PostAttachMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostAttachMessage.ProtoReflect.Descriptor instead.
-func (*PostAttachMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use AimsFilter.ProtoReflect.Descriptor instead.
+func (*AimsFilter) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *PostAttachMessage) GetPostID() string {
+func (x *AimsFilter) GetCreatorID() string {
 	if x != nil {
-		return x.PostID
+		return x.CreatorID
 	}
 	return ""
 }
 
-func (x *PostAttachMessage) GetAttachment() *Attachment {
+func (x *AimsFilter) GetStatus() string {
 	if x != nil {
-		return x.Attachment
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type Like struct {
+type AimsMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LikesCount int64  `protobuf:"varint,1,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
-	PostID     string `protobuf:"bytes,2,opt,name=PostID,proto3" json:"PostID,omitempty"`
-	Error      string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Aims  []*Aim `protobuf:"bytes,1,rep,name=Aims,proto3" json:"Aims,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *Like) Reset() {
-	*x = Like{}
+func (x *AimsMessage) Reset() {
+	*x = AimsMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creator_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1909,13 +1964,13 @@ func (x *Like) Reset() {
 	}
 }
 
-func (x *Like) String() string {
+func (x *AimsMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Like) ProtoMessage() {}
+func (*AimsMessage) ProtoMessage() {}
 
-func (x *Like) ProtoReflect() protoreflect.Message {
+func (x *AimsMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_creator_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1927,34 +1982,2387 @@ func (x *Like) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Like.ProtoReflect.Descriptor instead.
-func (*Like) Descriptor() ([]byte, []int) {
+// Deprecated: Use AimsMessage.ProtoReflect.Descriptor instead.
+func (*AimsMessage) Descriptor() ([]byte, []int) {
 	return file_creator_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Like) GetLikesCount() int64 {
-	if x != nil {
-		return x.LikesCount
-	}
-	return 0
-}
-
-func (x *Like) GetPostID() string {
+func (x *AimsMessage) GetAims() []*Aim {
 	if x != nil {
-		return x.PostID
+		return x.Aims
 	}
-	return ""
+	return nil
 }
 
-func (x *Like) GetError() string {
+func (x *AimsMessage) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-var File_creator_proto protoreflect.FileDescriptor
-
+type AimMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aim   *Aim   `protobuf:"bytes,1,opt,name=Aim,proto3" json:"Aim,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *AimMessage) Reset() {
+	*x = AimMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AimMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AimMessage) ProtoMessage() {}
+
+func (x *AimMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AimMessage.ProtoReflect.Descriptor instead.
+func (*AimMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AimMessage) GetAim() *Aim {
+	if x != nil {
+		return x.Aim
+	}
+	return nil
+}
+
+func (x *AimMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Post struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string                `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	CreatorID       string                `protobuf:"bytes,2,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	Creation        string                `protobuf:"bytes,3,opt,name=Creation,proto3" json:"Creation,omitempty"`
+	LikesCount      int64                 `protobuf:"varint,4,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
+	CommentsCount   int64                 `protobuf:"varint,5,opt,name=CommentsCount,proto3" json:"CommentsCount,omitempty"`
+	CreatorPhoto    string                `protobuf:"bytes,6,opt,name=CreatorPhoto,proto3" json:"CreatorPhoto,omitempty"`
+	CreatorName     string                `protobuf:"bytes,7,opt,name=CreatorName,proto3" json:"CreatorName,omitempty"`
+	Title           string                `protobuf:"bytes,8,opt,name=Title,proto3" json:"Title,omitempty"`
+	Text            string                `protobuf:"bytes,9,opt,name=Text,proto3" json:"Text,omitempty"`
+	IsAvailable     bool                  `protobuf:"varint,10,opt,name=IsAvailable,proto3" json:"IsAvailable,omitempty"`
+	IsLiked         bool                  `protobuf:"varint,11,opt,name=IsLiked,proto3" json:"IsLiked,omitempty"`
+	PostAttachments []*Attachment         `protobuf:"bytes,12,rep,name=PostAttachments,proto3" json:"PostAttachments,omitempty"`
+	Subscriptions   []*proto.Subscription `protobuf:"bytes,13,rep,name=Subscriptions,proto3" json:"Subscriptions,omitempty"`
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Post) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Post) GetCreatorID() string {
+	if x != nil {
+		return x.CreatorID
+	}
+	return ""
+}
+
+func (x *Post) GetCreation() string {
+	if x != nil {
+		return x.Creation
+	}
+	return ""
+}
+
+func (x *Post) GetLikesCount() int64 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *Post) GetCommentsCount() int64 {
+	if x != nil {
+		return x.CommentsCount
+	}
+	return 0
+}
+
+func (x *Post) GetCreatorPhoto() string {
+	if x != nil {
+		return x.CreatorPhoto
+	}
+	return ""
+}
+
+func (x *Post) GetCreatorName() string {
+	if x != nil {
+		return x.CreatorName
+	}
+	return ""
+}
+
+func (x *Post) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Post) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Post) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *Post) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *Post) GetPostAttachments() []*Attachment {
+	if x != nil {
+		return x.PostAttachments
+	}
+	return nil
+}
+
+func (x *Post) GetSubscriptions() []*proto.Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	UserPhoto  string `protobuf:"bytes,3,opt,name=UserPhoto,proto3" json:"UserPhoto,omitempty"`
+	PostID     string `protobuf:"bytes,4,opt,name=PostID,proto3" json:"PostID,omitempty"`
+	Text       string `protobuf:"bytes,5,opt,name=Text,proto3" json:"Text,omitempty"`
+	Creation   string `protobuf:"bytes,6,opt,name=Creation,proto3" json:"Creation,omitempty"`
+	LikesCount int64  `protobuf:"varint,7,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
+	Username   string `protobuf:"bytes,8,opt,name=Username,proto3" json:"Username,omitempty"`
+	IsOwner    bool   `protobuf:"varint,9,opt,name=IsOwner,proto3" json:"IsOwner,omitempty"`
+	IsLiked    bool   `protobuf:"varint,10,opt,name=IsLiked,proto3" json:"IsLiked,omitempty"`
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Comment) GetUserPhoto() string {
+	if x != nil {
+		return x.UserPhoto
+	}
+	return ""
+}
+
+func (x *Comment) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetCreation() string {
+	if x != nil {
+		return x.Creation
+	}
+	return ""
+}
+
+func (x *Comment) GetLikesCount() int64 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *Comment) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Comment) GetIsOwner() bool {
+	if x != nil {
+		return x.IsOwner
+	}
+	return false
+}
+
+func (x *Comment) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+type PostWithComments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post     *Post      `protobuf:"bytes,1,opt,name=Post,proto3" json:"Post,omitempty"`
+	Comments []*Comment `protobuf:"bytes,2,rep,name=Comments,proto3" json:"Comments,omitempty"`
+	Error    string     `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *PostWithComments) Reset() {
+	*x = PostWithComments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostWithComments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostWithComments) ProtoMessage() {}
+
+func (x *PostWithComments) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostWithComments.ProtoReflect.Descriptor instead.
+func (*PostWithComments) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PostWithComments) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostWithComments) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *PostWithComments) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PostsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=Posts,proto3" json:"Posts,omitempty"`
+	Error string  `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *PostsMessage) Reset() {
+	*x = PostsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostsMessage) ProtoMessage() {}
+
+func (x *PostsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostsMessage.ProtoReflect.Descriptor instead.
+func (*PostsMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PostsMessage) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *PostsMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PostMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post  *Post  `protobuf:"bytes,1,opt,name=Post,proto3" json:"Post,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *PostMessage) Reset() {
+	*x = PostMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMessage) ProtoMessage() {}
+
+func (x *PostMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMessage.ProtoReflect.Descriptor instead.
+func (*PostMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PostMessage) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CreatorBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance     *proto.Money `protobuf:"bytes,1,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Error       string       `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	GrossIncome *proto.Money `protobuf:"bytes,3,opt,name=GrossIncome,proto3" json:"GrossIncome,omitempty"`
+	Commission  *proto.Money `protobuf:"bytes,4,opt,name=Commission,proto3" json:"Commission,omitempty"`
+	NetIncome   *proto.Money `protobuf:"bytes,5,opt,name=NetIncome,proto3" json:"NetIncome,omitempty"`
+}
+
+func (x *CreatorBalance) Reset() {
+	*x = CreatorBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatorBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatorBalance) ProtoMessage() {}
+
+func (x *CreatorBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatorBalance.ProtoReflect.Descriptor instead.
+func (*CreatorBalance) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreatorBalance) GetBalance() *proto.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *CreatorBalance) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CreatorBalance) GetGrossIncome() *proto.Money {
+	if x != nil {
+		return x.GrossIncome
+	}
+	return nil
+}
+
+func (x *CreatorBalance) GetCommission() *proto.Money {
+	if x != nil {
+		return x.Commission
+	}
+	return nil
+}
+
+func (x *CreatorBalance) GetNetIncome() *proto.Money {
+	if x != nil {
+		return x.NetIncome
+	}
+	return nil
+}
+
+type UploadLimitMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxSize int64  `protobuf:"varint,1,opt,name=MaxSize,proto3" json:"MaxSize,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *UploadLimitMessage) Reset() {
+	*x = UploadLimitMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadLimitMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadLimitMessage) ProtoMessage() {}
+
+func (x *UploadLimitMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadLimitMessage.ProtoReflect.Descriptor instead.
+func (*UploadLimitMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UploadLimitMessage) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *UploadLimitMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MediaTypeUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Files int64  `protobuf:"varint,2,opt,name=Files,proto3" json:"Files,omitempty"`
+	Bytes int64  `protobuf:"varint,3,opt,name=Bytes,proto3" json:"Bytes,omitempty"`
+}
+
+func (x *MediaTypeUsage) Reset() {
+	*x = MediaTypeUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaTypeUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaTypeUsage) ProtoMessage() {}
+
+func (x *MediaTypeUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaTypeUsage.ProtoReflect.Descriptor instead.
+func (*MediaTypeUsage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MediaTypeUsage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MediaTypeUsage) GetFiles() int64 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *MediaTypeUsage) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type StorageUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan  string            `protobuf:"bytes,1,opt,name=Plan,proto3" json:"Plan,omitempty"`
+	Quota int64             `protobuf:"varint,2,opt,name=Quota,proto3" json:"Quota,omitempty"`
+	Used  int64             `protobuf:"varint,3,opt,name=Used,proto3" json:"Used,omitempty"`
+	Types []*MediaTypeUsage `protobuf:"bytes,4,rep,name=Types,proto3" json:"Types,omitempty"`
+	Error string            `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *StorageUsage) Reset() {
+	*x = StorageUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageUsage) ProtoMessage() {}
+
+func (x *StorageUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageUsage.ProtoReflect.Descriptor instead.
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StorageUsage) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *StorageUsage) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *StorageUsage) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *StorageUsage) GetTypes() []*MediaTypeUsage {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *StorageUsage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type StorageQuotaMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatorID string `protobuf:"bytes,1,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	Size      int64  `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+}
+
+func (x *StorageQuotaMessage) Reset() {
+	*x = StorageQuotaMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageQuotaMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageQuotaMessage) ProtoMessage() {}
+
+func (x *StorageQuotaMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageQuotaMessage.ProtoReflect.Descriptor instead.
+func (*StorageQuotaMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StorageQuotaMessage) GetCreatorID() string {
+	if x != nil {
+		return x.CreatorID
+	}
+	return ""
+}
+
+func (x *StorageQuotaMessage) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type Rendition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Width       int64  `protobuf:"varint,2,opt,name=Width,proto3" json:"Width,omitempty"`
+	Height      int64  `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+}
+
+func (x *Rendition) Reset() {
+	*x = Rendition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rendition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rendition) ProtoMessage() {}
+
+func (x *Rendition) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rendition.ProtoReflect.Descriptor instead.
+func (*Rendition) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Rendition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rendition) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Rendition) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Rendition) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string       `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Type       string       `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Renditions []*Rendition `protobuf:"bytes,3,rep,name=Renditions,proto3" json:"Renditions,omitempty"`
+	Size       int64        `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	Checksum   string       `protobuf:"bytes,5,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
+	Filename   string       `protobuf:"bytes,6,opt,name=Filename,proto3" json:"Filename,omitempty"`
+	Width      int64        `protobuf:"varint,7,opt,name=Width,proto3" json:"Width,omitempty"`
+	Height     int64        `protobuf:"varint,8,opt,name=Height,proto3" json:"Height,omitempty"`
+	Duration   float64      `protobuf:"fixed64,9,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	AltText    string       `protobuf:"bytes,10,opt,name=AltText,proto3" json:"AltText,omitempty"`
+	Caption    string       `protobuf:"bytes,11,opt,name=Caption,proto3" json:"Caption,omitempty"`
+	Downloads  int64        `protobuf:"varint,12,opt,name=Downloads,proto3" json:"Downloads,omitempty"`
+	BlobID     string       `protobuf:"bytes,13,opt,name=BlobID,proto3" json:"BlobID,omitempty"`
+	ScanStatus string       `protobuf:"bytes,14,opt,name=ScanStatus,proto3" json:"ScanStatus,omitempty"`
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Attachment) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Attachment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Attachment) GetRenditions() []*Rendition {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Attachment) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *Attachment) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *Attachment) GetDownloads() int64 {
+	if x != nil {
+		return x.Downloads
+	}
+	return 0
+}
+
+func (x *Attachment) GetBlobID() string {
+	if x != nil {
+		return x.BlobID
+	}
+	return ""
+}
+
+func (x *Attachment) GetScanStatus() string {
+	if x != nil {
+		return x.ScanStatus
+	}
+	return ""
+}
+
+type FirstDate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date  string `protobuf:"bytes,1,opt,name=Date,proto3" json:"Date,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *FirstDate) Reset() {
+	*x = FirstDate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirstDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirstDate) ProtoMessage() {}
+
+func (x *FirstDate) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirstDate.ProtoReflect.Descriptor instead.
+func (*FirstDate) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FirstDate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FirstDate) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Attachments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attachments []*Attachment `protobuf:"bytes,1,rep,name=Attachments,proto3" json:"Attachments,omitempty"`
+}
+
+func (x *Attachments) Reset() {
+	*x = Attachments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attachments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachments) ProtoMessage() {}
+
+func (x *Attachments) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachments.ProtoReflect.Descriptor instead.
+func (*Attachments) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Attachments) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type AttachmentMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=Attachment,proto3" json:"Attachment,omitempty"`
+	Extension  string      `protobuf:"bytes,2,opt,name=Extension,proto3" json:"Extension,omitempty"`
+	Error      string      `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Download   bool        `protobuf:"varint,4,opt,name=Download,proto3" json:"Download,omitempty"`
+}
+
+func (x *AttachmentMessage) Reset() {
+	*x = AttachmentMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachmentMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentMessage) ProtoMessage() {}
+
+func (x *AttachmentMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentMessage.ProtoReflect.Descriptor instead.
+func (*AttachmentMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AttachmentMessage) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *AttachmentMessage) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *AttachmentMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AttachmentMessage) GetDownload() bool {
+	if x != nil {
+		return x.Download
+	}
+	return false
+}
+
+type FlagMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flag  bool   `protobuf:"varint,1,opt,name=Flag,proto3" json:"Flag,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *FlagMessage) Reset() {
+	*x = FlagMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagMessage) ProtoMessage() {}
+
+func (x *FlagMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagMessage.ProtoReflect.Descriptor instead.
+func (*FlagMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FlagMessage) GetFlag() bool {
+	if x != nil {
+		return x.Flag
+	}
+	return false
+}
+
+func (x *FlagMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Extension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extension string `protobuf:"bytes,1,opt,name=Extension,proto3" json:"Extension,omitempty"`
+	Flag      bool   `protobuf:"varint,2,opt,name=Flag,proto3" json:"Flag,omitempty"`
+	MaxSize   int64  `protobuf:"varint,3,opt,name=MaxSize,proto3" json:"MaxSize,omitempty"`
+	Download  bool   `protobuf:"varint,4,opt,name=Download,proto3" json:"Download,omitempty"`
+}
+
+func (x *Extension) Reset() {
+	*x = Extension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extension) ProtoMessage() {}
+
+func (x *Extension) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extension.ProtoReflect.Descriptor instead.
+func (*Extension) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Extension) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *Extension) GetFlag() bool {
+	if x != nil {
+		return x.Flag
+	}
+	return false
+}
+
+func (x *Extension) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *Extension) GetDownload() bool {
+	if x != nil {
+		return x.Download
+	}
+	return false
+}
+
+type PostCreationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     string        `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Creator                string        `protobuf:"bytes,2,opt,name=Creator,proto3" json:"Creator,omitempty"`
+	Title                  string        `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
+	Text                   string        `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
+	Attachments            []*Attachment `protobuf:"bytes,5,rep,name=Attachments,proto3" json:"Attachments,omitempty"`
+	AvailableSubscriptions []string      `protobuf:"bytes,6,rep,name=AvailableSubscriptions,proto3" json:"AvailableSubscriptions,omitempty"`
+}
+
+func (x *PostCreationData) Reset() {
+	*x = PostCreationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostCreationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCreationData) ProtoMessage() {}
+
+func (x *PostCreationData) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCreationData.ProtoReflect.Descriptor instead.
+func (*PostCreationData) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PostCreationData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PostCreationData) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *PostCreationData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PostCreationData) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PostCreationData) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *PostCreationData) GetAvailableSubscriptions() []string {
+	if x != nil {
+		return x.AvailableSubscriptions
+	}
+	return nil
+}
+
+type PostEditData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Title                  string   `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Text                   string   `protobuf:"bytes,3,opt,name=Text,proto3" json:"Text,omitempty"`
+	AvailableSubscriptions []string `protobuf:"bytes,4,rep,name=AvailableSubscriptions,proto3" json:"AvailableSubscriptions,omitempty"`
+}
+
+func (x *PostEditData) Reset() {
+	*x = PostEditData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostEditData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEditData) ProtoMessage() {}
+
+func (x *PostEditData) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEditData.ProtoReflect.Descriptor instead.
+func (*PostEditData) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PostEditData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PostEditData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PostEditData) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PostEditData) GetAvailableSubscriptions() []string {
+	if x != nil {
+		return x.AvailableSubscriptions
+	}
+	return nil
+}
+
+type PostAttachMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID     string      `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Attachment *Attachment `protobuf:"bytes,2,opt,name=Attachment,proto3" json:"Attachment,omitempty"`
+}
+
+func (x *PostAttachMessage) Reset() {
+	*x = PostAttachMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostAttachMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostAttachMessage) ProtoMessage() {}
+
+func (x *PostAttachMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostAttachMessage.ProtoReflect.Descriptor instead.
+func (*PostAttachMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PostAttachMessage) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PostAttachMessage) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type DownloadMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID       string `protobuf:"bytes,1,opt,name=PostID,proto3" json:"PostID,omitempty"`
+	AttachmentID string `protobuf:"bytes,2,opt,name=AttachmentID,proto3" json:"AttachmentID,omitempty"`
+	Key          string `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
+	ExpiresAt    string `protobuf:"bytes,4,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+}
+
+func (x *DownloadMessage) Reset() {
+	*x = DownloadMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadMessage) ProtoMessage() {}
+
+func (x *DownloadMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadMessage.ProtoReflect.Descriptor instead.
+func (*DownloadMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DownloadMessage) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *DownloadMessage) GetAttachmentID() string {
+	if x != nil {
+		return x.AttachmentID
+	}
+	return ""
+}
+
+func (x *DownloadMessage) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DownloadMessage) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type DonationsFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatorID string `protobuf:"bytes,1,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	Limit     int64  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset    int64  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (x *DonationsFilter) Reset() {
+	*x = DonationsFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DonationsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DonationsFilter) ProtoMessage() {}
+
+func (x *DonationsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DonationsFilter.ProtoReflect.Descriptor instead.
+func (*DonationsFilter) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DonationsFilter) GetCreatorID() string {
+	if x != nil {
+		return x.CreatorID
+	}
+	return ""
+}
+
+func (x *DonationsFilter) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DonationsFilter) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type Donation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string       `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	UserID      string       `protobuf:"bytes,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	UserName    string       `protobuf:"bytes,3,opt,name=UserName,proto3" json:"UserName,omitempty"`
+	UserPhoto   string       `protobuf:"bytes,4,opt,name=UserPhoto,proto3" json:"UserPhoto,omitempty"`
+	Money       *proto.Money `protobuf:"bytes,5,opt,name=Money,proto3" json:"Money,omitempty"`
+	Message     string       `protobuf:"bytes,6,opt,name=Message,proto3" json:"Message,omitempty"`
+	Aim         string       `protobuf:"bytes,7,opt,name=Aim,proto3" json:"Aim,omitempty"`
+	IsAnonymous bool         `protobuf:"varint,8,opt,name=IsAnonymous,proto3" json:"IsAnonymous,omitempty"`
+	Date        string       `protobuf:"bytes,9,opt,name=Date,proto3" json:"Date,omitempty"`
+	AimID       string       `protobuf:"bytes,10,opt,name=AimID,proto3" json:"AimID,omitempty"`
+}
+
+func (x *Donation) Reset() {
+	*x = Donation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Donation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Donation) ProtoMessage() {}
+
+func (x *Donation) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Donation.ProtoReflect.Descriptor instead.
+func (*Donation) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Donation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Donation) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Donation) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Donation) GetUserPhoto() string {
+	if x != nil {
+		return x.UserPhoto
+	}
+	return ""
+}
+
+func (x *Donation) GetMoney() *proto.Money {
+	if x != nil {
+		return x.Money
+	}
+	return nil
+}
+
+func (x *Donation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Donation) GetAim() string {
+	if x != nil {
+		return x.Aim
+	}
+	return ""
+}
+
+func (x *Donation) GetIsAnonymous() bool {
+	if x != nil {
+		return x.IsAnonymous
+	}
+	return false
+}
+
+func (x *Donation) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Donation) GetAimID() string {
+	if x != nil {
+		return x.AimID
+	}
+	return ""
+}
+
+type DonationsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Donations []*Donation `protobuf:"bytes,1,rep,name=Donations,proto3" json:"Donations,omitempty"`
+	Error     string      `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *DonationsMessage) Reset() {
+	*x = DonationsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DonationsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DonationsMessage) ProtoMessage() {}
+
+func (x *DonationsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DonationsMessage.ProtoReflect.Descriptor instead.
+func (*DonationsMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DonationsMessage) GetDonations() []*Donation {
+	if x != nil {
+		return x.Donations
+	}
+	return nil
+}
+
+func (x *DonationsMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SupportersFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatorID string `protobuf:"bytes,1,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	From      string `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	Limit     int64  `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *SupportersFilter) Reset() {
+	*x = SupportersFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SupportersFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportersFilter) ProtoMessage() {}
+
+func (x *SupportersFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportersFilter.ProtoReflect.Descriptor instead.
+func (*SupportersFilter) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SupportersFilter) GetCreatorID() string {
+	if x != nil {
+		return x.CreatorID
+	}
+	return ""
+}
+
+func (x *SupportersFilter) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SupportersFilter) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Supporter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string       `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Name           string       `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	ProfilePhoto   string       `protobuf:"bytes,3,opt,name=ProfilePhoto,proto3" json:"ProfilePhoto,omitempty"`
+	Money          *proto.Money `protobuf:"bytes,4,opt,name=Money,proto3" json:"Money,omitempty"`
+	DonationsCount int64        `protobuf:"varint,5,opt,name=DonationsCount,proto3" json:"DonationsCount,omitempty"`
+}
+
+func (x *Supporter) Reset() {
+	*x = Supporter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Supporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Supporter) ProtoMessage() {}
+
+func (x *Supporter) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Supporter.ProtoReflect.Descriptor instead.
+func (*Supporter) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Supporter) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Supporter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Supporter) GetProfilePhoto() string {
+	if x != nil {
+		return x.ProfilePhoto
+	}
+	return ""
+}
+
+func (x *Supporter) GetMoney() *proto.Money {
+	if x != nil {
+		return x.Money
+	}
+	return nil
+}
+
+func (x *Supporter) GetDonationsCount() int64 {
+	if x != nil {
+		return x.DonationsCount
+	}
+	return 0
+}
+
+type LedgerFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatorID string `protobuf:"bytes,1,opt,name=CreatorID,proto3" json:"CreatorID,omitempty"`
+	From      string `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	To        string `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	Limit     int64  `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset    int64  `protobuf:"varint,5,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (x *LedgerFilter) Reset() {
+	*x = LedgerFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerFilter) ProtoMessage() {}
+
+func (x *LedgerFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerFilter.ProtoReflect.Descriptor instead.
+func (*LedgerFilter) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LedgerFilter) GetCreatorID() string {
+	if x != nil {
+		return x.CreatorID
+	}
+	return ""
+}
+
+func (x *LedgerFilter) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *LedgerFilter) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *LedgerFilter) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LedgerFilter) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string       `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	TransactionID string       `protobuf:"bytes,2,opt,name=TransactionID,proto3" json:"TransactionID,omitempty"`
+	Kind          string       `protobuf:"bytes,3,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Amount        *proto.Money `protobuf:"bytes,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	BalanceAfter  *proto.Money `protobuf:"bytes,5,opt,name=BalanceAfter,proto3" json:"BalanceAfter,omitempty"`
+	Reference     string       `protobuf:"bytes,6,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	CreatedAt     string       `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LedgerEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetTransactionID() string {
+	if x != nil {
+		return x.TransactionID
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAmount() *proto.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetBalanceAfter() *proto.Money {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type LedgerMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance *proto.Money   `protobuf:"bytes,1,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Entries []*LedgerEntry `protobuf:"bytes,2,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	Error   string         `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *LedgerMessage) Reset() {
+	*x = LedgerMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerMessage) ProtoMessage() {}
+
+func (x *LedgerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerMessage.ProtoReflect.Descriptor instead.
+func (*LedgerMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LedgerMessage) GetBalance() *proto.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *LedgerMessage) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LedgerMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SupportersMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Supporters []*Supporter `protobuf:"bytes,1,rep,name=Supporters,proto3" json:"Supporters,omitempty"`
+	Error      string       `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *SupportersMessage) Reset() {
+	*x = SupportersMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SupportersMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportersMessage) ProtoMessage() {}
+
+func (x *SupportersMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportersMessage.ProtoReflect.Descriptor instead.
+func (*SupportersMessage) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SupportersMessage) GetSupporters() []*Supporter {
+	if x != nil {
+		return x.Supporters
+	}
+	return nil
+}
+
+func (x *SupportersMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Like struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LikesCount int64  `protobuf:"varint,1,opt,name=LikesCount,proto3" json:"LikesCount,omitempty"`
+	PostID     string `protobuf:"bytes,2,opt,name=PostID,proto3" json:"PostID,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *Like) Reset() {
+	*x = Like{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creator_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Like) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Like) ProtoMessage() {}
+
+func (x *Like) ProtoReflect() protoreflect.Message {
+	mi := &file_creator_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Like.ProtoReflect.Descriptor instead.
+func (*Like) Descriptor() ([]byte, []int) {
+	return file_creator_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Like) GetLikesCount() int64 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *Like) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *Like) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_creator_proto protoreflect.FileDescriptor
+
 var file_creator_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2a, 0x0a,
@@ -1967,7 +4375,7 @@ var file_creator_proto_rawDesc = []byte{
 	0x72, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46,
 	0x69, 0x72, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x6f,
 	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x8c, 0x03, 0x0a, 0x04, 0x53, 0x74, 0x61,
+	0x63, 0x6f, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x80, 0x06, 0x0a, 0x04, 0x53, 0x74, 0x61,
 	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
 	0x24, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
@@ -1978,345 +4386,735 @@ var file_creator_proto_rawDesc = []byte{
 	0x73, 0x42, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x6f, 0x6e, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
 	0x0e, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2e, 0x0a, 0x12, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x4d, 0x6f, 0x6e,
-	0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x36, 0x0a, 0x16, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x16, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x4e,
-	0x65, 0x77, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4c,
-	0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x81, 0x02, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x68, 0x6f, 0x74,
-	0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74,
-	0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x0f, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24,
-	0x0a, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x59, 0x0a, 0x17, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12,
-	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4a, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49,
-	0x44, 0x22, 0x62, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x75, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a,
-	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22,
-	0x45, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44,
-	0x12, 0x14, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x22, 0xfe, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x07, 0x41, 0x69, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x41, 0x69, 0x6d, 0x52, 0x07, 0x41, 0x69, 0x6d, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x4d, 0x79, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x4d, 0x79, 0x50, 0x61, 0x67, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7f, 0x0a, 0x03, 0x41, 0x69, 0x6d, 0x12, 0x18,
-	0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x6f,
-	0x6e, 0x65, 0x79, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0b, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x47, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
-	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x47, 0x6f, 0x74, 0x22, 0xb5, 0x03, 0x0a, 0x04, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12,
-	0x1a, 0x0a, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x4c,
-	0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x68, 0x6f, 0x74,
-	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x35, 0x0a,
-	0x0f, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x0f, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x87, 0x02, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74,
-	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65,
-	0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x69,
-	0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x69, 0x0a, 0x10, 0x50, 0x6f,
-	0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19,
-	0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x41, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x50, 0x6f, 0x73,
-	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18,
+	0x3d, 0x0a, 0x12, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x12, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45,
+	0x0a, 0x16, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x16, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x4e, 0x65, 0x77,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x69, 0x6b,
+	0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4c,
+	0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x47, 0x69, 0x66,
+	0x74, 0x73, 0x42, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x47, 0x69, 0x66, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x0e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x0e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x69, 0x66,
+	0x74, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12,
+	0x3a, 0x0a, 0x0e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0e, 0x42, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x11,
+	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x6f, 0x75,
+	0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x22, 0x81, 0x02, 0x0a, 0x07, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x68, 0x6f,
+	0x74, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x68, 0x6f,
+	0x74, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x24, 0x0a, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x59, 0x0a, 0x17, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68,
+	0x6f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4a, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x44, 0x22, 0x62, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x75, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44,
+	0x22, 0xd9, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x05, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x26, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x46, 0x0a, 0x0d,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x06, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x14, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x22, 0x66, 0x0a, 0x18,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x19, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x36, 0x0a, 0x0c, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x65, 0x0a, 0x1f, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0xb7, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74,
+	0x22, 0x5a, 0x0a, 0x15, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xac, 0x01, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x52, 0x61, 0x74, 0x65, 0x42, 0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x52,
+	0x61, 0x74, 0x65, 0x42, 0x50, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x15, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x23,
+	0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x04, 0x52,
+	0x61, 0x74, 0x65, 0x22, 0x52, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x04,
+	0x52, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x04, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x64, 0x0a, 0x18, 0x45, 0x6e, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x52, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52,
+	0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x22, 0x98, 0x02,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a,
+	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x07, 0x41, 0x69, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x41, 0x69, 0x6d,
+	0x52, 0x07, 0x41, 0x69, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x4d,
+	0x79, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x4d,
+	0x79, 0x50, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x12,
+	0x1b, 0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0d,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x04, 0x41, 0x69, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x41,
+	0x69, 0x6d, 0x52, 0x04, 0x41, 0x69, 0x6d, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x03, 0x41, 0x69, 0x6d,
+	0x12, 0x18, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0b,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0b, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x29, 0x0a,
+	0x08, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x47, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x47, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x42, 0x0a, 0x0a, 0x41, 0x69, 0x6d, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3d, 0x0a, 0x0b, 0x41, 0x69, 0x6d, 0x73, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x41, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x41, 0x69, 0x6d, 0x52, 0x04, 0x41, 0x69, 0x6d, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x0a, 0x0a, 0x41, 0x69, 0x6d, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x03, 0x41, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x04, 0x2e, 0x41, 0x69, 0x6d, 0x52, 0x03, 0x41, 0x69, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xb5, 0x03, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x20,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x49, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49,
+	0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x50, 0x6f,
+	0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a,
+	0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x07, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x55, 0x73, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x49, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x49, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x4c,
+	0x69, 0x6b, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x4c, 0x69,
+	0x6b, 0x65, 0x64, 0x22, 0x69, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x30, 0x0a, 0x0a, 0x41, 0x74,
-	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0x35, 0x0a, 0x09,
-	0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x41,
+	0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x3e, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x19, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65,
+	0x22, 0x44, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x0e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6c, 0x61,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x55, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x47, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6f, 0x0a,
+	0x09, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x57,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xfc,
+	0x02, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1a, 0x0a,
+	0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x57, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x44, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x35, 0x0a,
+	0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3c, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x37, 0x0a, 0x0b, 0x46, 0x6c, 0x61, 0x67, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x73,
+	0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x6c, 0x61,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0xcd, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2d, 0x0a, 0x0b,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x64, 0x69, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65,
+	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36,
+	0x0a, 0x16, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x58, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x7d, 0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
+	0x5d, 0x0a, 0x0f, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x89,
+	0x02, 0x0a, 0x08, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x23, 0x0a,
+	0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x41, 0x69, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x69, 0x6d, 0x12, 0x20,
+	0x0a, 0x0b, 0x49, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x69, 0x6d, 0x49, 0x44, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x69, 0x6d, 0x49, 0x44, 0x22, 0x51, 0x0a, 0x10, 0x44, 0x6f,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27,
+	0x0a, 0x09, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x44, 0x6f,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a, 0x0a,
+	0x10, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x09, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68,
+	0x6f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x23, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e,
+	0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7e, 0x0a, 0x0c, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x25,
+	0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x76, 0x0a, 0x0d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26,
+	0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x55, 0x0a, 0x11,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x52, 0x0a, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a,
 	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x3c, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x37, 0x0a, 0x0b, 0x46, 0x6c, 0x61, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x09, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x22, 0xcd, 0x01, 0x0a, 0x10, 0x50, 0x6f,
-	0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65,
-	0x78, 0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x36, 0x0a, 0x16, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x16, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x50, 0x6f,
-	0x73, 0x74, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x58, 0x0a, 0x11,
-	0x50, 0x6f, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x0a, 0x41, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x04, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x91, 0x10, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x33, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x72, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x04, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4c,
+	0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xad, 0x19, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x0c,
+	0x46, 0x69, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x0f, 0x2e, 0x4b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x10, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x13, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x67, 0x65, 0x22,
+	0x00, 0x12, 0x38, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x00, 0x12, 0x30, 0x0a, 0x09, 0x49, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x13,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x69, 0x6d,
+	0x12, 0x04, 0x2e, 0x41, 0x69, 0x6d, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x20, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x69, 0x6d, 0x12, 0x04, 0x2e, 0x41, 0x69, 0x6d, 0x1a, 0x0b, 0x2e, 0x41, 0x69, 0x6d,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x69, 0x6d, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x69, 0x6d, 0x73, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0c, 0x2e, 0x41, 0x69, 0x6d, 0x73, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x11, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x10, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x11, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b,
+	0x49, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e,
+	0x46, 0x6c, 0x61, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x2a, 0x0a,
+	0x0e, 0x49, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0c, 0x2e, 0x46, 0x6c, 0x61, 0x67,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x24, 0x0a, 0x07, 0x41, 0x64, 0x64,
+	0x4c, 0x69, 0x6b, 0x65, 0x12, 0x10, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x05, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x22, 0x00, 0x12,
+	0x27, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x10, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x05, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x0d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x64, 0x69, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x0c,
+	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x0d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a,
+	0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x09, 0x41, 0x64, 0x64,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12,
 	0x0f, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x10, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
-	0x13, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61,
-	0x67, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2f,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12,
-	0x33, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x12, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x10, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x09, 0x49, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x13, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x69, 0x6d, 0x12, 0x04, 0x2e, 0x41, 0x69, 0x6d, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x49, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x13, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x10, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x11, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x57,
-	0x69, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a,
-	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x49, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x12, 0x10, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x1a, 0x0c, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0e, 0x49, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0c,
-	0x2e, 0x46, 0x6c, 0x61, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x24,
-	0x0a, 0x07, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x10, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x05, 0x2e, 0x4c, 0x69,
-	0x6b, 0x65, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69,
-	0x6b, 0x65, 0x12, 0x10, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x1a, 0x05, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x22, 0x00, 0x12, 0x2a, 0x0a,
-	0x08, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0d, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x16, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x12, 0x0c, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x41, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x39,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x12, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x41, 0x64, 0x64,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x10, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x08, 0x48,
+	0x6f, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x0f, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12,
 	0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30,
-	0x0a, 0x09, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x50, 0x6f,
-	0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x18, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x13,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x76, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x76, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x3b, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
 	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0a, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x18, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f,
-	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x38, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x68,
-	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49,
-	0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x10, 0x45, 0x64,
-	0x69, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x2a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a,
-	0x0b, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x08, 0x2e, 0x43,
+	0x12, 0x39, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x08, 0x2e, 0x43,
 	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x23, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4c, 0x69,
-	0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x1a, 0x05, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x11,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x05, 0x2e, 0x4c, 0x69,
-	0x6b, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0f, 0x49, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0a, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x10, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x05, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
-	0x0a, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0d, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x1a, 0x0f, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x23, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x05, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
+	0x22, 0x00, 0x12, 0x26, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x6b, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x1a, 0x05, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0f, 0x49, 0x73,
+	0x50, 0x6f, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x27, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x10,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x05, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x13, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0a, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0f, 0x2e,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00,
-	0x42, 0x2e, 0x5a, 0x2c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x13, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x37,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x14, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x12, 0x07, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x1a, 0x0e, 0x2e,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12,
+	0x34, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x15, 0x2e, 0x50,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x0e, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x19, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x12, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55,
+	0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1a, 0x2e, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x16, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x53, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0f,
+	0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x1a,
+	0x16, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0e, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x10, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x2e,
+	0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
+	0x11, 0x2e, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x12, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x11, 0x45, 0x6e, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e,
+	0x45, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2331,139 +5129,248 @@ func file_creator_proto_rawDescGZIP() []byte {
 	return file_creator_proto_rawDescData
 }
 
-var file_creator_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_creator_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_creator_proto_goTypes = []interface{}{
-	(*KeywordMessage)(nil),             // 0: KeywordMessage
-	(*StatisticsInput)(nil),            // 1: StatisticsInput
-	(*Stat)(nil),                       // 2: Stat
-	(*Creator)(nil),                    // 3: Creator
-	(*CreatorsMessage)(nil),            // 4: CreatorsMessage
-	(*NotificationCreatorInfo)(nil),    // 5: NotificationCreatorInfo
-	(*UserCreatorMessage)(nil),         // 6: UserCreatorMessage
-	(*SubscriptionCreatorMessage)(nil), // 7: SubscriptionCreatorMessage
-	(*PostUserMessage)(nil),            // 8: PostUserMessage
-	(*UpdateCreatorInfo)(nil),          // 9: UpdateCreatorInfo
-	(*CreatorTransfer)(nil),            // 10: CreatorTransfer
-	(*CreatorPage)(nil),                // 11: CreatorPage
-	(*Aim)(nil),                        // 12: Aim
-	(*Post)(nil),                       // 13: Post
-	(*Comment)(nil),                    // 14: Comment
-	(*PostWithComments)(nil),           // 15: PostWithComments
-	(*PostsMessage)(nil),               // 16: PostsMessage
-	(*PostMessage)(nil),                // 17: PostMessage
-	(*CreatorBalance)(nil),             // 18: CreatorBalance
-	(*Attachment)(nil),                 // 19: Attachment
-	(*FirstDate)(nil),                  // 20: FirstDate
-	(*Attachments)(nil),                // 21: Attachments
-	(*FlagMessage)(nil),                // 22: FlagMessage
-	(*Extension)(nil),                  // 23: Extension
-	(*PostCreationData)(nil),           // 24: PostCreationData
-	(*PostEditData)(nil),               // 25: PostEditData
-	(*PostAttachMessage)(nil),          // 26: PostAttachMessage
-	(*Like)(nil),                       // 27: Like
-	(*proto.Subscription)(nil),         // 28: common.Subscription
-	(*proto.UUIDMessage)(nil),          // 29: common.UUIDMessage
-	(*proto.Empty)(nil),                // 30: common.Empty
-	(*proto.UUIDResponse)(nil),         // 31: common.UUIDResponse
+	(*KeywordMessage)(nil),                  // 0: KeywordMessage
+	(*StatisticsInput)(nil),                 // 1: StatisticsInput
+	(*Stat)(nil),                            // 2: Stat
+	(*BillingPeriodStat)(nil),               // 3: BillingPeriodStat
+	(*Creator)(nil),                         // 4: Creator
+	(*CreatorsMessage)(nil),                 // 5: CreatorsMessage
+	(*NotificationCreatorInfo)(nil),         // 6: NotificationCreatorInfo
+	(*UserCreatorMessage)(nil),              // 7: UserCreatorMessage
+	(*SubscriptionCreatorMessage)(nil),      // 8: SubscriptionCreatorMessage
+	(*PostUserMessage)(nil),                 // 9: PostUserMessage
+	(*UpdateCreatorInfo)(nil),               // 10: UpdateCreatorInfo
+	(*Payout)(nil),                          // 11: Payout
+	(*PayoutMessage)(nil),                   // 12: PayoutMessage
+	(*PayoutCreatorMessage)(nil),            // 13: PayoutCreatorMessage
+	(*PayoutDestination)(nil),               // 14: PayoutDestination
+	(*PayoutDestinationMessage)(nil),        // 15: PayoutDestinationMessage
+	(*PayoutDestinationsMessage)(nil),       // 16: PayoutDestinationsMessage
+	(*PayoutDestinationCreatorMessage)(nil), // 17: PayoutDestinationCreatorMessage
+	(*PayoutSchedule)(nil),                  // 18: PayoutSchedule
+	(*PayoutScheduleMessage)(nil),           // 19: PayoutScheduleMessage
+	(*CommissionRate)(nil),                  // 20: CommissionRate
+	(*CommissionRateRequest)(nil),           // 21: CommissionRateRequest
+	(*CommissionRateMessage)(nil),           // 22: CommissionRateMessage
+	(*EndCommissionRateRequest)(nil),        // 23: EndCommissionRateRequest
+	(*CreatorPage)(nil),                     // 24: CreatorPage
+	(*Aim)(nil),                             // 25: Aim
+	(*AimsFilter)(nil),                      // 26: AimsFilter
+	(*AimsMessage)(nil),                     // 27: AimsMessage
+	(*AimMessage)(nil),                      // 28: AimMessage
+	(*Post)(nil),                            // 29: Post
+	(*Comment)(nil),                         // 30: Comment
+	(*PostWithComments)(nil),                // 31: PostWithComments
+	(*PostsMessage)(nil),                    // 32: PostsMessage
+	(*PostMessage)(nil),                     // 33: PostMessage
+	(*CreatorBalance)(nil),                  // 34: CreatorBalance
+	(*UploadLimitMessage)(nil),              // 35: UploadLimitMessage
+	(*MediaTypeUsage)(nil),                  // 36: MediaTypeUsage
+	(*StorageUsage)(nil),                    // 37: StorageUsage
+	(*StorageQuotaMessage)(nil),             // 38: StorageQuotaMessage
+	(*Rendition)(nil),                       // 39: Rendition
+	(*Attachment)(nil),                      // 40: Attachment
+	(*FirstDate)(nil),                       // 41: FirstDate
+	(*Attachments)(nil),                     // 42: Attachments
+	(*AttachmentMessage)(nil),               // 43: AttachmentMessage
+	(*FlagMessage)(nil),                     // 44: FlagMessage
+	(*Extension)(nil),                       // 45: Extension
+	(*PostCreationData)(nil),                // 46: PostCreationData
+	(*PostEditData)(nil),                    // 47: PostEditData
+	(*PostAttachMessage)(nil),               // 48: PostAttachMessage
+	(*DownloadMessage)(nil),                 // 49: DownloadMessage
+	(*DonationsFilter)(nil),                 // 50: DonationsFilter
+	(*Donation)(nil),                        // 51: Donation
+	(*DonationsMessage)(nil),                // 52: DonationsMessage
+	(*SupportersFilter)(nil),                // 53: SupportersFilter
+	(*Supporter)(nil),                       // 54: Supporter
+	(*LedgerFilter)(nil),                    // 55: LedgerFilter
+	(*LedgerEntry)(nil),                     // 56: LedgerEntry
+	(*LedgerMessage)(nil),                   // 57: LedgerMessage
+	(*SupportersMessage)(nil),               // 58: SupportersMessage
+	(*Like)(nil),                            // 59: Like
+	(*proto.Money)(nil),                     // 60: common.Money
+	(*proto.Subscription)(nil),              // 61: common.Subscription
+	(*proto.UUIDMessage)(nil),               // 62: common.UUIDMessage
+	(*proto.Empty)(nil),                     // 63: common.Empty
+	(*proto.UUIDResponse)(nil),              // 64: common.UUIDResponse
 }
 var file_creator_proto_depIdxs = []int32{
-	3,  // 0: CreatorsMessage.Creators:type_name -> Creator
-	3,  // 1: CreatorPage.CreatorInfo:type_name -> Creator
-	12, // 2: CreatorPage.AimInfo:type_name -> Aim
-	13, // 3: CreatorPage.Posts:type_name -> Post
-	28, // 4: CreatorPage.Subscriptions:type_name -> common.Subscription
-	19, // 5: Post.PostAttachments:type_name -> Attachment
-	28, // 6: Post.Subscriptions:type_name -> common.Subscription
-	13, // 7: PostWithComments.Post:type_name -> Post
-	14, // 8: PostWithComments.Comments:type_name -> Comment
-	13, // 9: PostsMessage.Posts:type_name -> Post
-	13, // 10: PostMessage.Post:type_name -> Post
-	19, // 11: Attachments.Attachments:type_name -> Attachment
-	19, // 12: PostCreationData.Attachments:type_name -> Attachment
-	19, // 13: PostAttachMessage.Attachment:type_name -> Attachment
-	0,  // 14: CreatorService.FindCreators:input_type -> KeywordMessage
-	6,  // 15: CreatorService.GetPage:input_type -> UserCreatorMessage
-	9,  // 16: CreatorService.UpdateCreatorData:input_type -> UpdateCreatorInfo
-	29, // 17: CreatorService.GetFeed:input_type -> common.UUIDMessage
-	30, // 18: CreatorService.GetAllCreators:input_type -> common.Empty
-	6,  // 19: CreatorService.IsCreator:input_type -> UserCreatorMessage
-	12, // 20: CreatorService.CreateAim:input_type -> Aim
-	29, // 21: CreatorService.CheckIfCreator:input_type -> common.UUIDMessage
-	24, // 22: CreatorService.CreatePost:input_type -> PostCreationData
-	8,  // 23: CreatorService.GetPost:input_type -> PostUserMessage
-	29, // 24: CreatorService.DeletePost:input_type -> common.UUIDMessage
-	8,  // 25: CreatorService.IsPostOwner:input_type -> PostUserMessage
-	14, // 26: CreatorService.IsCommentOwner:input_type -> Comment
-	8,  // 27: CreatorService.AddLike:input_type -> PostUserMessage
-	8,  // 28: CreatorService.RemoveLike:input_type -> PostUserMessage
-	25, // 29: CreatorService.EditPost:input_type -> PostEditData
-	21, // 30: CreatorService.DeleteAttachmentsFiles:input_type -> Attachments
-	29, // 31: CreatorService.DeleteAttachmentsByPostID:input_type -> common.UUIDMessage
-	26, // 32: CreatorService.DeleteAttachment:input_type -> PostAttachMessage
-	26, // 33: CreatorService.AddAttach:input_type -> PostAttachMessage
-	0,  // 34: CreatorService.GetFileExtension:input_type -> KeywordMessage
-	29, // 35: CreatorService.UpdateProfilePhoto:input_type -> common.UUIDMessage
-	29, // 36: CreatorService.CreatorNotificationInfo:input_type -> common.UUIDMessage
-	29, // 37: CreatorService.DeleteProfilePhoto:input_type -> common.UUIDMessage
-	29, // 38: CreatorService.UpdateCoverPhoto:input_type -> common.UUIDMessage
-	29, // 39: CreatorService.DeleteCoverPhoto:input_type -> common.UUIDMessage
-	28, // 40: CreatorService.CreateSubscription:input_type -> common.Subscription
-	7,  // 41: CreatorService.DeleteSubscription:input_type -> SubscriptionCreatorMessage
-	28, // 42: CreatorService.EditSubscription:input_type -> common.Subscription
-	14, // 43: CreatorService.CreateComment:input_type -> Comment
-	14, // 44: CreatorService.DeleteComment:input_type -> Comment
-	14, // 45: CreatorService.EditComment:input_type -> Comment
-	14, // 46: CreatorService.AddLikeComment:input_type -> Comment
-	14, // 47: CreatorService.RemoveLikeComment:input_type -> Comment
-	8,  // 48: CreatorService.IsPostAvailable:input_type -> PostUserMessage
-	1,  // 49: CreatorService.Statistics:input_type -> StatisticsInput
-	29, // 50: CreatorService.StatisticsFirstDate:input_type -> common.UUIDMessage
-	29, // 51: CreatorService.GetCreatorBalance:input_type -> common.UUIDMessage
-	10, // 52: CreatorService.UpdateBalance:input_type -> CreatorTransfer
-	4,  // 53: CreatorService.FindCreators:output_type -> CreatorsMessage
-	11, // 54: CreatorService.GetPage:output_type -> CreatorPage
-	30, // 55: CreatorService.UpdateCreatorData:output_type -> common.Empty
-	16, // 56: CreatorService.GetFeed:output_type -> PostsMessage
-	4,  // 57: CreatorService.GetAllCreators:output_type -> CreatorsMessage
-	22, // 58: CreatorService.IsCreator:output_type -> FlagMessage
-	30, // 59: CreatorService.CreateAim:output_type -> common.Empty
-	31, // 60: CreatorService.CheckIfCreator:output_type -> common.UUIDResponse
-	30, // 61: CreatorService.CreatePost:output_type -> common.Empty
-	15, // 62: CreatorService.GetPost:output_type -> PostWithComments
-	30, // 63: CreatorService.DeletePost:output_type -> common.Empty
-	22, // 64: CreatorService.IsPostOwner:output_type -> FlagMessage
-	22, // 65: CreatorService.IsCommentOwner:output_type -> FlagMessage
-	27, // 66: CreatorService.AddLike:output_type -> Like
-	27, // 67: CreatorService.RemoveLike:output_type -> Like
-	30, // 68: CreatorService.EditPost:output_type -> common.Empty
-	30, // 69: CreatorService.DeleteAttachmentsFiles:output_type -> common.Empty
-	30, // 70: CreatorService.DeleteAttachmentsByPostID:output_type -> common.Empty
-	30, // 71: CreatorService.DeleteAttachment:output_type -> common.Empty
-	30, // 72: CreatorService.AddAttach:output_type -> common.Empty
-	23, // 73: CreatorService.GetFileExtension:output_type -> Extension
-	31, // 74: CreatorService.UpdateProfilePhoto:output_type -> common.UUIDResponse
-	5,  // 75: CreatorService.CreatorNotificationInfo:output_type -> NotificationCreatorInfo
-	30, // 76: CreatorService.DeleteProfilePhoto:output_type -> common.Empty
-	31, // 77: CreatorService.UpdateCoverPhoto:output_type -> common.UUIDResponse
-	30, // 78: CreatorService.DeleteCoverPhoto:output_type -> common.Empty
-	30, // 79: CreatorService.CreateSubscription:output_type -> common.Empty
-	30, // 80: CreatorService.DeleteSubscription:output_type -> common.Empty
-	30, // 81: CreatorService.EditSubscription:output_type -> common.Empty
-	30, // 82: CreatorService.CreateComment:output_type -> common.Empty
-	30, // 83: CreatorService.DeleteComment:output_type -> common.Empty
-	30, // 84: CreatorService.EditComment:output_type -> common.Empty
-	27, // 85: CreatorService.AddLikeComment:output_type -> Like
-	27, // 86: CreatorService.RemoveLikeComment:output_type -> Like
-	30, // 87: CreatorService.IsPostAvailable:output_type -> common.Empty
-	2,  // 88: CreatorService.Statistics:output_type -> Stat
-	20, // 89: CreatorService.StatisticsFirstDate:output_type -> FirstDate
-	18, // 90: CreatorService.GetCreatorBalance:output_type -> CreatorBalance
-	18, // 91: CreatorService.UpdateBalance:output_type -> CreatorBalance
-	53, // [53:92] is the sub-list for method output_type
-	14, // [14:53] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	60,  // 0: Stat.MoneyFromDonations:type_name -> common.Money
+	60,  // 1: Stat.MoneyFromSubscriptions:type_name -> common.Money
+	60,  // 2: Stat.MoneyFromGifts:type_name -> common.Money
+	60,  // 3: Stat.GrossIncome:type_name -> common.Money
+	60,  // 4: Stat.Commission:type_name -> common.Money
+	60,  // 5: Stat.NetIncome:type_name -> common.Money
+	3,   // 6: Stat.BillingPeriods:type_name -> BillingPeriodStat
+	60,  // 7: BillingPeriodStat.Money:type_name -> common.Money
+	4,   // 8: CreatorsMessage.Creators:type_name -> Creator
+	60,  // 9: Payout.Money:type_name -> common.Money
+	11,  // 10: PayoutMessage.Payout:type_name -> Payout
+	14,  // 11: PayoutDestinationMessage.Destination:type_name -> PayoutDestination
+	14,  // 12: PayoutDestinationsMessage.Destinations:type_name -> PayoutDestination
+	60,  // 13: PayoutSchedule.Threshold:type_name -> common.Money
+	18,  // 14: PayoutScheduleMessage.Schedule:type_name -> PayoutSchedule
+	20,  // 15: CommissionRateRequest.Rate:type_name -> CommissionRate
+	20,  // 16: CommissionRateMessage.Rate:type_name -> CommissionRate
+	4,   // 17: CreatorPage.CreatorInfo:type_name -> Creator
+	25,  // 18: CreatorPage.AimInfo:type_name -> Aim
+	29,  // 19: CreatorPage.Posts:type_name -> Post
+	61,  // 20: CreatorPage.Subscriptions:type_name -> common.Subscription
+	25,  // 21: CreatorPage.Aims:type_name -> Aim
+	60,  // 22: Aim.MoneyNeeded:type_name -> common.Money
+	60,  // 23: Aim.MoneyGot:type_name -> common.Money
+	25,  // 24: AimsMessage.Aims:type_name -> Aim
+	25,  // 25: AimMessage.Aim:type_name -> Aim
+	40,  // 26: Post.PostAttachments:type_name -> Attachment
+	61,  // 27: Post.Subscriptions:type_name -> common.Subscription
+	29,  // 28: PostWithComments.Post:type_name -> Post
+	30,  // 29: PostWithComments.Comments:type_name -> Comment
+	29,  // 30: PostsMessage.Posts:type_name -> Post
+	29,  // 31: PostMessage.Post:type_name -> Post
+	60,  // 32: CreatorBalance.Balance:type_name -> common.Money
+	60,  // 33: CreatorBalance.GrossIncome:type_name -> common.Money
+	60,  // 34: CreatorBalance.Commission:type_name -> common.Money
+	60,  // 35: CreatorBalance.NetIncome:type_name -> common.Money
+	36,  // 36: StorageUsage.Types:type_name -> MediaTypeUsage
+	39,  // 37: Attachment.Renditions:type_name -> Rendition
+	40,  // 38: Attachments.Attachments:type_name -> Attachment
+	40,  // 39: AttachmentMessage.Attachment:type_name -> Attachment
+	40,  // 40: PostCreationData.Attachments:type_name -> Attachment
+	40,  // 41: PostAttachMessage.Attachment:type_name -> Attachment
+	60,  // 42: Donation.Money:type_name -> common.Money
+	51,  // 43: DonationsMessage.Donations:type_name -> Donation
+	60,  // 44: Supporter.Money:type_name -> common.Money
+	60,  // 45: LedgerEntry.Amount:type_name -> common.Money
+	60,  // 46: LedgerEntry.BalanceAfter:type_name -> common.Money
+	60,  // 47: LedgerMessage.Balance:type_name -> common.Money
+	56,  // 48: LedgerMessage.Entries:type_name -> LedgerEntry
+	54,  // 49: SupportersMessage.Supporters:type_name -> Supporter
+	0,   // 50: CreatorService.FindCreators:input_type -> KeywordMessage
+	7,   // 51: CreatorService.GetPage:input_type -> UserCreatorMessage
+	10,  // 52: CreatorService.UpdateCreatorData:input_type -> UpdateCreatorInfo
+	62,  // 53: CreatorService.GetFeed:input_type -> common.UUIDMessage
+	63,  // 54: CreatorService.GetAllCreators:input_type -> common.Empty
+	7,   // 55: CreatorService.IsCreator:input_type -> UserCreatorMessage
+	25,  // 56: CreatorService.CreateAim:input_type -> Aim
+	25,  // 57: CreatorService.UpdateAim:input_type -> Aim
+	26,  // 58: CreatorService.CreatorAims:input_type -> AimsFilter
+	62,  // 59: CreatorService.CheckIfCreator:input_type -> common.UUIDMessage
+	46,  // 60: CreatorService.CreatePost:input_type -> PostCreationData
+	9,   // 61: CreatorService.GetPost:input_type -> PostUserMessage
+	62,  // 62: CreatorService.DeletePost:input_type -> common.UUIDMessage
+	9,   // 63: CreatorService.IsPostOwner:input_type -> PostUserMessage
+	30,  // 64: CreatorService.IsCommentOwner:input_type -> Comment
+	9,   // 65: CreatorService.AddLike:input_type -> PostUserMessage
+	9,   // 66: CreatorService.RemoveLike:input_type -> PostUserMessage
+	47,  // 67: CreatorService.EditPost:input_type -> PostEditData
+	42,  // 68: CreatorService.DeleteAttachmentsFiles:input_type -> Attachments
+	62,  // 69: CreatorService.DeleteAttachmentsByPostID:input_type -> common.UUIDMessage
+	48,  // 70: CreatorService.DeleteAttachment:input_type -> PostAttachMessage
+	48,  // 71: CreatorService.AddAttach:input_type -> PostAttachMessage
+	0,   // 72: CreatorService.GetFileExtension:input_type -> KeywordMessage
+	48,  // 73: CreatorService.GetAttachment:input_type -> PostAttachMessage
+	49,  // 74: CreatorService.AddDownload:input_type -> DownloadMessage
+	0,   // 75: CreatorService.HoldBlob:input_type -> KeywordMessage
+	62,  // 76: CreatorService.UpdateProfilePhoto:input_type -> common.UUIDMessage
+	62,  // 77: CreatorService.CreatorNotificationInfo:input_type -> common.UUIDMessage
+	62,  // 78: CreatorService.DeleteProfilePhoto:input_type -> common.UUIDMessage
+	62,  // 79: CreatorService.UpdateCoverPhoto:input_type -> common.UUIDMessage
+	62,  // 80: CreatorService.DeleteCoverPhoto:input_type -> common.UUIDMessage
+	61,  // 81: CreatorService.CreateSubscription:input_type -> common.Subscription
+	8,   // 82: CreatorService.DeleteSubscription:input_type -> SubscriptionCreatorMessage
+	61,  // 83: CreatorService.EditSubscription:input_type -> common.Subscription
+	30,  // 84: CreatorService.CreateComment:input_type -> Comment
+	30,  // 85: CreatorService.DeleteComment:input_type -> Comment
+	30,  // 86: CreatorService.EditComment:input_type -> Comment
+	30,  // 87: CreatorService.AddLikeComment:input_type -> Comment
+	30,  // 88: CreatorService.RemoveLikeComment:input_type -> Comment
+	9,   // 89: CreatorService.IsPostAvailable:input_type -> PostUserMessage
+	1,   // 90: CreatorService.Statistics:input_type -> StatisticsInput
+	62,  // 91: CreatorService.StatisticsFirstDate:input_type -> common.UUIDMessage
+	62,  // 92: CreatorService.GetCreatorBalance:input_type -> common.UUIDMessage
+	62,  // 93: CreatorService.GetUploadLimit:input_type -> common.UUIDMessage
+	62,  // 94: CreatorService.GetStorageUsage:input_type -> common.UUIDMessage
+	38,  // 95: CreatorService.CheckStorageQuota:input_type -> StorageQuotaMessage
+	11,  // 96: CreatorService.RequestPayout:input_type -> Payout
+	13,  // 97: CreatorService.GetPayout:input_type -> PayoutCreatorMessage
+	14,  // 98: CreatorService.AddPayoutDestination:input_type -> PayoutDestination
+	62,  // 99: CreatorService.PayoutDestinations:input_type -> common.UUIDMessage
+	17,  // 100: CreatorService.DeletePayoutDestination:input_type -> PayoutDestinationCreatorMessage
+	62,  // 101: CreatorService.GetPayoutSchedule:input_type -> common.UUIDMessage
+	18,  // 102: CreatorService.SetPayoutSchedule:input_type -> PayoutSchedule
+	62,  // 103: CreatorService.DeletePayoutSchedule:input_type -> common.UUIDMessage
+	55,  // 104: CreatorService.CreatorLedger:input_type -> LedgerFilter
+	50,  // 105: CreatorService.CreatorDonations:input_type -> DonationsFilter
+	53,  // 106: CreatorService.TopSupporters:input_type -> SupportersFilter
+	21,  // 107: CreatorService.CreateCommissionRate:input_type -> CommissionRateRequest
+	23,  // 108: CreatorService.EndCommissionRate:input_type -> EndCommissionRateRequest
+	5,   // 109: CreatorService.FindCreators:output_type -> CreatorsMessage
+	24,  // 110: CreatorService.GetPage:output_type -> CreatorPage
+	63,  // 111: CreatorService.UpdateCreatorData:output_type -> common.Empty
+	32,  // 112: CreatorService.GetFeed:output_type -> PostsMessage
+	5,   // 113: CreatorService.GetAllCreators:output_type -> CreatorsMessage
+	44,  // 114: CreatorService.IsCreator:output_type -> FlagMessage
+	63,  // 115: CreatorService.CreateAim:output_type -> common.Empty
+	28,  // 116: CreatorService.UpdateAim:output_type -> AimMessage
+	27,  // 117: CreatorService.CreatorAims:output_type -> AimsMessage
+	64,  // 118: CreatorService.CheckIfCreator:output_type -> common.UUIDResponse
+	63,  // 119: CreatorService.CreatePost:output_type -> common.Empty
+	31,  // 120: CreatorService.GetPost:output_type -> PostWithComments
+	63,  // 121: CreatorService.DeletePost:output_type -> common.Empty
+	44,  // 122: CreatorService.IsPostOwner:output_type -> FlagMessage
+	44,  // 123: CreatorService.IsCommentOwner:output_type -> FlagMessage
+	59,  // 124: CreatorService.AddLike:output_type -> Like
+	59,  // 125: CreatorService.RemoveLike:output_type -> Like
+	63,  // 126: CreatorService.EditPost:output_type -> common.Empty
+	63,  // 127: CreatorService.DeleteAttachmentsFiles:output_type -> common.Empty
+	63,  // 128: CreatorService.DeleteAttachmentsByPostID:output_type -> common.Empty
+	63,  // 129: CreatorService.DeleteAttachment:output_type -> common.Empty
+	63,  // 130: CreatorService.AddAttach:output_type -> common.Empty
+	45,  // 131: CreatorService.GetFileExtension:output_type -> Extension
+	43,  // 132: CreatorService.GetAttachment:output_type -> AttachmentMessage
+	63,  // 133: CreatorService.AddDownload:output_type -> common.Empty
+	63,  // 134: CreatorService.HoldBlob:output_type -> common.Empty
+	64,  // 135: CreatorService.UpdateProfilePhoto:output_type -> common.UUIDResponse
+	6,   // 136: CreatorService.CreatorNotificationInfo:output_type -> NotificationCreatorInfo
+	63,  // 137: CreatorService.DeleteProfilePhoto:output_type -> common.Empty
+	64,  // 138: CreatorService.UpdateCoverPhoto:output_type -> common.UUIDResponse
+	63,  // 139: CreatorService.DeleteCoverPhoto:output_type -> common.Empty
+	63,  // 140: CreatorService.CreateSubscription:output_type -> common.Empty
+	63,  // 141: CreatorService.DeleteSubscription:output_type -> common.Empty
+	63,  // 142: CreatorService.EditSubscription:output_type -> common.Empty
+	63,  // 143: CreatorService.CreateComment:output_type -> common.Empty
+	63,  // 144: CreatorService.DeleteComment:output_type -> common.Empty
+	63,  // 145: CreatorService.EditComment:output_type -> common.Empty
+	59,  // 146: CreatorService.AddLikeComment:output_type -> Like
+	59,  // 147: CreatorService.RemoveLikeComment:output_type -> Like
+	63,  // 148: CreatorService.IsPostAvailable:output_type -> common.Empty
+	2,   // 149: CreatorService.Statistics:output_type -> Stat
+	41,  // 150: CreatorService.StatisticsFirstDate:output_type -> FirstDate
+	34,  // 151: CreatorService.GetCreatorBalance:output_type -> CreatorBalance
+	35,  // 152: CreatorService.GetUploadLimit:output_type -> UploadLimitMessage
+	37,  // 153: CreatorService.GetStorageUsage:output_type -> StorageUsage
+	63,  // 154: CreatorService.CheckStorageQuota:output_type -> common.Empty
+	12,  // 155: CreatorService.RequestPayout:output_type -> PayoutMessage
+	12,  // 156: CreatorService.GetPayout:output_type -> PayoutMessage
+	15,  // 157: CreatorService.AddPayoutDestination:output_type -> PayoutDestinationMessage
+	16,  // 158: CreatorService.PayoutDestinations:output_type -> PayoutDestinationsMessage
+	63,  // 159: CreatorService.DeletePayoutDestination:output_type -> common.Empty
+	19,  // 160: CreatorService.GetPayoutSchedule:output_type -> PayoutScheduleMessage
+	19,  // 161: CreatorService.SetPayoutSchedule:output_type -> PayoutScheduleMessage
+	63,  // 162: CreatorService.DeletePayoutSchedule:output_type -> common.Empty
+	57,  // 163: CreatorService.CreatorLedger:output_type -> LedgerMessage
+	52,  // 164: CreatorService.CreatorDonations:output_type -> DonationsMessage
+	58,  // 165: CreatorService.TopSupporters:output_type -> SupportersMessage
+	22,  // 166: CreatorService.CreateCommissionRate:output_type -> CommissionRateMessage
+	63,  // 167: CreatorService.EndCommissionRate:output_type -> common.Empty
+	109, // [109:168] is the sub-list for method output_type
+	50,  // [50:109] is the sub-list for method input_type
+	50,  // [50:50] is the sub-list for extension type_name
+	50,  // [50:50] is the sub-list for extension extendee
+	0,   // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_creator_proto_init() }
@@ -2484,8 +5391,152 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatisticsInput); i {
+		file_creator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatisticsInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillingPeriodStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Creator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatorsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationCreatorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserCreatorMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionCreatorMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostUserMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCreatorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutCreatorMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2496,8 +5547,8 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stat); i {
+		file_creator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutDestination); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2508,8 +5559,8 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Creator); i {
+		file_creator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutDestinationMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2520,8 +5571,8 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatorsMessage); i {
+		file_creator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutDestinationsMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2532,8 +5583,8 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationCreatorInfo); i {
+		file_creator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutDestinationCreatorMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2544,8 +5595,8 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserCreatorMessage); i {
+		file_creator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2556,8 +5607,8 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscriptionCreatorMessage); i {
+		file_creator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutScheduleMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2568,8 +5619,8 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostUserMessage); i {
+		file_creator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommissionRate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2580,8 +5631,8 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCreatorInfo); i {
+		file_creator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommissionRateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2592,8 +5643,8 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatorTransfer); i {
+		file_creator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommissionRateMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2604,7 +5655,19 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndCommissionRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatorPage); i {
 			case 0:
 				return &v.state
@@ -2616,7 +5679,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Aim); i {
 			case 0:
 				return &v.state
@@ -2628,7 +5691,43 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AimsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AimsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AimMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Post); i {
 			case 0:
 				return &v.state
@@ -2640,7 +5739,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Comment); i {
 			case 0:
 				return &v.state
@@ -2652,7 +5751,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostWithComments); i {
 			case 0:
 				return &v.state
@@ -2664,7 +5763,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostsMessage); i {
 			case 0:
 				return &v.state
@@ -2676,7 +5775,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostMessage); i {
 			case 0:
 				return &v.state
@@ -2688,7 +5787,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatorBalance); i {
 			case 0:
 				return &v.state
@@ -2700,7 +5799,67 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadLimitMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaTypeUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageQuotaMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rendition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Attachment); i {
 			case 0:
 				return &v.state
@@ -2712,7 +5871,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FirstDate); i {
 			case 0:
 				return &v.state
@@ -2724,7 +5883,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Attachments); i {
 			case 0:
 				return &v.state
@@ -2736,7 +5895,19 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FlagMessage); i {
 			case 0:
 				return &v.state
@@ -2748,7 +5919,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Extension); i {
 			case 0:
 				return &v.state
@@ -2760,7 +5931,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostCreationData); i {
 			case 0:
 				return &v.state
@@ -2772,7 +5943,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostEditData); i {
 			case 0:
 				return &v.state
@@ -2784,7 +5955,7 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostAttachMessage); i {
 			case 0:
 				return &v.state
@@ -2796,7 +5967,127 @@ func file_creator_proto_init() {
 				return nil
 			}
 		}
-		file_creator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_creator_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DonationsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Donation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DonationsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupportersFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Supporter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupportersMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creator_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Like); i {
 			case 0:
 				return &v.state
@@ -2815,7 +6106,7 @@ func file_creator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_creator_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,6 +30,8 @@ type CreatorServiceClient interface {
 	GetAllCreators(ctx context.Context, in *proto.Empty, opts ...grpc.CallOption) (*CreatorsMessage, error)
 	IsCreator(ctx context.Context, in *UserCreatorMessage, opts ...grpc.CallOption) (*FlagMessage, error)
 	CreateAim(ctx context.Context, in *Aim, opts ...grpc.CallOption) (*proto.Empty, error)
+	UpdateAim(ctx context.Context, in *Aim, opts ...grpc.CallOption) (*AimMessage, error)
+	CreatorAims(ctx context.Context, in *AimsFilter, opts ...grpc.CallOption) (*AimsMessage, error)
 	CheckIfCreator(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.UUIDResponse, error)
 	CreatePost(ctx context.Context, in *PostCreationData, opts ...grpc.CallOption) (*proto.Empty, error)
 	GetPost(ctx context.Context, in *PostUserMessage, opts ...grpc.CallOption) (*PostWithComments, error)
@@ -44,6 +46,9 @@ type CreatorServiceClient interface {
 	DeleteAttachment(ctx context.Context, in *PostAttachMessage, opts ...grpc.CallOption) (*proto.Empty, error)
 	AddAttach(ctx context.Context, in *PostAttachMessage, opts ...grpc.CallOption) (*proto.Empty, error)
 	GetFileExtension(ctx context.Context, in *KeywordMessage, opts ...grpc.CallOption) (*Extension, error)
+	GetAttachment(ctx context.Context, in *PostAttachMessage, opts ...grpc.CallOption) (*AttachmentMessage, error)
+	AddDownload(ctx context.Context, in *DownloadMessage, opts ...grpc.CallOption) (*proto.Empty, error)
+	HoldBlob(ctx context.Context, in *KeywordMessage, opts ...grpc.CallOption) (*proto.Empty, error)
 	UpdateProfilePhoto(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.UUIDResponse, error)
 	CreatorNotificationInfo(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*NotificationCreatorInfo, error)
 	DeleteProfilePhoto(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.Empty, error)
@@ -61,7 +66,22 @@ type CreatorServiceClient interface {
 	Statistics(ctx context.Context, in *StatisticsInput, opts ...grpc.CallOption) (*Stat, error)
 	StatisticsFirstDate(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*FirstDate, error)
 	GetCreatorBalance(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*CreatorBalance, error)
-	UpdateBalance(ctx context.Context, in *CreatorTransfer, opts ...grpc.CallOption) (*CreatorBalance, error)
+	GetUploadLimit(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*UploadLimitMessage, error)
+	GetStorageUsage(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*StorageUsage, error)
+	CheckStorageQuota(ctx context.Context, in *StorageQuotaMessage, opts ...grpc.CallOption) (*proto.Empty, error)
+	RequestPayout(ctx context.Context, in *Payout, opts ...grpc.CallOption) (*PayoutMessage, error)
+	GetPayout(ctx context.Context, in *PayoutCreatorMessage, opts ...grpc.CallOption) (*PayoutMessage, error)
+	AddPayoutDestination(ctx context.Context, in *PayoutDestination, opts ...grpc.CallOption) (*PayoutDestinationMessage, error)
+	PayoutDestinations(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*PayoutDestinationsMessage, error)
+	DeletePayoutDestination(ctx context.Context, in *PayoutDestinationCreatorMessage, opts ...grpc.CallOption) (*proto.Empty, error)
+	GetPayoutSchedule(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*PayoutScheduleMessage, error)
+	SetPayoutSchedule(ctx context.Context, in *PayoutSchedule, opts ...grpc.CallOption) (*PayoutScheduleMessage, error)
+	DeletePayoutSchedule(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.Empty, error)
+	CreatorLedger(ctx context.Context, in *LedgerFilter, opts ...grpc.CallOption) (*LedgerMessage, error)
+	CreatorDonations(ctx context.Context, in *DonationsFilter, opts ...grpc.CallOption) (*DonationsMessage, error)
+	TopSupporters(ctx context.Context, in *SupportersFilter, opts ...grpc.CallOption) (*SupportersMessage, error)
+	CreateCommissionRate(ctx context.Context, in *CommissionRateRequest, opts ...grpc.CallOption) (*CommissionRateMessage, error)
+	EndCommissionRate(ctx context.Context, in *EndCommissionRateRequest, opts ...grpc.CallOption) (*proto.Empty, error)
 }
 
 type creatorServiceClient struct {
@@ -135,6 +155,24 @@ func (c *creatorServiceClient) CreateAim(ctx context.Context, in *Aim, opts ...g
 	return out, nil
 }
 
+func (c *creatorServiceClient) UpdateAim(ctx context.Context, in *Aim, opts ...grpc.CallOption) (*AimMessage, error) {
+	out := new(AimMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/UpdateAim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) CreatorAims(ctx context.Context, in *AimsFilter, opts ...grpc.CallOption) (*AimsMessage, error) {
+	out := new(AimsMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/CreatorAims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *creatorServiceClient) CheckIfCreator(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.UUIDResponse, error) {
 	out := new(proto.UUIDResponse)
 	err := c.cc.Invoke(ctx, "/CreatorService/CheckIfCreator", in, out, opts...)
@@ -261,6 +299,33 @@ func (c *creatorServiceClient) GetFileExtension(ctx context.Context, in *Keyword
 	return out, nil
 }
 
+func (c *creatorServiceClient) GetAttachment(ctx context.Context, in *PostAttachMessage, opts ...grpc.CallOption) (*AttachmentMessage, error) {
+	out := new(AttachmentMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/GetAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) AddDownload(ctx context.Context, in *DownloadMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, "/CreatorService/AddDownload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) HoldBlob(ctx context.Context, in *KeywordMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, "/CreatorService/HoldBlob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *creatorServiceClient) UpdateProfilePhoto(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.UUIDResponse, error) {
 	out := new(proto.UUIDResponse)
 	err := c.cc.Invoke(ctx, "/CreatorService/UpdateProfilePhoto", in, out, opts...)
@@ -414,9 +479,144 @@ func (c *creatorServiceClient) GetCreatorBalance(ctx context.Context, in *proto.
 	return out, nil
 }
 
-func (c *creatorServiceClient) UpdateBalance(ctx context.Context, in *CreatorTransfer, opts ...grpc.CallOption) (*CreatorBalance, error) {
-	out := new(CreatorBalance)
-	err := c.cc.Invoke(ctx, "/CreatorService/UpdateBalance", in, out, opts...)
+func (c *creatorServiceClient) GetUploadLimit(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*UploadLimitMessage, error) {
+	out := new(UploadLimitMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/GetUploadLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) GetStorageUsage(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*StorageUsage, error) {
+	out := new(StorageUsage)
+	err := c.cc.Invoke(ctx, "/CreatorService/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) CheckStorageQuota(ctx context.Context, in *StorageQuotaMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, "/CreatorService/CheckStorageQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) RequestPayout(ctx context.Context, in *Payout, opts ...grpc.CallOption) (*PayoutMessage, error) {
+	out := new(PayoutMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/RequestPayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) GetPayout(ctx context.Context, in *PayoutCreatorMessage, opts ...grpc.CallOption) (*PayoutMessage, error) {
+	out := new(PayoutMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/GetPayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) AddPayoutDestination(ctx context.Context, in *PayoutDestination, opts ...grpc.CallOption) (*PayoutDestinationMessage, error) {
+	out := new(PayoutDestinationMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/AddPayoutDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) PayoutDestinations(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*PayoutDestinationsMessage, error) {
+	out := new(PayoutDestinationsMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/PayoutDestinations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) DeletePayoutDestination(ctx context.Context, in *PayoutDestinationCreatorMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, "/CreatorService/DeletePayoutDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) GetPayoutSchedule(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*PayoutScheduleMessage, error) {
+	out := new(PayoutScheduleMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/GetPayoutSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) SetPayoutSchedule(ctx context.Context, in *PayoutSchedule, opts ...grpc.CallOption) (*PayoutScheduleMessage, error) {
+	out := new(PayoutScheduleMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/SetPayoutSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) DeletePayoutSchedule(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, "/CreatorService/DeletePayoutSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) CreatorLedger(ctx context.Context, in *LedgerFilter, opts ...grpc.CallOption) (*LedgerMessage, error) {
+	out := new(LedgerMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/CreatorLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) CreatorDonations(ctx context.Context, in *DonationsFilter, opts ...grpc.CallOption) (*DonationsMessage, error) {
+	out := new(DonationsMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/CreatorDonations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) TopSupporters(ctx context.Context, in *SupportersFilter, opts ...grpc.CallOption) (*SupportersMessage, error) {
+	out := new(SupportersMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/TopSupporters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) CreateCommissionRate(ctx context.Context, in *CommissionRateRequest, opts ...grpc.CallOption) (*CommissionRateMessage, error) {
+	out := new(CommissionRateMessage)
+	err := c.cc.Invoke(ctx, "/CreatorService/CreateCommissionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creatorServiceClient) EndCommissionRate(ctx context.Context, in *EndCommissionRateRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, "/CreatorService/EndCommissionRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -434,6 +634,8 @@ type CreatorServiceServer interface {
 	GetAllCreators(context.Context, *proto.Empty) (*CreatorsMessage, error)
 	IsCreator(context.Context, *UserCreatorMessage) (*FlagMessage, error)
 	CreateAim(context.Context, *Aim) (*proto.Empty, error)
+	UpdateAim(context.Context, *Aim) (*AimMessage, error)
+	CreatorAims(context.Context, *AimsFilter) (*AimsMessage, error)
 	CheckIfCreator(context.Context, *proto.UUIDMessage) (*proto.UUIDResponse, error)
 	CreatePost(context.Context, *PostCreationData) (*proto.Empty, error)
 	GetPost(context.Context, *PostUserMessage) (*PostWithComments, error)
@@ -448,6 +650,9 @@ type CreatorServiceServer interface {
 	DeleteAttachment(context.Context, *PostAttachMessage) (*proto.Empty, error)
 	AddAttach(context.Context, *PostAttachMessage) (*proto.Empty, error)
 	GetFileExtension(context.Context, *KeywordMessage) (*Extension, error)
+	GetAttachment(context.Context, *PostAttachMessage) (*AttachmentMessage, error)
+	AddDownload(context.Context, *DownloadMessage) (*proto.Empty, error)
+	HoldBlob(context.Context, *KeywordMessage) (*proto.Empty, error)
 	UpdateProfilePhoto(context.Context, *proto.UUIDMessage) (*proto.UUIDResponse, error)
 	CreatorNotificationInfo(context.Context, *proto.UUIDMessage) (*NotificationCreatorInfo, error)
 	DeleteProfilePhoto(context.Context, *proto.UUIDMessage) (*proto.Empty, error)
@@ -465,7 +670,22 @@ type CreatorServiceServer interface {
 	Statistics(context.Context, *StatisticsInput) (*Stat, error)
 	StatisticsFirstDate(context.Context, *proto.UUIDMessage) (*FirstDate, error)
 	GetCreatorBalance(context.Context, *proto.UUIDMessage) (*CreatorBalance, error)
-	UpdateBalance(context.Context, *CreatorTransfer) (*CreatorBalance, error)
+	GetUploadLimit(context.Context, *proto.UUIDMessage) (*UploadLimitMessage, error)
+	GetStorageUsage(context.Context, *proto.UUIDMessage) (*StorageUsage, error)
+	CheckStorageQuota(context.Context, *StorageQuotaMessage) (*proto.Empty, error)
+	RequestPayout(context.Context, *Payout) (*PayoutMessage, error)
+	GetPayout(context.Context, *PayoutCreatorMessage) (*PayoutMessage, error)
+	AddPayoutDestination(context.Context, *PayoutDestination) (*PayoutDestinationMessage, error)
+	PayoutDestinations(context.Context, *proto.UUIDMessage) (*PayoutDestinationsMessage, error)
+	DeletePayoutDestination(context.Context, *PayoutDestinationCreatorMessage) (*proto.Empty, error)
+	GetPayoutSchedule(context.Context, *proto.UUIDMessage) (*PayoutScheduleMessage, error)
+	SetPayoutSchedule(context.Context, *PayoutSchedule) (*PayoutScheduleMessage, error)
+	DeletePayoutSchedule(context.Context, *proto.UUIDMessage) (*proto.Empty, error)
+	CreatorLedger(context.Context, *LedgerFilter) (*LedgerMessage, error)
+	CreatorDonations(context.Context, *DonationsFilter) (*DonationsMessage, error)
+	TopSupporters(context.Context, *SupportersFilter) (*SupportersMessage, error)
+	CreateCommissionRate(context.Context, *CommissionRateRequest) (*CommissionRateMessage, error)
+	EndCommissionRate(context.Context, *EndCommissionRateRequest) (*proto.Empty, error)
 	mustEmbedUnimplementedCreatorServiceServer()
 }
 
@@ -494,6 +714,12 @@ func (UnimplementedCreatorServiceServer) IsCreator(context.Context, *UserCreator
 func (UnimplementedCreatorServiceServer) CreateAim(context.Context, *Aim) (*proto.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAim not implemented")
 }
+func (UnimplementedCreatorServiceServer) UpdateAim(context.Context, *Aim) (*AimMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAim not implemented")
+}
+func (UnimplementedCreatorServiceServer) CreatorAims(context.Context, *AimsFilter) (*AimsMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatorAims not implemented")
+}
 func (UnimplementedCreatorServiceServer) CheckIfCreator(context.Context, *proto.UUIDMessage) (*proto.UUIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckIfCreator not implemented")
 }
@@ -536,6 +762,15 @@ func (UnimplementedCreatorServiceServer) AddAttach(context.Context, *PostAttachM
 func (UnimplementedCreatorServiceServer) GetFileExtension(context.Context, *KeywordMessage) (*Extension, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFileExtension not implemented")
 }
+func (UnimplementedCreatorServiceServer) GetAttachment(context.Context, *PostAttachMessage) (*AttachmentMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedCreatorServiceServer) AddDownload(context.Context, *DownloadMessage) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDownload not implemented")
+}
+func (UnimplementedCreatorServiceServer) HoldBlob(context.Context, *KeywordMessage) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldBlob not implemented")
+}
 func (UnimplementedCreatorServiceServer) UpdateProfilePhoto(context.Context, *proto.UUIDMessage) (*proto.UUIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfilePhoto not implemented")
 }
@@ -587,8 +822,53 @@ func (UnimplementedCreatorServiceServer) StatisticsFirstDate(context.Context, *p
 func (UnimplementedCreatorServiceServer) GetCreatorBalance(context.Context, *proto.UUIDMessage) (*CreatorBalance, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCreatorBalance not implemented")
 }
-func (UnimplementedCreatorServiceServer) UpdateBalance(context.Context, *CreatorTransfer) (*CreatorBalance, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBalance not implemented")
+func (UnimplementedCreatorServiceServer) GetUploadLimit(context.Context, *proto.UUIDMessage) (*UploadLimitMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadLimit not implemented")
+}
+func (UnimplementedCreatorServiceServer) GetStorageUsage(context.Context, *proto.UUIDMessage) (*StorageUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
+func (UnimplementedCreatorServiceServer) CheckStorageQuota(context.Context, *StorageQuotaMessage) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckStorageQuota not implemented")
+}
+func (UnimplementedCreatorServiceServer) RequestPayout(context.Context, *Payout) (*PayoutMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPayout not implemented")
+}
+func (UnimplementedCreatorServiceServer) GetPayout(context.Context, *PayoutCreatorMessage) (*PayoutMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayout not implemented")
+}
+func (UnimplementedCreatorServiceServer) AddPayoutDestination(context.Context, *PayoutDestination) (*PayoutDestinationMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPayoutDestination not implemented")
+}
+func (UnimplementedCreatorServiceServer) PayoutDestinations(context.Context, *proto.UUIDMessage) (*PayoutDestinationsMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutDestinations not implemented")
+}
+func (UnimplementedCreatorServiceServer) DeletePayoutDestination(context.Context, *PayoutDestinationCreatorMessage) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePayoutDestination not implemented")
+}
+func (UnimplementedCreatorServiceServer) GetPayoutSchedule(context.Context, *proto.UUIDMessage) (*PayoutScheduleMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoutSchedule not implemented")
+}
+func (UnimplementedCreatorServiceServer) SetPayoutSchedule(context.Context, *PayoutSchedule) (*PayoutScheduleMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPayoutSchedule not implemented")
+}
+func (UnimplementedCreatorServiceServer) DeletePayoutSchedule(context.Context, *proto.UUIDMessage) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePayoutSchedule not implemented")
+}
+func (UnimplementedCreatorServiceServer) CreatorLedger(context.Context, *LedgerFilter) (*LedgerMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatorLedger not implemented")
+}
+func (UnimplementedCreatorServiceServer) CreatorDonations(context.Context, *DonationsFilter) (*DonationsMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatorDonations not implemented")
+}
+func (UnimplementedCreatorServiceServer) TopSupporters(context.Context, *SupportersFilter) (*SupportersMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopSupporters not implemented")
+}
+func (UnimplementedCreatorServiceServer) CreateCommissionRate(context.Context, *CommissionRateRequest) (*CommissionRateMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommissionRate not implemented")
+}
+func (UnimplementedCreatorServiceServer) EndCommissionRate(context.Context, *EndCommissionRateRequest) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndCommissionRate not implemented")
 }
 func (UnimplementedCreatorServiceServer) mustEmbedUnimplementedCreatorServiceServer() {}
 
@@ -729,6 +1009,42 @@ func _CreatorService_CreateAim_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CreatorService_UpdateAim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Aim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).UpdateAim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/UpdateAim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).UpdateAim(ctx, req.(*Aim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_CreatorAims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AimsFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).CreatorAims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/CreatorAims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).CreatorAims(ctx, req.(*AimsFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CreatorService_CheckIfCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(proto.UUIDMessage)
 	if err := dec(in); err != nil {
@@ -981,6 +1297,60 @@ func _CreatorService_GetFileExtension_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CreatorService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostAttachMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/GetAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).GetAttachment(ctx, req.(*PostAttachMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_AddDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).AddDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/AddDownload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).AddDownload(ctx, req.(*DownloadMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_HoldBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeywordMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).HoldBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/HoldBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).HoldBlob(ctx, req.(*KeywordMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CreatorService_UpdateProfilePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(proto.UUIDMessage)
 	if err := dec(in); err != nil {
@@ -1287,20 +1657,290 @@ func _CreatorService_GetCreatorBalance_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CreatorService_UpdateBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatorTransfer)
+func _CreatorService_GetUploadLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.UUIDMessage)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CreatorServiceServer).UpdateBalance(ctx, in)
+		return srv.(CreatorServiceServer).GetUploadLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CreatorService/UpdateBalance",
+		FullMethod: "/CreatorService/GetUploadLimit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CreatorServiceServer).UpdateBalance(ctx, req.(*CreatorTransfer))
+		return srv.(CreatorServiceServer).GetUploadLimit(ctx, req.(*proto.UUIDMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.UUIDMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/GetStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).GetStorageUsage(ctx, req.(*proto.UUIDMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_CheckStorageQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageQuotaMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).CheckStorageQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/CheckStorageQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).CheckStorageQuota(ctx, req.(*StorageQuotaMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_RequestPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Payout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).RequestPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/RequestPayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).RequestPayout(ctx, req.(*Payout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_GetPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutCreatorMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).GetPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/GetPayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).GetPayout(ctx, req.(*PayoutCreatorMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_AddPayoutDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutDestination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).AddPayoutDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/AddPayoutDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).AddPayoutDestination(ctx, req.(*PayoutDestination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_PayoutDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.UUIDMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).PayoutDestinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/PayoutDestinations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).PayoutDestinations(ctx, req.(*proto.UUIDMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_DeletePayoutDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutDestinationCreatorMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).DeletePayoutDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/DeletePayoutDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).DeletePayoutDestination(ctx, req.(*PayoutDestinationCreatorMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_GetPayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.UUIDMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).GetPayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/GetPayoutSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).GetPayoutSchedule(ctx, req.(*proto.UUIDMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_SetPayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).SetPayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/SetPayoutSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).SetPayoutSchedule(ctx, req.(*PayoutSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_DeletePayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.UUIDMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).DeletePayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/DeletePayoutSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).DeletePayoutSchedule(ctx, req.(*proto.UUIDMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_CreatorLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).CreatorLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/CreatorLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).CreatorLedger(ctx, req.(*LedgerFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_CreatorDonations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DonationsFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).CreatorDonations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/CreatorDonations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).CreatorDonations(ctx, req.(*DonationsFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_TopSupporters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupportersFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).TopSupporters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/TopSupporters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).TopSupporters(ctx, req.(*SupportersFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_CreateCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommissionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).CreateCommissionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/CreateCommissionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).CreateCommissionRate(ctx, req.(*CommissionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreatorService_EndCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndCommissionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreatorServiceServer).EndCommissionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CreatorService/EndCommissionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreatorServiceServer).EndCommissionRate(ctx, req.(*EndCommissionRateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1340,6 +1980,14 @@ var CreatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAim",
 			Handler:    _CreatorService_CreateAim_Handler,
 		},
+		{
+			MethodName: "UpdateAim",
+			Handler:    _CreatorService_UpdateAim_Handler,
+		},
+		{
+			MethodName: "CreatorAims",
+			Handler:    _CreatorService_CreatorAims_Handler,
+		},
 		{
 			MethodName: "CheckIfCreator",
 			Handler:    _CreatorService_CheckIfCreator_Handler,
@@ -1396,6 +2044,18 @@ var CreatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFileExtension",
 			Handler:    _CreatorService_GetFileExtension_Handler,
 		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _CreatorService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "AddDownload",
+			Handler:    _CreatorService_AddDownload_Handler,
+		},
+		{
+			MethodName: "HoldBlob",
+			Handler:    _CreatorService_HoldBlob_Handler,
+		},
 		{
 			MethodName: "UpdateProfilePhoto",
 			Handler:    _CreatorService_UpdateProfilePhoto_Handler,
@@ -1465,8 +2125,68 @@ var CreatorService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CreatorService_GetCreatorBalance_Handler,
 		},
 		{
-			MethodName: "UpdateBalance",
-			Handler:    _CreatorService_UpdateBalance_Handler,
+			MethodName: "GetUploadLimit",
+			Handler:    _CreatorService_GetUploadLimit_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _CreatorService_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "CheckStorageQuota",
+			Handler:    _CreatorService_CheckStorageQuota_Handler,
+		},
+		{
+			MethodName: "RequestPayout",
+			Handler:    _CreatorService_RequestPayout_Handler,
+		},
+		{
+			MethodName: "GetPayout",
+			Handler:    _CreatorService_GetPayout_Handler,
+		},
+		{
+			MethodName: "AddPayoutDestination",
+			Handler:    _CreatorService_AddPayoutDestination_Handler,
+		},
+		{
+			MethodName: "PayoutDestinations",
+			Handler:    _CreatorService_PayoutDestinations_Handler,
+		},
+		{
+			MethodName: "DeletePayoutDestination",
+			Handler:    _CreatorService_DeletePayoutDestination_Handler,
+		},
+		{
+			MethodName: "GetPayoutSchedule",
+			Handler:    _CreatorService_GetPayoutSchedule_Handler,
+		},
+		{
+			MethodName: "SetPayoutSchedule",
+			Handler:    _CreatorService_SetPayoutSchedule_Handler,
+		},
+		{
+			MethodName: "DeletePayoutSchedule",
+			Handler:    _CreatorService_DeletePayoutSchedule_Handler,
+		},
+		{
+			MethodName: "CreatorLedger",
+			Handler:    _CreatorService_CreatorLedger_Handler,
+		},
+		{
+			MethodName: "CreatorDonations",
+			Handler:    _CreatorService_CreatorDonations_Handler,
+		},
+		{
+			MethodName: "TopSupporters",
+			Handler:    _CreatorService_TopSupporters_Handler,
+		},
+		{
+			MethodName: "CreateCommissionRate",
+			Handler:    _CreatorService_CreateCommissionRate_Handler,
+		},
+		{
+			MethodName: "EndCommissionRate",
+			Handler:    _CreatorService_EndCommissionRate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -108,10 +108,7 @@ func (h GrpcCreatorHandler) GetFeed(ctx context.Context, in *generatedCommon.UUI
 		})
 
 		for _, attach := range post.Attachments {
-			postsProto.Posts[i].PostAttachments = append(postsProto.Posts[i].PostAttachments, &generatedCreator.Attachment{
-				ID:   attach.Id.String(),
-				Type: attach.Type,
-			})
+			postsProto.Posts[i].PostAttachments = append(postsProto.Posts[i].PostAttachments, attach.ToProto())
 		}
 		postsProto.Posts[i].Subscriptions = nil
 
@@ -138,24 +135,23 @@ func (h GrpcCreatorHandler) GetPage(ctx context.Context, in *generatedCreator.Us
 	}
 
 	var creatorPage generatedCreator.CreatorPage
-	creatorPage.AimInfo = &generatedCreator.Aim{
-		Creator:     page.Aim.Creator.String(),
-		Description: page.Aim.Description,
-		MoneyNeeded: page.Aim.MoneyNeeded,
-		MoneyGot:    page.Aim.MoneyGot,
+	creatorPage.AimInfo = page.Aim.AimToProto()
+	for _, aim := range page.Aims {
+		creatorPage.Aims = append(creatorPage.Aims, aim.AimToProto())
 	}
 	creatorPage.Error = ""
 	creatorPage.IsMyPage = page.IsMyPage
 	creatorPage.Follows = page.Follows
 	for _, sub := range page.Subscriptions {
 		creatorPage.Subscriptions = append(creatorPage.Subscriptions, &generatedCommon.Subscription{
-			Id:           sub.Id.String(),
-			Creator:      sub.Creator.String(),
-			CreatorName:  sub.CreatorName,
-			CreatorPhoto: sub.CreatorPhoto.String(),
-			MonthCost:    sub.MonthCost,
-			Title:        sub.Title,
-			Description:  sub.Description,
+			Id:             sub.Id.String(),
+			Creator:        sub.Creator.String(),
+			CreatorName:    sub.CreatorName,
+			CreatorPhoto:   sub.CreatorPhoto.String(),
+			MonthCost:      sub.MonthCost.ToProto(),
+			Title:          sub.Title,
+			Description:    sub.Description,
+			BillingOptions: models.BillingOptionsToProto(sub.BillingOptions),
 		})
 	}
 
@@ -185,10 +181,7 @@ func (h GrpcCreatorHandler) GetPage(ctx context.Context, in *generatedCreator.Us
 		})
 
 		for _, attach := range post.Attachments {
-			creatorPage.Posts[i].PostAttachments = append(creatorPage.Posts[i].PostAttachments, &generatedCreator.Attachment{
-				ID:   attach.Id.String(),
-				Type: attach.Type,
-			})
+			creatorPage.Posts[i].PostAttachments = append(creatorPage.Posts[i].PostAttachments, attach.ToProto())
 		}
 
 		for _, sub := range post.Subscriptions {
@@ -197,7 +190,7 @@ func (h GrpcCreatorHandler) GetPage(ctx context.Context, in *generatedCreator.Us
 				Creator:      sub.Creator.String(),
 				CreatorName:  sub.CreatorName,
 				CreatorPhoto: sub.CreatorPhoto.String(),
-				MonthCost:    sub.MonthCost,
+				MonthCost:    sub.MonthCost.ToProto(),
 				Title:        sub.Title,
 				Description:  sub.Description,
 			})
@@ -240,17 +233,12 @@ func (h GrpcCreatorHandler) IsCreator(ctx context.Context, in *generatedCreator.
 }
 
 func (h GrpcCreatorHandler) CreateAim(ctx context.Context, in *generatedCreator.Aim) (*generatedCommon.Empty, error) {
-	creatorID, err := uuid.Parse(in.Creator)
-	if err != nil {
+	var aim models.Aim
+	if err := aim.AimToModel(in); err != nil {
 		return &generatedCommon.Empty{Error: err.Error()}, nil
 	}
 
-	err = h.uc.CreateAim(ctx, models.Aim{
-		Creator:     creatorID,
-		Description: in.Description,
-		MoneyNeeded: in.MoneyNeeded,
-		MoneyGot:    in.MoneyGot,
-	})
+	err := h.uc.CreateAim(ctx, aim)
 
 	if err != nil {
 		return &generatedCommon.Empty{Error: err.Error()}, nil
@@ -258,6 +246,39 @@ func (h GrpcCreatorHandler) CreateAim(ctx context.Context, in *generatedCreator.
 	return &generatedCommon.Empty{Error: ""}, nil
 }
 
+func (h GrpcCreatorHandler) UpdateAim(ctx context.Context, in *generatedCreator.Aim) (*generatedCreator.AimMessage, error) {
+	var aim models.Aim
+	if err := aim.AimToModel(in); err != nil {
+		return &generatedCreator.AimMessage{Error: err.Error()}, nil
+	}
+
+	aim, err := h.uc.UpdateAim(ctx, aim)
+
+	if err != nil {
+		return &generatedCreator.AimMessage{Error: err.Error()}, nil
+	}
+	return &generatedCreator.AimMessage{Aim: aim.AimToProto(), Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) CreatorAims(ctx context.Context, in *generatedCreator.AimsFilter) (*generatedCreator.AimsMessage, error) {
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return &generatedCreator.AimsMessage{Error: err.Error()}, nil
+	}
+
+	aims, err := h.uc.CreatorAims(ctx, creatorID, in.Status)
+	if err != nil {
+		return &generatedCreator.AimsMessage{Error: err.Error()}, nil
+	}
+
+	var aimsProto generatedCreator.AimsMessage
+	for _, v := range aims {
+		aimsProto.Aims = append(aimsProto.Aims, v.AimToProto())
+	}
+	aimsProto.Error = ""
+	return &aimsProto, nil
+}
+
 func (h GrpcCreatorHandler) CheckIfCreator(ctx context.Context, in *generatedCommon.UUIDMessage) (*generatedCommon.UUIDResponse, error) {
 	userID, err := uuid.Parse(in.Value)
 	if err != nil {
@@ -296,14 +317,19 @@ func (h GrpcCreatorHandler) CreatePost(ctx context.Context, in *generatedCreator
 
 	var attachs []models.AttachmentData
 	for _, attach := range in.Attachments {
-		attachID, err := uuid.Parse(attach.ID)
-		if err != nil {
+		var attachment models.Attachment
+		if err = attachment.AttachToModel(attach); err != nil {
 			return &generatedCommon.Empty{Error: err.Error()}, nil
 		}
+		// статус проверки решает сервис, а не клиент
+		attachment.ScanStatus = h.auc.UploadScanStatus(ctx)
 		attachs = append(attachs, models.AttachmentData{
-			Id:   attachID,
-			Data: nil,
-			Type: attach.Type,
+			Id:                 attachment.Id,
+			Data:               nil,
+			Type:               attachment.Type,
+			Renditions:         attachment.Renditions,
+			BlobId:             attachment.BlobId,
+			AttachmentMetadata: attachment.AttachmentMetadata,
 		})
 	}
 
@@ -334,15 +360,11 @@ func (h GrpcCreatorHandler) CreatePost(ctx context.Context, in *generatedCreator
 func (h GrpcCreatorHandler) DeleteAttachmentsFiles(ctx context.Context, in *generatedCreator.Attachments) (*generatedCommon.Empty, error) {
 	var attachs []models.Attachment
 	for _, attach := range in.Attachments {
-		attachID, err := uuid.Parse(attach.ID)
-		if err != nil {
+		var attachment models.Attachment
+		if err := attachment.AttachToModel(attach); err != nil {
 			return &generatedCommon.Empty{Error: err.Error()}, nil
 		}
-
-		attachs = append(attachs, models.Attachment{
-			Id:   attachID,
-			Type: attach.Type,
-		})
+		attachs = append(attachs, attachment)
 	}
 
 	err := h.auc.DeleteAttachmentsFiles(ctx, attachs...)
@@ -404,15 +426,12 @@ func (h GrpcCreatorHandler) AddAttach(ctx context.Context, in *generatedCreator.
 		return &generatedCommon.Empty{Error: err.Error()}, nil
 	}
 
-	attachID, err := uuid.Parse(in.Attachment.ID)
-	if err != nil {
+	var attachment models.Attachment
+	if err = attachment.AttachToModel(in.Attachment); err != nil {
 		return &generatedCommon.Empty{Error: err.Error()}, nil
 	}
 
-	err = h.auc.AddAttach(ctx, postID, models.Attachment{
-		Id:   attachID,
-		Type: in.Attachment.Type,
-	})
+	err = h.auc.AddAttach(ctx, postID, attachment)
 	if err != nil {
 		return &generatedCommon.Empty{Error: err.Error()}, nil
 	}
@@ -420,14 +439,73 @@ func (h GrpcCreatorHandler) AddAttach(ctx context.Context, in *generatedCreator.
 }
 
 func (h GrpcCreatorHandler) GetFileExtension(ctx context.Context, in *generatedCreator.KeywordMessage) (*generatedCreator.Extension, error) {
-	extension, flag := h.auc.GetFileExtension(ctx, in.Keyword)
+	mediaType, flag := h.auc.GetMediaType(ctx, in.Keyword)
 
 	return &generatedCreator.Extension{
-		Extension: extension,
+		Extension: mediaType.Extension,
 		Flag:      flag,
+		MaxSize:   mediaType.MaxSize,
+		Download:  mediaType.Download,
 	}, nil
 }
 
+func (h GrpcCreatorHandler) GetAttachment(ctx context.Context, in *generatedCreator.PostAttachMessage) (*generatedCreator.AttachmentMessage, error) {
+	postID, err := uuid.Parse(in.PostID)
+	if err != nil {
+		return &generatedCreator.AttachmentMessage{Error: err.Error()}, nil
+	}
+
+	if in.Attachment == nil {
+		return &generatedCreator.AttachmentMessage{Error: models.WrongData.Error()}, nil
+	}
+	attachID, err := uuid.Parse(in.Attachment.ID)
+	if err != nil {
+		return &generatedCreator.AttachmentMessage{Error: err.Error()}, nil
+	}
+
+	attach, err := h.auc.GetAttachment(ctx, postID, attachID)
+	if err != nil {
+		return &generatedCreator.AttachmentMessage{Error: err.Error()}, nil
+	}
+	mediaType, ok := h.auc.GetMediaType(ctx, attach.Type)
+	if !ok {
+		return &generatedCreator.AttachmentMessage{Error: models.Unsupported.Error()}, nil
+	}
+	return &generatedCreator.AttachmentMessage{
+		Attachment: attach.ToProto(),
+		Extension:  mediaType.Extension,
+		Download:   mediaType.Download,
+		Error:      "",
+	}, nil
+}
+
+func (h GrpcCreatorHandler) AddDownload(ctx context.Context, in *generatedCreator.DownloadMessage) (*generatedCommon.Empty, error) {
+	postID, err := uuid.Parse(in.PostID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	attachID, err := uuid.Parse(in.AttachmentID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, in.ExpiresAt)
+	if err != nil {
+		return &generatedCommon.Empty{Error: models.WrongData.Error()}, nil
+	}
+
+	if err = h.auc.AddDownload(ctx, postID, attachID, in.Key, expiresAt); err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	return &generatedCommon.Empty{Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) HoldBlob(ctx context.Context, in *generatedCreator.KeywordMessage) (*generatedCommon.Empty, error) {
+	if err := h.auc.HoldBlob(ctx, in.Keyword); err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	return &generatedCommon.Empty{Error: ""}, nil
+}
+
 func (h GrpcCreatorHandler) IsPostOwner(ctx context.Context, in *generatedCreator.PostUserMessage) (*generatedCreator.FlagMessage, error) {
 	postID, err := uuid.Parse(in.PostID)
 	if err != nil {
@@ -487,24 +565,191 @@ func (h GrpcCreatorHandler) GetCreatorBalance(ctx context.Context, in *generated
 	if err != nil {
 		return &generatedCreator.CreatorBalance{Error: err.Error()}, nil
 	}
-	return &generatedCreator.CreatorBalance{Error: "", Balance: balance}, nil
+	return balance.ToProto(), nil
 }
 
-func (h GrpcCreatorHandler) UpdateBalance(ctx context.Context, in *generatedCreator.CreatorTransfer) (*generatedCreator.CreatorBalance, error) {
+func (h GrpcCreatorHandler) GetUploadLimit(ctx context.Context, in *generatedCommon.UUIDMessage) (*generatedCreator.UploadLimitMessage, error) {
+	creatorID, err := uuid.Parse(in.Value)
+	if err != nil {
+		return &generatedCreator.UploadLimitMessage{Error: err.Error()}, nil
+	}
+
+	limit, err := h.uc.GetUploadLimit(ctx, creatorID)
+	if err != nil {
+		return &generatedCreator.UploadLimitMessage{Error: err.Error()}, nil
+	}
+	return &generatedCreator.UploadLimitMessage{MaxSize: limit, Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) GetStorageUsage(ctx context.Context, in *generatedCommon.UUIDMessage) (*generatedCreator.StorageUsage, error) {
+	creatorID, err := uuid.Parse(in.Value)
+	if err != nil {
+		return &generatedCreator.StorageUsage{Error: err.Error()}, nil
+	}
+
+	usage, err := h.uc.GetStorageUsage(ctx, creatorID)
+	if err != nil {
+		return &generatedCreator.StorageUsage{Error: err.Error()}, nil
+	}
+	return usage.ToProto(), nil
+}
+
+func (h GrpcCreatorHandler) CheckStorageQuota(ctx context.Context, in *generatedCreator.StorageQuotaMessage) (*generatedCommon.Empty, error) {
 	creatorID, err := uuid.Parse(in.CreatorID)
 	if err != nil {
-		return &generatedCreator.CreatorBalance{Error: err.Error()}, nil
+		return &generatedCommon.Empty{Error: err.Error()}, nil
 	}
 
-	balance, err := h.uc.UpdateBalance(ctx, models.CreatorTransfer{
-		Money:       in.Money,
-		CreatorID:   creatorID,
-		PhoneNumber: "",
-	})
+	if err = h.uc.CheckStorageQuota(ctx, creatorID, in.Size); err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	return &generatedCommon.Empty{Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) RequestPayout(ctx context.Context, in *generatedCreator.Payout) (*generatedCreator.PayoutMessage, error) {
+	payout, err := models.ProtoPayoutRequestToModel(in)
 	if err != nil {
-		return &generatedCreator.CreatorBalance{Error: err.Error()}, nil
+		return &generatedCreator.PayoutMessage{Error: err.Error()}, nil
+	}
+
+	payout, err = h.uc.RequestPayout(ctx, payout)
+	if err != nil {
+		return &generatedCreator.PayoutMessage{Error: err.Error()}, nil
+	}
+	return &generatedCreator.PayoutMessage{Payout: payout.ToProto(), Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) GetPayout(ctx context.Context, in *generatedCreator.PayoutCreatorMessage) (*generatedCreator.PayoutMessage, error) {
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return &generatedCreator.PayoutMessage{Error: err.Error()}, nil
+	}
+	payoutID, err := uuid.Parse(in.PayoutID)
+	if err != nil {
+		return &generatedCreator.PayoutMessage{Error: err.Error()}, nil
+	}
+
+	payout, err := h.uc.GetPayout(ctx, creatorID, payoutID)
+	if err != nil {
+		return &generatedCreator.PayoutMessage{Error: err.Error()}, nil
+	}
+	return &generatedCreator.PayoutMessage{Payout: payout.ToProto(), Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) AddPayoutDestination(ctx context.Context, in *generatedCreator.PayoutDestination) (*generatedCreator.PayoutDestinationMessage, error) {
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return &generatedCreator.PayoutDestinationMessage{Error: err.Error()}, nil
+	}
+
+	destination, err := h.uc.AddPayoutDestination(ctx, models.PayoutDestination{CreatorID: creatorID, PhoneNumber: in.PhoneNumber})
+	if err != nil {
+		return &generatedCreator.PayoutDestinationMessage{Error: err.Error()}, nil
 	}
-	return &generatedCreator.CreatorBalance{Error: "", Balance: balance}, nil
+	return &generatedCreator.PayoutDestinationMessage{Destination: destination.ToProto(), Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) PayoutDestinations(ctx context.Context, in *generatedCommon.UUIDMessage) (*generatedCreator.PayoutDestinationsMessage, error) {
+	creatorID, err := uuid.Parse(in.Value)
+	if err != nil {
+		return &generatedCreator.PayoutDestinationsMessage{Error: err.Error()}, nil
+	}
+
+	destinations, err := h.uc.PayoutDestinations(ctx, creatorID)
+	if err != nil {
+		return &generatedCreator.PayoutDestinationsMessage{Error: err.Error()}, nil
+	}
+
+	var destinationsProto generatedCreator.PayoutDestinationsMessage
+	for _, v := range destinations {
+		destinationsProto.Destinations = append(destinationsProto.Destinations, v.ToProto())
+	}
+	destinationsProto.Error = ""
+	return &destinationsProto, nil
+}
+
+func (h GrpcCreatorHandler) DeletePayoutDestination(ctx context.Context, in *generatedCreator.PayoutDestinationCreatorMessage) (*generatedCommon.Empty, error) {
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	destinationID, err := uuid.Parse(in.DestinationID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+
+	if err = h.uc.DeletePayoutDestination(ctx, creatorID, destinationID); err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	return &generatedCommon.Empty{Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) GetPayoutSchedule(ctx context.Context, in *generatedCommon.UUIDMessage) (*generatedCreator.PayoutScheduleMessage, error) {
+	creatorID, err := uuid.Parse(in.Value)
+	if err != nil {
+		return &generatedCreator.PayoutScheduleMessage{Error: err.Error()}, nil
+	}
+
+	schedule, err := h.uc.GetPayoutSchedule(ctx, creatorID)
+	if err != nil {
+		return &generatedCreator.PayoutScheduleMessage{Error: err.Error()}, nil
+	}
+	return &generatedCreator.PayoutScheduleMessage{Schedule: schedule.ToProto(), Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) SetPayoutSchedule(ctx context.Context, in *generatedCreator.PayoutSchedule) (*generatedCreator.PayoutScheduleMessage, error) {
+	var schedule models.PayoutSchedule
+	if err := schedule.ProtoPayoutScheduleToModel(in); err != nil {
+		return &generatedCreator.PayoutScheduleMessage{Error: models.WrongData.Error()}, nil
+	}
+
+	schedule, err := h.uc.SetPayoutSchedule(ctx, schedule)
+	if err != nil {
+		return &generatedCreator.PayoutScheduleMessage{Error: err.Error()}, nil
+	}
+	return &generatedCreator.PayoutScheduleMessage{Schedule: schedule.ToProto(), Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) DeletePayoutSchedule(ctx context.Context, in *generatedCommon.UUIDMessage) (*generatedCommon.Empty, error) {
+	creatorID, err := uuid.Parse(in.Value)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+
+	if err = h.uc.DeletePayoutSchedule(ctx, creatorID); err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	return &generatedCommon.Empty{Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) CreatorLedger(ctx context.Context, in *generatedCreator.LedgerFilter) (*generatedCreator.LedgerMessage, error) {
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return &generatedCreator.LedgerMessage{Error: err.Error()}, nil
+	}
+	filter := models.LedgerFilter{CreatorId: creatorID, Limit: in.Limit, Offset: in.Offset}
+	if len(in.From) != 0 {
+		if filter.From, err = time.Parse(time.RFC3339, in.From); err != nil {
+			return &generatedCreator.LedgerMessage{Error: err.Error()}, nil
+		}
+	}
+	if len(in.To) != 0 {
+		if filter.To, err = time.Parse(time.RFC3339, in.To); err != nil {
+			return &generatedCreator.LedgerMessage{Error: err.Error()}, nil
+		}
+	}
+
+	statement, err := h.uc.CreatorLedger(ctx, filter)
+	if err != nil {
+		return &generatedCreator.LedgerMessage{Error: err.Error()}, nil
+	}
+
+	ledgerProto := generatedCreator.LedgerMessage{Balance: statement.Balance.ToProto()}
+	for _, v := range statement.Entries {
+		ledgerProto.Entries = append(ledgerProto.Entries, v.ToProto())
+	}
+	ledgerProto.Error = ""
+	return &ledgerProto, nil
 }
 
 func (h GrpcCreatorHandler) GetPost(ctx context.Context, in *generatedCreator.PostUserMessage) (*generatedCreator.PostWithComments, error) {
@@ -526,7 +771,7 @@ func (h GrpcCreatorHandler) GetPost(ctx context.Context, in *generatedCreator.Po
 	var attachs []*generatedCreator.Attachment
 
 	for _, v := range post.Post.Attachments {
-		attachs = append(attachs, &generatedCreator.Attachment{ID: v.Id.String(), Type: v.Type})
+		attachs = append(attachs, v.ToProto())
 	}
 
 	var comments []*generatedCreator.Comment
@@ -695,12 +940,13 @@ func (h GrpcCreatorHandler) CreateSubscription(ctx context.Context, in *generate
 		return &generatedCommon.Empty{Error: err.Error()}, nil
 	}
 	err = h.suc.CreateSubscription(ctx, models.Subscription{
-		Id:          subId,
-		Creator:     creatorId,
-		CreatorName: in.CreatorName,
-		MonthCost:   in.MonthCost,
-		Title:       in.Title,
-		Description: in.Description,
+		Id:             subId,
+		Creator:        creatorId,
+		CreatorName:    in.CreatorName,
+		MonthCost:      models.MoneyFromProto(in.MonthCost),
+		Title:          in.Title,
+		Description:    in.Description,
+		BillingOptions: models.BillingOptionsFromProto(in.BillingOptions),
 	})
 	if err != nil {
 		return &generatedCommon.Empty{Error: err.Error()}, nil
@@ -718,11 +964,12 @@ func (h GrpcCreatorHandler) EditSubscription(ctx context.Context, in *generatedC
 		return &generatedCommon.Empty{Error: err.Error()}, nil
 	}
 	err = h.suc.EditSubscription(ctx, models.Subscription{
-		Id:          subId,
-		Creator:     creatorId,
-		MonthCost:   in.MonthCost,
-		Title:       in.Title,
-		Description: in.Description,
+		Id:             subId,
+		Creator:        creatorId,
+		MonthCost:      models.MoneyFromProto(in.MonthCost),
+		Title:          in.Title,
+		Description:    in.Description,
+		BillingOptions: models.BillingOptionsFromProto(in.BillingOptions),
 	})
 	if err != nil {
 		return &generatedCommon.Empty{Error: err.Error()}, nil
@@ -909,15 +1156,133 @@ func (h GrpcCreatorHandler) Statistics(ctx context.Context, in *generatedCreator
 	if err != nil {
 		return &generatedCreator.Stat{Error: err.Error()}, nil
 	}
+	var billingPeriods []*generatedCreator.BillingPeriodStat
+	for _, period := range stat.BillingPeriods {
+		billingPeriods = append(billingPeriods, &generatedCreator.BillingPeriodStat{
+			MonthCount:          period.MonthCount,
+			SubscriptionsBought: period.SubscriptionsBought,
+			Money:               period.Money.ToProto(),
+		})
+	}
 	return &generatedCreator.Stat{
 		CreatorId:              stat.CreatorId.String(),
 		PostsPerMonth:          stat.PostsPerMonth,
 		SubscriptionsBought:    stat.SubscriptionsBought,
 		DonationsCount:         stat.DonationsCount,
-		MoneyFromDonations:     stat.MoneyFromDonations,
-		MoneyFromSubscriptions: stat.MoneyFromSubscriptions,
+		MoneyFromDonations:     stat.MoneyFromDonations.ToProto(),
+		MoneyFromSubscriptions: stat.MoneyFromSubscriptions.ToProto(),
 		NewFollowers:           stat.NewFollowers,
 		LikesCount:             stat.LikesCount,
+		SubscriptionsExpired:   stat.SubscriptionsExpired,
+		GiftsBought:            stat.GiftsBought,
+		MoneyFromGifts:         stat.MoneyFromGifts.ToProto(),
+		GrossIncome:            stat.GrossIncome.ToProto(),
+		Commission:             stat.Commission.ToProto(),
+		NetIncome:              stat.NetIncome.ToProto(),
+		BillingPeriods:         billingPeriods,
 		Error:                  "",
 	}, nil
 }
+
+func (h GrpcCreatorHandler) CreatorDonations(ctx context.Context, in *generatedCreator.DonationsFilter) (*generatedCreator.DonationsMessage, error) {
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return &generatedCreator.DonationsMessage{Error: err.Error()}, nil
+	}
+
+	donations, err := h.uc.CreatorDonations(ctx, creatorID, in.Limit, in.Offset)
+	if err != nil {
+		return &generatedCreator.DonationsMessage{Error: err.Error()}, nil
+	}
+
+	var donationsProto generatedCreator.DonationsMessage
+	for _, v := range donations {
+		donationsProto.Donations = append(donationsProto.Donations, &generatedCreator.Donation{
+			Id:          v.Id.String(),
+			UserID:      v.UserId.String(),
+			UserName:    v.UserName,
+			UserPhoto:   v.UserPhoto.String(),
+			Money:       v.Money.ToProto(),
+			Message:     v.Message,
+			AimID:       v.AimId.String(),
+			Aim:         v.Aim,
+			IsAnonymous: v.IsAnonymous,
+			Date:        v.Date.Format(time.RFC3339),
+		})
+	}
+	donationsProto.Error = ""
+	return &donationsProto, nil
+}
+
+func (h GrpcCreatorHandler) TopSupporters(ctx context.Context, in *generatedCreator.SupportersFilter) (*generatedCreator.SupportersMessage, error) {
+	creatorID, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return &generatedCreator.SupportersMessage{Error: err.Error()}, nil
+	}
+	var from time.Time
+	if len(in.From) != 0 {
+		if from, err = time.Parse(time.RFC3339, in.From); err != nil {
+			return &generatedCreator.SupportersMessage{Error: err.Error()}, nil
+		}
+	}
+
+	supporters, err := h.uc.TopSupporters(ctx, creatorID, from, in.Limit)
+	if err != nil {
+		return &generatedCreator.SupportersMessage{Error: err.Error()}, nil
+	}
+
+	var supportersProto generatedCreator.SupportersMessage
+	for _, v := range supporters {
+		supportersProto.Supporters = append(supportersProto.Supporters, &generatedCreator.Supporter{
+			UserID:         v.UserId.String(),
+			Name:           v.Name,
+			ProfilePhoto:   v.ProfilePhoto.String(),
+			Money:          v.Money.ToProto(),
+			DonationsCount: v.DonationsCount,
+		})
+	}
+	supportersProto.Error = ""
+	return &supportersProto, nil
+}
+
+func (h GrpcCreatorHandler) CreateCommissionRate(ctx context.Context, in *generatedCreator.CommissionRateRequest) (*generatedCreator.CommissionRateMessage, error) {
+	adminID, err := uuid.Parse(in.AdminID)
+	if err != nil {
+		return &generatedCreator.CommissionRateMessage{Error: err.Error()}, nil
+	}
+	if in.Rate == nil {
+		return &generatedCreator.CommissionRateMessage{Error: models.WrongData.Error()}, nil
+	}
+	var rate models.CommissionRate
+	if err = rate.ProtoCommissionRateToModel(in.Rate); err != nil {
+		return &generatedCreator.CommissionRateMessage{Error: models.WrongData.Error()}, nil
+	}
+
+	rate, err = h.uc.CreateCommissionRate(ctx, adminID, rate)
+	if err != nil {
+		return &generatedCreator.CommissionRateMessage{Error: err.Error()}, nil
+	}
+	return &generatedCreator.CommissionRateMessage{Rate: rate.ToProto(), Error: ""}, nil
+}
+
+func (h GrpcCreatorHandler) EndCommissionRate(ctx context.Context, in *generatedCreator.EndCommissionRateRequest) (*generatedCommon.Empty, error) {
+	adminID, err := uuid.Parse(in.AdminID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	rateID, err := uuid.Parse(in.RateID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	var endsAt time.Time
+	if len(in.EndsAt) != 0 {
+		if endsAt, err = time.Parse(time.RFC3339, in.EndsAt); err != nil {
+			return &generatedCommon.Empty{Error: models.WrongData.Error()}, nil
+		}
+	}
+
+	if err = h.uc.EndCommissionRate(ctx, adminID, rateID, endsAt); err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	return &generatedCommon.Empty{Error: ""}, nil
+}
@@ -1,13 +1,16 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	generatedCommon "github.com/go-park-mail-ru/2023_1_4from5/internal/models/proto"
 	generatedAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/grpc/generated"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
 	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/imaging"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/token"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
@@ -17,9 +20,7 @@ import (
 	"go.uber.org/zap"
 	"io"
 	"net/http"
-	"os"
-	"path/filepath"
-	"strings"
+	"strconv"
 	"time"
 )
 
@@ -27,14 +28,16 @@ type CreatorHandler struct {
 	creatorClient   generatedCreator.CreatorServiceClient
 	authClient      generatedAuth.AuthServiceClient
 	notificationApp notification.NotificationApp
+	store           blob.BlobStore
 	logger          *zap.SugaredLogger
 }
 
-func NewCreatorHandler(creatorClient generatedCreator.CreatorServiceClient, authClient generatedAuth.AuthServiceClient, na notification.NotificationApp, logger *zap.SugaredLogger) *CreatorHandler {
+func NewCreatorHandler(creatorClient generatedCreator.CreatorServiceClient, authClient generatedAuth.AuthServiceClient, na notification.NotificationApp, store blob.BlobStore, logger *zap.SugaredLogger) *CreatorHandler {
 	return &CreatorHandler{
 		creatorClient:   creatorClient,
 		authClient:      authClient,
 		notificationApp: na,
+		store:           store,
 		logger:          logger,
 	}
 }
@@ -77,7 +80,35 @@ func (h *CreatorHandler) GetBalance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	utils.Response(w, http.StatusOK, balance.Balance)
+	var creatorBalance models.CreatorBalance
+	creatorBalance.ProtoCreatorBalanceToModel(balance)
+
+	utils.Response(w, http.StatusOK, creatorBalance)
+}
+
+// Storage показывает, сколько места занимают вложения автора по типам файлов и сколько осталось по его тарифу
+func (h *CreatorHandler) Storage(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.currentCreator(w, r)
+	if !ok {
+		return
+	}
+
+	usageProto, err := h.creatorClient.GetStorageUsage(r.Context(), &generatedCommon.UUIDMessage{Value: creatorID})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if usageProto.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	var usage models.StorageUsage
+	usage.ProtoStorageUsageToModel(usageProto)
+
+	utils.Response(w, http.StatusOK, usage)
 }
 
 func (h *CreatorHandler) TransferMoney(w http.ResponseWriter, r *http.Request) {
@@ -105,120 +136,510 @@ func (h *CreatorHandler) TransferMoney(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	transfer := models.CreatorTransfer{}
-	err = easyjson.UnmarshalFromReader(r.Body, &transfer)
+	payout := models.Payout{}
+	err = easyjson.UnmarshalFromReader(r.Body, &payout)
 	if err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
 
-	balance, err := h.creatorClient.GetCreatorBalance(r.Context(), &generatedCommon.UUIDMessage{Value: creatorID.Value})
+	// повтор запроса с тем же ключом возвращает уже созданную выплату, а не создаёт новую
+	payout.IdempotencyKey = r.Header.Get(models.IdempotencyKeyHeader)
+	if len(payout.IdempotencyKey) == 0 {
+		payout.IdempotencyKey = uuid.NewString()
+	}
 
+	payout.CreatorID, err = uuid.Parse(creatorID.Value)
 	if err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 
-	if balance.Error != "" {
+	if !payout.IsValid() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	payoutProto, err := h.creatorClient.RequestPayout(r.Context(), &generatedCreator.Payout{
+		CreatorID:      creatorID.Value,
+		Money:          payout.Money.ToProto(),
+		PhoneNumber:    payout.PhoneNumber,
+		IdempotencyKey: payout.IdempotencyKey,
+		DestinationID:  payout.ToProto().DestinationID,
+	})
+	if err != nil {
+		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 
-	if balance.Balance < transfer.Money {
+	if payoutProto.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if payoutProto.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	h.payoutResponse(w, payoutProto.Payout)
+}
+
+func (h *CreatorHandler) GetPayout(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	payoutID, ok := mux.Vars(r)["payout-uuid"]
+	if !ok {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
 
-	reqID, err := h.requestPayment(transfer)
+	if _, err = uuid.Parse(payoutID); err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	creatorID, err := h.creatorClient.CheckIfCreator(r.Context(), &generatedCommon.UUIDMessage{Value: userDataJWT.Id.String()})
 	if err != nil {
+		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 
-	if err = h.processPayment(reqID); err != nil {
+	if creatorID.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if creatorID.Error != "" {
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 
-	balance, err = h.creatorClient.UpdateBalance(r.Context(), &generatedCreator.CreatorTransfer{
+	payoutProto, err := h.creatorClient.GetPayout(r.Context(), &generatedCreator.PayoutCreatorMessage{
+		PayoutID:  payoutID,
 		CreatorID: creatorID.Value,
-		Money:     transfer.Money,
 	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if payoutProto.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+
+	if payoutProto.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	h.payoutResponse(w, payoutProto.Payout)
+}
 
+func (h *CreatorHandler) PayoutDestinations(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.currentCreator(w, r)
+	if !ok {
+		return
+	}
+
+	destinationsProto, err := h.creatorClient.PayoutDestinations(r.Context(), &generatedCommon.UUIDMessage{Value: creatorID})
 	if err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 
-	if balance.Error != "" {
+	if destinationsProto.Error != "" {
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 
-	utils.Response(w, http.StatusOK, balance)
+	destinations := make([]models.PayoutDestination, len(destinationsProto.Destinations))
+	for i, v := range destinationsProto.Destinations {
+		if err = destinations[i].ProtoPayoutDestinationToModel(v); err != nil {
+			h.logger.Error(err)
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+	}
+	utils.Response(w, http.StatusOK, destinations)
 }
 
-func (h *CreatorHandler) requestPayment(transfer models.CreatorTransfer) (models.PaymentResponse, error) {
-	paymentToken, flag := os.LookupEnv("PAYMENT_TOKEN")
-	if !flag {
-		return models.PaymentResponse{}, errors.New("no payment token")
+// AddPayoutDestination сохраняет реквизиты, если провайдер выплат их принял; иначе отвечает 400
+func (h *CreatorHandler) AddPayoutDestination(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.currentCreator(w, r)
+	if !ok {
+		return
 	}
 
-	method := "POST"
-	payload := strings.NewReader("pattern_id=p2p&to=" + transfer.PhoneNumber + "&identifier_type=phone" + "&amount=" + fmt.Sprintf("%f", transfer.Money) + "&comment=Payment%20to%20authorID%3D%3CUUID%3E&message=Payment%20from%20SubMe")
+	destination := models.PayoutDestination{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &destination); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
 
-	client := &http.Client{}
-	req, err := http.NewRequest(method, models.RequestPaymentURL, payload)
+	if !destination.IsValid() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
 
+	destinationProto, err := h.creatorClient.AddPayoutDestination(r.Context(), &generatedCreator.PayoutDestination{
+		CreatorID:   creatorID,
+		PhoneNumber: destination.PhoneNumber,
+	})
 	if err != nil {
 		h.logger.Error(err)
-		return models.PaymentResponse{}, err
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if destinationProto.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if destinationProto.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if err = destination.ProtoPayoutDestinationToModel(destinationProto.Destination); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	utils.Response(w, http.StatusOK, destination)
+}
+
+func (h *CreatorHandler) DeletePayoutDestination(w http.ResponseWriter, r *http.Request) {
+	destinationID, ok := mux.Vars(r)["destination-uuid"]
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if _, err := uuid.Parse(destinationID); err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
 	}
-	req.Header.Add("Authorization", "Bearer "+paymentToken)
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
-	res, err := client.Do(req)
+	creatorID, ok := h.currentCreator(w, r)
+	if !ok {
+		return
+	}
+
+	out, err := h.creatorClient.DeletePayoutDestination(r.Context(), &generatedCreator.PayoutDestinationCreatorMessage{
+		DestinationID: destinationID,
+		CreatorID:     creatorID,
+	})
 	if err != nil {
 		h.logger.Error(err)
-		return models.PaymentResponse{}, err
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
 	}
 
-	var reqID models.PaymentResponse
-	err = easyjson.UnmarshalFromReader(res.Body, &reqID)
+	utils.Response(w, http.StatusOK, nil)
+}
+
+func (h *CreatorHandler) GetPayoutSchedule(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.currentCreator(w, r)
+	if !ok {
+		return
+	}
+
+	scheduleProto, err := h.creatorClient.GetPayoutSchedule(r.Context(), &generatedCommon.UUIDMessage{Value: creatorID})
 	if err != nil {
 		h.logger.Error(err)
-		return models.PaymentResponse{}, err
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if scheduleProto.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
 	}
-	return reqID, nil
+
+	if scheduleProto.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	h.payoutScheduleResponse(w, scheduleProto.Schedule)
 }
 
-func (h *CreatorHandler) processPayment(reqID models.PaymentResponse) error {
-	method := "POST"
-	paymentToken, flag := os.LookupEnv("PAYMENT_TOKEN")
-	if !flag {
-		return errors.New("no payment token")
+// SetPayoutSchedule включает автоматические выплаты на сохранённые реквизиты или меняет их настройки
+func (h *CreatorHandler) SetPayoutSchedule(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.currentCreator(w, r)
+	if !ok {
+		return
+	}
+
+	schedule := models.PayoutSchedule{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &schedule); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
 	}
 
-	payload2 := strings.NewReader(fmt.Sprintf("request_id=%s", reqID.RequestID))
+	if !schedule.IsValid() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
 
-	req, err := http.NewRequest(method, models.ProcessPaymentURL, payload2)
+	scheduleProto, err := h.creatorClient.SetPayoutSchedule(r.Context(), &generatedCreator.PayoutSchedule{
+		CreatorID:     creatorID,
+		DestinationID: schedule.DestinationID.String(),
+		Period:        schedule.Period,
+		Threshold:     schedule.Threshold.ToProto(),
+	})
 	if err != nil {
 		h.logger.Error(err)
-		return err
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if scheduleProto.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if scheduleProto.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	h.payoutScheduleResponse(w, scheduleProto.Schedule)
+}
+
+func (h *CreatorHandler) DeletePayoutSchedule(w http.ResponseWriter, r *http.Request) {
+	creatorID, ok := h.currentCreator(w, r)
+	if !ok {
+		return
 	}
-	req.Header.Add("Authorization", "Bearer "+paymentToken)
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	client := &http.Client{}
-	res, err := client.Do(req)
+
+	out, err := h.creatorClient.DeletePayoutSchedule(r.Context(), &generatedCommon.UUIDMessage{Value: creatorID})
 	if err != nil {
 		h.logger.Error(err)
-		return err
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	utils.Response(w, http.StatusOK, nil)
+}
+
+func (h *CreatorHandler) payoutScheduleResponse(w http.ResponseWriter, scheduleProto *generatedCreator.PayoutSchedule) {
+	var schedule models.PayoutSchedule
+	if err := schedule.ProtoPayoutScheduleToModel(scheduleProto); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
 	}
-	defer res.Body.Close()
-	return nil
+	utils.Response(w, http.StatusOK, schedule)
+}
+
+// CreateCommissionRate заводит ставку комиссии платформы, доступно только администраторам
+func (h *CreatorHandler) CreateCommissionRate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	rate := models.CommissionRate{}
+	if err := easyjson.UnmarshalFromReader(r.Body, &rate); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	rateProto := rate.ToProto()
+	if rate.StartsAt.IsZero() {
+		rateProto.StartsAt = ""
+	}
+	out, err := h.creatorClient.CreateCommissionRate(r.Context(), &generatedCreator.CommissionRateRequest{
+		AdminID: userID,
+		Rate:    rateProto,
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.Forbbiden.Error() {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+
+	if out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if out.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if err := rate.ProtoCommissionRateToModel(out.Rate); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	utils.Response(w, http.StatusOK, rate)
+}
+
+// EndCommissionRate завершает ставку комиссии сейчас, доступно только администраторам
+func (h *CreatorHandler) EndCommissionRate(w http.ResponseWriter, r *http.Request) {
+	rateID, ok := mux.Vars(r)["rate-uuid"]
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if _, err := uuid.Parse(rateID); err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	userID, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+
+	out, err := h.creatorClient.EndCommissionRate(r.Context(), &generatedCreator.EndCommissionRateRequest{
+		AdminID: userID,
+		RateID:  rateID,
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.Forbbiden.Error() {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+
+	if out.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	utils.Response(w, http.StatusOK, nil)
+}
+
+// currentUser возвращает id пользователя с действующей сессией, иначе ответ уже записан и возвращается false
+func (h *CreatorHandler) currentUser(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return "", false
+	}
+
+	uv, err := h.authClient.CheckUserVersion(r.Context(), &generatedAuth.AccessDetails{
+		Login:       userDataJWT.Login,
+		Id:          userDataJWT.Id.String(),
+		UserVersion: userDataJWT.UserVersion,
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return "", false
+	}
+	if len(uv.Error) != 0 {
+		utils.Cookie(w, "", "SSID")
+		utils.Response(w, http.StatusForbidden, nil)
+		return "", false
+	}
+	return userDataJWT.Id.String(), true
+}
+
+// currentCreator возвращает id автора, от имени которого сделан запрос. Если пользователь не автор
+// или проверить это не удалось, ответ уже записан и возвращается false.
+func (h *CreatorHandler) currentCreator(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return "", false
+	}
+
+	creatorID, err := h.creatorClient.CheckIfCreator(r.Context(), &generatedCommon.UUIDMessage{Value: userDataJWT.Id.String()})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return "", false
+	}
+
+	if creatorID.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return "", false
+	}
+
+	if creatorID.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return "", false
+	}
+	return creatorID.Value, true
+}
+
+// payoutResponse отвечает 200 на завершённую выплату и 202 на выплату, которая ещё проводится
+func (h *CreatorHandler) payoutResponse(w http.ResponseWriter, payoutProto *generatedCreator.Payout) {
+	var payout models.Payout
+	if err := payout.ProtoPayoutToModel(payoutProto); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if !payout.IsFinal() {
+		utils.Response(w, http.StatusAccepted, payout)
+		return
+	}
+	utils.Response(w, http.StatusOK, payout)
 }
 
 func (h *CreatorHandler) SubscribeCreatorToNotifications(w http.ResponseWriter, r *http.Request) {
@@ -453,7 +874,7 @@ func (h *CreatorHandler) UpdateProfilePhoto(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	file, fileTmp, err := r.FormFile("upload")
+	file, _, err := r.FormFile("upload")
 	if err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
@@ -462,16 +883,20 @@ func (h *CreatorHandler) UpdateProfilePhoto(w http.ResponseWriter, r *http.Reque
 	// проверка типа файла
 	buf, _ := io.ReadAll(file)
 	file.Close()
-	if file, err = fileTmp.Open(); err != nil {
-		h.logger.Error(err)
+	if http.DetectContentType(buf) != "image/jpeg" && http.DetectContentType(buf) != "image/png" && http.DetectContentType(buf) != "image/jpg" {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
-	if http.DetectContentType(buf) != "image/jpeg" && http.DetectContentType(buf) != "image/png" && http.DetectContentType(buf) != "image/jpg" {
+	images, err := imaging.Process(bytes.NewReader(buf), imaging.AvatarSpecs, imaging.Options{Flatten: true})
+	if errors.Is(err, models.WrongData) || errors.Is(err, models.Unsupported) {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
-	defer file.Close()
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
 
 	var oldName uuid.UUID
 	oldName, err = uuid.Parse(r.PostFormValue("path"))
@@ -482,7 +907,7 @@ func (h *CreatorHandler) UpdateProfilePhoto(w http.ResponseWriter, r *http.Reque
 	}
 
 	if oldName != uuid.Nil {
-		err = os.Remove(filepath.Join(models.FolderPath, fmt.Sprintf("%s.jpg", oldName.String())))
+		err = imaging.DeletePhoto(r.Context(), h.store, oldName.String())
 		if err != nil {
 			h.logger.Error(err)
 			utils.Response(w, http.StatusBadRequest, nil)
@@ -503,15 +928,7 @@ func (h *CreatorHandler) UpdateProfilePhoto(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	f, err := os.Create(fmt.Sprintf("%s%s.jpg", models.FolderPath, name.Value))
-	if err != nil {
-		h.logger.Error(err)
-		utils.Response(w, http.StatusInternalServerError, nil)
-		return
-	}
-	defer f.Close()
-
-	if _, err = io.Copy(f, file); err != nil {
+	if _, err = imaging.SavePhoto(r.Context(), h.store, name.Value, images); err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
@@ -588,7 +1005,7 @@ func (h *CreatorHandler) UpdateCoverPhoto(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	file, fileTmp, err := r.FormFile("upload")
+	file, _, err := r.FormFile("upload")
 	if err != nil {
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
@@ -596,16 +1013,20 @@ func (h *CreatorHandler) UpdateCoverPhoto(w http.ResponseWriter, r *http.Request
 	// проверка типа файла
 	buf, _ := io.ReadAll(file)
 	file.Close()
-	if file, err = fileTmp.Open(); err != nil {
-		h.logger.Error(err)
+	if http.DetectContentType(buf) != "image/jpeg" && http.DetectContentType(buf) != "image/png" && http.DetectContentType(buf) != "image/jpg" {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
-	if http.DetectContentType(buf) != "image/jpeg" && http.DetectContentType(buf) != "image/png" && http.DetectContentType(buf) != "image/jpg" {
+	images, err := imaging.Process(bytes.NewReader(buf), imaging.CoverSpecs, imaging.Options{Flatten: true})
+	if errors.Is(err, models.WrongData) || errors.Is(err, models.Unsupported) {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
-	defer file.Close()
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
 
 	var oldName uuid.UUID
 	oldName, err = uuid.Parse(r.PostFormValue("path"))
@@ -616,7 +1037,7 @@ func (h *CreatorHandler) UpdateCoverPhoto(w http.ResponseWriter, r *http.Request
 	}
 
 	if oldName != uuid.Nil {
-		err = os.Remove(models.FolderPath + fmt.Sprintf("%s.jpg", oldName.String()))
+		err = imaging.DeletePhoto(r.Context(), h.store, oldName.String())
 		if err != nil {
 			h.logger.Error(err)
 			utils.Response(w, http.StatusBadRequest, nil)
@@ -637,15 +1058,7 @@ func (h *CreatorHandler) UpdateCoverPhoto(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	f, err := os.Create(fmt.Sprintf("%s%s.jpg", models.FolderPath, name.Value))
-	if err != nil {
-		h.logger.Error(err)
-		utils.Response(w, http.StatusInternalServerError, nil)
-		return
-	}
-	defer f.Close()
-
-	if _, err = io.Copy(f, file); err != nil {
+	if _, err = imaging.SavePhoto(r.Context(), h.store, name.Value, images); err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
@@ -719,29 +1132,104 @@ func (h *CreatorHandler) GetPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if creatorPage.Error == models.InternalError.Error() {
+	if creatorPage.Error == models.InternalError.Error() {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if creatorPage.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	var page models.CreatorPage
+	page, err = h.CreatorPageToModel(creatorPage)
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	page.Sanitize()
+
+	utils.Response(w, http.StatusOK, page)
+}
+
+func (h *CreatorHandler) CreateAim(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	uv, err := h.authClient.CheckUserVersion(r.Context(), &generatedAuth.AccessDetails{
+		Login:       userDataJWT.Login,
+		Id:          userDataJWT.Id.String(),
+		UserVersion: userDataJWT.UserVersion,
+	})
+	if err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if len(uv.Error) != 0 {
+		utils.Cookie(w, "", "SSID")
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+	aimInfo := models.Aim{}
+	err = easyjson.UnmarshalFromReader(r.Body, &aimInfo)
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if !aimInfo.IsValid() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	isCreator, err := h.creatorClient.IsCreator(r.Context(), &generatedCreator.UserCreatorMessage{
+		UserID:    userDataJWT.Id.String(),
+		CreatorID: aimInfo.Creator.String(),
+	})
+
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if isCreator.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if isCreator.Error == models.InternalError.Error() {
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
-	if creatorPage.Error == models.WrongData.Error() {
-		utils.Response(w, http.StatusBadRequest, nil)
+
+	if !isCreator.Flag {
+		utils.Response(w, http.StatusForbidden, nil)
 		return
 	}
 
-	var page models.CreatorPage
-	page, err = h.CreatorPageToModel(creatorPage)
+	aimInfo.Id = uuid.New()
+	aimInfo.MoneyGot = models.NewMoney(0)
+	aimInfo.CompletedAt = nil
+	out, err := h.creatorClient.CreateAim(r.Context(), aimInfo.AimToProto())
 	if err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
-
-	page.Sanitize()
-
-	utils.Response(w, http.StatusOK, page)
+	if out.Error == models.InternalError.Error() {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	utils.Response(w, http.StatusOK, aimInfo.Id)
 }
 
-func (h *CreatorHandler) CreateAim(w http.ResponseWriter, r *http.Request) {
+func (h *CreatorHandler) UpdateAim(w http.ResponseWriter, r *http.Request) {
 	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
 	if err != nil {
 		utils.Response(w, http.StatusUnauthorized, nil)
@@ -762,62 +1250,128 @@ func (h *CreatorHandler) CreateAim(w http.ResponseWriter, r *http.Request) {
 		utils.Response(w, http.StatusForbidden, nil)
 		return
 	}
-	aimInfo := models.Aim{}
-	err = easyjson.UnmarshalFromReader(r.Body, &aimInfo)
+
+	aimUUID, ok := mux.Vars(r)["aim-uuid"]
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	aimID, err := uuid.Parse(aimUUID)
 	if err != nil {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
 
-	if len(aimInfo.Description) > 100 {
+	aimInfo := models.Aim{}
+	err = easyjson.UnmarshalFromReader(r.Body, &aimInfo)
+	if err != nil || !aimInfo.IsValid() {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
 
-	isCreator, err := h.creatorClient.IsCreator(r.Context(), &generatedCreator.UserCreatorMessage{
-		UserID:    userDataJWT.Id.String(),
-		CreatorID: aimInfo.Creator.String(),
-	})
-
+	creatorID, err := h.creatorClient.CheckIfCreator(r.Context(), &generatedCommon.UUIDMessage{Value: userDataJWT.Id.String()})
 	if err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 
-	if isCreator.Error == models.WrongData.Error() {
-		utils.Response(w, http.StatusBadRequest, nil)
+	if creatorID.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusForbidden, nil)
 		return
 	}
 
-	if isCreator.Error == models.InternalError.Error() {
+	if creatorID.Error != "" {
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 
-	if !isCreator.Flag {
-		utils.Response(w, http.StatusForbidden, nil)
+	aimInfo.Id = aimID
+	aimInfo.Creator, err = uuid.Parse(creatorID.Value)
+	if err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 
-	out, err := h.creatorClient.CreateAim(r.Context(), &generatedCreator.Aim{
-		Creator:     aimInfo.Creator.String(),
-		Description: aimInfo.Description,
-		MoneyNeeded: aimInfo.MoneyNeeded,
-		MoneyGot:    aimInfo.MoneyGot,
-	})
+	out, err := h.creatorClient.UpdateAim(r.Context(), aimInfo.AimToProto())
 	if err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
-	if out.Error == models.InternalError.Error() {
+	if out.Error == models.NotFound.Error() || out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	if out.Error != "" {
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
+
+	// сумму цели уменьшили до уже собранной - цель достигнута так же, как при донате
+	if out.Aim != nil && len(out.Aim.CompletedAt) != 0 {
+		for _, topic := range []string{"user", "creator"} {
+			if err = h.notificationApp.SendUserNotification(models.Notification{
+				Topic: fmt.Sprintf("%s-%s", creatorID.Value, topic),
+				Title: "Цель достигнута",
+				Body:  fmt.Sprintf("Цель \"%s\" собрана", out.Aim.Description),
+			}, r.Context()); err != nil {
+				h.logger.Error(err)
+			}
+		}
+	}
 	utils.Response(w, http.StatusOK, nil)
 }
 
+func (h *CreatorHandler) CreatorAims(w http.ResponseWriter, r *http.Request) {
+	creatorUUID, ok := mux.Vars(r)["creator-uuid"]
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	if _, err := uuid.Parse(creatorUUID); err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	if !models.IsAimStatus(status) {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	out, err := h.creatorClient.CreatorAims(r.Context(), &generatedCreator.AimsFilter{
+		CreatorID: creatorUUID,
+		Status:    status,
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	aims := make([]models.Aim, len(out.Aims))
+	for i, v := range out.Aims {
+		if err = aims[i].AimToModel(v); err != nil {
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+		aims[i].Sanitize()
+	}
+
+	utils.Response(w, http.StatusOK, aims)
+}
+
 func (h *CreatorHandler) UpdateCreatorData(w http.ResponseWriter, r *http.Request) {
 	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
 
@@ -928,6 +1482,14 @@ func (h *CreatorHandler) CreatorPageToModel(creatorPage *generatedCreator.Creato
 		return models.CreatorPage{}, models.InternalError
 	}
 
+	page.Aims = make([]models.Aim, len(creatorPage.Aims))
+	for i, aim := range creatorPage.Aims {
+		if err = page.Aims[i].AimToModel(aim); err != nil {
+			h.logger.Error(err)
+			return models.CreatorPage{}, models.InternalError
+		}
+	}
+
 	page.Posts = make([]models.Post, len(creatorPage.Posts))
 	for i, post := range creatorPage.Posts {
 		err = page.Posts[i].PostToModel(post)
@@ -1007,7 +1569,7 @@ func (h *CreatorHandler) DeleteCoverPhoto(w http.ResponseWriter, r *http.Request
 	}
 
 	if oldName != uuid.Nil {
-		err = os.Remove(models.FolderPath + fmt.Sprintf("%s.jpg", oldName.String()))
+		err = imaging.DeletePhoto(r.Context(), h.store, oldName.String())
 		if err != nil {
 			h.logger.Error(err)
 			utils.Response(w, http.StatusBadRequest, nil)
@@ -1099,7 +1661,7 @@ func (h *CreatorHandler) DeleteProfilePhoto(w http.ResponseWriter, r *http.Reque
 	}
 
 	if oldName != uuid.Nil {
-		err = os.Remove(models.FolderPath + fmt.Sprintf("%s.jpg", oldName.String()))
+		err = imaging.DeletePhoto(r.Context(), h.store, oldName.String())
 		if err != nil {
 			h.logger.Error(err)
 			utils.Response(w, http.StatusBadRequest, nil)
@@ -1224,3 +1786,216 @@ func (h *CreatorHandler) Statistics(w http.ResponseWriter, r *http.Request) {
 
 	utils.Response(w, http.StatusOK, statistics)
 }
+
+func (h *CreatorHandler) Donations(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	var limit, offset int64
+	if tmp := r.URL.Query().Get("limit"); tmp != "" {
+		if limit, err = strconv.ParseInt(tmp, 10, 64); err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+	}
+	if tmp := r.URL.Query().Get("offset"); tmp != "" {
+		if offset, err = strconv.ParseInt(tmp, 10, 64); err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+	}
+
+	creatorID, err := h.creatorClient.CheckIfCreator(r.Context(), &generatedCommon.UUIDMessage{Value: userDataJWT.Id.String()})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if creatorID.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if creatorID.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	out, err := h.creatorClient.CreatorDonations(r.Context(), &generatedCreator.DonationsFilter{
+		CreatorID: creatorID.Value,
+		Limit:     limit,
+		Offset:    offset})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	donations := make([]models.Donation, len(out.Donations))
+	for i, v := range out.Donations {
+		if err = donations[i].ProtoDonationToModel(v); err != nil {
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+		donations[i].Sanitize()
+	}
+
+	utils.Response(w, http.StatusOK, donations)
+}
+
+func (h *CreatorHandler) Ledger(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := generatedCreator.LedgerFilter{
+		From: query.Get("from"),
+		To:   query.Get("to"),
+	}
+	for _, v := range []string{filter.From, filter.To} {
+		if v == "" {
+			continue
+		}
+		if _, err = time.Parse(time.RFC3339, v); err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+	}
+	if limit := query.Get("limit"); limit != "" {
+		if filter.Limit, err = strconv.ParseInt(limit, 10, 64); err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+	}
+	if offset := query.Get("offset"); offset != "" {
+		if filter.Offset, err = strconv.ParseInt(offset, 10, 64); err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+	}
+
+	creatorID, err := h.creatorClient.CheckIfCreator(r.Context(), &generatedCommon.UUIDMessage{Value: userDataJWT.Id.String()})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if creatorID.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if creatorID.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	filter.CreatorID = creatorID.Value
+	out, err := h.creatorClient.CreatorLedger(r.Context(), &filter)
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	statement := models.LedgerStatement{
+		Balance: models.MoneyFromProto(out.Balance),
+		Entries: make([]models.LedgerEntry, len(out.Entries)),
+	}
+	for i, v := range out.Entries {
+		if err = statement.Entries[i].ProtoLedgerEntryToModel(v); err != nil {
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+	}
+
+	utils.Response(w, http.StatusOK, statement)
+}
+
+func (h *CreatorHandler) TopSupporters(w http.ResponseWriter, r *http.Request) {
+	creatorUUID, ok := mux.Vars(r)["creator-uuid"]
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	if _, err := uuid.Parse(creatorUUID); err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	from, ok := models.SupportersPeriodStart(r.URL.Query().Get("period"), time.Now())
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	filter := &generatedCreator.SupportersFilter{CreatorID: creatorUUID}
+	if !from.IsZero() {
+		filter.From = from.Format(time.RFC3339)
+	}
+	if tmp := r.URL.Query().Get("limit"); tmp != "" {
+		limit, err := strconv.ParseInt(tmp, 10, 64)
+		if err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+		filter.Limit = limit
+	}
+
+	out, err := h.creatorClient.TopSupporters(r.Context(), filter)
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	supporters := make([]models.Supporter, len(out.Supporters))
+	for i, v := range out.Supporters {
+		if err = supporters[i].ProtoSupporterToModel(v); err != nil {
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+		supporters[i].Sanitize()
+	}
+
+	utils.Response(w, http.StatusOK, supporters)
+}
@@ -11,6 +11,7 @@ import (
 	generatedAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/grpc/generated"
 	mockAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/mocks"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/usecase"
+	mockBlob "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/mocks"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
 	mockCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/mocks"
 	mock "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/mocks"
@@ -20,6 +21,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"image"
 	"image/color"
 	"image/png"
@@ -37,7 +39,7 @@ var subs = []*generatedCommon.Subscription{{
 	Creator:      uuid.New().String(),
 	CreatorName:  "test",
 	CreatorPhoto: uuid.New().String(),
-	MonthCost:    0,
+	MonthCost:    models.NewMoney(0).ToProto(),
 	Title:        "test",
 	Description:  "test",
 }}
@@ -95,15 +97,15 @@ var testUser = models.User{
 var aim = &generated.Aim{
 	Creator:     uuid.New().String(),
 	Description: "test",
-	MoneyNeeded: 100,
-	MoneyGot:    10,
+	MoneyNeeded: models.NewMoney(10000).ToProto(),
+	MoneyGot:    models.NewMoney(1000).ToProto(),
 }
 
 var aimWithErr = &generated.Aim{
 	Creator:     "111",
 	Description: "test",
-	MoneyNeeded: 100,
-	MoneyGot:    10,
+	MoneyNeeded: models.NewMoney(10000).ToProto(),
+	MoneyGot:    models.NewMoney(1000).ToProto(),
 }
 
 var creator = &generated.Creator{
@@ -150,6 +152,7 @@ func TestNewCreatorHandler(t *testing.T) {
 	authClient := mockAuth.NewMockAuthServiceClient(ctl)
 	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
 	notify := mock.NewMockNotificationApp(ctl)
+	store := mockBlob.NewMockBlobStore(ctl)
 
 	logger := zap.NewNop()
 
@@ -161,8 +164,8 @@ func TestNewCreatorHandler(t *testing.T) {
 	}(logger)
 	zapSugar := logger.Sugar()
 
-	testHandler := NewCreatorHandler(creatorClient, authClient, notify, zapSugar)
-	if testHandler.authClient != authClient || testHandler.creatorClient != creatorClient || testHandler.notificationApp != notify {
+	testHandler := NewCreatorHandler(creatorClient, authClient, notify, store, zapSugar)
+	if testHandler.authClient != authClient || testHandler.creatorClient != creatorClient || testHandler.notificationApp != notify || testHandler.store != store {
 		t.Error("bad constructor")
 	}
 }
@@ -376,10 +379,10 @@ func TestCreatorHandler_GetPage(t *testing.T) {
 
 }
 
-var testAim = models.Aim{Creator: uuid.New(), Description: "test", MoneyNeeded: 500, MoneyGot: 0}
+var testAim = models.Aim{Creator: uuid.New(), Description: "test", MoneyNeeded: models.NewMoney(50000)}
 var testAimWithLongDescription = models.Aim{Creator: uuid.New(), Description: "testtesttesttesttesttesttesttesttesttesttesttesttesttesttest" +
 	"testtesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttesttest" +
-	"testtesttesttesttesttesttest", MoneyNeeded: 500, MoneyGot: 0}
+	"testtesttesttesttesttesttest", MoneyNeeded: models.NewMoney(50000)}
 
 func bodyPrepare(val interface{}) []byte {
 	valJSON, err := json.Marshal(&val)
@@ -708,6 +711,129 @@ func TestCreatorHandler_CreateAim(t *testing.T) {
 	}
 }
 
+func TestCreatorHandler_UpdateAim(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	authClient := mockAuth.NewMockAuthServiceClient(ctl)
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+	notify := mock.NewMockNotificationApp(ctl)
+
+	logger := zap.NewNop()
+
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	bdy, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: uuid.New()})
+	aimID := uuid.New().String()
+	creatorID := uuid.New().String()
+
+	prepare := func() *http.Request {
+		r := httptest.NewRequest("PUT", "/aim/"+aimID, bytes.NewReader(bodyPrepare(testAim)))
+		r = mux.SetURLVars(r, map[string]string{"aim-uuid": aimID})
+		r.AddCookie(&http.Cookie{
+			Name:     "SSID",
+			Value:    bdy,
+			Expires:  time.Time{},
+			HttpOnly: true,
+		})
+		authClient.EXPECT().CheckUserVersion(gomock.Any(), gomock.Any()).Return(&generatedAuth.UserVersion{
+			UserVersion: int64(1),
+			Error:       "",
+		}, nil)
+		creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+			Value: creatorID,
+			Error: "",
+		}, nil)
+		return r
+	}
+
+	tests := []struct {
+		name           string
+		mock           func() *http.Request
+		expectedStatus int
+	}{
+		{
+			name: "OK",
+			mock: func() *http.Request {
+				r := prepare()
+				creatorClient.EXPECT().UpdateAim(gomock.Any(), gomock.Any()).Return(&generated.AimMessage{
+					Aim:   &generated.Aim{Id: aimID, Description: testAim.Description},
+					Error: "",
+				}, nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "OK goal reached",
+			mock: func() *http.Request {
+				r := prepare()
+				creatorClient.EXPECT().UpdateAim(gomock.Any(), gomock.Any()).Return(&generated.AimMessage{
+					Aim:   &generated.Aim{Id: aimID, Description: testAim.Description, CompletedAt: time.Now().Format(time.RFC3339)},
+					Error: "",
+				}, nil)
+				notify.EXPECT().SendUserNotification(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Wrong aim id",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("PUT", "/aim/1", bytes.NewReader(bodyPrepare(testAim)))
+				r = mux.SetURLVars(r, map[string]string{"aim-uuid": "1"})
+				r.AddCookie(&http.Cookie{
+					Name:     "SSID",
+					Value:    bdy,
+					Expires:  time.Time{},
+					HttpOnly: true,
+				})
+				authClient.EXPECT().CheckUserVersion(gomock.Any(), gomock.Any()).Return(&generatedAuth.UserVersion{
+					UserVersion: int64(1),
+					Error:       "",
+				}, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not found",
+			mock: func() *http.Request {
+				r := prepare()
+				creatorClient.EXPECT().UpdateAim(gomock.Any(), gomock.Any()).Return(&generated.AimMessage{
+					Error: models.NotFound.Error(),
+				}, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorHandler{
+				creatorClient:   creatorClient,
+				authClient:      authClient,
+				notificationApp: notify,
+				logger:          zapSugar,
+			}
+			w := httptest.NewRecorder()
+			r := test.mock()
+			h.UpdateAim(w, r)
+			require.Equal(t, test.expectedStatus, w.Code, fmt.Errorf("%s :  expected %d, got %d,"+
+				" for test:%s", test.name, test.expectedStatus, w.Code, test.name))
+		})
+	}
+}
+
 func TestCreatorHandler_GetAllCreators(t *testing.T) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
@@ -2544,7 +2670,7 @@ func TestCreatorHandler_GetBalance(t *testing.T) {
 					Error: "",
 				}, nil)
 				creatorClient.EXPECT().GetCreatorBalance(gomock.Any(), gomock.Any()).Return(&generated.CreatorBalance{
-					Balance: 100.1,
+					Balance: models.NewMoney(10010).ToProto(),
 					Error:   ""}, nil)
 				return r
 			},
@@ -2623,7 +2749,7 @@ func TestCreatorHandler_GetBalance(t *testing.T) {
 					Error: "",
 				}, nil)
 				creatorClient.EXPECT().GetCreatorBalance(gomock.Any(), gomock.Any()).Return(&generated.CreatorBalance{
-					Balance: 100.1,
+					Balance: models.NewMoney(10010).ToProto(),
 					Error:   ""}, errors.New("test"))
 				return r
 			},
@@ -2642,7 +2768,7 @@ func TestCreatorHandler_GetBalance(t *testing.T) {
 					Error: "",
 				}, nil)
 				creatorClient.EXPECT().GetCreatorBalance(gomock.Any(), gomock.Any()).Return(&generated.CreatorBalance{
-					Balance: 100.1,
+					Balance: models.NewMoney(10010).ToProto(),
 					Error:   "test"}, nil)
 				return r
 			},
@@ -2666,12 +2792,19 @@ func TestCreatorHandler_GetBalance(t *testing.T) {
 	}
 }
 
-var testTransfer = models.CreatorTransfer{
-	Money:       10,
-	CreatorID:   uuid.New(),
+var testPayout = models.Payout{
+	Money:       models.NewMoney(1000),
 	PhoneNumber: "89999999999",
 }
 
+func testPayoutProto(status string) *generated.Payout {
+	payout := testPayout
+	payout.Id = uuid.New()
+	payout.CreatorID = uuid.New()
+	payout.Status = status
+	return payout.ToProto()
+}
+
 func TestCreatorHandler_TransferMoney(t *testing.T) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
@@ -2721,7 +2854,7 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 			name: "Internal from Creator service CheckIfCreator",
 			mock: func() *http.Request {
 				r := httptest.NewRequest("PUT", "/transferMoney",
-					bytes.NewReader(bodyPrepare(testTransfer)))
+					bytes.NewReader(bodyPrepare(testPayout)))
 
 				setJWTToken(r, bdy)
 
@@ -2737,7 +2870,7 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 			name: "Creator NotFound",
 			mock: func() *http.Request {
 				r := httptest.NewRequest("PUT", "/transferMoney",
-					bytes.NewReader(bodyPrepare(testTransfer)))
+					bytes.NewReader(bodyPrepare(testPayout)))
 
 				setJWTToken(r, bdy)
 
@@ -2753,7 +2886,7 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 			name: "CheckIfCreator internalError",
 			mock: func() *http.Request {
 				r := httptest.NewRequest("PUT", "/transferMoney",
-					bytes.NewReader(bodyPrepare(testTransfer)))
+					bytes.NewReader(bodyPrepare(testPayout)))
 
 				setJWTToken(r, bdy)
 
@@ -2769,7 +2902,7 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 			name: "Wrong Token",
 			mock: func() *http.Request {
 				r := httptest.NewRequest("PUT", "/transferMoney",
-					bytes.NewReader(bodyPrepare(testTransfer)))
+					bytes.NewReader(bodyPrepare(testPayout)))
 
 				setJWTToken(r, "1")
 
@@ -2778,10 +2911,10 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 			expectedStatus: http.StatusUnauthorized,
 		},
 		{
-			name: "Internal err from creator service GetCreatorBalance",
+			name: "OK",
 			mock: func() *http.Request {
 				r := httptest.NewRequest("PUT", "/transferMoney",
-					bytes.NewReader(bodyPrepare(testTransfer)))
+					bytes.NewReader(bodyPrepare(testPayout)))
 
 				setJWTToken(r, bdy)
 
@@ -2789,18 +2922,76 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 					Value: uuid.New().String(),
 					Error: "",
 				}, nil)
-				creatorClient.EXPECT().GetCreatorBalance(gomock.Any(), gomock.Any()).Return(&generated.CreatorBalance{
-					Balance: 100.1,
-					Error:   ""}, errors.New("test"))
+				creatorClient.EXPECT().RequestPayout(gomock.Any(), gomock.Any()).Return(&generated.PayoutMessage{
+					Payout: testPayoutProto(models.PayoutStatusSucceeded),
+					Error:  ""}, nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Payout in progress with idempotency key",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("PUT", "/transferMoney",
+					bytes.NewReader(bodyPrepare(testPayout)))
+				r.Header.Set(models.IdempotencyKeyHeader, "test-key")
+
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: uuid.New().String(),
+					Error: "",
+				}, nil)
+				creatorClient.EXPECT().RequestPayout(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, in *generated.Payout, _ ...grpc.CallOption) (*generated.PayoutMessage, error) {
+						require.Equal(t, "test-key", in.IdempotencyKey)
+						return &generated.PayoutMessage{Payout: testPayoutProto(models.PayoutStatusProcessing)}, nil
+					})
+				return r
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name: "Wrong phone number",
+			mock: func() *http.Request {
+				payout := testPayout
+				payout.PhoneNumber = "phone"
+				r := httptest.NewRequest("PUT", "/transferMoney",
+					bytes.NewReader(bodyPrepare(payout)))
+
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: uuid.New().String(),
+					Error: "",
+				}, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Internal err from creator service RequestPayout",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("PUT", "/transferMoney",
+					bytes.NewReader(bodyPrepare(testPayout)))
+
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: uuid.New().String(),
+					Error: "",
+				}, nil)
+				creatorClient.EXPECT().RequestPayout(gomock.Any(), gomock.Any()).Return(&generated.PayoutMessage{
+					Error: ""}, errors.New("test"))
 				return r
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
 		{
-			name: "GetCreatorBalance internalError",
+			name: "RequestPayout internalError",
 			mock: func() *http.Request {
 				r := httptest.NewRequest("PUT", "/transferMoney",
-					bytes.NewReader(bodyPrepare(testTransfer)))
+					bytes.NewReader(bodyPrepare(testPayout)))
 
 				setJWTToken(r, bdy)
 
@@ -2808,9 +2999,8 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 					Value: uuid.New().String(),
 					Error: "",
 				}, nil)
-				creatorClient.EXPECT().GetCreatorBalance(gomock.Any(), gomock.Any()).Return(&generated.CreatorBalance{
-					Balance: 100.1,
-					Error:   "test"}, nil)
+				creatorClient.EXPECT().RequestPayout(gomock.Any(), gomock.Any()).Return(&generated.PayoutMessage{
+					Error: "test"}, nil)
 				return r
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -2835,7 +3025,7 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 			name: "Not enough money on balance",
 			mock: func() *http.Request {
 				r := httptest.NewRequest("PUT", "/transferMoney",
-					bytes.NewReader(bodyPrepare(testTransfer)))
+					bytes.NewReader(bodyPrepare(testPayout)))
 
 				setJWTToken(r, bdy)
 
@@ -2843,9 +3033,8 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 					Value: uuid.New().String(),
 					Error: "",
 				}, nil)
-				creatorClient.EXPECT().GetCreatorBalance(gomock.Any(), gomock.Any()).Return(&generated.CreatorBalance{
-					Balance: 9,
-					Error:   ""}, nil)
+				creatorClient.EXPECT().RequestPayout(gomock.Any(), gomock.Any()).Return(&generated.PayoutMessage{
+					Error: models.WrongData.Error()}, nil)
 				return r
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -2868,6 +3057,96 @@ func TestCreatorHandler_TransferMoney(t *testing.T) {
 	}
 }
 
+func TestCreatorHandler_GetPayout(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+	zapSugar := zap.NewNop().Sugar()
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	bdy, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: uuid.New()})
+
+	tests := []struct {
+		name           string
+		payoutID       string
+		mock           func()
+		expectedStatus int
+	}{
+		{
+			name:     "OK",
+			payoutID: uuid.New().String(),
+			mock: func() {
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: uuid.New().String(),
+				}, nil)
+				creatorClient.EXPECT().GetPayout(gomock.Any(), gomock.Any()).Return(&generated.PayoutMessage{
+					Payout: testPayoutProto(models.PayoutStatusFailed)}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Processing",
+			payoutID: uuid.New().String(),
+			mock: func() {
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: uuid.New().String(),
+				}, nil)
+				creatorClient.EXPECT().GetPayout(gomock.Any(), gomock.Any()).Return(&generated.PayoutMessage{
+					Payout: testPayoutProto(models.PayoutStatusRequested)}, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "Wrong payout id",
+			payoutID:       "test",
+			mock:           func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:     "Not found",
+			payoutID: uuid.New().String(),
+			mock: func() {
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: uuid.New().String(),
+				}, nil)
+				creatorClient.EXPECT().GetPayout(gomock.Any(), gomock.Any()).Return(&generated.PayoutMessage{
+					Error: models.NotFound.Error()}, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:     "Internal err from creator service GetPayout",
+			payoutID: uuid.New().String(),
+			mock: func() {
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: uuid.New().String(),
+				}, nil)
+				creatorClient.EXPECT().GetPayout(gomock.Any(), gomock.Any()).Return(nil, errors.New("test"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorHandler{
+				creatorClient: creatorClient,
+				logger:        zapSugar,
+			}
+			test.mock()
+			r := httptest.NewRequest("GET", "/payout/"+test.payoutID, nil)
+			r = mux.SetURLVars(r, map[string]string{"payout-uuid": test.payoutID})
+			setJWTToken(r, bdy)
+			w := httptest.NewRecorder()
+			h.GetPayout(w, r)
+			require.Equal(t, test.expectedStatus, w.Code, fmt.Errorf("%s :  expected %d, got %d,"+
+				" for test:%s", test.name, test.expectedStatus, w.Code, test.name))
+		})
+	}
+}
+
 var testToken = models.NotificationToken{Token: "test"}
 
 func TestCreatorHandler_SubscribeCreatorToNotifications(t *testing.T) {
@@ -3294,8 +3573,8 @@ func TestCreatorHandler_Statistics(t *testing.T) {
 					PostsPerMonth:          10,
 					SubscriptionsBought:    10,
 					DonationsCount:         10,
-					MoneyFromDonations:     10,
-					MoneyFromSubscriptions: 10,
+					MoneyFromDonations:     models.NewMoney(1000).ToProto(),
+					MoneyFromSubscriptions: models.NewMoney(1000).ToProto(),
 					NewFollowers:           10,
 					LikesCount:             10,
 					CommentsCount:          10,
@@ -3554,3 +3833,463 @@ func TestCreatorHandler_StatisticsFirstDate(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatorHandler_TopSupporters(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+
+	logger := zap.NewNop()
+
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+
+	creatorID := uuid.New().String()
+
+	tests := []struct {
+		name           string
+		mock           func() *http.Request
+		expectedStatus int
+	}{
+		{
+			name: "OK",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/supporters/"+creatorID+"?period=week", nil)
+				r = mux.SetURLVars(r, map[string]string{"creator-uuid": creatorID})
+
+				creatorClient.EXPECT().TopSupporters(gomock.Any(), gomock.Any()).Return(&generated.SupportersMessage{
+					Supporters: []*generated.Supporter{{
+						UserID:         uuid.New().String(),
+						Name:           "test",
+						ProfilePhoto:   uuid.New().String(),
+						Money:          models.NewMoney(10000).ToProto(),
+						DonationsCount: 2,
+					}},
+					Error: ""}, nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Wrong period",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/supporters/"+creatorID+"?period=decade", nil)
+				r = mux.SetURLVars(r, map[string]string{"creator-uuid": creatorID})
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Wrong limit",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/supporters/"+creatorID+"?limit=1000", nil)
+				r = mux.SetURLVars(r, map[string]string{"creator-uuid": creatorID})
+
+				creatorClient.EXPECT().TopSupporters(gomock.Any(), gomock.Any()).Return(&generated.SupportersMessage{
+					Error: models.WrongData.Error()}, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Wrong creator id",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/supporters/1", nil)
+				r = mux.SetURLVars(r, map[string]string{"creator-uuid": "1"})
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Internal from Creator service",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/supporters/"+creatorID, nil)
+				r = mux.SetURLVars(r, map[string]string{"creator-uuid": creatorID})
+
+				creatorClient.EXPECT().TopSupporters(gomock.Any(), gomock.Any()).Return(nil, errors.New("test"))
+				return r
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorHandler{
+				creatorClient: creatorClient,
+				logger:        zapSugar,
+			}
+			w := httptest.NewRecorder()
+			r := test.mock()
+			h.TopSupporters(w, r)
+			require.Equal(t, test.expectedStatus, w.Code, fmt.Errorf("%s :  expected %d, got %d,"+
+				" for test:%s", test.name, test.expectedStatus, w.Code, test.name))
+		})
+	}
+}
+
+func TestCreatorHandler_AddPayoutDestination(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+	zapSugar := zap.NewNop().Sugar()
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	bdy, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: uuid.New()})
+	destination := models.PayoutDestination{PhoneNumber: "89999999999"}
+	saved := models.PayoutDestination{Id: uuid.New(), CreatorID: uuid.New(), PhoneNumber: destination.PhoneNumber, VerifiedAt: time.Now()}
+
+	tests := []struct {
+		name           string
+		mock           func() *http.Request
+		expectedStatus int
+	}{
+		{
+			name: "OK",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("POST", "/payout/destinations", bytes.NewReader(bodyPrepare(destination)))
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: saved.CreatorID.String()}, nil)
+				creatorClient.EXPECT().AddPayoutDestination(gomock.Any(), gomock.Any()).Return(&generated.PayoutDestinationMessage{
+					Destination: saved.ToProto()}, nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Wrong phone number",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("POST", "/payout/destinations",
+					bytes.NewReader(bodyPrepare(models.PayoutDestination{PhoneNumber: "phone"})))
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: saved.CreatorID.String()}, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Rejected by provider",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("POST", "/payout/destinations", bytes.NewReader(bodyPrepare(destination)))
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: saved.CreatorID.String()}, nil)
+				creatorClient.EXPECT().AddPayoutDestination(gomock.Any(), gomock.Any()).Return(&generated.PayoutDestinationMessage{
+					Error: models.WrongData.Error()}, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not a creator",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("POST", "/payout/destinations", bytes.NewReader(bodyPrepare(destination)))
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Error: models.NotFound.Error()}, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Wrong Token",
+			mock: func() *http.Request {
+				return httptest.NewRequest("POST", "/payout/destinations", bytes.NewReader(bodyPrepare(destination)))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorHandler{
+				creatorClient: creatorClient,
+				logger:        zapSugar,
+			}
+			w := httptest.NewRecorder()
+			r := test.mock()
+			h.AddPayoutDestination(w, r)
+			require.Equal(t, test.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestCreatorHandler_Storage(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+	zapSugar := zap.NewNop().Sugar()
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	bdy, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: uuid.New()})
+	creatorID := uuid.New()
+	usage := models.StorageUsage{Plan: "free", Quota: 1000, Used: 300, Types: []models.MediaTypeUsage{
+		{Type: "video/mp4", Files: 1, Bytes: 200},
+		{Type: "image/png", Files: 2, Bytes: 100},
+	}}
+
+	tests := []struct {
+		name           string
+		mock           func() *http.Request
+		expectedStatus int
+	}{
+		{
+			name: "OK",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/storage", nil)
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: creatorID.String()}, nil)
+				creatorClient.EXPECT().GetStorageUsage(gomock.Any(), &generatedCommon.UUIDMessage{Value: creatorID.String()}).
+					Return(usage.ToProto(), nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Error from creator service",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/storage", nil)
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: creatorID.String()}, nil)
+				creatorClient.EXPECT().GetStorageUsage(gomock.Any(), gomock.Any()).Return(&generated.StorageUsage{
+					Error: models.InternalError.Error()}, nil)
+				return r
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Not a creator",
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/storage", nil)
+				setJWTToken(r, bdy)
+
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Error: models.NotFound.Error()}, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Wrong Token",
+			mock: func() *http.Request {
+				return httptest.NewRequest("GET", "/storage", nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorHandler{
+				creatorClient: creatorClient,
+				logger:        zapSugar,
+			}
+			w := httptest.NewRecorder()
+			r := test.mock()
+			h.Storage(w, r)
+			require.Equal(t, test.expectedStatus, w.Code)
+			if w.Code != http.StatusOK {
+				return
+			}
+			var got models.StorageUsage
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+			require.Equal(t, usage, got)
+		})
+	}
+}
+
+func TestCreatorHandler_SetPayoutSchedule(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+	zapSugar := zap.NewNop().Sugar()
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	bdy, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: uuid.New()})
+	creatorID := uuid.New()
+	schedule := models.PayoutSchedule{DestinationID: uuid.New(), Period: models.PayoutPeriodMonthly, Threshold: models.MinPayoutThreshold}
+	saved := schedule
+	saved.CreatorID = creatorID
+	saved.NextRunAt = models.NextPayoutRun(schedule.Period, time.Now())
+
+	tests := []struct {
+		name           string
+		body           models.PayoutSchedule
+		mock           func()
+		expectedStatus int
+	}{
+		{
+			name: "OK",
+			body: schedule,
+			mock: func() {
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: creatorID.String()}, nil)
+				creatorClient.EXPECT().SetPayoutSchedule(gomock.Any(), gomock.Any()).Return(&generated.PayoutScheduleMessage{
+					Schedule: saved.ToProto()}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Threshold too low",
+			body: models.PayoutSchedule{DestinationID: schedule.DestinationID, Period: schedule.Period, Threshold: models.NewMoney(100)},
+			mock: func() {
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: creatorID.String()}, nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown destination",
+			body: schedule,
+			mock: func() {
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: creatorID.String()}, nil)
+				creatorClient.EXPECT().SetPayoutSchedule(gomock.Any(), gomock.Any()).Return(&generated.PayoutScheduleMessage{
+					Error: models.WrongData.Error()}, nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Internal from Creator service",
+			body: schedule,
+			mock: func() {
+				creatorClient.EXPECT().CheckIfCreator(gomock.Any(), gomock.Any()).Return(&generatedCommon.UUIDResponse{
+					Value: creatorID.String()}, nil)
+				creatorClient.EXPECT().SetPayoutSchedule(gomock.Any(), gomock.Any()).Return(nil, errors.New("test"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorHandler{
+				creatorClient: creatorClient,
+				logger:        zapSugar,
+			}
+			test.mock()
+			r := httptest.NewRequest("PUT", "/payout/schedule", bytes.NewReader(bodyPrepare(test.body)))
+			setJWTToken(r, bdy)
+			w := httptest.NewRecorder()
+			h.SetPayoutSchedule(w, r)
+			require.Equal(t, test.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestCreatorHandler_CreateCommissionRate(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	authClient := mockAuth.NewMockAuthServiceClient(ctl)
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	bdy, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: uuid.New()})
+	rate := models.CommissionRate{RateBP: 1500, Description: "Новая базовая ставка"}
+
+	prepare := func() *http.Request {
+		r := httptest.NewRequest("POST", "/admin/commission", bytes.NewReader(bodyPrepare(rate)))
+		r.AddCookie(&http.Cookie{
+			Name:     "SSID",
+			Value:    bdy,
+			Expires:  time.Time{},
+			HttpOnly: true,
+		})
+		authClient.EXPECT().CheckUserVersion(gomock.Any(), gomock.Any()).Return(&generatedAuth.UserVersion{
+			UserVersion: int64(1),
+			Error:       "",
+		}, nil)
+		return r
+	}
+
+	tests := []struct {
+		name           string
+		mock           func() *http.Request
+		expectedStatus int
+	}{
+		{
+			name: "OK",
+			mock: func() *http.Request {
+				r := prepare()
+				creatorClient.EXPECT().CreateCommissionRate(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in *generated.CommissionRateRequest, opts ...interface{}) (*generated.CommissionRateMessage, error) {
+						// без starts_at ставка действует с момента создания, время ставит сервис
+						require.Empty(t, in.Rate.StartsAt)
+						in.Rate.Id = uuid.New().String()
+						in.Rate.StartsAt = time.Now().Format(time.RFC3339)
+						return &generated.CommissionRateMessage{Rate: in.Rate, Error: ""}, nil
+					})
+				return r
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not admin",
+			mock: func() *http.Request {
+				r := prepare()
+				creatorClient.EXPECT().CreateCommissionRate(gomock.Any(), gomock.Any()).Return(&generated.CommissionRateMessage{
+					Error: models.Forbbiden.Error(),
+				}, nil)
+				return r
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Wrong rate",
+			mock: func() *http.Request {
+				r := prepare()
+				creatorClient.EXPECT().CreateCommissionRate(gomock.Any(), gomock.Any()).Return(&generated.CommissionRateMessage{
+					Error: models.WrongData.Error(),
+				}, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Unauthorized",
+			mock: func() *http.Request {
+				return httptest.NewRequest("POST", "/admin/commission", bytes.NewReader(bodyPrepare(rate)))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorHandler{
+				creatorClient: creatorClient,
+				authClient:    authClient,
+				logger:        zap.NewNop().Sugar(),
+			}
+			w := httptest.NewRecorder()
+			r := test.mock()
+			h.CreateCommissionRate(w, r)
+			require.Equal(t, test.expectedStatus, w.Code, fmt.Errorf("%s :  expected %d, got %d,"+
+				" for test:%s", test.name, test.expectedStatus, w.Code, test.name))
+		})
+	}
+}
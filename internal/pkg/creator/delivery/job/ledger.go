@@ -0,0 +1,73 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator"
+	"go.uber.org/zap"
+	"os"
+	"time"
+)
+
+const defaultLedgerCheckInterval = time.Hour
+
+// GetLedgerCheckInterval reads LEDGER_CHECK_INTERVAL (time.Duration string) from the environment.
+func GetLedgerCheckInterval() (time.Duration, error) {
+	return getInterval("LEDGER_CHECK_INTERVAL", defaultLedgerCheckInterval)
+}
+
+func getInterval(name string, defaultInterval time.Duration) (time.Duration, error) {
+	interval, flag := os.LookupEnv(name)
+	if !flag {
+		return defaultInterval, nil
+	}
+	tmp, err := time.ParseDuration(interval)
+	if err != nil || tmp <= 0 {
+		return 0, errors.New("wrong " + name + " value")
+	}
+	return tmp, nil
+}
+
+// LedgerJob периодически сверяет creator.balance с журналом проводок и пишет расхождения в лог
+type LedgerJob struct {
+	uc       creator.CreatorUsecase
+	interval time.Duration
+	logger   *zap.SugaredLogger
+}
+
+func NewLedgerJob(uc creator.CreatorUsecase, interval time.Duration, logger *zap.SugaredLogger) *LedgerJob {
+	return &LedgerJob{
+		uc:       uc,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+func (j *LedgerJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	j.Process(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.Process(ctx)
+		}
+	}
+}
+
+func (j *LedgerJob) Process(ctx context.Context) []models.LedgerMismatch {
+	mismatches, err := j.uc.CheckLedger(ctx)
+	if err != nil {
+		j.logger.Error(err)
+		return nil
+	}
+	for _, mismatch := range mismatches {
+		j.logger.Errorf("ledger mismatch for creator %s: balance %s, ledger %s",
+			mismatch.CreatorId, mismatch.Balance, mismatch.LedgerBalance)
+	}
+	return mismatches
+}
@@ -0,0 +1,74 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	mockCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestGetLedgerCheckInterval(t *testing.T) {
+	tests := []struct {
+		name        string
+		interval    string
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{
+			name:     "OK",
+			interval: "15m",
+			expected: 15 * time.Minute,
+		},
+		{
+			name:        "WrongInterval",
+			interval:    "day",
+			expectedErr: true,
+		},
+		{
+			name:        "Negative",
+			interval:    "-1h",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			os.Setenv("LEDGER_CHECK_INTERVAL", test.interval)
+			defer os.Unsetenv("LEDGER_CHECK_INTERVAL")
+
+			interval, err := GetLedgerCheckInterval()
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, interval)
+		})
+	}
+
+	interval, err := GetLedgerCheckInterval()
+	require.NoError(t, err)
+	require.Equal(t, defaultLedgerCheckInterval, interval)
+}
+
+func TestLedgerJob_Process(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	creatorUsecase := mockCreator.NewMockCreatorUsecase(ctl)
+
+	mismatch := models.LedgerMismatch{CreatorId: uuid.New(), Balance: models.NewMoney(1000), LedgerBalance: models.NewMoney(900)}
+	j := NewLedgerJob(creatorUsecase, time.Hour, zap.NewNop().Sugar())
+
+	creatorUsecase.EXPECT().CheckLedger(gomock.Any()).Return([]models.LedgerMismatch{mismatch}, nil)
+	require.Equal(t, []models.LedgerMismatch{mismatch}, j.Process(context.Background()))
+
+	creatorUsecase.EXPECT().CheckLedger(gomock.Any()).Return(nil, errors.New("test"))
+	require.Empty(t, j.Process(context.Background()))
+}
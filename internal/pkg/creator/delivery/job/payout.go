@@ -0,0 +1,64 @@
+package job
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification"
+	"go.uber.org/zap"
+	"time"
+)
+
+const defaultPayoutRetryInterval = time.Minute
+
+// GetPayoutRetryInterval reads PAYOUT_RETRY_INTERVAL (time.Duration string) from the environment.
+func GetPayoutRetryInterval() (time.Duration, error) {
+	return getInterval("PAYOUT_RETRY_INTERVAL", defaultPayoutRetryInterval)
+}
+
+// PayoutJob периодически повторяет незавершённые выплаты и сообщает автору о завершённых
+type PayoutJob struct {
+	uc              creator.CreatorUsecase
+	notificationApp notification.NotificationApp
+	interval        time.Duration
+	logger          *zap.SugaredLogger
+}
+
+func NewPayoutJob(uc creator.CreatorUsecase, na notification.NotificationApp, interval time.Duration, logger *zap.SugaredLogger) *PayoutJob {
+	return &PayoutJob{
+		uc:              uc,
+		notificationApp: na,
+		interval:        interval,
+		logger:          logger,
+	}
+}
+
+func (j *PayoutJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	j.Process(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.Process(ctx)
+		}
+	}
+}
+
+func (j *PayoutJob) Process(ctx context.Context) []models.Payout {
+	payouts, err := j.uc.RetryPayouts(ctx)
+	if err != nil {
+		j.logger.Error(err)
+		return nil
+	}
+	for _, payout := range payouts {
+		if payout.Status == models.PayoutStatusFailed {
+			j.logger.Errorf("payout %s for creator %s failed: %s", payout.Id, payout.CreatorID, payout.LastError)
+		}
+		notifyPayout(ctx, j.notificationApp, payout)
+	}
+	return payouts
+}
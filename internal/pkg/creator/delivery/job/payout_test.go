@@ -0,0 +1,39 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	mockCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/mocks"
+	mockNotification "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestPayoutJob_Process(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	creatorUsecase := mockCreator.NewMockCreatorUsecase(ctl)
+	notificationApp := mockNotification.NewMockNotificationApp(ctl)
+
+	payouts := []models.Payout{
+		{Id: uuid.New(), CreatorID: uuid.New(), Status: models.PayoutStatusSucceeded},
+		{Id: uuid.New(), CreatorID: uuid.New(), Status: models.PayoutStatusFailed, LastError: "test"},
+		{Id: uuid.New(), CreatorID: uuid.New(), Status: models.PayoutStatusProcessing},
+	}
+	j := NewPayoutJob(creatorUsecase, notificationApp, time.Minute, zap.NewNop().Sugar())
+
+	creatorUsecase.EXPECT().RetryPayouts(gomock.Any()).Return(payouts, nil)
+	// о выплате, которая ещё проводится, автору не сообщают
+	for _, payout := range payouts[:2] {
+		notificationApp.EXPECT().SendUserNotification(mockNotification.Topic(payout.CreatorID.String()+"-creator"), gomock.Any()).Return(nil)
+	}
+	require.Equal(t, payouts, j.Process(context.Background()))
+
+	creatorUsecase.EXPECT().RetryPayouts(gomock.Any()).Return(nil, errors.New("test"))
+	require.Empty(t, j.Process(context.Background()))
+}
@@ -0,0 +1,83 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification"
+	"go.uber.org/zap"
+	"time"
+)
+
+const defaultPayoutScheduleInterval = time.Hour
+
+// GetPayoutScheduleInterval reads PAYOUT_SCHEDULE_INTERVAL (time.Duration string) from the environment.
+func GetPayoutScheduleInterval() (time.Duration, error) {
+	return getInterval("PAYOUT_SCHEDULE_INTERVAL", defaultPayoutScheduleInterval)
+}
+
+// PayoutScheduleJob периодически запускает автоматические выплаты авторов и сообщает автору о результате,
+// в том числе о запуске, в котором выплату не удалось создать.
+// Выплата, которая осталась в processing, завершается джобой повторов, она и пришлёт уведомление.
+type PayoutScheduleJob struct {
+	uc              creator.CreatorUsecase
+	notificationApp notification.NotificationApp
+	interval        time.Duration
+	logger          *zap.SugaredLogger
+}
+
+func NewPayoutScheduleJob(uc creator.CreatorUsecase, na notification.NotificationApp, interval time.Duration, logger *zap.SugaredLogger) *PayoutScheduleJob {
+	return &PayoutScheduleJob{
+		uc:              uc,
+		notificationApp: na,
+		interval:        interval,
+		logger:          logger,
+	}
+}
+
+func (j *PayoutScheduleJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	j.Process(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.Process(ctx)
+		}
+	}
+}
+
+func (j *PayoutScheduleJob) Process(ctx context.Context) []models.Payout {
+	payouts, err := j.uc.RunPayoutSchedules(ctx)
+	if err != nil {
+		j.logger.Error(err)
+		return nil
+	}
+	for _, payout := range payouts {
+		notifyPayout(ctx, j.notificationApp, payout)
+	}
+	return payouts
+}
+
+// notifyPayout сообщает автору о завершённой выплате, о незавершённой ничего не отправляет
+func notifyPayout(ctx context.Context, na notification.NotificationApp, payout models.Payout) {
+	topic := fmt.Sprintf("%s-%s", payout.CreatorID, "creator")
+	switch payout.Status {
+	case models.PayoutStatusSucceeded:
+		_ = na.SendUserNotification(models.Notification{
+			Topic: topic,
+			Title: "Выплата проведена",
+			Body:  fmt.Sprintf("На ваш кошелёк переведено %s ₽", payout.Money),
+		}, ctx)
+	case models.PayoutStatusFailed:
+		_ = na.SendUserNotification(models.Notification{
+			Topic: topic,
+			Title: "Выплата не прошла",
+			Body:  fmt.Sprintf("Не удалось перевести %s ₽, деньги остались на балансе", payout.Money),
+		}, ctx)
+	}
+}
@@ -0,0 +1,36 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	mockCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/mocks"
+	mockNotification "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestPayoutScheduleJob_Process(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	creatorUsecase := mockCreator.NewMockCreatorUsecase(ctl)
+	notificationApp := mockNotification.NewMockNotificationApp(ctl)
+
+	succeeded := models.Payout{Id: uuid.New(), CreatorID: uuid.New(), Money: models.NewMoney(10000), Status: models.PayoutStatusSucceeded}
+	j := NewPayoutScheduleJob(creatorUsecase, notificationApp, time.Hour, zap.NewNop().Sugar())
+
+	// выплату по расписанию не удалось создать - автор всё равно получает уведомление
+	failed := models.Payout{CreatorID: uuid.New(), Money: models.NewMoney(20000), Status: models.PayoutStatusFailed, LastError: models.InternalError.Error()}
+
+	creatorUsecase.EXPECT().RunPayoutSchedules(gomock.Any()).Return([]models.Payout{succeeded, failed}, nil)
+	notificationApp.EXPECT().SendUserNotification(mockNotification.Topic(succeeded.CreatorID.String()+"-creator"), gomock.Any()).Return(nil)
+	notificationApp.EXPECT().SendUserNotification(mockNotification.Topic(failed.CreatorID.String()+"-creator"), gomock.Any()).Return(nil)
+	require.Equal(t, []models.Payout{succeeded, failed}, j.Process(context.Background()))
+
+	creatorUsecase.EXPECT().RunPayoutSchedules(gomock.Any()).Return(nil, errors.New("test"))
+	require.Empty(t, j.Process(context.Background()))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	"github.com/google/uuid"
+	"time"
 )
 
 //go:generate mockgen -source=interfaces.go -destination=./mocks/creator_mock.go -package=mock
@@ -11,6 +12,8 @@ import (
 type CreatorUsecase interface {
 	GetPage(ctx context.Context, userID, creatorID uuid.UUID) (models.CreatorPage, error)
 	CreateAim(ctx context.Context, aimInfo models.Aim) error
+	UpdateAim(ctx context.Context, aimInfo models.Aim) (models.Aim, error)
+	CreatorAims(ctx context.Context, creatorID uuid.UUID, status string) ([]models.Aim, error)
 	GetAllCreators(ctx context.Context) ([]models.Creator, error)
 	FindCreators(ctx context.Context, keyword string) ([]models.Creator, error)
 	UpdateCreatorData(ctx context.Context, updateData models.UpdateCreatorInfo) error
@@ -23,14 +26,35 @@ type CreatorUsecase interface {
 	Statistics(ctx context.Context, statsInput models.StatisticsDates) (models.Statistics, error)
 	StatisticsFirstDate(ctx context.Context, creatorID uuid.UUID) (string, error)
 	CreatorNotificationInfo(ctx context.Context, creatorID uuid.UUID) (models.NotificationCreatorInfo, error)
-	GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (float32, error)
-	UpdateBalance(ctx context.Context, transfer models.CreatorTransfer) (float32, error)
+	GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (models.CreatorBalance, error)
+	GetUploadLimit(ctx context.Context, creatorID uuid.UUID) (int64, error)
+	GetStorageUsage(ctx context.Context, creatorID uuid.UUID) (models.StorageUsage, error)
+	CheckStorageQuota(ctx context.Context, creatorID uuid.UUID, size int64) error
+	RequestPayout(ctx context.Context, payout models.Payout) (models.Payout, error)
+	GetPayout(ctx context.Context, creatorID, payoutID uuid.UUID) (models.Payout, error)
+	RetryPayouts(ctx context.Context) ([]models.Payout, error)
+	AddPayoutDestination(ctx context.Context, destination models.PayoutDestination) (models.PayoutDestination, error)
+	PayoutDestinations(ctx context.Context, creatorID uuid.UUID) ([]models.PayoutDestination, error)
+	DeletePayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) error
+	GetPayoutSchedule(ctx context.Context, creatorID uuid.UUID) (models.PayoutSchedule, error)
+	SetPayoutSchedule(ctx context.Context, schedule models.PayoutSchedule) (models.PayoutSchedule, error)
+	DeletePayoutSchedule(ctx context.Context, creatorID uuid.UUID) error
+	RunPayoutSchedules(ctx context.Context) ([]models.Payout, error)
+	CreatorDonations(ctx context.Context, creatorID uuid.UUID, limit, offset int64) ([]models.Donation, error)
+	TopSupporters(ctx context.Context, creatorID uuid.UUID, from time.Time, limit int64) ([]models.Supporter, error)
+	CreatorLedger(ctx context.Context, filter models.LedgerFilter) (models.LedgerStatement, error)
+	CheckLedger(ctx context.Context) ([]models.LedgerMismatch, error)
+	CreateCommissionRate(ctx context.Context, adminID uuid.UUID, rate models.CommissionRate) (models.CommissionRate, error)
+	EndCommissionRate(ctx context.Context, adminID, rateID uuid.UUID, endsAt time.Time) error
+	SetDefaultCommissionRate(ctx context.Context, rateBP int64) error
 }
 
 type CreatorRepo interface {
 	GetCreatorSubs(ctx context.Context, creatorID uuid.UUID) ([]models.Subscription, error)
 	GetPage(ctx context.Context, userID, creatorID uuid.UUID) (models.CreatorPage, error)
 	CreateAim(ctx context.Context, aimInfo models.Aim) error
+	UpdateAim(ctx context.Context, aimInfo models.Aim) (models.Aim, error)
+	CreatorAims(ctx context.Context, creatorID uuid.UUID, status string) ([]models.Aim, error)
 	GetAllCreators(ctx context.Context) ([]models.Creator, error)
 	FindCreators(ctx context.Context, keyword string) ([]models.Creator, error)
 	UpdateCreatorData(ctx context.Context, updateData models.UpdateCreatorInfo) error
@@ -43,6 +67,32 @@ type CreatorRepo interface {
 	Statistics(ctx context.Context, statsInput models.StatisticsDates) (models.Statistics, error)
 	StatisticsFirstDate(ctx context.Context, creatorID uuid.UUID) (string, error)
 	CreatorNotificationInfo(ctx context.Context, creatorID uuid.UUID) (models.NotificationCreatorInfo, error)
-	GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (float32, error)
-	UpdateBalance(ctx context.Context, transfer models.CreatorTransfer) (float32, error)
+	GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (models.Money, error)
+	GetUploadLimit(ctx context.Context, creatorID uuid.UUID) (int64, error)
+	GetStorageUsage(ctx context.Context, creatorID uuid.UUID) (models.StorageUsage, error)
+	CreatorIncome(ctx context.Context, creatorID uuid.UUID) (models.Money, models.Money, error)
+	CreatePayout(ctx context.Context, payout models.Payout) (models.Payout, error)
+	GetPayout(ctx context.Context, creatorID, payoutID uuid.UUID) (models.Payout, error)
+	ClaimPayout(ctx context.Context, payoutID uuid.UUID) (models.Payout, error)
+	ClaimDuePayouts(ctx context.Context, limit int64) ([]models.Payout, error)
+	UpdatePayoutStatus(ctx context.Context, payout models.Payout, from string) error
+	CompletePayout(ctx context.Context, payout models.Payout) (models.Payout, error)
+	GetAvailableBalance(ctx context.Context, creatorID uuid.UUID) (models.Money, error)
+	AddPayoutDestination(ctx context.Context, destination models.PayoutDestination) (models.PayoutDestination, error)
+	PayoutDestinations(ctx context.Context, creatorID uuid.UUID) ([]models.PayoutDestination, error)
+	GetPayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) (models.PayoutDestination, error)
+	DeletePayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) error
+	GetPayoutSchedule(ctx context.Context, creatorID uuid.UUID) (models.PayoutSchedule, error)
+	SetPayoutSchedule(ctx context.Context, schedule models.PayoutSchedule) error
+	DeletePayoutSchedule(ctx context.Context, creatorID uuid.UUID) error
+	ClaimDuePayoutSchedules(ctx context.Context, now time.Time, limit int64) ([]models.PayoutSchedule, error)
+	MovePayoutSchedule(ctx context.Context, schedule models.PayoutSchedule, next time.Time) error
+	CreatorDonations(ctx context.Context, creatorID uuid.UUID, limit, offset int64) ([]models.Donation, error)
+	TopSupporters(ctx context.Context, creatorID uuid.UUID, from time.Time, limit int64) ([]models.Supporter, error)
+	CreatorLedger(ctx context.Context, filter models.LedgerFilter) ([]models.LedgerEntry, error)
+	LedgerMismatches(ctx context.Context) ([]models.LedgerMismatch, error)
+	IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error)
+	AddCommissionRate(ctx context.Context, rate models.CommissionRate) (models.CommissionRate, error)
+	EndCommissionRate(ctx context.Context, rateID uuid.UUID, endsAt time.Time) error
+	SetDefaultCommissionRate(ctx context.Context, rateBP int64) error
 }
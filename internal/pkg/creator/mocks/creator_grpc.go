@@ -57,6 +57,26 @@ func (mr *MockCreatorServiceClientMockRecorder) AddAttach(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttach", reflect.TypeOf((*MockCreatorServiceClient)(nil).AddAttach), varargs...)
 }
 
+// AddDownload mocks base method.
+func (m *MockCreatorServiceClient) AddDownload(ctx context.Context, in *generated.DownloadMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDownload", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDownload indicates an expected call of AddDownload.
+func (mr *MockCreatorServiceClientMockRecorder) AddDownload(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDownload", reflect.TypeOf((*MockCreatorServiceClient)(nil).AddDownload), varargs...)
+}
+
 // AddLike mocks base method.
 func (m *MockCreatorServiceClient) AddLike(ctx context.Context, in *generated.PostUserMessage, opts ...grpc.CallOption) (*generated.Like, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +117,26 @@ func (mr *MockCreatorServiceClientMockRecorder) AddLikeComment(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLikeComment", reflect.TypeOf((*MockCreatorServiceClient)(nil).AddLikeComment), varargs...)
 }
 
+// AddPayoutDestination mocks base method.
+func (m *MockCreatorServiceClient) AddPayoutDestination(ctx context.Context, in *generated.PayoutDestination, opts ...grpc.CallOption) (*generated.PayoutDestinationMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddPayoutDestination", varargs...)
+	ret0, _ := ret[0].(*generated.PayoutDestinationMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPayoutDestination indicates an expected call of AddPayoutDestination.
+func (mr *MockCreatorServiceClientMockRecorder) AddPayoutDestination(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPayoutDestination", reflect.TypeOf((*MockCreatorServiceClient)(nil).AddPayoutDestination), varargs...)
+}
+
 // CheckIfCreator mocks base method.
 func (m *MockCreatorServiceClient) CheckIfCreator(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.UUIDResponse, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +157,26 @@ func (mr *MockCreatorServiceClientMockRecorder) CheckIfCreator(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfCreator", reflect.TypeOf((*MockCreatorServiceClient)(nil).CheckIfCreator), varargs...)
 }
 
+// CheckStorageQuota mocks base method.
+func (m *MockCreatorServiceClient) CheckStorageQuota(ctx context.Context, in *generated.StorageQuotaMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckStorageQuota", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckStorageQuota indicates an expected call of CheckStorageQuota.
+func (mr *MockCreatorServiceClientMockRecorder) CheckStorageQuota(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStorageQuota", reflect.TypeOf((*MockCreatorServiceClient)(nil).CheckStorageQuota), varargs...)
+}
+
 // CreateAim mocks base method.
 func (m *MockCreatorServiceClient) CreateAim(ctx context.Context, in *generated.Aim, opts ...grpc.CallOption) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +217,26 @@ func (mr *MockCreatorServiceClientMockRecorder) CreateComment(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCreatorServiceClient)(nil).CreateComment), varargs...)
 }
 
+// CreateCommissionRate mocks base method.
+func (m *MockCreatorServiceClient) CreateCommissionRate(ctx context.Context, in *generated.CommissionRateRequest, opts ...grpc.CallOption) (*generated.CommissionRateMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCommissionRate", varargs...)
+	ret0, _ := ret[0].(*generated.CommissionRateMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCommissionRate indicates an expected call of CreateCommissionRate.
+func (mr *MockCreatorServiceClientMockRecorder) CreateCommissionRate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommissionRate", reflect.TypeOf((*MockCreatorServiceClient)(nil).CreateCommissionRate), varargs...)
+}
+
 // CreatePost mocks base method.
 func (m *MockCreatorServiceClient) CreatePost(ctx context.Context, in *generated.PostCreationData, opts ...grpc.CallOption) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +277,66 @@ func (mr *MockCreatorServiceClientMockRecorder) CreateSubscription(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockCreatorServiceClient)(nil).CreateSubscription), varargs...)
 }
 
+// CreatorAims mocks base method.
+func (m *MockCreatorServiceClient) CreatorAims(ctx context.Context, in *generated.AimsFilter, opts ...grpc.CallOption) (*generated.AimsMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatorAims", varargs...)
+	ret0, _ := ret[0].(*generated.AimsMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorAims indicates an expected call of CreatorAims.
+func (mr *MockCreatorServiceClientMockRecorder) CreatorAims(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorAims", reflect.TypeOf((*MockCreatorServiceClient)(nil).CreatorAims), varargs...)
+}
+
+// CreatorDonations mocks base method.
+func (m *MockCreatorServiceClient) CreatorDonations(ctx context.Context, in *generated.DonationsFilter, opts ...grpc.CallOption) (*generated.DonationsMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatorDonations", varargs...)
+	ret0, _ := ret[0].(*generated.DonationsMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorDonations indicates an expected call of CreatorDonations.
+func (mr *MockCreatorServiceClientMockRecorder) CreatorDonations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorDonations", reflect.TypeOf((*MockCreatorServiceClient)(nil).CreatorDonations), varargs...)
+}
+
+// CreatorLedger mocks base method.
+func (m *MockCreatorServiceClient) CreatorLedger(ctx context.Context, in *generated.LedgerFilter, opts ...grpc.CallOption) (*generated.LedgerMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatorLedger", varargs...)
+	ret0, _ := ret[0].(*generated.LedgerMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorLedger indicates an expected call of CreatorLedger.
+func (mr *MockCreatorServiceClientMockRecorder) CreatorLedger(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorLedger", reflect.TypeOf((*MockCreatorServiceClient)(nil).CreatorLedger), varargs...)
+}
+
 // CreatorNotificationInfo mocks base method.
 func (m *MockCreatorServiceClient) CreatorNotificationInfo(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*generated.NotificationCreatorInfo, error) {
 	m.ctrl.T.Helper()
@@ -317,6 +457,46 @@ func (mr *MockCreatorServiceClientMockRecorder) DeleteCoverPhoto(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCoverPhoto", reflect.TypeOf((*MockCreatorServiceClient)(nil).DeleteCoverPhoto), varargs...)
 }
 
+// DeletePayoutDestination mocks base method.
+func (m *MockCreatorServiceClient) DeletePayoutDestination(ctx context.Context, in *generated.PayoutDestinationCreatorMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePayoutDestination", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePayoutDestination indicates an expected call of DeletePayoutDestination.
+func (mr *MockCreatorServiceClientMockRecorder) DeletePayoutDestination(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayoutDestination", reflect.TypeOf((*MockCreatorServiceClient)(nil).DeletePayoutDestination), varargs...)
+}
+
+// DeletePayoutSchedule mocks base method.
+func (m *MockCreatorServiceClient) DeletePayoutSchedule(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePayoutSchedule", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePayoutSchedule indicates an expected call of DeletePayoutSchedule.
+func (mr *MockCreatorServiceClientMockRecorder) DeletePayoutSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayoutSchedule", reflect.TypeOf((*MockCreatorServiceClient)(nil).DeletePayoutSchedule), varargs...)
+}
+
 // DeletePost mocks base method.
 func (m *MockCreatorServiceClient) DeletePost(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -437,6 +617,26 @@ func (mr *MockCreatorServiceClientMockRecorder) EditSubscription(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditSubscription", reflect.TypeOf((*MockCreatorServiceClient)(nil).EditSubscription), varargs...)
 }
 
+// EndCommissionRate mocks base method.
+func (m *MockCreatorServiceClient) EndCommissionRate(ctx context.Context, in *generated.EndCommissionRateRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EndCommissionRate", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndCommissionRate indicates an expected call of EndCommissionRate.
+func (mr *MockCreatorServiceClientMockRecorder) EndCommissionRate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndCommissionRate", reflect.TypeOf((*MockCreatorServiceClient)(nil).EndCommissionRate), varargs...)
+}
+
 // FindCreators mocks base method.
 func (m *MockCreatorServiceClient) FindCreators(ctx context.Context, in *generated.KeywordMessage, opts ...grpc.CallOption) (*generated.CreatorsMessage, error) {
 	m.ctrl.T.Helper()
@@ -477,6 +677,26 @@ func (mr *MockCreatorServiceClientMockRecorder) GetAllCreators(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCreators", reflect.TypeOf((*MockCreatorServiceClient)(nil).GetAllCreators), varargs...)
 }
 
+// GetAttachment mocks base method.
+func (m *MockCreatorServiceClient) GetAttachment(ctx context.Context, in *generated.PostAttachMessage, opts ...grpc.CallOption) (*generated.AttachmentMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAttachment", varargs...)
+	ret0, _ := ret[0].(*generated.AttachmentMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockCreatorServiceClientMockRecorder) GetAttachment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockCreatorServiceClient)(nil).GetAttachment), varargs...)
+}
+
 // GetCreatorBalance mocks base method.
 func (m *MockCreatorServiceClient) GetCreatorBalance(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*generated.CreatorBalance, error) {
 	m.ctrl.T.Helper()
@@ -557,6 +777,46 @@ func (mr *MockCreatorServiceClientMockRecorder) GetPage(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockCreatorServiceClient)(nil).GetPage), varargs...)
 }
 
+// GetPayout mocks base method.
+func (m *MockCreatorServiceClient) GetPayout(ctx context.Context, in *generated.PayoutCreatorMessage, opts ...grpc.CallOption) (*generated.PayoutMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPayout", varargs...)
+	ret0, _ := ret[0].(*generated.PayoutMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayout indicates an expected call of GetPayout.
+func (mr *MockCreatorServiceClientMockRecorder) GetPayout(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayout", reflect.TypeOf((*MockCreatorServiceClient)(nil).GetPayout), varargs...)
+}
+
+// GetPayoutSchedule mocks base method.
+func (m *MockCreatorServiceClient) GetPayoutSchedule(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*generated.PayoutScheduleMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPayoutSchedule", varargs...)
+	ret0, _ := ret[0].(*generated.PayoutScheduleMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutSchedule indicates an expected call of GetPayoutSchedule.
+func (mr *MockCreatorServiceClientMockRecorder) GetPayoutSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutSchedule", reflect.TypeOf((*MockCreatorServiceClient)(nil).GetPayoutSchedule), varargs...)
+}
+
 // GetPost mocks base method.
 func (m *MockCreatorServiceClient) GetPost(ctx context.Context, in *generated.PostUserMessage, opts ...grpc.CallOption) (*generated.PostWithComments, error) {
 	m.ctrl.T.Helper()
@@ -577,6 +837,66 @@ func (mr *MockCreatorServiceClientMockRecorder) GetPost(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPost", reflect.TypeOf((*MockCreatorServiceClient)(nil).GetPost), varargs...)
 }
 
+// GetStorageUsage mocks base method.
+func (m *MockCreatorServiceClient) GetStorageUsage(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*generated.StorageUsage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStorageUsage", varargs...)
+	ret0, _ := ret[0].(*generated.StorageUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageUsage indicates an expected call of GetStorageUsage.
+func (mr *MockCreatorServiceClientMockRecorder) GetStorageUsage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageUsage", reflect.TypeOf((*MockCreatorServiceClient)(nil).GetStorageUsage), varargs...)
+}
+
+// GetUploadLimit mocks base method.
+func (m *MockCreatorServiceClient) GetUploadLimit(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*generated.UploadLimitMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUploadLimit", varargs...)
+	ret0, _ := ret[0].(*generated.UploadLimitMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadLimit indicates an expected call of GetUploadLimit.
+func (mr *MockCreatorServiceClientMockRecorder) GetUploadLimit(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadLimit", reflect.TypeOf((*MockCreatorServiceClient)(nil).GetUploadLimit), varargs...)
+}
+
+// HoldBlob mocks base method.
+func (m *MockCreatorServiceClient) HoldBlob(ctx context.Context, in *generated.KeywordMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HoldBlob", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldBlob indicates an expected call of HoldBlob.
+func (mr *MockCreatorServiceClientMockRecorder) HoldBlob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldBlob", reflect.TypeOf((*MockCreatorServiceClient)(nil).HoldBlob), varargs...)
+}
+
 // IsCommentOwner mocks base method.
 func (m *MockCreatorServiceClient) IsCommentOwner(ctx context.Context, in *generated.Comment, opts ...grpc.CallOption) (*generated.FlagMessage, error) {
 	m.ctrl.T.Helper()
@@ -657,6 +977,26 @@ func (mr *MockCreatorServiceClientMockRecorder) IsPostOwner(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPostOwner", reflect.TypeOf((*MockCreatorServiceClient)(nil).IsPostOwner), varargs...)
 }
 
+// PayoutDestinations mocks base method.
+func (m *MockCreatorServiceClient) PayoutDestinations(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*generated.PayoutDestinationsMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PayoutDestinations", varargs...)
+	ret0, _ := ret[0].(*generated.PayoutDestinationsMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayoutDestinations indicates an expected call of PayoutDestinations.
+func (mr *MockCreatorServiceClientMockRecorder) PayoutDestinations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayoutDestinations", reflect.TypeOf((*MockCreatorServiceClient)(nil).PayoutDestinations), varargs...)
+}
+
 // RemoveLike mocks base method.
 func (m *MockCreatorServiceClient) RemoveLike(ctx context.Context, in *generated.PostUserMessage, opts ...grpc.CallOption) (*generated.Like, error) {
 	m.ctrl.T.Helper()
@@ -697,6 +1037,46 @@ func (mr *MockCreatorServiceClientMockRecorder) RemoveLikeComment(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLikeComment", reflect.TypeOf((*MockCreatorServiceClient)(nil).RemoveLikeComment), varargs...)
 }
 
+// RequestPayout mocks base method.
+func (m *MockCreatorServiceClient) RequestPayout(ctx context.Context, in *generated.Payout, opts ...grpc.CallOption) (*generated.PayoutMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestPayout", varargs...)
+	ret0, _ := ret[0].(*generated.PayoutMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPayout indicates an expected call of RequestPayout.
+func (mr *MockCreatorServiceClientMockRecorder) RequestPayout(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPayout", reflect.TypeOf((*MockCreatorServiceClient)(nil).RequestPayout), varargs...)
+}
+
+// SetPayoutSchedule mocks base method.
+func (m *MockCreatorServiceClient) SetPayoutSchedule(ctx context.Context, in *generated.PayoutSchedule, opts ...grpc.CallOption) (*generated.PayoutScheduleMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetPayoutSchedule", varargs...)
+	ret0, _ := ret[0].(*generated.PayoutScheduleMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPayoutSchedule indicates an expected call of SetPayoutSchedule.
+func (mr *MockCreatorServiceClientMockRecorder) SetPayoutSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPayoutSchedule", reflect.TypeOf((*MockCreatorServiceClient)(nil).SetPayoutSchedule), varargs...)
+}
+
 // Statistics mocks base method.
 func (m *MockCreatorServiceClient) Statistics(ctx context.Context, in *generated.StatisticsInput, opts ...grpc.CallOption) (*generated.Stat, error) {
 	m.ctrl.T.Helper()
@@ -737,24 +1117,44 @@ func (mr *MockCreatorServiceClientMockRecorder) StatisticsFirstDate(ctx, in inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatisticsFirstDate", reflect.TypeOf((*MockCreatorServiceClient)(nil).StatisticsFirstDate), varargs...)
 }
 
-// UpdateBalance mocks base method.
-func (m *MockCreatorServiceClient) UpdateBalance(ctx context.Context, in *generated.CreatorTransfer, opts ...grpc.CallOption) (*generated.CreatorBalance, error) {
+// TopSupporters mocks base method.
+func (m *MockCreatorServiceClient) TopSupporters(ctx context.Context, in *generated.SupportersFilter, opts ...grpc.CallOption) (*generated.SupportersMessage, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateBalance", varargs...)
-	ret0, _ := ret[0].(*generated.CreatorBalance)
+	ret := m.ctrl.Call(m, "TopSupporters", varargs...)
+	ret0, _ := ret[0].(*generated.SupportersMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateBalance indicates an expected call of UpdateBalance.
-func (mr *MockCreatorServiceClientMockRecorder) UpdateBalance(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// TopSupporters indicates an expected call of TopSupporters.
+func (mr *MockCreatorServiceClientMockRecorder) TopSupporters(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBalance", reflect.TypeOf((*MockCreatorServiceClient)(nil).UpdateBalance), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopSupporters", reflect.TypeOf((*MockCreatorServiceClient)(nil).TopSupporters), varargs...)
+}
+
+// UpdateAim mocks base method.
+func (m *MockCreatorServiceClient) UpdateAim(ctx context.Context, in *generated.Aim, opts ...grpc.CallOption) (*generated.AimMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAim", varargs...)
+	ret0, _ := ret[0].(*generated.AimMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAim indicates an expected call of UpdateAim.
+func (mr *MockCreatorServiceClientMockRecorder) UpdateAim(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAim", reflect.TypeOf((*MockCreatorServiceClient)(nil).UpdateAim), varargs...)
 }
 
 // UpdateCoverPhoto mocks base method.
@@ -855,6 +1255,21 @@ func (mr *MockCreatorServiceServerMockRecorder) AddAttach(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttach", reflect.TypeOf((*MockCreatorServiceServer)(nil).AddAttach), arg0, arg1)
 }
 
+// AddDownload mocks base method.
+func (m *MockCreatorServiceServer) AddDownload(arg0 context.Context, arg1 *generated.DownloadMessage) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDownload", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDownload indicates an expected call of AddDownload.
+func (mr *MockCreatorServiceServerMockRecorder) AddDownload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDownload", reflect.TypeOf((*MockCreatorServiceServer)(nil).AddDownload), arg0, arg1)
+}
+
 // AddLike mocks base method.
 func (m *MockCreatorServiceServer) AddLike(arg0 context.Context, arg1 *generated.PostUserMessage) (*generated.Like, error) {
 	m.ctrl.T.Helper()
@@ -885,6 +1300,21 @@ func (mr *MockCreatorServiceServerMockRecorder) AddLikeComment(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLikeComment", reflect.TypeOf((*MockCreatorServiceServer)(nil).AddLikeComment), arg0, arg1)
 }
 
+// AddPayoutDestination mocks base method.
+func (m *MockCreatorServiceServer) AddPayoutDestination(arg0 context.Context, arg1 *generated.PayoutDestination) (*generated.PayoutDestinationMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPayoutDestination", arg0, arg1)
+	ret0, _ := ret[0].(*generated.PayoutDestinationMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPayoutDestination indicates an expected call of AddPayoutDestination.
+func (mr *MockCreatorServiceServerMockRecorder) AddPayoutDestination(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPayoutDestination", reflect.TypeOf((*MockCreatorServiceServer)(nil).AddPayoutDestination), arg0, arg1)
+}
+
 // CheckIfCreator mocks base method.
 func (m *MockCreatorServiceServer) CheckIfCreator(arg0 context.Context, arg1 *proto.UUIDMessage) (*proto.UUIDResponse, error) {
 	m.ctrl.T.Helper()
@@ -900,6 +1330,21 @@ func (mr *MockCreatorServiceServerMockRecorder) CheckIfCreator(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfCreator", reflect.TypeOf((*MockCreatorServiceServer)(nil).CheckIfCreator), arg0, arg1)
 }
 
+// CheckStorageQuota mocks base method.
+func (m *MockCreatorServiceServer) CheckStorageQuota(arg0 context.Context, arg1 *generated.StorageQuotaMessage) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckStorageQuota", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckStorageQuota indicates an expected call of CheckStorageQuota.
+func (mr *MockCreatorServiceServerMockRecorder) CheckStorageQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStorageQuota", reflect.TypeOf((*MockCreatorServiceServer)(nil).CheckStorageQuota), arg0, arg1)
+}
+
 // CreateAim mocks base method.
 func (m *MockCreatorServiceServer) CreateAim(arg0 context.Context, arg1 *generated.Aim) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -930,6 +1375,21 @@ func (mr *MockCreatorServiceServerMockRecorder) CreateComment(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCreatorServiceServer)(nil).CreateComment), arg0, arg1)
 }
 
+// CreateCommissionRate mocks base method.
+func (m *MockCreatorServiceServer) CreateCommissionRate(arg0 context.Context, arg1 *generated.CommissionRateRequest) (*generated.CommissionRateMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCommissionRate", arg0, arg1)
+	ret0, _ := ret[0].(*generated.CommissionRateMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCommissionRate indicates an expected call of CreateCommissionRate.
+func (mr *MockCreatorServiceServerMockRecorder) CreateCommissionRate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommissionRate", reflect.TypeOf((*MockCreatorServiceServer)(nil).CreateCommissionRate), arg0, arg1)
+}
+
 // CreatePost mocks base method.
 func (m *MockCreatorServiceServer) CreatePost(arg0 context.Context, arg1 *generated.PostCreationData) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -960,6 +1420,51 @@ func (mr *MockCreatorServiceServerMockRecorder) CreateSubscription(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockCreatorServiceServer)(nil).CreateSubscription), arg0, arg1)
 }
 
+// CreatorAims mocks base method.
+func (m *MockCreatorServiceServer) CreatorAims(arg0 context.Context, arg1 *generated.AimsFilter) (*generated.AimsMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorAims", arg0, arg1)
+	ret0, _ := ret[0].(*generated.AimsMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorAims indicates an expected call of CreatorAims.
+func (mr *MockCreatorServiceServerMockRecorder) CreatorAims(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorAims", reflect.TypeOf((*MockCreatorServiceServer)(nil).CreatorAims), arg0, arg1)
+}
+
+// CreatorDonations mocks base method.
+func (m *MockCreatorServiceServer) CreatorDonations(arg0 context.Context, arg1 *generated.DonationsFilter) (*generated.DonationsMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorDonations", arg0, arg1)
+	ret0, _ := ret[0].(*generated.DonationsMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorDonations indicates an expected call of CreatorDonations.
+func (mr *MockCreatorServiceServerMockRecorder) CreatorDonations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorDonations", reflect.TypeOf((*MockCreatorServiceServer)(nil).CreatorDonations), arg0, arg1)
+}
+
+// CreatorLedger mocks base method.
+func (m *MockCreatorServiceServer) CreatorLedger(arg0 context.Context, arg1 *generated.LedgerFilter) (*generated.LedgerMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorLedger", arg0, arg1)
+	ret0, _ := ret[0].(*generated.LedgerMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorLedger indicates an expected call of CreatorLedger.
+func (mr *MockCreatorServiceServerMockRecorder) CreatorLedger(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorLedger", reflect.TypeOf((*MockCreatorServiceServer)(nil).CreatorLedger), arg0, arg1)
+}
+
 // CreatorNotificationInfo mocks base method.
 func (m *MockCreatorServiceServer) CreatorNotificationInfo(arg0 context.Context, arg1 *proto.UUIDMessage) (*generated.NotificationCreatorInfo, error) {
 	m.ctrl.T.Helper()
@@ -1050,6 +1555,36 @@ func (mr *MockCreatorServiceServerMockRecorder) DeleteCoverPhoto(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCoverPhoto", reflect.TypeOf((*MockCreatorServiceServer)(nil).DeleteCoverPhoto), arg0, arg1)
 }
 
+// DeletePayoutDestination mocks base method.
+func (m *MockCreatorServiceServer) DeletePayoutDestination(arg0 context.Context, arg1 *generated.PayoutDestinationCreatorMessage) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayoutDestination", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePayoutDestination indicates an expected call of DeletePayoutDestination.
+func (mr *MockCreatorServiceServerMockRecorder) DeletePayoutDestination(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayoutDestination", reflect.TypeOf((*MockCreatorServiceServer)(nil).DeletePayoutDestination), arg0, arg1)
+}
+
+// DeletePayoutSchedule mocks base method.
+func (m *MockCreatorServiceServer) DeletePayoutSchedule(arg0 context.Context, arg1 *proto.UUIDMessage) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayoutSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePayoutSchedule indicates an expected call of DeletePayoutSchedule.
+func (mr *MockCreatorServiceServerMockRecorder) DeletePayoutSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayoutSchedule", reflect.TypeOf((*MockCreatorServiceServer)(nil).DeletePayoutSchedule), arg0, arg1)
+}
+
 // DeletePost mocks base method.
 func (m *MockCreatorServiceServer) DeletePost(arg0 context.Context, arg1 *proto.UUIDMessage) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -1140,6 +1675,21 @@ func (mr *MockCreatorServiceServerMockRecorder) EditSubscription(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditSubscription", reflect.TypeOf((*MockCreatorServiceServer)(nil).EditSubscription), arg0, arg1)
 }
 
+// EndCommissionRate mocks base method.
+func (m *MockCreatorServiceServer) EndCommissionRate(arg0 context.Context, arg1 *generated.EndCommissionRateRequest) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndCommissionRate", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndCommissionRate indicates an expected call of EndCommissionRate.
+func (mr *MockCreatorServiceServerMockRecorder) EndCommissionRate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndCommissionRate", reflect.TypeOf((*MockCreatorServiceServer)(nil).EndCommissionRate), arg0, arg1)
+}
+
 // FindCreators mocks base method.
 func (m *MockCreatorServiceServer) FindCreators(arg0 context.Context, arg1 *generated.KeywordMessage) (*generated.CreatorsMessage, error) {
 	m.ctrl.T.Helper()
@@ -1170,6 +1720,21 @@ func (mr *MockCreatorServiceServerMockRecorder) GetAllCreators(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCreators", reflect.TypeOf((*MockCreatorServiceServer)(nil).GetAllCreators), arg0, arg1)
 }
 
+// GetAttachment mocks base method.
+func (m *MockCreatorServiceServer) GetAttachment(arg0 context.Context, arg1 *generated.PostAttachMessage) (*generated.AttachmentMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", arg0, arg1)
+	ret0, _ := ret[0].(*generated.AttachmentMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockCreatorServiceServerMockRecorder) GetAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockCreatorServiceServer)(nil).GetAttachment), arg0, arg1)
+}
+
 // GetCreatorBalance mocks base method.
 func (m *MockCreatorServiceServer) GetCreatorBalance(arg0 context.Context, arg1 *proto.UUIDMessage) (*generated.CreatorBalance, error) {
 	m.ctrl.T.Helper()
@@ -1230,6 +1795,36 @@ func (mr *MockCreatorServiceServerMockRecorder) GetPage(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockCreatorServiceServer)(nil).GetPage), arg0, arg1)
 }
 
+// GetPayout mocks base method.
+func (m *MockCreatorServiceServer) GetPayout(arg0 context.Context, arg1 *generated.PayoutCreatorMessage) (*generated.PayoutMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayout", arg0, arg1)
+	ret0, _ := ret[0].(*generated.PayoutMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayout indicates an expected call of GetPayout.
+func (mr *MockCreatorServiceServerMockRecorder) GetPayout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayout", reflect.TypeOf((*MockCreatorServiceServer)(nil).GetPayout), arg0, arg1)
+}
+
+// GetPayoutSchedule mocks base method.
+func (m *MockCreatorServiceServer) GetPayoutSchedule(arg0 context.Context, arg1 *proto.UUIDMessage) (*generated.PayoutScheduleMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*generated.PayoutScheduleMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutSchedule indicates an expected call of GetPayoutSchedule.
+func (mr *MockCreatorServiceServerMockRecorder) GetPayoutSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutSchedule", reflect.TypeOf((*MockCreatorServiceServer)(nil).GetPayoutSchedule), arg0, arg1)
+}
+
 // GetPost mocks base method.
 func (m *MockCreatorServiceServer) GetPost(arg0 context.Context, arg1 *generated.PostUserMessage) (*generated.PostWithComments, error) {
 	m.ctrl.T.Helper()
@@ -1245,6 +1840,51 @@ func (mr *MockCreatorServiceServerMockRecorder) GetPost(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPost", reflect.TypeOf((*MockCreatorServiceServer)(nil).GetPost), arg0, arg1)
 }
 
+// GetStorageUsage mocks base method.
+func (m *MockCreatorServiceServer) GetStorageUsage(arg0 context.Context, arg1 *proto.UUIDMessage) (*generated.StorageUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageUsage", arg0, arg1)
+	ret0, _ := ret[0].(*generated.StorageUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageUsage indicates an expected call of GetStorageUsage.
+func (mr *MockCreatorServiceServerMockRecorder) GetStorageUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageUsage", reflect.TypeOf((*MockCreatorServiceServer)(nil).GetStorageUsage), arg0, arg1)
+}
+
+// GetUploadLimit mocks base method.
+func (m *MockCreatorServiceServer) GetUploadLimit(arg0 context.Context, arg1 *proto.UUIDMessage) (*generated.UploadLimitMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadLimit", arg0, arg1)
+	ret0, _ := ret[0].(*generated.UploadLimitMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadLimit indicates an expected call of GetUploadLimit.
+func (mr *MockCreatorServiceServerMockRecorder) GetUploadLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadLimit", reflect.TypeOf((*MockCreatorServiceServer)(nil).GetUploadLimit), arg0, arg1)
+}
+
+// HoldBlob mocks base method.
+func (m *MockCreatorServiceServer) HoldBlob(arg0 context.Context, arg1 *generated.KeywordMessage) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldBlob", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldBlob indicates an expected call of HoldBlob.
+func (mr *MockCreatorServiceServerMockRecorder) HoldBlob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldBlob", reflect.TypeOf((*MockCreatorServiceServer)(nil).HoldBlob), arg0, arg1)
+}
+
 // IsCommentOwner mocks base method.
 func (m *MockCreatorServiceServer) IsCommentOwner(arg0 context.Context, arg1 *generated.Comment) (*generated.FlagMessage, error) {
 	m.ctrl.T.Helper()
@@ -1305,6 +1945,21 @@ func (mr *MockCreatorServiceServerMockRecorder) IsPostOwner(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPostOwner", reflect.TypeOf((*MockCreatorServiceServer)(nil).IsPostOwner), arg0, arg1)
 }
 
+// PayoutDestinations mocks base method.
+func (m *MockCreatorServiceServer) PayoutDestinations(arg0 context.Context, arg1 *proto.UUIDMessage) (*generated.PayoutDestinationsMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayoutDestinations", arg0, arg1)
+	ret0, _ := ret[0].(*generated.PayoutDestinationsMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayoutDestinations indicates an expected call of PayoutDestinations.
+func (mr *MockCreatorServiceServerMockRecorder) PayoutDestinations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayoutDestinations", reflect.TypeOf((*MockCreatorServiceServer)(nil).PayoutDestinations), arg0, arg1)
+}
+
 // RemoveLike mocks base method.
 func (m *MockCreatorServiceServer) RemoveLike(arg0 context.Context, arg1 *generated.PostUserMessage) (*generated.Like, error) {
 	m.ctrl.T.Helper()
@@ -1335,6 +1990,36 @@ func (mr *MockCreatorServiceServerMockRecorder) RemoveLikeComment(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLikeComment", reflect.TypeOf((*MockCreatorServiceServer)(nil).RemoveLikeComment), arg0, arg1)
 }
 
+// RequestPayout mocks base method.
+func (m *MockCreatorServiceServer) RequestPayout(arg0 context.Context, arg1 *generated.Payout) (*generated.PayoutMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPayout", arg0, arg1)
+	ret0, _ := ret[0].(*generated.PayoutMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPayout indicates an expected call of RequestPayout.
+func (mr *MockCreatorServiceServerMockRecorder) RequestPayout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPayout", reflect.TypeOf((*MockCreatorServiceServer)(nil).RequestPayout), arg0, arg1)
+}
+
+// SetPayoutSchedule mocks base method.
+func (m *MockCreatorServiceServer) SetPayoutSchedule(arg0 context.Context, arg1 *generated.PayoutSchedule) (*generated.PayoutScheduleMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPayoutSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*generated.PayoutScheduleMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPayoutSchedule indicates an expected call of SetPayoutSchedule.
+func (mr *MockCreatorServiceServerMockRecorder) SetPayoutSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPayoutSchedule", reflect.TypeOf((*MockCreatorServiceServer)(nil).SetPayoutSchedule), arg0, arg1)
+}
+
 // Statistics mocks base method.
 func (m *MockCreatorServiceServer) Statistics(arg0 context.Context, arg1 *generated.StatisticsInput) (*generated.Stat, error) {
 	m.ctrl.T.Helper()
@@ -1365,19 +2050,34 @@ func (mr *MockCreatorServiceServerMockRecorder) StatisticsFirstDate(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatisticsFirstDate", reflect.TypeOf((*MockCreatorServiceServer)(nil).StatisticsFirstDate), arg0, arg1)
 }
 
-// UpdateBalance mocks base method.
-func (m *MockCreatorServiceServer) UpdateBalance(arg0 context.Context, arg1 *generated.CreatorTransfer) (*generated.CreatorBalance, error) {
+// TopSupporters mocks base method.
+func (m *MockCreatorServiceServer) TopSupporters(arg0 context.Context, arg1 *generated.SupportersFilter) (*generated.SupportersMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBalance", arg0, arg1)
-	ret0, _ := ret[0].(*generated.CreatorBalance)
+	ret := m.ctrl.Call(m, "TopSupporters", arg0, arg1)
+	ret0, _ := ret[0].(*generated.SupportersMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopSupporters indicates an expected call of TopSupporters.
+func (mr *MockCreatorServiceServerMockRecorder) TopSupporters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopSupporters", reflect.TypeOf((*MockCreatorServiceServer)(nil).TopSupporters), arg0, arg1)
+}
+
+// UpdateAim mocks base method.
+func (m *MockCreatorServiceServer) UpdateAim(arg0 context.Context, arg1 *generated.Aim) (*generated.AimMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAim", arg0, arg1)
+	ret0, _ := ret[0].(*generated.AimMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateBalance indicates an expected call of UpdateBalance.
-func (mr *MockCreatorServiceServerMockRecorder) UpdateBalance(arg0, arg1 interface{}) *gomock.Call {
+// UpdateAim indicates an expected call of UpdateAim.
+func (mr *MockCreatorServiceServerMockRecorder) UpdateAim(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBalance", reflect.TypeOf((*MockCreatorServiceServer)(nil).UpdateBalance), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAim", reflect.TypeOf((*MockCreatorServiceServer)(nil).UpdateAim), arg0, arg1)
 }
 
 // UpdateCoverPhoto mocks base method.
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +37,21 @@ func (m *MockCreatorUsecase) EXPECT() *MockCreatorUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AddPayoutDestination mocks base method.
+func (m *MockCreatorUsecase) AddPayoutDestination(ctx context.Context, destination models.PayoutDestination) (models.PayoutDestination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPayoutDestination", ctx, destination)
+	ret0, _ := ret[0].(models.PayoutDestination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPayoutDestination indicates an expected call of AddPayoutDestination.
+func (mr *MockCreatorUsecaseMockRecorder) AddPayoutDestination(ctx, destination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPayoutDestination", reflect.TypeOf((*MockCreatorUsecase)(nil).AddPayoutDestination), ctx, destination)
+}
+
 // CheckIfCreator mocks base method.
 func (m *MockCreatorUsecase) CheckIfCreator(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +67,35 @@ func (mr *MockCreatorUsecaseMockRecorder) CheckIfCreator(ctx, userID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfCreator", reflect.TypeOf((*MockCreatorUsecase)(nil).CheckIfCreator), ctx, userID)
 }
 
+// CheckLedger mocks base method.
+func (m *MockCreatorUsecase) CheckLedger(ctx context.Context) ([]models.LedgerMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLedger", ctx)
+	ret0, _ := ret[0].([]models.LedgerMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckLedger indicates an expected call of CheckLedger.
+func (mr *MockCreatorUsecaseMockRecorder) CheckLedger(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLedger", reflect.TypeOf((*MockCreatorUsecase)(nil).CheckLedger), ctx)
+}
+
+// CheckStorageQuota mocks base method.
+func (m *MockCreatorUsecase) CheckStorageQuota(ctx context.Context, creatorID uuid.UUID, size int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckStorageQuota", ctx, creatorID, size)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckStorageQuota indicates an expected call of CheckStorageQuota.
+func (mr *MockCreatorUsecaseMockRecorder) CheckStorageQuota(ctx, creatorID, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStorageQuota", reflect.TypeOf((*MockCreatorUsecase)(nil).CheckStorageQuota), ctx, creatorID, size)
+}
+
 // CreateAim mocks base method.
 func (m *MockCreatorUsecase) CreateAim(ctx context.Context, aimInfo models.Aim) error {
 	m.ctrl.T.Helper()
@@ -65,6 +110,66 @@ func (mr *MockCreatorUsecaseMockRecorder) CreateAim(ctx, aimInfo interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAim", reflect.TypeOf((*MockCreatorUsecase)(nil).CreateAim), ctx, aimInfo)
 }
 
+// CreateCommissionRate mocks base method.
+func (m *MockCreatorUsecase) CreateCommissionRate(ctx context.Context, adminID uuid.UUID, rate models.CommissionRate) (models.CommissionRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCommissionRate", ctx, adminID, rate)
+	ret0, _ := ret[0].(models.CommissionRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCommissionRate indicates an expected call of CreateCommissionRate.
+func (mr *MockCreatorUsecaseMockRecorder) CreateCommissionRate(ctx, adminID, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommissionRate", reflect.TypeOf((*MockCreatorUsecase)(nil).CreateCommissionRate), ctx, adminID, rate)
+}
+
+// CreatorAims mocks base method.
+func (m *MockCreatorUsecase) CreatorAims(ctx context.Context, creatorID uuid.UUID, status string) ([]models.Aim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorAims", ctx, creatorID, status)
+	ret0, _ := ret[0].([]models.Aim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorAims indicates an expected call of CreatorAims.
+func (mr *MockCreatorUsecaseMockRecorder) CreatorAims(ctx, creatorID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorAims", reflect.TypeOf((*MockCreatorUsecase)(nil).CreatorAims), ctx, creatorID, status)
+}
+
+// CreatorDonations mocks base method.
+func (m *MockCreatorUsecase) CreatorDonations(ctx context.Context, creatorID uuid.UUID, limit, offset int64) ([]models.Donation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorDonations", ctx, creatorID, limit, offset)
+	ret0, _ := ret[0].([]models.Donation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorDonations indicates an expected call of CreatorDonations.
+func (mr *MockCreatorUsecaseMockRecorder) CreatorDonations(ctx, creatorID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorDonations", reflect.TypeOf((*MockCreatorUsecase)(nil).CreatorDonations), ctx, creatorID, limit, offset)
+}
+
+// CreatorLedger mocks base method.
+func (m *MockCreatorUsecase) CreatorLedger(ctx context.Context, filter models.LedgerFilter) (models.LedgerStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorLedger", ctx, filter)
+	ret0, _ := ret[0].(models.LedgerStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorLedger indicates an expected call of CreatorLedger.
+func (mr *MockCreatorUsecaseMockRecorder) CreatorLedger(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorLedger", reflect.TypeOf((*MockCreatorUsecase)(nil).CreatorLedger), ctx, filter)
+}
+
 // CreatorNotificationInfo mocks base method.
 func (m *MockCreatorUsecase) CreatorNotificationInfo(ctx context.Context, creatorID uuid.UUID) (models.NotificationCreatorInfo, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +199,34 @@ func (mr *MockCreatorUsecaseMockRecorder) DeleteCoverPhoto(ctx, creatorId interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCoverPhoto", reflect.TypeOf((*MockCreatorUsecase)(nil).DeleteCoverPhoto), ctx, creatorId)
 }
 
+// DeletePayoutDestination mocks base method.
+func (m *MockCreatorUsecase) DeletePayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayoutDestination", ctx, creatorID, destinationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePayoutDestination indicates an expected call of DeletePayoutDestination.
+func (mr *MockCreatorUsecaseMockRecorder) DeletePayoutDestination(ctx, creatorID, destinationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayoutDestination", reflect.TypeOf((*MockCreatorUsecase)(nil).DeletePayoutDestination), ctx, creatorID, destinationID)
+}
+
+// DeletePayoutSchedule mocks base method.
+func (m *MockCreatorUsecase) DeletePayoutSchedule(ctx context.Context, creatorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayoutSchedule", ctx, creatorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePayoutSchedule indicates an expected call of DeletePayoutSchedule.
+func (mr *MockCreatorUsecaseMockRecorder) DeletePayoutSchedule(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayoutSchedule", reflect.TypeOf((*MockCreatorUsecase)(nil).DeletePayoutSchedule), ctx, creatorID)
+}
+
 // DeleteProfilePhoto mocks base method.
 func (m *MockCreatorUsecase) DeleteProfilePhoto(ctx context.Context, creatorId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -108,6 +241,20 @@ func (mr *MockCreatorUsecaseMockRecorder) DeleteProfilePhoto(ctx, creatorId inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProfilePhoto", reflect.TypeOf((*MockCreatorUsecase)(nil).DeleteProfilePhoto), ctx, creatorId)
 }
 
+// EndCommissionRate mocks base method.
+func (m *MockCreatorUsecase) EndCommissionRate(ctx context.Context, adminID, rateID uuid.UUID, endsAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndCommissionRate", ctx, adminID, rateID, endsAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndCommissionRate indicates an expected call of EndCommissionRate.
+func (mr *MockCreatorUsecaseMockRecorder) EndCommissionRate(ctx, adminID, rateID, endsAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndCommissionRate", reflect.TypeOf((*MockCreatorUsecase)(nil).EndCommissionRate), ctx, adminID, rateID, endsAt)
+}
+
 // FindCreators mocks base method.
 func (m *MockCreatorUsecase) FindCreators(ctx context.Context, keyword string) ([]models.Creator, error) {
 	m.ctrl.T.Helper()
@@ -139,10 +286,10 @@ func (mr *MockCreatorUsecaseMockRecorder) GetAllCreators(ctx interface{}) *gomoc
 }
 
 // GetCreatorBalance mocks base method.
-func (m *MockCreatorUsecase) GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (float32, error) {
+func (m *MockCreatorUsecase) GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (models.CreatorBalance, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCreatorBalance", ctx, creatorID)
-	ret0, _ := ret[0].(float32)
+	ret0, _ := ret[0].(models.CreatorBalance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -183,6 +330,155 @@ func (mr *MockCreatorUsecaseMockRecorder) GetPage(ctx, userID, creatorID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockCreatorUsecase)(nil).GetPage), ctx, userID, creatorID)
 }
 
+// GetPayout mocks base method.
+func (m *MockCreatorUsecase) GetPayout(ctx context.Context, creatorID, payoutID uuid.UUID) (models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayout", ctx, creatorID, payoutID)
+	ret0, _ := ret[0].(models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayout indicates an expected call of GetPayout.
+func (mr *MockCreatorUsecaseMockRecorder) GetPayout(ctx, creatorID, payoutID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayout", reflect.TypeOf((*MockCreatorUsecase)(nil).GetPayout), ctx, creatorID, payoutID)
+}
+
+// GetPayoutSchedule mocks base method.
+func (m *MockCreatorUsecase) GetPayoutSchedule(ctx context.Context, creatorID uuid.UUID) (models.PayoutSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutSchedule", ctx, creatorID)
+	ret0, _ := ret[0].(models.PayoutSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutSchedule indicates an expected call of GetPayoutSchedule.
+func (mr *MockCreatorUsecaseMockRecorder) GetPayoutSchedule(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutSchedule", reflect.TypeOf((*MockCreatorUsecase)(nil).GetPayoutSchedule), ctx, creatorID)
+}
+
+// GetStorageUsage mocks base method.
+func (m *MockCreatorUsecase) GetStorageUsage(ctx context.Context, creatorID uuid.UUID) (models.StorageUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageUsage", ctx, creatorID)
+	ret0, _ := ret[0].(models.StorageUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageUsage indicates an expected call of GetStorageUsage.
+func (mr *MockCreatorUsecaseMockRecorder) GetStorageUsage(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageUsage", reflect.TypeOf((*MockCreatorUsecase)(nil).GetStorageUsage), ctx, creatorID)
+}
+
+// GetUploadLimit mocks base method.
+func (m *MockCreatorUsecase) GetUploadLimit(ctx context.Context, creatorID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadLimit", ctx, creatorID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadLimit indicates an expected call of GetUploadLimit.
+func (mr *MockCreatorUsecaseMockRecorder) GetUploadLimit(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadLimit", reflect.TypeOf((*MockCreatorUsecase)(nil).GetUploadLimit), ctx, creatorID)
+}
+
+// PayoutDestinations mocks base method.
+func (m *MockCreatorUsecase) PayoutDestinations(ctx context.Context, creatorID uuid.UUID) ([]models.PayoutDestination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayoutDestinations", ctx, creatorID)
+	ret0, _ := ret[0].([]models.PayoutDestination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayoutDestinations indicates an expected call of PayoutDestinations.
+func (mr *MockCreatorUsecaseMockRecorder) PayoutDestinations(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayoutDestinations", reflect.TypeOf((*MockCreatorUsecase)(nil).PayoutDestinations), ctx, creatorID)
+}
+
+// RequestPayout mocks base method.
+func (m *MockCreatorUsecase) RequestPayout(ctx context.Context, payout models.Payout) (models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPayout", ctx, payout)
+	ret0, _ := ret[0].(models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPayout indicates an expected call of RequestPayout.
+func (mr *MockCreatorUsecaseMockRecorder) RequestPayout(ctx, payout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPayout", reflect.TypeOf((*MockCreatorUsecase)(nil).RequestPayout), ctx, payout)
+}
+
+// RetryPayouts mocks base method.
+func (m *MockCreatorUsecase) RetryPayouts(ctx context.Context) ([]models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryPayouts", ctx)
+	ret0, _ := ret[0].([]models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryPayouts indicates an expected call of RetryPayouts.
+func (mr *MockCreatorUsecaseMockRecorder) RetryPayouts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryPayouts", reflect.TypeOf((*MockCreatorUsecase)(nil).RetryPayouts), ctx)
+}
+
+// RunPayoutSchedules mocks base method.
+func (m *MockCreatorUsecase) RunPayoutSchedules(ctx context.Context) ([]models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunPayoutSchedules", ctx)
+	ret0, _ := ret[0].([]models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunPayoutSchedules indicates an expected call of RunPayoutSchedules.
+func (mr *MockCreatorUsecaseMockRecorder) RunPayoutSchedules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPayoutSchedules", reflect.TypeOf((*MockCreatorUsecase)(nil).RunPayoutSchedules), ctx)
+}
+
+// SetDefaultCommissionRate mocks base method.
+func (m *MockCreatorUsecase) SetDefaultCommissionRate(ctx context.Context, rateBP int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultCommissionRate", ctx, rateBP)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultCommissionRate indicates an expected call of SetDefaultCommissionRate.
+func (mr *MockCreatorUsecaseMockRecorder) SetDefaultCommissionRate(ctx, rateBP interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultCommissionRate", reflect.TypeOf((*MockCreatorUsecase)(nil).SetDefaultCommissionRate), ctx, rateBP)
+}
+
+// SetPayoutSchedule mocks base method.
+func (m *MockCreatorUsecase) SetPayoutSchedule(ctx context.Context, schedule models.PayoutSchedule) (models.PayoutSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPayoutSchedule", ctx, schedule)
+	ret0, _ := ret[0].(models.PayoutSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPayoutSchedule indicates an expected call of SetPayoutSchedule.
+func (mr *MockCreatorUsecaseMockRecorder) SetPayoutSchedule(ctx, schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPayoutSchedule", reflect.TypeOf((*MockCreatorUsecase)(nil).SetPayoutSchedule), ctx, schedule)
+}
+
 // Statistics mocks base method.
 func (m *MockCreatorUsecase) Statistics(ctx context.Context, statsInput models.StatisticsDates) (models.Statistics, error) {
 	m.ctrl.T.Helper()
@@ -213,19 +509,34 @@ func (mr *MockCreatorUsecaseMockRecorder) StatisticsFirstDate(ctx, creatorID int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatisticsFirstDate", reflect.TypeOf((*MockCreatorUsecase)(nil).StatisticsFirstDate), ctx, creatorID)
 }
 
-// UpdateBalance mocks base method.
-func (m *MockCreatorUsecase) UpdateBalance(ctx context.Context, transfer models.CreatorTransfer) (float32, error) {
+// TopSupporters mocks base method.
+func (m *MockCreatorUsecase) TopSupporters(ctx context.Context, creatorID uuid.UUID, from time.Time, limit int64) ([]models.Supporter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopSupporters", ctx, creatorID, from, limit)
+	ret0, _ := ret[0].([]models.Supporter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopSupporters indicates an expected call of TopSupporters.
+func (mr *MockCreatorUsecaseMockRecorder) TopSupporters(ctx, creatorID, from, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopSupporters", reflect.TypeOf((*MockCreatorUsecase)(nil).TopSupporters), ctx, creatorID, from, limit)
+}
+
+// UpdateAim mocks base method.
+func (m *MockCreatorUsecase) UpdateAim(ctx context.Context, aimInfo models.Aim) (models.Aim, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBalance", ctx, transfer)
-	ret0, _ := ret[0].(float32)
+	ret := m.ctrl.Call(m, "UpdateAim", ctx, aimInfo)
+	ret0, _ := ret[0].(models.Aim)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateBalance indicates an expected call of UpdateBalance.
-func (mr *MockCreatorUsecaseMockRecorder) UpdateBalance(ctx, transfer interface{}) *gomock.Call {
+// UpdateAim indicates an expected call of UpdateAim.
+func (mr *MockCreatorUsecaseMockRecorder) UpdateAim(ctx, aimInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBalance", reflect.TypeOf((*MockCreatorUsecase)(nil).UpdateBalance), ctx, transfer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAim", reflect.TypeOf((*MockCreatorUsecase)(nil).UpdateAim), ctx, aimInfo)
 }
 
 // UpdateCoverPhoto mocks base method.
@@ -295,6 +606,36 @@ func (m *MockCreatorRepo) EXPECT() *MockCreatorRepoMockRecorder {
 	return m.recorder
 }
 
+// AddCommissionRate mocks base method.
+func (m *MockCreatorRepo) AddCommissionRate(ctx context.Context, rate models.CommissionRate) (models.CommissionRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCommissionRate", ctx, rate)
+	ret0, _ := ret[0].(models.CommissionRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCommissionRate indicates an expected call of AddCommissionRate.
+func (mr *MockCreatorRepoMockRecorder) AddCommissionRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCommissionRate", reflect.TypeOf((*MockCreatorRepo)(nil).AddCommissionRate), ctx, rate)
+}
+
+// AddPayoutDestination mocks base method.
+func (m *MockCreatorRepo) AddPayoutDestination(ctx context.Context, destination models.PayoutDestination) (models.PayoutDestination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPayoutDestination", ctx, destination)
+	ret0, _ := ret[0].(models.PayoutDestination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPayoutDestination indicates an expected call of AddPayoutDestination.
+func (mr *MockCreatorRepoMockRecorder) AddPayoutDestination(ctx, destination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPayoutDestination", reflect.TypeOf((*MockCreatorRepo)(nil).AddPayoutDestination), ctx, destination)
+}
+
 // CheckIfCreator mocks base method.
 func (m *MockCreatorRepo) CheckIfCreator(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +651,66 @@ func (mr *MockCreatorRepoMockRecorder) CheckIfCreator(ctx, userID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfCreator", reflect.TypeOf((*MockCreatorRepo)(nil).CheckIfCreator), ctx, userID)
 }
 
+// ClaimDuePayoutSchedules mocks base method.
+func (m *MockCreatorRepo) ClaimDuePayoutSchedules(ctx context.Context, now time.Time, limit int64) ([]models.PayoutSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDuePayoutSchedules", ctx, now, limit)
+	ret0, _ := ret[0].([]models.PayoutSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDuePayoutSchedules indicates an expected call of ClaimDuePayoutSchedules.
+func (mr *MockCreatorRepoMockRecorder) ClaimDuePayoutSchedules(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDuePayoutSchedules", reflect.TypeOf((*MockCreatorRepo)(nil).ClaimDuePayoutSchedules), ctx, now, limit)
+}
+
+// ClaimDuePayouts mocks base method.
+func (m *MockCreatorRepo) ClaimDuePayouts(ctx context.Context, limit int64) ([]models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDuePayouts", ctx, limit)
+	ret0, _ := ret[0].([]models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDuePayouts indicates an expected call of ClaimDuePayouts.
+func (mr *MockCreatorRepoMockRecorder) ClaimDuePayouts(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDuePayouts", reflect.TypeOf((*MockCreatorRepo)(nil).ClaimDuePayouts), ctx, limit)
+}
+
+// ClaimPayout mocks base method.
+func (m *MockCreatorRepo) ClaimPayout(ctx context.Context, payoutID uuid.UUID) (models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPayout", ctx, payoutID)
+	ret0, _ := ret[0].(models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPayout indicates an expected call of ClaimPayout.
+func (mr *MockCreatorRepoMockRecorder) ClaimPayout(ctx, payoutID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPayout", reflect.TypeOf((*MockCreatorRepo)(nil).ClaimPayout), ctx, payoutID)
+}
+
+// CompletePayout mocks base method.
+func (m *MockCreatorRepo) CompletePayout(ctx context.Context, payout models.Payout) (models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletePayout", ctx, payout)
+	ret0, _ := ret[0].(models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompletePayout indicates an expected call of CompletePayout.
+func (mr *MockCreatorRepoMockRecorder) CompletePayout(ctx, payout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletePayout", reflect.TypeOf((*MockCreatorRepo)(nil).CompletePayout), ctx, payout)
+}
+
 // CreateAim mocks base method.
 func (m *MockCreatorRepo) CreateAim(ctx context.Context, aimInfo models.Aim) error {
 	m.ctrl.T.Helper()
@@ -324,6 +725,82 @@ func (mr *MockCreatorRepoMockRecorder) CreateAim(ctx, aimInfo interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAim", reflect.TypeOf((*MockCreatorRepo)(nil).CreateAim), ctx, aimInfo)
 }
 
+// CreatePayout mocks base method.
+func (m *MockCreatorRepo) CreatePayout(ctx context.Context, payout models.Payout) (models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayout", ctx, payout)
+	ret0, _ := ret[0].(models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayout indicates an expected call of CreatePayout.
+func (mr *MockCreatorRepoMockRecorder) CreatePayout(ctx, payout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayout", reflect.TypeOf((*MockCreatorRepo)(nil).CreatePayout), ctx, payout)
+}
+
+// CreatorAims mocks base method.
+func (m *MockCreatorRepo) CreatorAims(ctx context.Context, creatorID uuid.UUID, status string) ([]models.Aim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorAims", ctx, creatorID, status)
+	ret0, _ := ret[0].([]models.Aim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorAims indicates an expected call of CreatorAims.
+func (mr *MockCreatorRepoMockRecorder) CreatorAims(ctx, creatorID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorAims", reflect.TypeOf((*MockCreatorRepo)(nil).CreatorAims), ctx, creatorID, status)
+}
+
+// CreatorDonations mocks base method.
+func (m *MockCreatorRepo) CreatorDonations(ctx context.Context, creatorID uuid.UUID, limit, offset int64) ([]models.Donation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorDonations", ctx, creatorID, limit, offset)
+	ret0, _ := ret[0].([]models.Donation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorDonations indicates an expected call of CreatorDonations.
+func (mr *MockCreatorRepoMockRecorder) CreatorDonations(ctx, creatorID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorDonations", reflect.TypeOf((*MockCreatorRepo)(nil).CreatorDonations), ctx, creatorID, limit, offset)
+}
+
+// CreatorIncome mocks base method.
+func (m *MockCreatorRepo) CreatorIncome(ctx context.Context, creatorID uuid.UUID) (models.Money, models.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorIncome", ctx, creatorID)
+	ret0, _ := ret[0].(models.Money)
+	ret1, _ := ret[1].(models.Money)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatorIncome indicates an expected call of CreatorIncome.
+func (mr *MockCreatorRepoMockRecorder) CreatorIncome(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorIncome", reflect.TypeOf((*MockCreatorRepo)(nil).CreatorIncome), ctx, creatorID)
+}
+
+// CreatorLedger mocks base method.
+func (m *MockCreatorRepo) CreatorLedger(ctx context.Context, filter models.LedgerFilter) ([]models.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatorLedger", ctx, filter)
+	ret0, _ := ret[0].([]models.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatorLedger indicates an expected call of CreatorLedger.
+func (mr *MockCreatorRepoMockRecorder) CreatorLedger(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatorLedger", reflect.TypeOf((*MockCreatorRepo)(nil).CreatorLedger), ctx, filter)
+}
+
 // CreatorNotificationInfo mocks base method.
 func (m *MockCreatorRepo) CreatorNotificationInfo(ctx context.Context, creatorID uuid.UUID) (models.NotificationCreatorInfo, error) {
 	m.ctrl.T.Helper()
@@ -353,6 +830,34 @@ func (mr *MockCreatorRepoMockRecorder) DeleteCoverPhoto(ctx, creatorId interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCoverPhoto", reflect.TypeOf((*MockCreatorRepo)(nil).DeleteCoverPhoto), ctx, creatorId)
 }
 
+// DeletePayoutDestination mocks base method.
+func (m *MockCreatorRepo) DeletePayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayoutDestination", ctx, creatorID, destinationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePayoutDestination indicates an expected call of DeletePayoutDestination.
+func (mr *MockCreatorRepoMockRecorder) DeletePayoutDestination(ctx, creatorID, destinationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayoutDestination", reflect.TypeOf((*MockCreatorRepo)(nil).DeletePayoutDestination), ctx, creatorID, destinationID)
+}
+
+// DeletePayoutSchedule mocks base method.
+func (m *MockCreatorRepo) DeletePayoutSchedule(ctx context.Context, creatorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayoutSchedule", ctx, creatorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePayoutSchedule indicates an expected call of DeletePayoutSchedule.
+func (mr *MockCreatorRepoMockRecorder) DeletePayoutSchedule(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayoutSchedule", reflect.TypeOf((*MockCreatorRepo)(nil).DeletePayoutSchedule), ctx, creatorID)
+}
+
 // DeleteProfilePhoto mocks base method.
 func (m *MockCreatorRepo) DeleteProfilePhoto(ctx context.Context, creatorId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -367,6 +872,20 @@ func (mr *MockCreatorRepoMockRecorder) DeleteProfilePhoto(ctx, creatorId interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProfilePhoto", reflect.TypeOf((*MockCreatorRepo)(nil).DeleteProfilePhoto), ctx, creatorId)
 }
 
+// EndCommissionRate mocks base method.
+func (m *MockCreatorRepo) EndCommissionRate(ctx context.Context, rateID uuid.UUID, endsAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndCommissionRate", ctx, rateID, endsAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndCommissionRate indicates an expected call of EndCommissionRate.
+func (mr *MockCreatorRepoMockRecorder) EndCommissionRate(ctx, rateID, endsAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndCommissionRate", reflect.TypeOf((*MockCreatorRepo)(nil).EndCommissionRate), ctx, rateID, endsAt)
+}
+
 // FindCreators mocks base method.
 func (m *MockCreatorRepo) FindCreators(ctx context.Context, keyword string) ([]models.Creator, error) {
 	m.ctrl.T.Helper()
@@ -397,11 +916,26 @@ func (mr *MockCreatorRepoMockRecorder) GetAllCreators(ctx interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCreators", reflect.TypeOf((*MockCreatorRepo)(nil).GetAllCreators), ctx)
 }
 
+// GetAvailableBalance mocks base method.
+func (m *MockCreatorRepo) GetAvailableBalance(ctx context.Context, creatorID uuid.UUID) (models.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableBalance", ctx, creatorID)
+	ret0, _ := ret[0].(models.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableBalance indicates an expected call of GetAvailableBalance.
+func (mr *MockCreatorRepoMockRecorder) GetAvailableBalance(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableBalance", reflect.TypeOf((*MockCreatorRepo)(nil).GetAvailableBalance), ctx, creatorID)
+}
+
 // GetCreatorBalance mocks base method.
-func (m *MockCreatorRepo) GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (float32, error) {
+func (m *MockCreatorRepo) GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (models.Money, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCreatorBalance", ctx, creatorID)
-	ret0, _ := ret[0].(float32)
+	ret0, _ := ret[0].(models.Money)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -457,6 +991,168 @@ func (mr *MockCreatorRepoMockRecorder) GetPage(ctx, userID, creatorID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockCreatorRepo)(nil).GetPage), ctx, userID, creatorID)
 }
 
+// GetPayout mocks base method.
+func (m *MockCreatorRepo) GetPayout(ctx context.Context, creatorID, payoutID uuid.UUID) (models.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayout", ctx, creatorID, payoutID)
+	ret0, _ := ret[0].(models.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayout indicates an expected call of GetPayout.
+func (mr *MockCreatorRepoMockRecorder) GetPayout(ctx, creatorID, payoutID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayout", reflect.TypeOf((*MockCreatorRepo)(nil).GetPayout), ctx, creatorID, payoutID)
+}
+
+// GetPayoutDestination mocks base method.
+func (m *MockCreatorRepo) GetPayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) (models.PayoutDestination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutDestination", ctx, creatorID, destinationID)
+	ret0, _ := ret[0].(models.PayoutDestination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutDestination indicates an expected call of GetPayoutDestination.
+func (mr *MockCreatorRepoMockRecorder) GetPayoutDestination(ctx, creatorID, destinationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutDestination", reflect.TypeOf((*MockCreatorRepo)(nil).GetPayoutDestination), ctx, creatorID, destinationID)
+}
+
+// GetPayoutSchedule mocks base method.
+func (m *MockCreatorRepo) GetPayoutSchedule(ctx context.Context, creatorID uuid.UUID) (models.PayoutSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutSchedule", ctx, creatorID)
+	ret0, _ := ret[0].(models.PayoutSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutSchedule indicates an expected call of GetPayoutSchedule.
+func (mr *MockCreatorRepoMockRecorder) GetPayoutSchedule(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutSchedule", reflect.TypeOf((*MockCreatorRepo)(nil).GetPayoutSchedule), ctx, creatorID)
+}
+
+// GetStorageUsage mocks base method.
+func (m *MockCreatorRepo) GetStorageUsage(ctx context.Context, creatorID uuid.UUID) (models.StorageUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageUsage", ctx, creatorID)
+	ret0, _ := ret[0].(models.StorageUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageUsage indicates an expected call of GetStorageUsage.
+func (mr *MockCreatorRepoMockRecorder) GetStorageUsage(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageUsage", reflect.TypeOf((*MockCreatorRepo)(nil).GetStorageUsage), ctx, creatorID)
+}
+
+// GetUploadLimit mocks base method.
+func (m *MockCreatorRepo) GetUploadLimit(ctx context.Context, creatorID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadLimit", ctx, creatorID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadLimit indicates an expected call of GetUploadLimit.
+func (mr *MockCreatorRepoMockRecorder) GetUploadLimit(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadLimit", reflect.TypeOf((*MockCreatorRepo)(nil).GetUploadLimit), ctx, creatorID)
+}
+
+// IsAdmin mocks base method.
+func (m *MockCreatorRepo) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockCreatorRepoMockRecorder) IsAdmin(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockCreatorRepo)(nil).IsAdmin), ctx, userID)
+}
+
+// LedgerMismatches mocks base method.
+func (m *MockCreatorRepo) LedgerMismatches(ctx context.Context) ([]models.LedgerMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LedgerMismatches", ctx)
+	ret0, _ := ret[0].([]models.LedgerMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LedgerMismatches indicates an expected call of LedgerMismatches.
+func (mr *MockCreatorRepoMockRecorder) LedgerMismatches(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LedgerMismatches", reflect.TypeOf((*MockCreatorRepo)(nil).LedgerMismatches), ctx)
+}
+
+// MovePayoutSchedule mocks base method.
+func (m *MockCreatorRepo) MovePayoutSchedule(ctx context.Context, schedule models.PayoutSchedule, next time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePayoutSchedule", ctx, schedule, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovePayoutSchedule indicates an expected call of MovePayoutSchedule.
+func (mr *MockCreatorRepoMockRecorder) MovePayoutSchedule(ctx, schedule, next interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePayoutSchedule", reflect.TypeOf((*MockCreatorRepo)(nil).MovePayoutSchedule), ctx, schedule, next)
+}
+
+// PayoutDestinations mocks base method.
+func (m *MockCreatorRepo) PayoutDestinations(ctx context.Context, creatorID uuid.UUID) ([]models.PayoutDestination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayoutDestinations", ctx, creatorID)
+	ret0, _ := ret[0].([]models.PayoutDestination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayoutDestinations indicates an expected call of PayoutDestinations.
+func (mr *MockCreatorRepoMockRecorder) PayoutDestinations(ctx, creatorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayoutDestinations", reflect.TypeOf((*MockCreatorRepo)(nil).PayoutDestinations), ctx, creatorID)
+}
+
+// SetDefaultCommissionRate mocks base method.
+func (m *MockCreatorRepo) SetDefaultCommissionRate(ctx context.Context, rateBP int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultCommissionRate", ctx, rateBP)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultCommissionRate indicates an expected call of SetDefaultCommissionRate.
+func (mr *MockCreatorRepoMockRecorder) SetDefaultCommissionRate(ctx, rateBP interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultCommissionRate", reflect.TypeOf((*MockCreatorRepo)(nil).SetDefaultCommissionRate), ctx, rateBP)
+}
+
+// SetPayoutSchedule mocks base method.
+func (m *MockCreatorRepo) SetPayoutSchedule(ctx context.Context, schedule models.PayoutSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPayoutSchedule", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPayoutSchedule indicates an expected call of SetPayoutSchedule.
+func (mr *MockCreatorRepoMockRecorder) SetPayoutSchedule(ctx, schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPayoutSchedule", reflect.TypeOf((*MockCreatorRepo)(nil).SetPayoutSchedule), ctx, schedule)
+}
+
 // Statistics mocks base method.
 func (m *MockCreatorRepo) Statistics(ctx context.Context, statsInput models.StatisticsDates) (models.Statistics, error) {
 	m.ctrl.T.Helper()
@@ -487,19 +1183,34 @@ func (mr *MockCreatorRepoMockRecorder) StatisticsFirstDate(ctx, creatorID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatisticsFirstDate", reflect.TypeOf((*MockCreatorRepo)(nil).StatisticsFirstDate), ctx, creatorID)
 }
 
-// UpdateBalance mocks base method.
-func (m *MockCreatorRepo) UpdateBalance(ctx context.Context, transfer models.CreatorTransfer) (float32, error) {
+// TopSupporters mocks base method.
+func (m *MockCreatorRepo) TopSupporters(ctx context.Context, creatorID uuid.UUID, from time.Time, limit int64) ([]models.Supporter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopSupporters", ctx, creatorID, from, limit)
+	ret0, _ := ret[0].([]models.Supporter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopSupporters indicates an expected call of TopSupporters.
+func (mr *MockCreatorRepoMockRecorder) TopSupporters(ctx, creatorID, from, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopSupporters", reflect.TypeOf((*MockCreatorRepo)(nil).TopSupporters), ctx, creatorID, from, limit)
+}
+
+// UpdateAim mocks base method.
+func (m *MockCreatorRepo) UpdateAim(ctx context.Context, aimInfo models.Aim) (models.Aim, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBalance", ctx, transfer)
-	ret0, _ := ret[0].(float32)
+	ret := m.ctrl.Call(m, "UpdateAim", ctx, aimInfo)
+	ret0, _ := ret[0].(models.Aim)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateBalance indicates an expected call of UpdateBalance.
-func (mr *MockCreatorRepoMockRecorder) UpdateBalance(ctx, transfer interface{}) *gomock.Call {
+// UpdateAim indicates an expected call of UpdateAim.
+func (mr *MockCreatorRepoMockRecorder) UpdateAim(ctx, aimInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBalance", reflect.TypeOf((*MockCreatorRepo)(nil).UpdateBalance), ctx, transfer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAim", reflect.TypeOf((*MockCreatorRepo)(nil).UpdateAim), ctx, aimInfo)
 }
 
 // UpdateCoverPhoto mocks base method.
@@ -530,6 +1241,20 @@ func (mr *MockCreatorRepoMockRecorder) UpdateCreatorData(ctx, updateData interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCreatorData", reflect.TypeOf((*MockCreatorRepo)(nil).UpdateCreatorData), ctx, updateData)
 }
 
+// UpdatePayoutStatus mocks base method.
+func (m *MockCreatorRepo) UpdatePayoutStatus(ctx context.Context, payout models.Payout, from string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayoutStatus", ctx, payout, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayoutStatus indicates an expected call of UpdatePayoutStatus.
+func (mr *MockCreatorRepoMockRecorder) UpdatePayoutStatus(ctx, payout, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayoutStatus", reflect.TypeOf((*MockCreatorRepo)(nil).UpdatePayoutStatus), ctx, payout, from)
+}
+
 // UpdateProfilePhoto mocks base method.
 func (m *MockCreatorRepo) UpdateProfilePhoto(ctx context.Context, creatorId, path uuid.UUID) error {
 	m.ctrl.T.Helper()
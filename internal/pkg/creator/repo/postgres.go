@@ -13,28 +13,68 @@ import (
 )
 
 const (
-	CreatorInfo             = `SELECT user_id, name, cover_photo, followers_count, description, posts_count, aim, money_got, money_needed, profile_photo FROM "creator" WHERE creator_id=$1;`
-	GetCreatorSubs          = `SELECT subscription_id, month_cost, title, description, is_available FROM "subscription" WHERE creator_id=$1;`
-	GetAllCreators          = `SELECT creator_id, user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM "creator" LIMIT 100;`
-	CreatorPosts            = `SELECT "post".post_id, creation_date, title, post_text, likes_count, comments_count, array_agg(attachment_id), array_agg(attachment_type), array_agg(DISTINCT subscription_id) FROM "post" LEFT JOIN "attachment" a on "post".post_id = a.post_id LEFT JOIN "post_subscription" ps on "post".post_id = ps.post_id WHERE creator_id = $1 GROUP BY "post".post_id, creation_date, title, post_text ORDER BY creation_date DESC;`
-	UserSubscriptions       = `SELECT array_agg(subscription_id) FROM "user_subscription" WHERE user_id=$1;`
-	IsLiked                 = `SELECT post_id, user_id FROM "like_post" WHERE post_id = $1 AND user_id = $2`
-	GetSubInfo              = `SELECT creator_id, month_cost, title, description FROM "subscription" WHERE subscription_id = $1;`
-	AddAim                  = `UPDATE creator SET aim = $1,  money_got = $2, money_needed = $3 WHERE creator_id = $4;`
-	CheckIfFollow           = `SELECT user_id FROM "follow" WHERE user_id = $1 AND creator_id = $2;`
-	FindCreators            = `SELECT creator_id, user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM creator WHERE (make_tsvector(name, 'A'::"char") || make_tsvector(description, 'B'::"char")) @@ (plainto_tsquery('ru', $1) || plainto_tsquery('english', $1)) or LOWER(name) like LOWER($1) or LOWER(description) like LOWER($1) ORDER BY make_tsrank(name, $1, 'russian'::regconfig), make_tsrank(description, $1, 'russian'::regconfig) DESC LIMIT 30;`
-	CheckIfCreator          = `SELECT creator_id FROM "creator" WHERE user_id = $1`
-	UpdateCreatorData       = `UPDATE creator SET name = $1, description = $2 WHERE creator_id = $3`
-	Feed                    = `SELECT t.post_id, t.creator_id, creation_date, title, post_text, array_agg(attachment_id), array_agg(attachment_type), t.name, t.profile_photo, t.likes_count, t.comments_count FROM ( SELECT DISTINCT p.post_id, p.creator_id, creation_date, title, post_text, c.name, c.profile_photo, p.likes_count, p.comments_count FROM follow f JOIN post p on p.creator_id = f.creator_id JOIN creator c on f.creator_id = c.creator_id LEFT JOIN post_subscription ps on p.post_id = ps.post_id JOIN user_subscription us on f.user_id = us.user_id and (ps.subscription_id = us.subscription_id or ps.subscription_id is null) WHERE f.user_id = $1 GROUP BY c.name, p.creator_id, creation_date, title, post_text, p.post_id, c.profile_photo, c.creator_id LIMIT 50) as t LEFT JOIN attachment a on a.post_id = t.post_id GROUP BY t.name, t.creator_id, creation_date, title, post_text, t.post_id, t.profile_photo, t.likes_count, t.comments_count ORDER BY creation_date DESC;`
-	UpdateProfilePhoto      = `UPDATE "creator" SET profile_photo = $1 WHERE creator_id = $2;`
-	UpdateCoverPhoto        = `UPDATE "creator" SET cover_photo = $1 WHERE creator_id = $2;`
-	DeleteCoverPhoto        = `UPDATE "creator" SET cover_photo = null WHERE creator_id = $1`
-	DeleteProfilePhoto      = `UPDATE "creator" SET profile_photo = null WHERE creator_id = $1`
-	GetStatistics           = `SELECT coalesce(sum(posts_per_month), 0), coalesce(sum(subscriptions_bought), 0), coalesce(sum(donations_count), 0), coalesce(sum(money_from_donations), 0), coalesce(sum(money_from_subscriptions),0), coalesce(sum(new_followers), 0), coalesce(sum(likes_count), 0), coalesce(sum(comments_count), 0) FROM "statistics" AS s WHERE creator_id = $1 AND  date_trunc('month'::text, s.month::date)::date BETWEEN date_trunc('month'::text, $2::date)::date AND  date_trunc('month'::text, $3::date)::date;`
-	CreatorNotificationInfo = `SELECT profile_photo, name FROM creator WHERE creator_id = $1;`
-	FirstStatisticsDate     = `SELECT MIN(month) FROM statistics WHERE creator_id = $1;`
-	CreatorBalance          = `SELECT balance FROM creator WHERE creator_id = $1;`
-	UpdateBalance           = `UPDATE creator SET balance = balance - $1 WHERE creator_id = $2 RETURNING balance;`
+	CreatorInfo                = `SELECT user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM "creator" WHERE creator_id=$1;`
+	GetCreatorSubs             = `SELECT subscription_id, month_cost, title, description, is_available FROM "subscription" WHERE creator_id=$1;`
+	GetCreatorBilling          = `SELECT o.subscription_id, o.month_count, coalesce(o.price, 0), coalesce(o.discount_percent, 0) FROM "subscription_billing_option" o join subscription s on s.subscription_id = o.subscription_id WHERE s.creator_id = $1 ORDER BY o.month_count;`
+	GetAllCreators             = `SELECT creator_id, user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM "creator" LIMIT 100;`
+	CreatorPosts               = `SELECT "post".post_id, creation_date, title, post_text, likes_count, comments_count, array_agg(attachment_id), array_agg(attachment_type), array_agg(a.renditions::text), array_agg(json_build_object('size', a.size, 'checksum', a.checksum, 'filename', a.filename, 'width', a.width, 'height', a.height, 'duration', a.duration, 'alt_text', a.alt_text, 'caption', a.caption, 'scan_status', a.scan_status)::text), array_agg(DISTINCT subscription_id) FROM "post" LEFT JOIN "attachment" a on "post".post_id = a.post_id LEFT JOIN "post_subscription" ps on "post".post_id = ps.post_id WHERE creator_id = $1 GROUP BY "post".post_id, creation_date, title, post_text ORDER BY creation_date DESC;`
+	UserSubscriptions          = `SELECT array_agg(subscription_id) FROM "user_subscription" WHERE user_id=$1;`
+	IsLiked                    = `SELECT post_id, user_id FROM "like_post" WHERE post_id = $1 AND user_id = $2`
+	GetSubInfo                 = `SELECT creator_id, month_cost, title, description FROM "subscription" WHERE subscription_id = $1;`
+	AddAim                     = `INSERT INTO "aim" (aim_id, creator_id, description, money_needed, deadline, position) VALUES ($1, $2, $3, $4, $5, $6);`
+	UpdateAim                  = `UPDATE "aim" SET description = $1, money_needed = $2, deadline = $3, position = $4, completed_at = CASE WHEN money_got >= $2 THEN now() END WHERE aim_id = $5 AND creator_id = $6 AND completed_at IS NULL RETURNING money_got, completed_at;`
+	CreatorAims                = `SELECT aim_id, description, money_needed, money_got, deadline, position, completed_at FROM "aim" WHERE creator_id = $1 AND ($2 = '' OR ($2 = 'active' AND completed_at IS NULL AND (deadline IS NULL OR deadline > now())) OR ($2 = 'completed' AND completed_at IS NOT NULL) OR ($2 = 'expired' AND completed_at IS NULL AND deadline <= now())) ORDER BY completed_at IS NOT NULL, completed_at DESC, position, created_at;`
+	CheckIfFollow              = `SELECT user_id FROM "follow" WHERE user_id = $1 AND creator_id = $2;`
+	FindCreators               = `SELECT creator_id, user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM creator WHERE (make_tsvector(name, 'A'::"char") || make_tsvector(description, 'B'::"char")) @@ (plainto_tsquery('ru', $1) || plainto_tsquery('english', $1)) or LOWER(name) like LOWER($1) or LOWER(description) like LOWER($1) ORDER BY make_tsrank(name, $1, 'russian'::regconfig), make_tsrank(description, $1, 'russian'::regconfig) DESC LIMIT 30;`
+	CheckIfCreator             = `SELECT creator_id FROM "creator" WHERE user_id = $1`
+	UpdateCreatorData          = `UPDATE creator SET name = $1, description = $2 WHERE creator_id = $3`
+	Feed                       = `SELECT t.post_id, t.creator_id, creation_date, title, post_text, array_agg(attachment_id), array_agg(attachment_type), array_agg(a.renditions::text), array_agg(json_build_object('size', a.size, 'checksum', a.checksum, 'filename', a.filename, 'width', a.width, 'height', a.height, 'duration', a.duration, 'alt_text', a.alt_text, 'caption', a.caption, 'scan_status', a.scan_status)::text), t.name, t.profile_photo, t.likes_count, t.comments_count FROM ( SELECT DISTINCT p.post_id, p.creator_id, creation_date, title, post_text, c.name, c.profile_photo, p.likes_count, p.comments_count FROM follow f JOIN post p on p.creator_id = f.creator_id JOIN creator c on f.creator_id = c.creator_id LEFT JOIN post_subscription ps on p.post_id = ps.post_id JOIN user_subscription us on f.user_id = us.user_id and (ps.subscription_id = us.subscription_id or ps.subscription_id is null) WHERE f.user_id = $1 GROUP BY c.name, p.creator_id, creation_date, title, post_text, p.post_id, c.profile_photo, c.creator_id LIMIT 50) as t LEFT JOIN attachment a on a.post_id = t.post_id GROUP BY t.name, t.creator_id, creation_date, title, post_text, t.post_id, t.profile_photo, t.likes_count, t.comments_count ORDER BY creation_date DESC;`
+	UpdateProfilePhoto         = `UPDATE "creator" SET profile_photo = $1 WHERE creator_id = $2;`
+	UpdateCoverPhoto           = `UPDATE "creator" SET cover_photo = $1 WHERE creator_id = $2;`
+	DeleteCoverPhoto           = `UPDATE "creator" SET cover_photo = null WHERE creator_id = $1`
+	DeleteProfilePhoto         = `UPDATE "creator" SET profile_photo = null WHERE creator_id = $1`
+	GetStatistics              = `SELECT coalesce(sum(posts_per_month), 0), coalesce(sum(subscriptions_bought), 0), coalesce(sum(donations_count), 0), coalesce(sum(money_from_donations), 0), coalesce(sum(money_from_subscriptions),0), coalesce(sum(new_followers), 0), coalesce(sum(likes_count), 0), coalesce(sum(comments_count), 0), coalesce(sum(subscriptions_expired), 0), coalesce(sum(gifts_bought), 0), coalesce(sum(money_from_gifts), 0), coalesce(sum(commission), 0) FROM "statistics" AS s WHERE creator_id = $1 AND  date_trunc('month'::text, s.month::date)::date BETWEEN date_trunc('month'::text, $2::date)::date AND  date_trunc('month'::text, $3::date)::date;`
+	CreatorNotificationInfo    = `SELECT profile_photo, name FROM creator WHERE creator_id = $1;`
+	FirstStatisticsDate        = `SELECT MIN(month) FROM statistics WHERE creator_id = $1;`
+	CreatorBalance             = `SELECT balance FROM creator WHERE creator_id = $1;`
+	UploadLimit                = `SELECT upload_limit FROM "creator" WHERE creator_id = $1;`
+	StoragePlan                = `SELECT c.storage_plan, sp.quota FROM "creator" c JOIN "storage_plan" sp ON sp.name = c.storage_plan WHERE c.creator_id = $1;`
+	StorageByType              = `SELECT media_type, files, bytes FROM "creator_storage" WHERE creator_id = $1 AND files > 0 ORDER BY bytes DESC, media_type;`
+	CreatorIncome              = `SELECT coalesce(sum(gross - refunded), 0), coalesce(sum(fee - fee_refunded), 0) FROM "revenue_split" WHERE creator_id = $1;`
+	UpdateBalance              = `UPDATE creator SET balance = balance + $1 WHERE creator_id = $2;`
+	AvailableBalance           = `SELECT coalesce(balance, 0) FROM "creator" WHERE creator_id = $1 FOR UPDATE;`
+	GetAvailableBalance        = `SELECT coalesce(balance, 0) FROM "creator" WHERE creator_id = $1;`
+	PayoutByKey                = `SELECT ` + payoutColumns + ` FROM "payout" WHERE creator_id = $1 AND idempotency_key = $2;`
+	GetPayout                  = `SELECT ` + payoutColumns + ` FROM "payout" WHERE payout_id = $1 AND creator_id = $2;`
+	AddPayout                  = `INSERT INTO "payout" (payout_id, creator_id, money, phone_number, idempotency_key, destination_id) VALUES ($1, $2, $3, $4, $5, nullif($6, '00000000-0000-0000-0000-000000000000'::uuid)) RETURNING ` + payoutColumns + `;`
+	ClaimPayout                = `UPDATE "payout" SET ` + claimPayout + ` WHERE payout_id = $1 AND status IN ('requested', 'processing') AND next_attempt_at <= now() RETURNING ` + payoutColumns + `;`
+	ClaimDuePayouts            = `UPDATE "payout" SET ` + claimPayout + ` WHERE payout_id IN (SELECT payout_id FROM "payout" WHERE status IN ('requested', 'processing') AND next_attempt_at <= now() ORDER BY next_attempt_at LIMIT $1 FOR UPDATE SKIP LOCKED) RETURNING ` + payoutColumns + `;`
+	UpdatePayoutStatus         = `UPDATE "payout" SET status = $1, provider_request_id = coalesce(nullif($2, ''), provider_request_id), last_error = nullif($3, ''), updated_at = now() WHERE payout_id = $4 AND status = $5 RETURNING updated_at;`
+	CompletePayout             = `UPDATE "payout" SET status = 'succeeded', last_error = NULL, updated_at = now() WHERE payout_id = $1 AND status = 'processing' RETURNING updated_at;`
+	AddPayoutDestination       = `INSERT INTO "payout_destination" (destination_id, creator_id, phone_number) VALUES ($1, $2, $3) RETURNING verified_at;`
+	PayoutDestinations         = `SELECT destination_id, creator_id, phone_number, verified_at FROM "payout_destination" WHERE creator_id = $1 ORDER BY verified_at;`
+	GetPayoutDestination       = `SELECT destination_id, creator_id, phone_number, verified_at FROM "payout_destination" WHERE destination_id = $1 AND creator_id = $2;`
+	DeletePayoutDestination    = `DELETE FROM "payout_destination" WHERE destination_id = $1 AND creator_id = $2;`
+	GetPayoutSchedule          = `SELECT creator_id, destination_id, period, threshold, next_run_at FROM "payout_schedule" WHERE creator_id = $1;`
+	SetPayoutSchedule          = `INSERT INTO "payout_schedule" (creator_id, destination_id, period, threshold, next_run_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (creator_id) DO UPDATE SET destination_id = excluded.destination_id, period = excluded.period, threshold = excluded.threshold, next_run_at = excluded.next_run_at, claimed_until = NULL;`
+	DeletePayoutSchedule       = `DELETE FROM "payout_schedule" WHERE creator_id = $1;`
+	DuePayoutSchedules         = `SELECT creator_id, destination_id, period, threshold, next_run_at FROM "payout_schedule" WHERE next_run_at <= $1 AND (claimed_until IS NULL OR claimed_until <= $1) ORDER BY next_run_at LIMIT $2 FOR UPDATE SKIP LOCKED;`
+	ClaimPayoutSchedule        = `UPDATE "payout_schedule" SET claimed_until = $1 WHERE creator_id = $2;`
+	MovePayoutSchedule         = `UPDATE "payout_schedule" SET next_run_at = $1, claimed_until = NULL WHERE creator_id = $2 AND next_run_at = $3;`
+	AddLedgerEntry             = `INSERT INTO "ledger_entry" (transaction_id, account, creator_id, kind, amount, reference) VALUES ($1, $2, nullif($3, '00000000-0000-0000-0000-000000000000'::uuid), $4, $5, $6);`
+	CreatorLedger              = `SELECT entry_id, transaction_id, kind, amount, balance_after, coalesce(reference, ''), created_at FROM (SELECT entry_id, transaction_id, kind, amount, sum(amount) OVER (ORDER BY created_at, entry_id) AS balance_after, reference, created_at FROM "ledger_entry" WHERE creator_id = $1 AND account = 'creator') AS l WHERE created_at >= $2 AND created_at <= $3 ORDER BY created_at DESC, entry_id DESC LIMIT $4 OFFSET $5;`
+	LedgerMismatches           = `SELECT c.creator_id, coalesce(c.balance, 0), coalesce(sum(l.amount), 0) FROM "creator" c LEFT JOIN "ledger_entry" l ON l.creator_id = c.creator_id AND l.account = 'creator' GROUP BY c.creator_id, c.balance HAVING coalesce(c.balance, 0) <> coalesce(sum(l.amount), 0);`
+	CreatorDonations           = `SELECT d.donation_id, CASE WHEN d.is_anonymous THEN '00000000-0000-0000-0000-000000000000'::uuid ELSE coalesce(d.user_id, '00000000-0000-0000-0000-000000000000'::uuid) END, CASE WHEN d.is_anonymous THEN '' ELSE coalesce(u.display_name, '') END, CASE WHEN d.is_anonymous THEN '00000000-0000-0000-0000-000000000000'::uuid ELSE coalesce(u.profile_photo, '00000000-0000-0000-0000-000000000000'::uuid) END, d.money_count, coalesce(d.message, ''), coalesce(d.aim_id, '00000000-0000-0000-0000-000000000000'::uuid), coalesce(a.description, ''), d.is_anonymous, d.donation_date FROM "donation" d left join "user" u on u.user_id = d.user_id left join "aim" a on a.aim_id = d.aim_id WHERE d.creator_id = $1 ORDER BY d.donation_date DESC LIMIT $2 OFFSET $3;`
+	TopSupporters              = `SELECT u.user_id, u.display_name, coalesce(u.profile_photo, '00000000-0000-0000-0000-000000000000'::uuid), sum(d.money_count) AS total, count(*) FROM "donation" d join "user" u on u.user_id = d.user_id WHERE d.creator_id = $1 AND NOT d.is_anonymous AND d.donation_date >= $2 GROUP BY u.user_id, u.display_name, u.profile_photo ORDER BY total DESC LIMIT $3;`
+	GetBillingPeriodStatistics = `SELECT month_count, coalesce(sum(subscriptions_bought), 0), coalesce(sum(money), 0) FROM "statistics_billing_period" WHERE creator_id = $1 AND month BETWEEN date_trunc('month'::text, $2::date)::date AND date_trunc('month'::text, $3::date)::date GROUP BY month_count ORDER BY month_count;`
+	IsAdmin                    = `SELECT is_admin FROM "user" WHERE user_id = $1;`
+	AddCommissionRate          = `INSERT INTO "commission_rate" (creator_id, rate_bp, starts_at, ends_at, description) SELECT nullif($1, '00000000-0000-0000-0000-000000000000'::uuid), $2, $3, $4, $5 WHERE $1 = '00000000-0000-0000-0000-000000000000'::uuid OR EXISTS (SELECT 1 FROM "creator" WHERE creator_id = $1) RETURNING rate_id;`
+	EndCommissionRate          = `UPDATE "commission_rate" SET ends_at = $1 WHERE rate_id = $2 AND starts_at < $1 AND (ends_at IS NULL OR ends_at > $1) RETURNING rate_id;`
+	DefaultCommissionRate      = `SELECT rate_id, rate_bp FROM "commission_rate" WHERE creator_id IS NULL AND ends_at IS NULL AND starts_at <= now() ORDER BY starts_at DESC LIMIT 1 FOR UPDATE;`
+	EndDefaultCommission       = `UPDATE "commission_rate" SET ends_at = now() WHERE rate_id = $1;`
+	AddDefaultCommission       = `INSERT INTO "commission_rate" (creator_id, rate_bp, starts_at, description) VALUES (NULL, $1, now(), $2);`
+	payoutColumns              = `payout_id, creator_id, money, phone_number, idempotency_key, status, coalesce(provider_request_id, ''), attempts, coalesce(last_error, ''), created_at, updated_at, coalesce(destination_id, '00000000-0000-0000-0000-000000000000'::uuid)`
+	claimPayout                = `attempts = attempts + 1, next_attempt_at = now() + least(power(2, attempts), 60) * interval '1 minute', updated_at = now()`
 )
 
 type CreatorRepo struct {
@@ -70,14 +110,406 @@ func (r *CreatorRepo) CreatorNotificationInfo(ctx context.Context, creatorID uui
 	return info, nil
 }
 
-func (ur *CreatorRepo) UpdateBalance(ctx context.Context, transfer models.CreatorTransfer) (float32, error) {
-	var newBalance float32
-	row := ur.db.QueryRowContext(ctx, UpdateBalance, transfer.Money, transfer.CreatorID)
-	if err := row.Scan(&newBalance); err != nil && !errors.Is(err, sql.ErrNoRows) {
-		ur.logger.Error(err)
-		return 0, models.InternalError
+// CreatePayout сохраняет выплату в состоянии requested и в той же транзакции списывает её сумму с баланса автора:
+// возврат платежа, пришедший во время выплаты, уже не сможет потратить эти деньги.
+// Повторный запрос с тем же ключом идемпотентности возвращает уже созданную выплату,
+// если денег на балансе не хватает, возвращает WrongData.
+func (r *CreatorRepo) CreatePayout(ctx context.Context, payout models.Payout) (models.Payout, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return models.Payout{}, models.InternalError
+	}
+
+	var available models.Money
+	row := tx.QueryRowContext(ctx, AvailableBalance, payout.CreatorID)
+	if err = row.Scan(&available); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.Payout{}, models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		return models.Payout{}, models.NotFound
+	}
+
+	existing, err := scanPayout(tx.QueryRowContext(ctx, PayoutByKey, payout.CreatorID, payout.IdempotencyKey).Scan)
+	if err == nil {
+		_ = tx.Rollback()
+		// номер телефона хранится зашифрованным, его сверяет usecase
+		if existing.Money.Amount != payout.Money.Amount {
+			return models.Payout{}, models.WrongData
+		}
+		return existing, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.Payout{}, models.InternalError
+	}
+
+	if insufficient, err := available.Less(payout.Money); err != nil || insufficient {
+		_ = tx.Rollback()
+		return models.Payout{}, models.WrongData
+	}
+
+	created, err := scanPayout(tx.QueryRowContext(ctx, AddPayout, payout.Id, payout.CreatorID, payout.Money, payout.PhoneNumber, payout.IdempotencyKey, payout.DestinationID).Scan)
+	if err != nil {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.Payout{}, models.InternalError
+	}
+	debit, err := models.NewMoney(0).Sub(created.Money)
+	if err != nil {
+		_ = tx.Rollback()
+		return models.Payout{}, models.WrongData
+	}
+	if err = r.postPayout(ctx, tx, created, debit, models.LedgerKindPayout); err != nil {
+		_ = tx.Rollback()
+		return models.Payout{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return models.Payout{}, models.InternalError
+	}
+	return created, nil
+}
+
+func (r *CreatorRepo) GetPayout(ctx context.Context, creatorID, payoutID uuid.UUID) (models.Payout, error) {
+	payout, err := scanPayout(r.db.QueryRowContext(ctx, GetPayout, payoutID, creatorID).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Payout{}, models.NotFound
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.Payout{}, models.InternalError
+	}
+	return payout, nil
+}
+
+// ClaimPayout захватывает незавершённую выплату на очередную попытку.
+// Возвращает NotFound, если выплата уже завершена или попытка по ней ещё не подошла.
+// Следующая попытка откладывается с экспоненциальной задержкой до часа,
+// поэтому одну выплату не проводят параллельно сервис и джоба повторов.
+func (r *CreatorRepo) ClaimPayout(ctx context.Context, payoutID uuid.UUID) (models.Payout, error) {
+	payout, err := scanPayout(r.db.QueryRowContext(ctx, ClaimPayout, payoutID).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Payout{}, models.NotFound
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.Payout{}, models.InternalError
+	}
+	return payout, nil
+}
+
+// ClaimDuePayouts захватывает на очередную попытку не больше limit незавершённых выплат, по которым подошло время повтора
+func (r *CreatorRepo) ClaimDuePayouts(ctx context.Context, limit int64) ([]models.Payout, error) {
+	var payouts = make([]models.Payout, 0)
+	rows, err := r.db.QueryContext(ctx, ClaimDuePayouts, limit)
+	if err != nil && !errors.Is(sql.ErrNoRows, err) {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		payout, err := scanPayout(rows.Scan)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		payouts = append(payouts, payout)
+	}
+	return payouts, nil
+}
+
+// UpdatePayoutStatus сохраняет новое состояние выплаты, если она всё ещё в состоянии from.
+// Выплата в failed возвращает списанную при создании сумму на баланс автора в той же транзакции.
+// Возвращает WrongData, если выплату уже перевели в другое состояние.
+func (r *CreatorRepo) UpdatePayoutStatus(ctx context.Context, payout models.Payout, from string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+
+	var updatedAt time.Time
+	row := tx.QueryRowContext(ctx, UpdatePayoutStatus, payout.Status, payout.ProviderRequestID, payout.LastError, payout.Id, from)
+	if err = row.Scan(&updatedAt); errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		return models.WrongData
+	} else if err != nil {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	}
+	if payout.Status == models.PayoutStatusFailed {
+		if err = r.postPayout(ctx, tx, payout, payout.Money, models.LedgerKindPayoutReturn); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+// CompletePayout переводит выплату в succeeded, сумма списана с баланса ещё при создании выплаты
+func (r *CreatorRepo) CompletePayout(ctx context.Context, payout models.Payout) (models.Payout, error) {
+	row := r.db.QueryRowContext(ctx, CompletePayout, payout.Id)
+	if err := row.Scan(&payout.UpdatedAt); errors.Is(err, sql.ErrNoRows) {
+		return models.Payout{}, models.WrongData
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.Payout{}, models.InternalError
+	}
+	payout.Status = models.PayoutStatusSucceeded
+	payout.LastError = ""
+	return payout, nil
+}
+
+// postPayout изменяет баланс автора на amount и записывает в журнал проводку между счетами creator и payouts.
+// Баланс может уйти в минус: возврат платежа списывается и с уже выведенных денег.
+func (r *CreatorRepo) postPayout(ctx context.Context, tx *sql.Tx, payout models.Payout, amount models.Money, kind string) error {
+	if _, err := tx.ExecContext(ctx, UpdateBalance, amount, payout.CreatorID); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	counter, err := models.NewMoney(0).Sub(amount)
+	if err != nil {
+		return models.WrongData
+	}
+	transactionID := uuid.New()
+	for _, entry := range []struct {
+		account   string
+		creatorID uuid.UUID
+		amount    models.Money
+	}{
+		{account: models.LedgerAccountCreator, creatorID: payout.CreatorID, amount: amount},
+		{account: models.LedgerAccountPayouts, amount: counter},
+	} {
+		if _, err = tx.ExecContext(ctx, AddLedgerEntry, transactionID, entry.account, entry.creatorID, kind, entry.amount, payout.Id.String()); err != nil {
+			r.logger.Error(err)
+			return models.InternalError
+		}
 	}
-	return newBalance, nil
+	return nil
+}
+
+func scanPayout(scan func(dest ...interface{}) error) (models.Payout, error) {
+	var payout models.Payout
+	err := scan(&payout.Id, &payout.CreatorID, &payout.Money, &payout.PhoneNumber, &payout.IdempotencyKey, &payout.Status,
+		&payout.ProviderRequestID, &payout.Attempts, &payout.LastError, &payout.CreatedAt, &payout.UpdatedAt, &payout.DestinationID)
+	return payout, err
+}
+
+// GetAvailableBalance возвращает сумму, которую автор может вывести: суммы незавершённых выплат уже списаны с баланса
+func (r *CreatorRepo) GetAvailableBalance(ctx context.Context, creatorID uuid.UUID) (models.Money, error) {
+	var available models.Money
+	row := r.db.QueryRowContext(ctx, GetAvailableBalance, creatorID)
+	if err := row.Scan(&available); errors.Is(err, sql.ErrNoRows) {
+		return models.Money{}, models.NotFound
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.Money{}, models.InternalError
+	}
+	return available, nil
+}
+
+func (r *CreatorRepo) AddPayoutDestination(ctx context.Context, destination models.PayoutDestination) (models.PayoutDestination, error) {
+	row := r.db.QueryRowContext(ctx, AddPayoutDestination, destination.Id, destination.CreatorID, destination.PhoneNumber)
+	if err := row.Scan(&destination.VerifiedAt); err != nil {
+		r.logger.Error(err)
+		return models.PayoutDestination{}, models.InternalError
+	}
+	return destination, nil
+}
+
+func (r *CreatorRepo) PayoutDestinations(ctx context.Context, creatorID uuid.UUID) ([]models.PayoutDestination, error) {
+	var destinations = make([]models.PayoutDestination, 0)
+	rows, err := r.db.QueryContext(ctx, PayoutDestinations, creatorID)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		destination, err := scanPayoutDestination(rows.Scan)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		destinations = append(destinations, destination)
+	}
+	return destinations, nil
+}
+
+func (r *CreatorRepo) GetPayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) (models.PayoutDestination, error) {
+	destination, err := scanPayoutDestination(r.db.QueryRowContext(ctx, GetPayoutDestination, destinationID, creatorID).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.PayoutDestination{}, models.NotFound
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.PayoutDestination{}, models.InternalError
+	}
+	return destination, nil
+}
+
+// DeletePayoutDestination удаляет реквизиты вместе с расписанием, которое на них ссылается.
+// У проведённых выплат остаётся свой номер телефона, ссылка на реквизиты обнуляется.
+func (r *CreatorRepo) DeletePayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, DeletePayoutDestination, destinationID, creatorID)
+	if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	if deleted, err := result.RowsAffected(); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	} else if deleted == 0 {
+		return models.NotFound
+	}
+	return nil
+}
+
+func scanPayoutDestination(scan func(dest ...interface{}) error) (models.PayoutDestination, error) {
+	var destination models.PayoutDestination
+	err := scan(&destination.Id, &destination.CreatorID, &destination.PhoneNumber, &destination.VerifiedAt)
+	return destination, err
+}
+
+func (r *CreatorRepo) GetPayoutSchedule(ctx context.Context, creatorID uuid.UUID) (models.PayoutSchedule, error) {
+	schedule, err := scanPayoutSchedule(r.db.QueryRowContext(ctx, GetPayoutSchedule, creatorID).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.PayoutSchedule{}, models.NotFound
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.PayoutSchedule{}, models.InternalError
+	}
+	return schedule, nil
+}
+
+func (r *CreatorRepo) SetPayoutSchedule(ctx context.Context, schedule models.PayoutSchedule) error {
+	_, err := r.db.ExecContext(ctx, SetPayoutSchedule, schedule.CreatorID, schedule.DestinationID, schedule.Period, schedule.Threshold, schedule.NextRunAt)
+	if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+func (r *CreatorRepo) DeletePayoutSchedule(ctx context.Context, creatorID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, DeletePayoutSchedule, creatorID)
+	if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	if deleted, err := result.RowsAffected(); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	} else if deleted == 0 {
+		return models.NotFound
+	}
+	return nil
+}
+
+// ClaimDuePayoutSchedules захватывает не больше limit расписаний, время запуска которых наступило к now,
+// на PayoutScheduleClaim, чтобы их не взяла параллельная джоба. Время запуска не меняется: его переносит
+// MovePayoutSchedule после создания выплаты, а незавершённый запуск повторяется, когда захват истечёт.
+func (r *CreatorRepo) ClaimDuePayoutSchedules(ctx context.Context, now time.Time, limit int64) ([]models.PayoutSchedule, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+
+	var schedules = make([]models.PayoutSchedule, 0)
+	rows, err := tx.QueryContext(ctx, DuePayoutSchedules, now, limit)
+	if err != nil {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return nil, models.InternalError
+	}
+	for rows.Next() {
+		schedule, err := scanPayoutSchedule(rows.Scan)
+		if err != nil {
+			r.logger.Error(err)
+			_ = rows.Close()
+			_ = tx.Rollback()
+			return nil, models.InternalError
+		}
+		schedules = append(schedules, schedule)
+	}
+	_ = rows.Close()
+
+	for _, schedule := range schedules {
+		if _, err = tx.ExecContext(ctx, ClaimPayoutSchedule, now.Add(models.PayoutScheduleClaim), schedule.CreatorID); err != nil {
+			r.logger.Error(err)
+			_ = tx.Rollback()
+			return nil, models.InternalError
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	return schedules, nil
+}
+
+// MovePayoutSchedule переносит запуск расписания на next и снимает захват. Запуск, который успели изменить
+// через SetPayoutSchedule, не переносится.
+func (r *CreatorRepo) MovePayoutSchedule(ctx context.Context, schedule models.PayoutSchedule, next time.Time) error {
+	if _, err := r.db.ExecContext(ctx, MovePayoutSchedule, next, schedule.CreatorID, schedule.NextRunAt); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+func scanPayoutSchedule(scan func(dest ...interface{}) error) (models.PayoutSchedule, error) {
+	var schedule models.PayoutSchedule
+	err := scan(&schedule.CreatorID, &schedule.DestinationID, &schedule.Period, &schedule.Threshold, &schedule.NextRunAt)
+	return schedule, err
+}
+
+func (r *CreatorRepo) CreatorLedger(ctx context.Context, filter models.LedgerFilter) ([]models.LedgerEntry, error) {
+	var entries = make([]models.LedgerEntry, 0)
+	rows, err := r.db.QueryContext(ctx, CreatorLedger, filter.CreatorId, filter.From, filter.To, filter.Limit, filter.Offset)
+	if err != nil && !errors.Is(sql.ErrNoRows, err) {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var entry models.LedgerEntry
+		err = rows.Scan(&entry.Id, &entry.TransactionId, &entry.Kind, &entry.Amount, &entry.BalanceAfter, &entry.Reference, &entry.CreatedAt)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (r *CreatorRepo) LedgerMismatches(ctx context.Context) ([]models.LedgerMismatch, error) {
+	var mismatches = make([]models.LedgerMismatch, 0)
+	rows, err := r.db.QueryContext(ctx, LedgerMismatches)
+	if err != nil && !errors.Is(sql.ErrNoRows, err) {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var mismatch models.LedgerMismatch
+		if err = rows.Scan(&mismatch.CreatorId, &mismatch.Balance, &mismatch.LedgerBalance); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+	return mismatches, nil
 }
 
 func (r *CreatorRepo) GetUserSubscriptions(ctx context.Context, userId uuid.UUID) ([]uuid.UUID, error) {
@@ -100,16 +532,74 @@ func (r *CreatorRepo) StatisticsFirstDate(ctx context.Context, creatorID uuid.UU
 	return firstDate, nil
 }
 
-func (r *CreatorRepo) GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (float32, error) {
-	var balance float32
+func (r *CreatorRepo) GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (models.Money, error) {
+	var balance models.Money
 	row := r.db.QueryRowContext(ctx, CreatorBalance, creatorID)
 	if err := row.Scan(&balance); err != nil && !errors.Is(sql.ErrNoRows, err) {
 		r.logger.Error(err)
-		return 0, models.InternalError
+		return models.Money{}, models.InternalError
 	}
 	return balance, nil
 }
 
+// GetUploadLimit возвращает максимальный размер файла, который может загрузить автор, WrongData - автора нет
+func (r *CreatorRepo) GetUploadLimit(ctx context.Context, creatorID uuid.UUID) (int64, error) {
+	var limit int64
+	row := r.db.QueryRowContext(ctx, UploadLimit, creatorID)
+	if err := row.Scan(&limit); errors.Is(err, sql.ErrNoRows) {
+		return 0, models.WrongData
+	} else if err != nil {
+		r.logger.Error(err)
+		return 0, models.InternalError
+	}
+	return limit, nil
+}
+
+// GetStorageUsage возвращает тариф автора и место, которое занимают его вложения, по типам файлов. WrongData - автора нет
+func (r *CreatorRepo) GetStorageUsage(ctx context.Context, creatorID uuid.UUID) (models.StorageUsage, error) {
+	usage := models.StorageUsage{Types: make([]models.MediaTypeUsage, 0)}
+	row := r.db.QueryRowContext(ctx, StoragePlan, creatorID)
+	if err := row.Scan(&usage.Plan, &usage.Quota); errors.Is(err, sql.ErrNoRows) {
+		return models.StorageUsage{}, models.WrongData
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.StorageUsage{}, models.InternalError
+	}
+
+	rows, err := r.db.QueryContext(ctx, StorageByType, creatorID)
+	if err != nil {
+		r.logger.Error(err)
+		return models.StorageUsage{}, models.InternalError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tmp models.MediaTypeUsage
+		if err = rows.Scan(&tmp.Type, &tmp.Files, &tmp.Bytes); err != nil {
+			r.logger.Error(err)
+			return models.StorageUsage{}, models.InternalError
+		}
+		usage.Used += tmp.Bytes
+		usage.Types = append(usage.Types, tmp)
+	}
+	if err = rows.Err(); err != nil {
+		r.logger.Error(err)
+		return models.StorageUsage{}, models.InternalError
+	}
+	return usage, nil
+}
+
+// CreatorIncome возвращает доход автора за всё время до вычета комиссии и сумму удержанной комиссии за вычетом возвратов
+func (r *CreatorRepo) CreatorIncome(ctx context.Context, creatorID uuid.UUID) (models.Money, models.Money, error) {
+	var gross, commission models.Money
+	row := r.db.QueryRowContext(ctx, CreatorIncome, creatorID)
+	if err := row.Scan(&gross, &commission); err != nil {
+		r.logger.Error(err)
+		return models.Money{}, models.Money{}, models.InternalError
+	}
+	return gross, commission, nil
+}
+
 func (r *CreatorRepo) IsLiked(ctx context.Context, userID uuid.UUID, postID uuid.UUID) (bool, error) {
 	row := r.db.QueryRowContext(ctx, IsLiked, postID, userID)
 	if err := row.Scan(&postID, &userID); err != nil && !errors.Is(sql.ErrNoRows, err) {
@@ -124,16 +614,14 @@ func (r *CreatorRepo) IsLiked(ctx context.Context, userID uuid.UUID, postID uuid
 func (r *CreatorRepo) CreatorInfo(ctx context.Context, creatorPage *models.CreatorPage, creatorID uuid.UUID) error {
 	row := r.db.QueryRowContext(ctx, CreatorInfo, creatorID)
 	creatorPage.CreatorInfo.Id = creatorID
-	var tmpAim sql.NullString
 	if err := row.Scan(&creatorPage.CreatorInfo.UserId, &creatorPage.CreatorInfo.Name, &creatorPage.CreatorInfo.CoverPhoto,
 		&creatorPage.CreatorInfo.FollowersCount, &creatorPage.CreatorInfo.Description, &creatorPage.CreatorInfo.PostsCount,
-		&tmpAim, &creatorPage.Aim.MoneyGot, &creatorPage.Aim.MoneyNeeded, &creatorPage.CreatorInfo.ProfilePhoto); err != nil && !errors.Is(sql.ErrNoRows, err) {
+		&creatorPage.CreatorInfo.ProfilePhoto); err != nil && !errors.Is(sql.ErrNoRows, err) {
 		return models.InternalError
 	} else if errors.Is(sql.ErrNoRows, err) {
 		return models.NotFound
 	}
 	creatorPage.Aim.Creator = creatorID
-	creatorPage.Aim.Description = tmpAim.String
 	return nil
 }
 
@@ -163,9 +651,64 @@ func (r *CreatorRepo) GetCreatorSubs(ctx context.Context, creatorID uuid.UUID) (
 
 		subs = append(subs, tmpSub)
 	}
+
+	options, err := r.creatorBillingOptions(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range subs {
+		subs[i].BillingOptions = options[subs[i].Id]
+		subs[i].SetBillingPrices()
+	}
 	return subs, nil
 }
 
+// creatorBillingOptions возвращает варианты оплаты всех уровней автора
+func (r *CreatorRepo) creatorBillingOptions(ctx context.Context, creatorID uuid.UUID) (map[uuid.UUID][]models.BillingOption, error) {
+	options := make(map[uuid.UUID][]models.BillingOption)
+	rows, err := r.db.QueryContext(ctx, GetCreatorBilling, creatorID)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var subscriptionID uuid.UUID
+		var option models.BillingOption
+		if err = rows.Scan(&subscriptionID, &option.MonthCount, &option.Price, &option.DiscountPercent); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		options[subscriptionID] = append(options[subscriptionID], option)
+	}
+	return options, nil
+}
+
+// postAttachments собирает вложения поста из массивов array_agg, у поста без вложений в массивах один NULL
+func postAttachments(attachs []uuid.UUID, types []sql.NullString, renditions []sql.NullString, metadata []sql.NullString) ([]models.Attachment, error) {
+	attachments := make([]models.Attachment, 0, len(attachs))
+	for i, v := range attachs {
+		if v == uuid.Nil {
+			continue
+		}
+		attachment := models.Attachment{Id: v, Type: types[i].String}
+		if i < len(renditions) {
+			var err error
+			if attachment.Renditions, err = models.ParseRenditions(renditions[i].String); err != nil {
+				return nil, err
+			}
+		}
+		if i < len(metadata) {
+			var err error
+			if attachment.AttachmentMetadata, err = models.ParseAttachmentMetadata(metadata[i].String); err != nil {
+				return nil, err
+			}
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments, nil
+}
+
 func (r *CreatorRepo) CreatorPosts(ctx context.Context, creatorId uuid.UUID) ([]models.Post, error) {
 	var posts = make([]models.Post, 0)
 	rows, err := r.db.QueryContext(ctx, CreatorPosts, creatorId)
@@ -180,8 +723,10 @@ func (r *CreatorRepo) CreatorPosts(ctx context.Context, creatorId uuid.UUID) ([]
 		post.Creator = creatorId
 		attachs := make([]uuid.UUID, 0)
 		types := make([]sql.NullString, 0)
+		renditions := make([]sql.NullString, 0)
+		metadata := make([]sql.NullString, 0)
 		err = rows.Scan(&post.Id, &post.Creation, &post.Title,
-			&post.Text, &post.LikesCount, &post.CommentsCount, pq.Array(&attachs), pq.Array(&types), pq.Array(&availableSubscriptions)) //подписки, при которыз пост доступен
+			&post.Text, &post.LikesCount, &post.CommentsCount, pq.Array(&attachs), pq.Array(&types), pq.Array(&renditions), pq.Array(&metadata), pq.Array(&availableSubscriptions)) //подписки, при которыз пост доступен
 		if err != nil {
 			r.logger.Error(err)
 			return nil, models.InternalError
@@ -191,11 +736,9 @@ func (r *CreatorRepo) CreatorPosts(ctx context.Context, creatorId uuid.UUID) ([]
 			r.logger.Error(err)
 			return nil, models.InternalError
 		}
-		post.Attachments = make([]models.Attachment, 0, len(attachs))
-		for i, v := range attachs {
-			if v != uuid.Nil {
-				post.Attachments = append(post.Attachments, models.Attachment{Id: v, Type: types[i].String})
-			}
+		if post.Attachments, err = postAttachments(attachs, types, renditions, metadata); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
 		}
 
 		posts = append(posts, post)
@@ -256,12 +799,23 @@ func (r *CreatorRepo) GetPage(ctx context.Context, userId uuid.UUID, creatorId u
 				creatorPage.Posts[i].Text = ""
 				creatorPage.Posts[i].Attachments = nil
 			}
+			// вложения из карантина и отклонённые проверкой видит только автор
+			if !creatorPage.IsMyPage {
+				creatorPage.Posts[i].Attachments = models.ScannedAttachments(creatorPage.Posts[i].Attachments)
+			}
 		}
 
 		if creatorPage.Subscriptions, err = r.GetCreatorSubs(ctx, creatorId); err != nil {
 			return models.CreatorPage{}, err
 		}
 
+		if creatorPage.Aims, err = r.CreatorAims(ctx, creatorId, models.AimStatusActive); err != nil {
+			return models.CreatorPage{}, err
+		}
+		if len(creatorPage.Aims) != 0 { // основная цель - первая по порядку
+			creatorPage.Aim = creatorPage.Aims[0]
+		}
+
 		return creatorPage, nil
 	}
 	return models.CreatorPage{}, models.WrongData // такого автора нет
@@ -287,7 +841,7 @@ func (r *CreatorRepo) GetSubsByID(ctx context.Context, subsIDs ...uuid.UUID) ([]
 }
 
 func (r *CreatorRepo) CreateAim(ctx context.Context, aimInfo models.Aim) error {
-	row := r.db.QueryRowContext(ctx, AddAim, aimInfo.Description, aimInfo.MoneyGot, aimInfo.MoneyNeeded, aimInfo.Creator)
+	row := r.db.QueryRowContext(ctx, AddAim, aimInfo.Id, aimInfo.Creator, aimInfo.Description, aimInfo.MoneyNeeded, aimInfo.Deadline, aimInfo.Position)
 	if err := row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		r.logger.Error(err)
 		return models.InternalError
@@ -295,6 +849,50 @@ func (r *CreatorRepo) CreateAim(ctx context.Context, aimInfo models.Aim) error {
 	return nil
 }
 
+// UpdateAim изменяет цель и возвращает её; если собранного уже хватает на новую сумму, цель отмечается достигнутой
+func (r *CreatorRepo) UpdateAim(ctx context.Context, aimInfo models.Aim) (models.Aim, error) {
+	var completedAt sql.NullTime
+	row := r.db.QueryRowContext(ctx, UpdateAim, aimInfo.Description, aimInfo.MoneyNeeded, aimInfo.Deadline, aimInfo.Position, aimInfo.Id, aimInfo.Creator)
+	if err := row.Scan(&aimInfo.MoneyGot, &completedAt); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.logger.Error(err)
+		return models.Aim{}, models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) { // нет такой цели у автора или она уже собрана
+		return models.Aim{}, models.NotFound
+	}
+	if completedAt.Valid {
+		aimInfo.CompletedAt = &completedAt.Time
+	}
+	return aimInfo, nil
+}
+
+func (r *CreatorRepo) CreatorAims(ctx context.Context, creatorID uuid.UUID, status string) ([]models.Aim, error) {
+	var aims = make([]models.Aim, 0)
+	rows, err := r.db.QueryContext(ctx, CreatorAims, creatorID, status)
+	if err != nil && !errors.Is(sql.ErrNoRows, err) {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		aim := models.Aim{Creator: creatorID}
+		var deadline, completedAt sql.NullTime
+		err = rows.Scan(&aim.Id, &aim.Description, &aim.MoneyNeeded, &aim.MoneyGot, &deadline, &aim.Position, &completedAt)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		if deadline.Valid {
+			aim.Deadline = &deadline.Time
+		}
+		if completedAt.Valid {
+			aim.CompletedAt = &completedAt.Time
+		}
+		aims = append(aims, aim)
+	}
+
+	return aims, nil
+}
+
 func (r *CreatorRepo) GetAllCreators(ctx context.Context) ([]models.Creator, error) {
 	var creators = make([]models.Creator, 0)
 	rows, err := r.db.QueryContext(ctx, GetAllCreators)
@@ -378,8 +976,10 @@ func (r *CreatorRepo) GetFeed(ctx context.Context, userID uuid.UUID) ([]models.P
 		var post models.Post
 		attachs := make([]uuid.UUID, 0)
 		types := make([]sql.NullString, 0)
+		renditions := make([]sql.NullString, 0)
+		metadata := make([]sql.NullString, 0)
 		err = rows.Scan(&post.Id, &post.Creator, &post.Creation,
-			&post.Title, &post.Text, pq.Array(&attachs), pq.Array(&types), &post.CreatorName, &post.CreatorPhoto, &post.LikesCount, &post.CommentsCount)
+			&post.Title, &post.Text, pq.Array(&attachs), pq.Array(&types), pq.Array(&renditions), pq.Array(&metadata), &post.CreatorName, &post.CreatorPhoto, &post.LikesCount, &post.CommentsCount)
 		if err != nil {
 			r.logger.Error(err)
 			return nil, models.InternalError
@@ -391,12 +991,11 @@ func (r *CreatorRepo) GetFeed(ctx context.Context, userID uuid.UUID) ([]models.P
 			return nil, models.InternalError
 		}
 
-		post.Attachments = make([]models.Attachment, 0, len(attachs))
-		for i, v := range attachs {
-			if v != uuid.Nil {
-				post.Attachments = append(post.Attachments, models.Attachment{Id: v, Type: types[i].String})
-			}
+		if post.Attachments, err = postAttachments(attachs, types, renditions, metadata); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
 		}
+		post.Attachments = models.ScannedAttachments(post.Attachments)
 
 		feed = append(feed, post)
 	}
@@ -445,7 +1044,7 @@ func (r *CreatorRepo) Statistics(ctx context.Context, statsInput models.Statisti
 	var stat models.Statistics
 
 	row := r.db.QueryRowContext(ctx, GetStatistics, statsInput.CreatorId, statsInput.FirstMonth.Format(time.RFC3339), statsInput.SecondMonth.Format(time.RFC3339))
-	err := row.Scan(&stat.PostsPerMonth, &stat.SubscriptionsBought, &stat.DonationsCount, &stat.MoneyFromDonations, &stat.MoneyFromSubscriptions, &stat.NewFollowers, &stat.LikesCount, &stat.CommentsCount)
+	err := row.Scan(&stat.PostsPerMonth, &stat.SubscriptionsBought, &stat.DonationsCount, &stat.MoneyFromDonations, &stat.MoneyFromSubscriptions, &stat.NewFollowers, &stat.LikesCount, &stat.CommentsCount, &stat.SubscriptionsExpired, &stat.GiftsBought, &stat.MoneyFromGifts, &stat.Commission)
 	if err != nil && errors.Is(sql.ErrNoRows, err) {
 		return models.Statistics{}, models.WrongData
 	}
@@ -454,6 +1053,150 @@ func (r *CreatorRepo) Statistics(ctx context.Context, statsInput models.Statisti
 		r.logger.Error(err)
 		return models.Statistics{}, models.InternalError
 	}
+	if stat.GrossIncome, err = stat.MoneyFromSubscriptions.Add(stat.MoneyFromDonations); err == nil {
+		stat.GrossIncome, err = stat.GrossIncome.Add(stat.MoneyFromGifts)
+	}
+	if err == nil {
+		stat.NetIncome, err = stat.GrossIncome.Sub(stat.Commission)
+	}
+	if err != nil {
+		r.logger.Error(err)
+		return models.Statistics{}, models.InternalError
+	}
+
+	rows, err := r.db.QueryContext(ctx, GetBillingPeriodStatistics, statsInput.CreatorId, statsInput.FirstMonth.Format(time.RFC3339), statsInput.SecondMonth.Format(time.RFC3339))
+	if err != nil {
+		r.logger.Error(err)
+		return models.Statistics{}, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var period models.BillingPeriodStat
+		if err = rows.Scan(&period.MonthCount, &period.SubscriptionsBought, &period.Money); err != nil {
+			r.logger.Error(err)
+			return models.Statistics{}, models.InternalError
+		}
+		stat.BillingPeriods = append(stat.BillingPeriods, period)
+	}
 
 	return stat, nil
 }
+
+func (r *CreatorRepo) CreatorDonations(ctx context.Context, creatorID uuid.UUID, limit, offset int64) ([]models.Donation, error) {
+	var donations = make([]models.Donation, 0)
+	rows, err := r.db.QueryContext(ctx, CreatorDonations, creatorID, limit, offset)
+	if err != nil && !errors.Is(sql.ErrNoRows, err) {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var donation models.Donation
+		err = rows.Scan(&donation.Id, &donation.UserId, &donation.UserName, &donation.UserPhoto, &donation.Money,
+			&donation.Message, &donation.AimId, &donation.Aim, &donation.IsAnonymous, &donation.Date)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		donations = append(donations, donation)
+	}
+
+	return donations, nil
+}
+
+func (r *CreatorRepo) TopSupporters(ctx context.Context, creatorID uuid.UUID, from time.Time, limit int64) ([]models.Supporter, error) {
+	var supporters = make([]models.Supporter, 0)
+	rows, err := r.db.QueryContext(ctx, TopSupporters, creatorID, from, limit)
+	if err != nil && !errors.Is(sql.ErrNoRows, err) {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var supporter models.Supporter
+		err = rows.Scan(&supporter.UserId, &supporter.Name, &supporter.ProfilePhoto, &supporter.Money, &supporter.DonationsCount)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		supporters = append(supporters, supporter)
+	}
+
+	return supporters, nil
+}
+
+func (r *CreatorRepo) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var isAdmin bool
+	row := r.db.QueryRowContext(ctx, IsAdmin, userID)
+	if err := row.Scan(&isAdmin); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.logger.Error(err)
+		return false, models.InternalError
+	}
+	return isAdmin, nil
+}
+
+// AddCommissionRate сохраняет ставку комиссии, для ставки несуществующего автора возвращает NotFound
+func (r *CreatorRepo) AddCommissionRate(ctx context.Context, rate models.CommissionRate) (models.CommissionRate, error) {
+	row := r.db.QueryRowContext(ctx, AddCommissionRate, rate.CreatorId, rate.RateBP, rate.StartsAt, rate.EndsAt, rate.Description)
+	if err := row.Scan(&rate.Id); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.logger.Error(err)
+		return models.CommissionRate{}, models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) {
+		return models.CommissionRate{}, models.NotFound
+	}
+	return rate, nil
+}
+
+// EndCommissionRate завершает ставку в момент endsAt. Ставку, которая к этому моменту ещё не началась
+// или уже закончилась, завершить нельзя - возвращается NotFound.
+func (r *CreatorRepo) EndCommissionRate(ctx context.Context, rateID uuid.UUID, endsAt time.Time) error {
+	row := r.db.QueryRowContext(ctx, EndCommissionRate, endsAt, rateID)
+	if err := row.Scan(&rateID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.logger.Error(err)
+		return models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) {
+		return models.NotFound
+	}
+	return nil
+}
+
+// SetDefaultCommissionRate делает rateBP бессрочной общей ставкой с текущего момента: действующая
+// бессрочная общая ставка завершается, если она другая. Ставки авторов и промо не меняются.
+func (r *CreatorRepo) SetDefaultCommissionRate(ctx context.Context, rateBP int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+
+	var rateID uuid.UUID
+	var current int64
+	row := tx.QueryRowContext(ctx, DefaultCommissionRate)
+	if err = row.Scan(&rateID, &current); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	} else if err == nil {
+		if current == rateBP {
+			_ = tx.Rollback()
+			return nil
+		}
+		if _, err = tx.ExecContext(ctx, EndDefaultCommission, rateID); err != nil {
+			r.logger.Error(err)
+			_ = tx.Rollback()
+			return models.InternalError
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, AddDefaultCommission, rateBP, models.DefaultCommissionRateTitle); err != nil {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
@@ -23,11 +23,11 @@ var testSubscriptionId = []uuid.UUID{subsIDs[0]}
 var id = subsIDs[0].String()
 var attachTypes = []string{"test1", "test2"}
 var attachments = []models.Attachment{{Id: attachsIDs[0], Type: attachTypes[0]}, {Id: attachsIDs[1], Type: attachTypes[1]}}
-var subs = []models.Subscription{{Id: subsIDs[0], Creator: creatorId, MonthCost: int64(100), Title: "test", Description: "TEST"}, {Id: subsIDs[1], Creator: creatorId, MonthCost: 100}}
+var subs = []models.Subscription{{Id: subsIDs[0], Creator: creatorId, MonthCost: models.NewMoney(10000), Title: "test", Description: "TEST"}, {Id: subsIDs[1], Creator: creatorId, MonthCost: models.NewMoney(10000)}}
 var posts = []models.Post{{Id: uuid.New(), Creator: creatorId, LikesCount: 4, CommentsCount: 4, Title: "test", Text: "TEST", Attachments: attachments, Subscriptions: subs}, {Id: uuid.New(), Creator: creatorId, LikesCount: 15, CommentsCount: 15, Title: "test1", Text: "TEST1", Attachments: attachments, Subscriptions: subs}}
 var creatorInfo = models.Creator{Id: creatorId, UserId: uuid.New(), Name: "testName", FollowersCount: int64(5), Description: "test", PostsCount: 10}
-var creatorAim = models.Aim{MoneyGot: 100.0, MoneyNeeded: 200.0, Description: "testAim", Creator: creatorId}
-var creatorPageRes = models.CreatorPage{CreatorInfo: creatorInfo, Aim: creatorAim}
+var creatorAim = models.Aim{MoneyGot: models.NewMoney(10000), MoneyNeeded: models.NewMoney(20000), Description: "testAim", Creator: creatorId}
+var creatorPageRes = models.CreatorPage{CreatorInfo: creatorInfo, Aim: models.Aim{Creator: creatorId}}
 var creatorPageRes2 = models.CreatorPage{CreatorInfo: creatorInfo, Aim: creatorAim, Posts: posts, Subscriptions: subs}
 var creators = []models.Creator{creatorInfo, creatorInfo}
 
@@ -119,12 +119,12 @@ func TestCreatorRepo_CreatorPosts(t *testing.T) {
 		{
 			name: "Ok",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"post_id", "creation_date", "title", "post_text", "likes_count", "comments_count", "attachment_id", "attachment_type", "subscription_id"})
+				rows := sqlmock.NewRows([]string{"post_id", "creation_date", "title", "post_text", "likes_count", "comments_count", "attachment_id", "attachment_type", "renditions", "metadata", "subscription_id"})
 
-				rows = rows.AddRow(posts[0].Id, posts[0].Creation, posts[0].Title, posts[0].Text, posts[0].LikesCount, posts[0].CommentsCount, fmt.Sprintf("{'%s','%s'}", attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s}", attachTypes[0], attachTypes[1]), fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
-				rows = rows.AddRow(posts[1].Id, posts[1].Creation, posts[1].Title, posts[1].Text, posts[1].LikesCount, posts[1].CommentsCount, fmt.Sprintf("{'%s','%s'}", attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s}", attachTypes[0], attachTypes[1]), fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
+				rows = rows.AddRow(posts[0].Id, posts[0].Creation, posts[0].Title, posts[0].Text, posts[0].LikesCount, posts[0].CommentsCount, fmt.Sprintf("{'%s','%s'}", attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s}", attachTypes[0], attachTypes[1]), "{NULL,NULL}", "{NULL,NULL}", fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
+				rows = rows.AddRow(posts[1].Id, posts[1].Creation, posts[1].Title, posts[1].Text, posts[1].LikesCount, posts[1].CommentsCount, fmt.Sprintf("{'%s','%s'}", attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s}", attachTypes[0], attachTypes[1]), "{NULL,NULL}", "{NULL,NULL}", fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
 
-				mock.ExpectQuery(`SELECT "post"\.post_id, creation_date, title, post_text, likes_count, comments_count, array_agg\(attachment_id\), array_agg\(attachment_type\), array_agg\(DISTINCT subscription_id\) FROM "post" LEFT JOIN "attachment" a on "post"\.post_id \= a\.post_id LEFT JOIN "post_subscription" ps on "post"\.post_id \= ps\.post_id WHERE`).
+				mock.ExpectQuery(`SELECT "post"\.post_id, creation_date, title, post_text, likes_count, comments_count, array_agg\(attachment_id\), array_agg\(attachment_type\), array_agg\(a\.renditions::text\), array_agg\(json_build_object\(.+\)::text\), array_agg\(DISTINCT subscription_id\) FROM "post" LEFT JOIN "attachment" a on "post"\.post_id \= a\.post_id LEFT JOIN "post_subscription" ps on "post"\.post_id \= ps\.post_id WHERE`).
 					WithArgs(creatorId).WillReturnRows(rows)
 				for i := 0; i < 4; i++ {
 					rows = sqlmock.NewRows([]string{"creator_id", "month_cost", "title", "description"}).AddRow(subs[i%2].Creator, subs[i%2].MonthCost, subs[i%2].Title, subs[i%2].Description)
@@ -137,7 +137,7 @@ func TestCreatorRepo_CreatorPosts(t *testing.T) {
 		{
 			name: "Internal Error for Get Posts",
 			mock: func() {
-				mock.ExpectQuery(`SELECT "post"\.post_id, creation_date, title, post_text, likes_count, comments_count, array_agg\(attachment_id\), array_agg\(attachment_type\), array_agg\(DISTINCT subscription_id\) FROM "post" LEFT JOIN "attachment" a on "post"\.post_id \= a\.post_id LEFT JOIN "post_subscription" ps on "post"\.post_id \= ps\.post_id WHERE`).
+				mock.ExpectQuery(`SELECT "post"\.post_id, creation_date, title, post_text, likes_count, comments_count, array_agg\(attachment_id\), array_agg\(attachment_type\), array_agg\(a\.renditions::text\), array_agg\(json_build_object\(.+\)::text\), array_agg\(DISTINCT subscription_id\) FROM "post" LEFT JOIN "attachment" a on "post"\.post_id \= a\.post_id LEFT JOIN "post_subscription" ps on "post"\.post_id \= ps\.post_id WHERE`).
 					WithArgs(creatorId).WillReturnError(errors.New("test"))
 			},
 			creatorId:   creatorId,
@@ -146,12 +146,12 @@ func TestCreatorRepo_CreatorPosts(t *testing.T) {
 		{
 			name: "Internal Error in GetSubsById",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"post_id", "creation_date", "title", "post_text", "likes_count", "comments_count", "attachment_id", "attachment_type", "subscription_id"})
+				rows := sqlmock.NewRows([]string{"post_id", "creation_date", "title", "post_text", "likes_count", "comments_count", "attachment_id", "attachment_type", "renditions", "metadata", "subscription_id"})
 
-				rows = rows.AddRow(posts[0].Id, posts[0].Creation, posts[0].Title, posts[0].Text, posts[0].LikesCount, posts[0].CommentsCount, fmt.Sprintf("{'%s','%s','%s','%s'}", attachsIDs[0], attachsIDs[1], attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s,%s,%s}", attachTypes[0], attachTypes[1], attachTypes[0], attachTypes[1]), fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
-				rows = rows.AddRow(posts[1].Id, posts[1].Creation, posts[1].Title, posts[1].Text, posts[1].LikesCount, posts[1].CommentsCount, fmt.Sprintf("{'%s','%s','%s','%s'}", attachsIDs[0], attachsIDs[1], attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s,%s,%s}", attachTypes[0], attachTypes[1], attachTypes[0], attachTypes[1]), fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
+				rows = rows.AddRow(posts[0].Id, posts[0].Creation, posts[0].Title, posts[0].Text, posts[0].LikesCount, posts[0].CommentsCount, fmt.Sprintf("{'%s','%s','%s','%s'}", attachsIDs[0], attachsIDs[1], attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s,%s,%s}", attachTypes[0], attachTypes[1], attachTypes[0], attachTypes[1]), "{NULL,NULL,NULL,NULL}", "{NULL,NULL,NULL,NULL}", fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
+				rows = rows.AddRow(posts[1].Id, posts[1].Creation, posts[1].Title, posts[1].Text, posts[1].LikesCount, posts[1].CommentsCount, fmt.Sprintf("{'%s','%s','%s','%s'}", attachsIDs[0], attachsIDs[1], attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s,%s,%s}", attachTypes[0], attachTypes[1], attachTypes[0], attachTypes[1]), "{NULL,NULL,NULL,NULL}", "{NULL,NULL,NULL,NULL}", fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
 
-				mock.ExpectQuery(`SELECT "post"\.post_id, creation_date, title, post_text, likes_count, comments_count, array_agg\(attachment_id\), array_agg\(attachment_type\), array_agg\(DISTINCT subscription_id\) FROM "post" LEFT JOIN "attachment" a on "post"\.post_id \= a\.post_id LEFT JOIN "post_subscription" ps on "post"\.post_id \= ps\.post_id WHERE`).
+				mock.ExpectQuery(`SELECT "post"\.post_id, creation_date, title, post_text, likes_count, comments_count, array_agg\(attachment_id\), array_agg\(attachment_type\), array_agg\(a\.renditions::text\), array_agg\(json_build_object\(.+\)::text\), array_agg\(DISTINCT subscription_id\) FROM "post" LEFT JOIN "attachment" a on "post"\.post_id \= a\.post_id LEFT JOIN "post_subscription" ps on "post"\.post_id \= ps\.post_id WHERE`).
 					WithArgs(creatorId).WillReturnRows(rows)
 
 				mock.ExpectQuery(`SELECT creator_id, month_cost, title, description FROM "subscription" WHERE`).WithArgs(subsIDs[0]).WillReturnError(models.InternalError)
@@ -163,12 +163,12 @@ func TestCreatorRepo_CreatorPosts(t *testing.T) {
 		{
 			name: "Internal Error wrong data type",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"post_id", "creation_date", "title", "post_text", "likes_count", "comments_count", "attachment_id", "attachment_type", "subscription_id"})
+				rows := sqlmock.NewRows([]string{"post_id", "creation_date", "title", "post_text", "likes_count", "comments_count", "attachment_id", "attachment_type", "renditions", "metadata", "subscription_id"})
 
-				rows = rows.AddRow(posts[0].Id, posts[0].Creation, posts[0].Title, posts[0].Text, posts[0].LikesCount, posts[0].CommentsCount, fmt.Sprintf("{'%s','%s','%s','%s'}", attachsIDs[0], attachsIDs[1], attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s,%s,%s}", attachTypes[0], attachTypes[1], attachTypes[0], attachTypes[1]), fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
-				rows = rows.AddRow(posts[1].Id, posts[0].Creation, posts[0].Title, posts[0].Text, posts[1].LikesCount, posts[1].CommentsCount, fmt.Sprintf("{'%s','%s','%s','%s'}", attachsIDs[0], attachsIDs[1], attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s,%s,%s}", attachTypes[0], attachTypes[1], attachTypes[0], attachTypes[1]), fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
+				rows = rows.AddRow(posts[0].Id, posts[0].Creation, posts[0].Title, posts[0].Text, posts[0].LikesCount, posts[0].CommentsCount, fmt.Sprintf("{'%s','%s','%s','%s'}", attachsIDs[0], attachsIDs[1], attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s,%s,%s}", attachTypes[0], attachTypes[1], attachTypes[0], attachTypes[1]), "{NULL,NULL,NULL,NULL}", "{NULL,NULL,NULL,NULL}", fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
+				rows = rows.AddRow(posts[1].Id, posts[0].Creation, posts[0].Title, posts[0].Text, posts[1].LikesCount, posts[1].CommentsCount, fmt.Sprintf("{'%s','%s','%s','%s'}", attachsIDs[0], attachsIDs[1], attachsIDs[0], attachsIDs[1]), fmt.Sprintf("{%s,%s,%s,%s}", attachTypes[0], attachTypes[1], attachTypes[0], attachTypes[1]), "{NULL,NULL,NULL,NULL}", "{NULL,NULL,NULL,NULL}", fmt.Sprintf("{'%s','%s'}", subsIDs[0], subsIDs[1]))
 
-				mock.ExpectQuery(`SELECT "post"\.post_id, creation_date, title, post_text, likes_count, comments_count, array_agg\(attachment_id\), array_agg\(attachment_type\), array_agg\(DISTINCT subscription_id\) FROM "post" LEFT JOIN "attachment" a on "post"\.post_id \= a\.post_id LEFT JOIN "post_subscription" ps on "post"\.post_id \= ps\.post_id WHERE`).
+				mock.ExpectQuery(`SELECT "post"\.post_id, creation_date, title, post_text, likes_count, comments_count, array_agg\(attachment_id\), array_agg\(attachment_type\), array_agg\(a\.renditions::text\), array_agg\(json_build_object\(.+\)::text\), array_agg\(DISTINCT subscription_id\) FROM "post" LEFT JOIN "attachment" a on "post"\.post_id \= a\.post_id LEFT JOIN "post_subscription" ps on "post"\.post_id \= ps\.post_id WHERE`).
 					WithArgs(creatorId).WillReturnRows(rows)
 
 			},
@@ -371,9 +371,9 @@ func TestCreatorRepo_CreatorInfo(t *testing.T) {
 			name: "Ok",
 			mock: func() {
 				rows := sqlmock.NewRows([]string{"user_id", "name", "cover_photo", "followers_count", "description", "posts_count",
-					"aim", "money_got", "money_needed", "profile_photo"}).AddRow(creatorInfo.UserId, creatorInfo.Name, creatorInfo.CoverPhoto, creatorInfo.FollowersCount,
-					creatorInfo.Description, creatorInfo.PostsCount, creatorAim.Description, creatorAim.MoneyGot, creatorAim.MoneyNeeded, creatorInfo.ProfilePhoto)
-				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, aim, money_got, money_needed, profile_photo FROM "creator" WHERE`).
+					"profile_photo"}).AddRow(creatorInfo.UserId, creatorInfo.Name, creatorInfo.CoverPhoto, creatorInfo.FollowersCount,
+					creatorInfo.Description, creatorInfo.PostsCount, creatorInfo.ProfilePhoto)
+				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM "creator" WHERE`).
 					WithArgs(creatorId).WillReturnRows(rows)
 			},
 			creatorPage: &models.CreatorPage{},
@@ -384,7 +384,7 @@ func TestCreatorRepo_CreatorInfo(t *testing.T) {
 		{
 			name: "InternalError",
 			mock: func() {
-				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, aim, money_got, money_needed, profile_photo FROM "creator" WHERE`).
+				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM "creator" WHERE`).
 					WithArgs(creatorId).WillReturnError(errors.New("test"))
 			},
 			creatorPage: &models.CreatorPage{},
@@ -394,7 +394,7 @@ func TestCreatorRepo_CreatorInfo(t *testing.T) {
 		{
 			name: "InternalError",
 			mock: func() {
-				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, aim, money_got, money_needed, profile_photo FROM "creator" WHERE`).
+				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM "creator" WHERE`).
 					WithArgs(creatorId).WillReturnError(sql.ErrNoRows)
 			},
 			creatorPage: &models.CreatorPage{},
@@ -420,6 +420,12 @@ func TestCreatorRepo_CreatorInfo(t *testing.T) {
 }
 
 func TestCreatorRepo_GetCreatorSubs(t *testing.T) {
+	subsWithBilling := append([]models.Subscription{}, subs...)
+	subsWithBilling[0].BillingOptions = []models.BillingOption{
+		{MonthCount: 3, Price: models.NewMoney(27000), DiscountPercent: 10},
+		{MonthCount: 12, Price: models.NewMoney(100000)},
+	}
+
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("cant create mock: %s", err)
@@ -452,9 +458,12 @@ func TestCreatorRepo_GetCreatorSubs(t *testing.T) {
 				}
 				mock.ExpectQuery(`SELECT subscription_id, month_cost, title, description, is_available FROM "subscription" WHERE`).
 					WithArgs(creatorId).WillReturnRows(rows)
+				mock.ExpectQuery(`FROM "subscription_billing_option" o`).
+					WithArgs(creatorId).WillReturnRows(sqlmock.NewRows([]string{"subscription_id", "month_count", "price", "discount_percent"}).
+					AddRow(subs[0].Id, 3, 0, 10).AddRow(subs[0].Id, 12, 100000, 0))
 			},
 			creatorId:   creatorId,
-			expectedRes: subs,
+			expectedRes: subsWithBilling,
 			expectedErr: nil,
 		},
 		{
@@ -528,8 +537,8 @@ func TestCreatorRepo_CreateAim(t *testing.T) {
 			name: "Ok",
 			mock: func() {
 				rows := sqlmock.NewRows([]string{})
-				mock.ExpectQuery(`UPDATE creator SET aim \= \$1, money_got \= \$2, money_needed \= \$3 WHERE creator_id \= \$4; `).
-					WithArgs(creatorAim.Description, creatorAim.MoneyGot, creatorAim.MoneyNeeded, creatorAim.Creator).WillReturnRows(rows)
+				mock.ExpectQuery(`INSERT INTO "aim"`).
+					WithArgs(creatorAim.Id, creatorAim.Creator, creatorAim.Description, creatorAim.MoneyNeeded, sqlmock.AnyArg(), creatorAim.Position).WillReturnRows(rows)
 			},
 			aim:         creatorAim,
 			expectedErr: nil,
@@ -538,8 +547,8 @@ func TestCreatorRepo_CreateAim(t *testing.T) {
 			name: "Internal Error",
 			mock: func() {
 				//rows := sqlmock.NewRows([]string{})
-				mock.ExpectQuery(`UPDATE creator SET aim \= \$1, money_got \= \$2, money_needed \= \$3 WHERE creator_id \= \$4; `).
-					WithArgs(creatorAim.Description, creatorAim.MoneyGot, creatorAim.MoneyNeeded, creatorAim.Creator).WillReturnError(errors.New("test"))
+				mock.ExpectQuery(`INSERT INTO "aim"`).
+					WithArgs(creatorAim.Id, creatorAim.Creator, creatorAim.Description, creatorAim.MoneyNeeded, sqlmock.AnyArg(), creatorAim.Position).WillReturnError(errors.New("test"))
 			},
 			aim:         creatorAim,
 			expectedErr: models.InternalError,
@@ -734,7 +743,7 @@ func TestCreatorRepo_GetPage(t *testing.T) {
 		{
 			name: "Wrong Data no such author",
 			mock: func() {
-				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, aim, money_got, money_needed, profile_photo FROM "creator" WHERE`).
+				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM "creator" WHERE`).
 					WithArgs(creatorId).WillReturnError(sql.ErrNoRows)
 
 			},
@@ -745,7 +754,7 @@ func TestCreatorRepo_GetPage(t *testing.T) {
 		{
 			name: "Internal Error in CreatorInfo",
 			mock: func() {
-				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, aim, money_got, money_needed, profile_photo FROM "creator" WHERE`).
+				mock.ExpectQuery(`SELECT user_id, name, cover_photo, followers_count, description, posts_count, profile_photo FROM "creator" WHERE`).
 					WithArgs(creatorId).WillReturnError(errors.New("test"))
 
 			},
@@ -1179,7 +1188,139 @@ func TestCreatorRepo_CreatorNotificationInfo(t *testing.T) {
 	}
 }
 
-func TestCreatorRepo_UpdateBalance(t *testing.T) {
+var payoutColumnNames = []string{"payout_id", "creator_id", "money", "phone_number", "idempotency_key", "status",
+	"provider_request_id", "attempts", "last_error", "created_at", "updated_at", "destination_id"}
+
+func TestCreatorRepo_CreatePayout(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewCreatorRepo(db, zap.NewNop().Sugar())
+	payout := models.Payout{Id: uuid.New(), CreatorID: uuid.New(), Money: models.NewMoney(10000), PhoneNumber: "89999999999", IdempotencyKey: "key"}
+	payoutRow := func(id uuid.UUID, money int64) *sqlmock.Rows {
+		return sqlmock.NewRows(payoutColumnNames).
+			AddRow(id, payout.CreatorID, money, payout.PhoneNumber, payout.IdempotencyKey, models.PayoutStatusRequested, "", 0, "", time.Now(), time.Now(), payout.DestinationID)
+	}
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedID  uuid.UUID
+		expectedErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT coalesce\(balance, 0\) FROM "creator"`).WithArgs(payout.CreatorID).
+					WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(15000))
+				mock.ExpectQuery(`SELECT payout_id, (.+) FROM "payout" WHERE creator_id = \$1 AND idempotency_key = \$2`).
+					WithArgs(payout.CreatorID, payout.IdempotencyKey).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`INSERT INTO "payout"`).
+					WithArgs(payout.Id, payout.CreatorID, 10000, payout.PhoneNumber, payout.IdempotencyKey, payout.DestinationID).
+					WillReturnRows(payoutRow(payout.Id, 10000))
+				mock.ExpectExec(`UPDATE creator SET balance = balance \+ \$1`).WithArgs(-10000, payout.CreatorID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO "ledger_entry"`).
+					WithArgs(sqlmock.AnyArg(), models.LedgerAccountCreator, payout.CreatorID, models.LedgerKindPayout, -10000, payout.Id.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO "ledger_entry"`).
+					WithArgs(sqlmock.AnyArg(), models.LedgerAccountPayouts, uuid.Nil, models.LedgerKindPayout, 10000, payout.Id.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedID: payout.Id,
+		},
+		{
+			name: "Err in ledger",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT coalesce\(balance, 0\) FROM "creator"`).WithArgs(payout.CreatorID).
+					WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(15000))
+				mock.ExpectQuery(`SELECT payout_id, (.+) FROM "payout" WHERE creator_id = \$1 AND idempotency_key = \$2`).
+					WithArgs(payout.CreatorID, payout.IdempotencyKey).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`INSERT INTO "payout"`).
+					WithArgs(payout.Id, payout.CreatorID, 10000, payout.PhoneNumber, payout.IdempotencyKey, payout.DestinationID).
+					WillReturnRows(payoutRow(payout.Id, 10000))
+				mock.ExpectExec(`UPDATE creator SET balance = balance \+ \$1`).WithArgs(-10000, payout.CreatorID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO "ledger_entry"`).WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+		{
+			name: "Same idempotency key",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT coalesce\(balance, 0\) FROM "creator"`).WithArgs(payout.CreatorID).
+					WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(0))
+				mock.ExpectQuery(`SELECT payout_id, (.+) FROM "payout"`).
+					WithArgs(payout.CreatorID, payout.IdempotencyKey).WillReturnRows(payoutRow(creatorId, 10000))
+				mock.ExpectRollback()
+			},
+			expectedID: creatorId,
+		},
+		{
+			name: "Same idempotency key, other amount",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT coalesce\(balance, 0\) FROM "creator"`).WithArgs(payout.CreatorID).
+					WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(15000))
+				mock.ExpectQuery(`SELECT payout_id, (.+) FROM "payout"`).
+					WithArgs(payout.CreatorID, payout.IdempotencyKey).WillReturnRows(payoutRow(creatorId, 500))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Not enough money",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT coalesce\(balance, 0\) FROM "creator"`).WithArgs(payout.CreatorID).
+					WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(9999))
+				mock.ExpectQuery(`SELECT payout_id, (.+) FROM "payout"`).
+					WithArgs(payout.CreatorID, payout.IdempotencyKey).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Creator not found",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT coalesce\(balance, 0\) FROM "creator"`).WithArgs(payout.CreatorID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.NotFound,
+		},
+		{
+			name: "Err",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT coalesce\(balance, 0\) FROM "creator"`).WithArgs(payout.CreatorID).WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+
+			got, err := r.CreatePayout(context.Background(), payout)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedID, got.Id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreatorRepo_CompletePayout(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("cant create mock: %s", err)
@@ -1195,27 +1336,32 @@ func TestCreatorRepo_UpdateBalance(t *testing.T) {
 	}(logger)
 	zapSugar := logger.Sugar()
 	r := NewCreatorRepo(db, zapSugar)
+	payout := models.Payout{Id: uuid.New(), CreatorID: creatorId, Money: models.NewMoney(10000), Status: models.PayoutStatusProcessing}
 
 	tests := []struct {
 		name        string
 		mock        func()
 		expectedErr error
-		expectedRes float32
 	}{
 		{
 			name: "Ok",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"balance"}).AddRow(120.0)
-				mock.ExpectQuery(`UPDATE creator SET balance = balance`).WithArgs(100.0, creatorId).WillReturnRows(rows)
+				mock.ExpectQuery(`UPDATE "payout" SET status = 'succeeded'`).WithArgs(payout.Id).
+					WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
 			},
 			expectedErr: nil,
-			expectedRes: 120,
 		},
-
+		{
+			name: "Already completed",
+			mock: func() {
+				mock.ExpectQuery(`UPDATE "payout" SET status = 'succeeded'`).WithArgs(payout.Id).WillReturnError(sql.ErrNoRows)
+			},
+			expectedErr: models.WrongData,
+		},
 		{
 			name: "Err",
 			mock: func() {
-				mock.ExpectQuery(`UPDATE creator SET balance = balance`).WithArgs(100.0, creatorId).WillReturnError(errors.New("test"))
+				mock.ExpectQuery(`UPDATE "payout" SET status = 'succeeded'`).WithArgs(payout.Id).WillReturnError(errors.New("test"))
 			},
 			expectedErr: models.InternalError,
 		},
@@ -1225,22 +1371,66 @@ func TestCreatorRepo_UpdateBalance(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			test.mock()
 
-			got, err := r.UpdateBalance(context.Background(), models.CreatorTransfer{
-				Money:       100.0,
-				CreatorID:   creatorId,
-				PhoneNumber: "89999999",
-			})
+			got, err := r.CompletePayout(context.Background(), payout)
 			if test.expectedErr != nil {
-				assert.Error(t, err)
+				assert.Equal(t, test.expectedErr, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, test.expectedRes, got)
+				assert.Equal(t, models.PayoutStatusSucceeded, got.Status)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
+func TestCreatorRepo_UpdatePayoutStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewCreatorRepo(db, zap.NewNop().Sugar())
+	payout := models.Payout{Id: uuid.New(), CreatorID: creatorId, Money: models.NewMoney(10000), Status: models.PayoutStatusProcessing, ProviderRequestID: "request"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE "payout" SET status = \$1`).
+		WithArgs(models.PayoutStatusProcessing, "request", "", payout.Id, models.PayoutStatusRequested).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectCommit()
+	assert.NoError(t, r.UpdatePayoutStatus(context.Background(), payout, models.PayoutStatusRequested))
+
+	// неудавшаяся выплата возвращает сумму на баланс автора
+	failed := payout
+	failed.Status = models.PayoutStatusFailed
+	failed.LastError = "rejected"
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE "payout" SET status = \$1`).
+		WithArgs(models.PayoutStatusFailed, "request", "rejected", payout.Id, models.PayoutStatusProcessing).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectExec(`UPDATE creator SET balance = balance \+ \$1`).WithArgs(10000, creatorId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "ledger_entry"`).
+		WithArgs(sqlmock.AnyArg(), models.LedgerAccountCreator, creatorId, models.LedgerKindPayoutReturn, 10000, payout.Id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "ledger_entry"`).
+		WithArgs(sqlmock.AnyArg(), models.LedgerAccountPayouts, uuid.Nil, models.LedgerKindPayoutReturn, -10000, payout.Id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	assert.NoError(t, r.UpdatePayoutStatus(context.Background(), failed, models.PayoutStatusProcessing))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE "payout" SET status = \$1`).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	assert.Equal(t, models.WrongData, r.UpdatePayoutStatus(context.Background(), payout, models.PayoutStatusRequested))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE "payout" SET status = \$1`).WillReturnError(errors.New("test"))
+	mock.ExpectRollback()
+	assert.Equal(t, models.InternalError, r.UpdatePayoutStatus(context.Background(), payout, models.PayoutStatusRequested))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 var testDate = time.Now()
 
 func TestCreatorRepo_StatisticsFirstDate(t *testing.T) {
@@ -1322,16 +1512,16 @@ func TestCreatorRepo_GetCreatorBalance(t *testing.T) {
 		name        string
 		mock        func()
 		expectedErr error
-		expectedRes float32
+		expectedRes models.Money
 	}{
 		{
 			name: "Ok",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"balance"}).AddRow(100.0)
+				rows := sqlmock.NewRows([]string{"balance"}).AddRow(10010)
 				mock.ExpectQuery(`SELECT balance FROM creator WHERE`).WithArgs(creatorId).WillReturnRows(rows)
 			},
 			expectedErr: nil,
-			expectedRes: 100.0,
+			expectedRes: models.NewMoney(10010),
 		},
 
 		{
@@ -1359,6 +1549,191 @@ func TestCreatorRepo_GetCreatorBalance(t *testing.T) {
 	}
 }
 
+func TestCreatorRepo_GetUploadLimit(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewCreatorRepo(db, zapSugar)
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+		expectedRes int64
+	}{
+		{
+			name: "Ok",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"upload_limit"}).AddRow(int64(models.DefaultUploadLimit))
+				mock.ExpectQuery(`SELECT upload_limit FROM "creator" WHERE`).WithArgs(creatorId).WillReturnRows(rows)
+			},
+			expectedRes: models.DefaultUploadLimit,
+		},
+		{
+			name: "No creator",
+			mock: func() {
+				mock.ExpectQuery(`SELECT upload_limit FROM "creator" WHERE`).WithArgs(creatorId).WillReturnError(sql.ErrNoRows)
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Err",
+			mock: func() {
+				mock.ExpectQuery(`SELECT upload_limit FROM "creator" WHERE`).WithArgs(creatorId).WillReturnError(errors.New("test"))
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+
+			got, err := r.GetUploadLimit(context.Background(), creatorId)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedRes, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreatorRepo_GetStorageUsage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewCreatorRepo(db, zap.NewNop().Sugar())
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+		expectedRes models.StorageUsage
+	}{
+		{
+			name: "Ok",
+			mock: func() {
+				mock.ExpectQuery(`SELECT c.storage_plan, sp.quota FROM "creator" c`).WithArgs(creatorId).
+					WillReturnRows(sqlmock.NewRows([]string{"storage_plan", "quota"}).AddRow("free", int64(1000)))
+				mock.ExpectQuery(`SELECT media_type, files, bytes FROM "creator_storage"`).WithArgs(creatorId).
+					WillReturnRows(sqlmock.NewRows([]string{"media_type", "files", "bytes"}).
+						AddRow("video/mp4", int64(1), int64(200)).
+						AddRow("image/png", int64(2), int64(100)))
+			},
+			expectedRes: models.StorageUsage{Plan: "free", Quota: 1000, Used: 300, Types: []models.MediaTypeUsage{
+				{Type: "video/mp4", Files: 1, Bytes: 200},
+				{Type: "image/png", Files: 2, Bytes: 100},
+			}},
+		},
+		{
+			name: "Nothing uploaded",
+			mock: func() {
+				mock.ExpectQuery(`SELECT c.storage_plan, sp.quota FROM "creator" c`).WithArgs(creatorId).
+					WillReturnRows(sqlmock.NewRows([]string{"storage_plan", "quota"}).AddRow("pro", int64(1000)))
+				mock.ExpectQuery(`SELECT media_type, files, bytes FROM "creator_storage"`).WithArgs(creatorId).
+					WillReturnRows(sqlmock.NewRows([]string{"media_type", "files", "bytes"}))
+			},
+			expectedRes: models.StorageUsage{Plan: "pro", Quota: 1000, Types: []models.MediaTypeUsage{}},
+		},
+		{
+			name: "No creator",
+			mock: func() {
+				mock.ExpectQuery(`SELECT c.storage_plan, sp.quota FROM "creator" c`).WithArgs(creatorId).WillReturnError(sql.ErrNoRows)
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Err",
+			mock: func() {
+				mock.ExpectQuery(`SELECT c.storage_plan, sp.quota FROM "creator" c`).WithArgs(creatorId).
+					WillReturnRows(sqlmock.NewRows([]string{"storage_plan", "quota"}).AddRow("free", int64(1000)))
+				mock.ExpectQuery(`SELECT media_type, files, bytes FROM "creator_storage"`).WithArgs(creatorId).
+					WillReturnError(errors.New("test"))
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+
+			got, err := r.GetStorageUsage(context.Background(), creatorId)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedRes, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreatorRepo_CreatorIncome(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewCreatorRepo(db, zapSugar)
+
+	tests := []struct {
+		name               string
+		mock               func()
+		expectedErr        error
+		expectedGross      models.Money
+		expectedCommission models.Money
+	}{
+		{
+			name: "Ok",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"gross", "fee"}).AddRow(10000, 1000)
+				mock.ExpectQuery(`SELECT coalesce\(sum\(gross - refunded\), 0\), coalesce\(sum\(fee - fee_refunded\), 0\) FROM "revenue_split"`).WithArgs(creatorId).WillReturnRows(rows)
+			},
+			expectedGross:      models.NewMoney(10000),
+			expectedCommission: models.NewMoney(1000),
+		},
+		{
+			name: "Err",
+			mock: func() {
+				mock.ExpectQuery(`FROM "revenue_split"`).WithArgs(creatorId).WillReturnError(errors.New("test"))
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+
+			gross, commission, err := r.CreatorIncome(context.Background(), creatorId)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedGross, gross)
+			assert.Equal(t, test.expectedCommission, commission)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 var testStatDates = models.StatisticsDates{
 	CreatorId:   creatorId,
 	FirstMonth:  time.Now(),
@@ -1391,8 +1766,10 @@ func TestCreatorRepo_Statistics(t *testing.T) {
 		{
 			name: "Ok",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"posts_per_month", "subscriptions_bought", "donations_count", "money_from_donations", "money_from_subscriptions", "new_followers", "likes_count", "comments_count"}).AddRow(10, 10, 10, 10, 10, 10, 10, 10)
+				rows := sqlmock.NewRows([]string{"posts_per_month", "subscriptions_bought", "donations_count", "money_from_donations", "money_from_subscriptions", "new_followers", "likes_count", "comments_count", "subscriptions_expired", "gifts_bought", "money_from_gifts", "commission"}).AddRow(10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 3)
 				mock.ExpectQuery(`SELECT coalesce`).WithArgs(testStatDates.CreatorId, testStatDates.FirstMonth.Format(time.RFC3339), testStatDates.SecondMonth.Format(time.RFC3339)).WillReturnRows(rows)
+				periods := sqlmock.NewRows([]string{"month_count", "subscriptions_bought", "money"}).AddRow(1, 7, 7).AddRow(3, 3, 3)
+				mock.ExpectQuery(`FROM "statistics_billing_period"`).WithArgs(testStatDates.CreatorId, testStatDates.FirstMonth.Format(time.RFC3339), testStatDates.SecondMonth.Format(time.RFC3339)).WillReturnRows(periods)
 			},
 			expectedErr: nil,
 			expectedRes: models.Statistics{
@@ -1400,12 +1777,31 @@ func TestCreatorRepo_Statistics(t *testing.T) {
 				PostsPerMonth:          10,
 				SubscriptionsBought:    10,
 				DonationsCount:         10,
-				MoneyFromDonations:     10,
-				MoneyFromSubscriptions: 10,
+				MoneyFromDonations:     models.NewMoney(10),
+				MoneyFromSubscriptions: models.NewMoney(10),
 				NewFollowers:           10,
 				LikesCount:             10,
 				CommentsCount:          10,
+				SubscriptionsExpired:   10,
+				GiftsBought:            10,
+				MoneyFromGifts:         models.NewMoney(10),
+				GrossIncome:            models.NewMoney(30),
+				Commission:             models.NewMoney(3),
+				NetIncome:              models.NewMoney(27),
+				BillingPeriods: []models.BillingPeriodStat{
+					{MonthCount: 1, SubscriptionsBought: 7, Money: models.NewMoney(7)},
+					{MonthCount: 3, SubscriptionsBought: 3, Money: models.NewMoney(3)},
+				},
+			},
+		},
+		{
+			name: "Err billing periods",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"posts_per_month", "subscriptions_bought", "donations_count", "money_from_donations", "money_from_subscriptions", "new_followers", "likes_count", "comments_count", "subscriptions_expired", "gifts_bought", "money_from_gifts", "commission"}).AddRow(10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 3)
+				mock.ExpectQuery(`SELECT coalesce`).WithArgs(testStatDates.CreatorId, testStatDates.FirstMonth.Format(time.RFC3339), testStatDates.SecondMonth.Format(time.RFC3339)).WillReturnRows(rows)
+				mock.ExpectQuery(`FROM "statistics_billing_period"`).WithArgs(testStatDates.CreatorId, testStatDates.FirstMonth.Format(time.RFC3339), testStatDates.SecondMonth.Format(time.RFC3339)).WillReturnError(errors.New("test"))
 			},
+			expectedErr: models.InternalError,
 		},
 
 		{
@@ -1439,3 +1835,227 @@ func TestCreatorRepo_Statistics(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatorRepo_PayoutDestinations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewCreatorRepo(db, zap.NewNop().Sugar())
+	destination := models.PayoutDestination{Id: uuid.New(), CreatorID: creatorId, PhoneNumber: "enc:v1:test:a:b", VerifiedAt: time.Now()}
+	columns := []string{"destination_id", "creator_id", "phone_number", "verified_at"}
+
+	mock.ExpectQuery(`SELECT destination_id, (.+) FROM "payout_destination" WHERE creator_id = \$1`).WithArgs(creatorId).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(destination.Id, destination.CreatorID, destination.PhoneNumber, destination.VerifiedAt))
+	destinations, err := r.PayoutDestinations(context.Background(), creatorId)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.PayoutDestination{destination}, destinations)
+
+	mock.ExpectQuery(`SELECT destination_id, (.+) FROM "payout_destination" WHERE destination_id = \$1 AND creator_id = \$2`).
+		WithArgs(destination.Id, creatorId).WillReturnError(sql.ErrNoRows)
+	_, err = r.GetPayoutDestination(context.Background(), creatorId, destination.Id)
+	assert.Equal(t, models.NotFound, err)
+
+	mock.ExpectExec(`DELETE FROM "payout_destination"`).WithArgs(destination.Id, creatorId).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, r.DeletePayoutDestination(context.Background(), creatorId, destination.Id))
+
+	mock.ExpectExec(`DELETE FROM "payout_destination"`).WithArgs(destination.Id, creatorId).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.Equal(t, models.NotFound, r.DeletePayoutDestination(context.Background(), creatorId, destination.Id))
+
+	mock.ExpectExec(`DELETE FROM "payout_destination"`).WithArgs(destination.Id, creatorId).WillReturnError(errors.New("test"))
+	assert.Equal(t, models.InternalError, r.DeletePayoutDestination(context.Background(), creatorId, destination.Id))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatorRepo_ClaimDuePayoutSchedules(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewCreatorRepo(db, zap.NewNop().Sugar())
+	now := time.Date(2023, 5, 10, 12, 0, 0, 0, time.UTC)
+	schedule := models.PayoutSchedule{CreatorID: creatorId, DestinationID: uuid.New(), Period: models.PayoutPeriodMonthly,
+		Threshold: models.NewMoney(10000), NextRunAt: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)}
+	columns := []string{"creator_id", "destination_id", "period", "threshold", "next_run_at"}
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expected    []models.PayoutSchedule
+		expectedErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT creator_id, (.+) FROM "payout_schedule" WHERE next_run_at <= \$1 (.+) FOR UPDATE SKIP LOCKED`).
+					WithArgs(now, 10).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(schedule.CreatorID, schedule.DestinationID, schedule.Period, 10000, schedule.NextRunAt))
+				// время запуска не меняется, пока выплата не создана
+				mock.ExpectExec(`UPDATE "payout_schedule" SET claimed_until = \$1`).
+					WithArgs(now.Add(models.PayoutScheduleClaim), creatorId).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expected: []models.PayoutSchedule{schedule},
+		},
+		{
+			name: "Err",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT creator_id, (.+) FROM "payout_schedule"`).WithArgs(now, 10).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(schedule.CreatorID, schedule.DestinationID, schedule.Period, 10000, schedule.NextRunAt))
+				mock.ExpectExec(`UPDATE "payout_schedule" SET claimed_until`).WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+
+			schedules, err := r.ClaimDuePayoutSchedules(context.Background(), now, 10)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expected, schedules)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreatorRepo_CommissionRate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewCreatorRepo(db, zap.NewNop().Sugar())
+	rateID := uuid.New()
+	rate := models.CommissionRate{CreatorId: creatorId, RateBP: 500, StartsAt: time.Now(), Description: "Промо"}
+
+	mock.ExpectQuery(`INSERT INTO "commission_rate" (.+) WHERE \$1 = (.+) OR EXISTS`).
+		WithArgs(creatorId, int64(500), rate.StartsAt, sqlmock.AnyArg(), rate.Description).
+		WillReturnRows(sqlmock.NewRows([]string{"rate_id"}).AddRow(rateID))
+	got, err := r.AddCommissionRate(context.Background(), rate)
+	assert.NoError(t, err)
+	assert.Equal(t, rateID, got.Id)
+
+	// автора нет
+	mock.ExpectQuery(`INSERT INTO "commission_rate"`).WillReturnError(sql.ErrNoRows)
+	_, err = r.AddCommissionRate(context.Background(), rate)
+	assert.Equal(t, models.NotFound, err)
+
+	endsAt := time.Now()
+	mock.ExpectQuery(`UPDATE "commission_rate" SET ends_at = \$1 WHERE rate_id = \$2`).WithArgs(endsAt, rateID).
+		WillReturnRows(sqlmock.NewRows([]string{"rate_id"}).AddRow(rateID))
+	assert.NoError(t, r.EndCommissionRate(context.Background(), rateID, endsAt))
+
+	// ставка ещё не началась или уже закончилась
+	mock.ExpectQuery(`UPDATE "commission_rate" SET ends_at = \$1`).WithArgs(endsAt, rateID).WillReturnError(sql.ErrNoRows)
+	assert.Equal(t, models.NotFound, r.EndCommissionRate(context.Background(), rateID, endsAt))
+
+	mock.ExpectQuery(`SELECT is_admin FROM "user"`).WithArgs(userId).WillReturnError(sql.ErrNoRows)
+	isAdmin, err := r.IsAdmin(context.Background(), userId)
+	assert.NoError(t, err)
+	assert.False(t, isAdmin)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatorRepo_SetDefaultCommissionRate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewCreatorRepo(db, zap.NewNop().Sugar())
+	rateID := uuid.New()
+	columns := []string{"rate_id", "rate_bp"}
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "Changed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT rate_id, rate_bp FROM "commission_rate" WHERE creator_id IS NULL AND ends_at IS NULL (.+) FOR UPDATE`).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(rateID, int64(0)))
+				mock.ExpectExec(`UPDATE "commission_rate" SET ends_at = now\(\) WHERE rate_id = \$1`).WithArgs(rateID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO "commission_rate"`).WithArgs(int64(1000), models.DefaultCommissionRateTitle).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Same",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT rate_id, rate_bp FROM "commission_rate"`).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(rateID, int64(1000)))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "No default",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT rate_id, rate_bp FROM "commission_rate"`).WillReturnError(sql.ErrNoRows)
+				mock.ExpectExec(`INSERT INTO "commission_rate"`).WithArgs(int64(1000), models.DefaultCommissionRateTitle).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Err",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT rate_id, rate_bp FROM "commission_rate"`).WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+
+			err := r.SetDefaultCommissionRate(context.Background(), 1000)
+			assert.Equal(t, test.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreatorRepo_MovePayoutSchedule(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewCreatorRepo(db, zap.NewNop().Sugar())
+	schedule := models.PayoutSchedule{CreatorID: creatorId, Period: models.PayoutPeriodMonthly, NextRunAt: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)}
+	next := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`UPDATE "payout_schedule" SET next_run_at = \$1, claimed_until = NULL WHERE creator_id = \$2 AND next_run_at = \$3`).
+		WithArgs(next, creatorId, schedule.NextRunAt).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, r.MovePayoutSchedule(context.Background(), schedule, next))
+
+	mock.ExpectExec(`UPDATE "payout_schedule" SET next_run_at`).WillReturnError(errors.New("test"))
+	assert.Equal(t, models.InternalError, r.MovePayoutSchedule(context.Background(), schedule, next))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,21 +2,38 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/encryption"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"time"
 )
 
+const (
+	// payoutRetryBatch - сколько выплат джоба повторов проводит за один проход
+	payoutRetryBatch = 50
+	// payoutScheduleBatch - сколько расписаний джоба автоматических выплат обрабатывает за один проход
+	payoutScheduleBatch = 50
+)
+
+// CreatorUsecase хранит реквизиты выплат зашифрованными через cipher и расшифровывает их
+// только для ответа автору и для вызова провайдера выплат
 type CreatorUsecase struct {
-	repo   creator.CreatorRepo
-	logger *zap.SugaredLogger
+	repo           creator.CreatorRepo
+	payoutProvider payout.PayoutProvider
+	cipher         encryption.Cipher
+	logger         *zap.SugaredLogger
 }
 
-func NewCreatorUsecase(repo creator.CreatorRepo, logger *zap.SugaredLogger) *CreatorUsecase {
+func NewCreatorUsecase(repo creator.CreatorRepo, payoutProvider payout.PayoutProvider, cipher encryption.Cipher, logger *zap.SugaredLogger) *CreatorUsecase {
 	return &CreatorUsecase{
-		repo:   repo,
-		logger: logger,
+		repo:           repo,
+		payoutProvider: payoutProvider,
+		cipher:         cipher,
+		logger:         logger,
 	}
 }
 
@@ -32,6 +49,20 @@ func (uc *CreatorUsecase) CreateAim(ctx context.Context, aimInfo models.Aim) err
 	return uc.repo.CreateAim(ctx, aimInfo)
 }
 
+func (uc *CreatorUsecase) UpdateAim(ctx context.Context, aimInfo models.Aim) (models.Aim, error) {
+	if !aimInfo.IsValid() {
+		return models.Aim{}, models.WrongData
+	}
+	return uc.repo.UpdateAim(ctx, aimInfo)
+}
+
+func (uc *CreatorUsecase) CreatorAims(ctx context.Context, creatorID uuid.UUID, status string) ([]models.Aim, error) {
+	if !models.IsAimStatus(status) {
+		return nil, models.WrongData
+	}
+	return uc.repo.CreatorAims(ctx, creatorID, status)
+}
+
 func (uc *CreatorUsecase) UpdateCreatorData(ctx context.Context, updateData models.UpdateCreatorInfo) error {
 	return uc.repo.UpdateCreatorData(ctx, updateData)
 }
@@ -85,10 +116,437 @@ func (uc *CreatorUsecase) Statistics(ctx context.Context, statsInput models.Stat
 	return uc.repo.Statistics(ctx, statsInput)
 }
 
-func (uc *CreatorUsecase) GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (float32, error) {
-	return uc.repo.GetCreatorBalance(ctx, creatorID)
+func (uc *CreatorUsecase) GetUploadLimit(ctx context.Context, creatorID uuid.UUID) (int64, error) {
+	return uc.repo.GetUploadLimit(ctx, creatorID)
+}
+
+func (uc *CreatorUsecase) GetStorageUsage(ctx context.Context, creatorID uuid.UUID) (models.StorageUsage, error) {
+	return uc.repo.GetStorageUsage(ctx, creatorID)
+}
+
+// CheckStorageQuota возвращает QuotaExceeded, если ещё size байт вложений не помещаются в квоту тарифа автора
+func (uc *CreatorUsecase) CheckStorageQuota(ctx context.Context, creatorID uuid.UUID, size int64) error {
+	usage, err := uc.repo.GetStorageUsage(ctx, creatorID)
+	if err != nil {
+		return err
+	}
+	if !usage.Fits(size) {
+		return models.QuotaExceeded
+	}
+	return nil
+}
+
+func (uc *CreatorUsecase) GetCreatorBalance(ctx context.Context, creatorID uuid.UUID) (models.CreatorBalance, error) {
+	balance, err := uc.repo.GetCreatorBalance(ctx, creatorID)
+	if err != nil {
+		return models.CreatorBalance{}, err
+	}
+	gross, commission, err := uc.repo.CreatorIncome(ctx, creatorID)
+	if err != nil {
+		return models.CreatorBalance{}, err
+	}
+	netIncome, err := gross.Sub(commission)
+	if err != nil {
+		uc.logger.Error(err)
+		return models.CreatorBalance{}, models.InternalError
+	}
+	return models.CreatorBalance{
+		Balance:     balance,
+		GrossIncome: gross,
+		Commission:  commission,
+		NetIncome:   netIncome,
+	}, nil
+}
+
+// RequestPayout создаёт выплату и сразу пытается её провести.
+// Повторный запрос с тем же ключом идемпотентности возвращает уже созданную выплату и не переводит деньги второй раз.
+func (uc *CreatorUsecase) RequestPayout(ctx context.Context, payout models.Payout) (models.Payout, error) {
+	if !payout.IsValid() {
+		return models.Payout{}, models.WrongData
+	}
+	if payout.DestinationID != uuid.Nil {
+		destination, err := uc.getPayoutDestination(ctx, payout.CreatorID, payout.DestinationID)
+		if errors.Is(err, models.NotFound) {
+			return models.Payout{}, models.WrongData
+		} else if err != nil {
+			return models.Payout{}, err
+		}
+		payout.PhoneNumber = destination.PhoneNumber
+	}
+	phoneNumber := payout.PhoneNumber
+	var err error
+	if payout.PhoneNumber, err = uc.cipher.Encrypt(phoneNumber); err != nil {
+		uc.logger.Error(err)
+		return models.Payout{}, models.InternalError
+	}
+	payout.Id = uuid.New()
+	stored, err := uc.repo.CreatePayout(ctx, payout)
+	if err != nil {
+		return models.Payout{}, err
+	}
+	if stored.Id != payout.Id {
+		if stored, err = uc.decryptPayout(stored); err != nil {
+			return models.Payout{}, err
+		}
+		// ключ идемпотентности повторно использован для выплаты на другой номер
+		if stored.PhoneNumber != phoneNumber {
+			return models.Payout{}, models.WrongData
+		}
+		return stored, nil
+	}
+
+	claimed, err := uc.repo.ClaimPayout(ctx, stored.Id)
+	if errors.Is(err, models.NotFound) {
+		return uc.decryptPayout(stored)
+	} else if err != nil {
+		return models.Payout{}, err
+	}
+	executed, err := uc.executePayout(ctx, claimed)
+	if err != nil {
+		return models.Payout{}, err
+	}
+	return uc.decryptPayout(executed)
+}
+
+func (uc *CreatorUsecase) GetPayout(ctx context.Context, creatorID, payoutID uuid.UUID) (models.Payout, error) {
+	payout, err := uc.repo.GetPayout(ctx, creatorID, payoutID)
+	if err != nil {
+		return models.Payout{}, err
+	}
+	return uc.decryptPayout(payout)
+}
+
+func (uc *CreatorUsecase) decryptPayout(payout models.Payout) (models.Payout, error) {
+	phoneNumber, err := uc.cipher.Decrypt(payout.PhoneNumber)
+	if err != nil {
+		uc.logger.Error(err)
+		return models.Payout{}, models.InternalError
+	}
+	payout.PhoneNumber = phoneNumber
+	return payout, nil
+}
+
+// AddPayoutDestination сохраняет реквизиты автора после проверки у провайдера: проверочный перевод
+// регистрируется, но не проводится, поэтому деньги не списываются. Уже сохранённый номер возвращается без новой проверки.
+func (uc *CreatorUsecase) AddPayoutDestination(ctx context.Context, destination models.PayoutDestination) (models.PayoutDestination, error) {
+	if !destination.IsValid() {
+		return models.PayoutDestination{}, models.WrongData
+	}
+	destinations, err := uc.PayoutDestinations(ctx, destination.CreatorID)
+	if err != nil {
+		return models.PayoutDestination{}, err
+	}
+	for _, saved := range destinations {
+		if saved.PhoneNumber == destination.PhoneNumber {
+			return saved, nil
+		}
+	}
+	if len(destinations) >= models.MaxPayoutDestinations {
+		return models.PayoutDestination{}, models.WrongData
+	}
+
+	destination.Id = uuid.New()
+	_, err = uc.payoutProvider.RequestPayout(ctx, models.Payout{
+		Id:          destination.Id,
+		CreatorID:   destination.CreatorID,
+		Money:       models.PayoutVerificationAmount,
+		PhoneNumber: destination.PhoneNumber,
+	})
+	if errors.Is(err, models.PayoutRejected) {
+		return models.PayoutDestination{}, models.WrongData
+	} else if err != nil {
+		uc.logger.Error(err)
+		return models.PayoutDestination{}, models.InternalError
+	}
+
+	phoneNumber := destination.PhoneNumber
+	if destination.PhoneNumber, err = uc.cipher.Encrypt(phoneNumber); err != nil {
+		uc.logger.Error(err)
+		return models.PayoutDestination{}, models.InternalError
+	}
+	saved, err := uc.repo.AddPayoutDestination(ctx, destination)
+	if err != nil {
+		return models.PayoutDestination{}, err
+	}
+	saved.PhoneNumber = phoneNumber
+	return saved, nil
+}
+
+func (uc *CreatorUsecase) PayoutDestinations(ctx context.Context, creatorID uuid.UUID) ([]models.PayoutDestination, error) {
+	destinations, err := uc.repo.PayoutDestinations(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range destinations {
+		if destinations[i].PhoneNumber, err = uc.cipher.Decrypt(destinations[i].PhoneNumber); err != nil {
+			uc.logger.Error(err)
+			return nil, models.InternalError
+		}
+	}
+	return destinations, nil
+}
+
+func (uc *CreatorUsecase) DeletePayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) error {
+	return uc.repo.DeletePayoutDestination(ctx, creatorID, destinationID)
+}
+
+func (uc *CreatorUsecase) getPayoutDestination(ctx context.Context, creatorID, destinationID uuid.UUID) (models.PayoutDestination, error) {
+	destination, err := uc.repo.GetPayoutDestination(ctx, creatorID, destinationID)
+	if err != nil {
+		return models.PayoutDestination{}, err
+	}
+	if destination.PhoneNumber, err = uc.cipher.Decrypt(destination.PhoneNumber); err != nil {
+		uc.logger.Error(err)
+		return models.PayoutDestination{}, models.InternalError
+	}
+	return destination, nil
+}
+
+func (uc *CreatorUsecase) GetPayoutSchedule(ctx context.Context, creatorID uuid.UUID) (models.PayoutSchedule, error) {
+	return uc.repo.GetPayoutSchedule(ctx, creatorID)
+}
+
+// SetPayoutSchedule включает или меняет автоматические выплаты, первый запуск - в начале следующего периода
+func (uc *CreatorUsecase) SetPayoutSchedule(ctx context.Context, schedule models.PayoutSchedule) (models.PayoutSchedule, error) {
+	if !schedule.IsValid() {
+		return models.PayoutSchedule{}, models.WrongData
+	}
+	if _, err := uc.repo.GetPayoutDestination(ctx, schedule.CreatorID, schedule.DestinationID); errors.Is(err, models.NotFound) {
+		return models.PayoutSchedule{}, models.WrongData
+	} else if err != nil {
+		return models.PayoutSchedule{}, err
+	}
+	schedule.NextRunAt = models.NextPayoutRun(schedule.Period, time.Now())
+	if err := uc.repo.SetPayoutSchedule(ctx, schedule); err != nil {
+		return models.PayoutSchedule{}, err
+	}
+	return schedule, nil
+}
+
+func (uc *CreatorUsecase) DeletePayoutSchedule(ctx context.Context, creatorID uuid.UUID) error {
+	return uc.repo.DeletePayoutSchedule(ctx, creatorID)
+}
+
+// RunPayoutSchedules запускает автоматические выплаты, время которых наступило: весь доступный баланс
+// выводится на сохранённые реквизиты, если он не меньше порога. Выплата проходит через RequestPayout
+// с ключом идемпотентности запуска, поэтому повторный проход за тот же период не выводит деньги второй раз.
+// Расписание переносится на следующий период, только когда выплата создана или выводить нечего.
+// Неудачный запуск возвращается выплатой в failed, чтобы автор узнал о нём; запуск повторяется,
+// если ошибка временная, и пропускается, если выплату с такими данными не создать (WrongData).
+func (uc *CreatorUsecase) RunPayoutSchedules(ctx context.Context) ([]models.Payout, error) {
+	now := time.Now()
+	schedules, err := uc.repo.ClaimDuePayoutSchedules(ctx, now, payoutScheduleBatch)
+	if err != nil {
+		return nil, err
+	}
+	payouts := make([]models.Payout, 0, len(schedules))
+	for _, schedule := range schedules {
+		available, err := uc.repo.GetAvailableBalance(ctx, schedule.CreatorID)
+		if err != nil {
+			uc.logger.Error(err)
+			continue
+		}
+		belowThreshold, err := available.Less(schedule.Threshold)
+		if err != nil {
+			uc.logger.Errorf("payout schedule of creator %s: %s", schedule.CreatorID, err)
+		}
+		if err != nil || belowThreshold {
+			uc.movePayoutSchedule(ctx, schedule, now)
+			continue
+		}
+		payout, err := uc.RequestPayout(ctx, models.Payout{
+			CreatorID:      schedule.CreatorID,
+			Money:          available,
+			DestinationID:  schedule.DestinationID,
+			IdempotencyKey: schedule.IdempotencyKey(),
+		})
+		if err != nil {
+			uc.logger.Errorf("scheduled payout for creator %s: %s", schedule.CreatorID, err)
+			if errors.Is(err, models.WrongData) {
+				uc.movePayoutSchedule(ctx, schedule, now)
+			}
+			payouts = append(payouts, models.Payout{
+				CreatorID:      schedule.CreatorID,
+				Money:          available,
+				DestinationID:  schedule.DestinationID,
+				IdempotencyKey: schedule.IdempotencyKey(),
+				Status:         models.PayoutStatusFailed,
+				LastError:      err.Error(),
+			})
+			continue
+		}
+		uc.movePayoutSchedule(ctx, schedule, now)
+		payouts = append(payouts, payout)
+	}
+	return payouts, nil
+}
+
+// movePayoutSchedule переносит расписание на следующий период; если не вышло, запуск повторится
+// после захвата, а выплату с тем же ключом идемпотентности RequestPayout второй раз не создаст
+func (uc *CreatorUsecase) movePayoutSchedule(ctx context.Context, schedule models.PayoutSchedule, now time.Time) {
+	if err := uc.repo.MovePayoutSchedule(ctx, schedule, models.NextPayoutRun(schedule.Period, now)); err != nil {
+		uc.logger.Error(err)
+	}
+}
+
+// RetryPayouts проводит незавершённые выплаты, по которым подошло время следующей попытки
+func (uc *CreatorUsecase) RetryPayouts(ctx context.Context) ([]models.Payout, error) {
+	claimed, err := uc.repo.ClaimDuePayouts(ctx, payoutRetryBatch)
+	if err != nil {
+		return nil, err
+	}
+	payouts := make([]models.Payout, 0, len(claimed))
+	for _, payout := range claimed {
+		payout, err = uc.executePayout(ctx, payout)
+		if err != nil {
+			uc.logger.Error(err)
+			continue
+		}
+		payouts = append(payouts, payout)
+	}
+	return payouts, nil
+}
+
+// executePayout проводит захваченную выплату через провайдера: requested -> processing -> succeeded.
+// Выплата в processing уже зарегистрирована у провайдера, поэтому для неё повторяется только ProcessPayout.
+func (uc *CreatorUsecase) executePayout(ctx context.Context, payout models.Payout) (models.Payout, error) {
+	if payout.Status == models.PayoutStatusRequested {
+		destination, err := uc.decryptPayout(payout)
+		if err != nil {
+			return models.Payout{}, err
+		}
+		requestID, err := uc.payoutProvider.RequestPayout(ctx, destination)
+		if err != nil {
+			return uc.payoutAttemptFailed(ctx, payout, err)
+		}
+		payout.ProviderRequestID = requestID
+		if err = uc.transitPayout(ctx, &payout, models.PayoutStatusProcessing, ""); err != nil {
+			return models.Payout{}, err
+		}
+	}
+
+	if err := uc.payoutProvider.ProcessPayout(ctx, payout.ProviderRequestID); err != nil {
+		return uc.payoutAttemptFailed(ctx, payout, err)
+	}
+	return uc.repo.CompletePayout(ctx, payout)
+}
+
+// payoutAttemptFailed записывает неудачную попытку. Отказ провайдера завершает выплату в failed,
+// остальные ошибки оставляют её в текущем состоянии до следующей попытки.
+// Выплату в processing по числу попыток не завершаем: провайдер мог уже перевести деньги.
+func (uc *CreatorUsecase) payoutAttemptFailed(ctx context.Context, payout models.Payout, cause error) (models.Payout, error) {
+	if errors.Is(cause, models.PayoutInProgress) {
+		return payout, nil
+	}
+	uc.logger.Error(cause)
+
+	status := payout.Status
+	if errors.Is(cause, models.PayoutRejected) ||
+		(payout.Status == models.PayoutStatusRequested && payout.Attempts >= models.MaxPayoutAttempts) {
+		status = models.PayoutStatusFailed
+	}
+	if err := uc.transitPayout(ctx, &payout, status, cause.Error()); err != nil {
+		return models.Payout{}, err
+	}
+	return payout, nil
+}
+
+func (uc *CreatorUsecase) transitPayout(ctx context.Context, payout *models.Payout, status, lastError string) error {
+	from := payout.Status
+	if !models.CanTransitPayout(from, status) {
+		return models.WrongData
+	}
+	payout.Status = status
+	payout.LastError = lastError
+	return uc.repo.UpdatePayoutStatus(ctx, *payout, from)
 }
 
-func (uc *CreatorUsecase) UpdateBalance(ctx context.Context, transfer models.CreatorTransfer) (float32, error) {
-	return uc.repo.UpdateBalance(ctx, transfer)
+func (uc *CreatorUsecase) CreatorDonations(ctx context.Context, creatorID uuid.UUID, limit, offset int64) ([]models.Donation, error) {
+	if limit == 0 {
+		limit = models.DefaultDonationsLimit
+	}
+	if limit < 0 || limit > models.MaxDonationsLimit || offset < 0 {
+		return nil, models.WrongData
+	}
+	return uc.repo.CreatorDonations(ctx, creatorID, limit, offset)
+}
+
+func (uc *CreatorUsecase) TopSupporters(ctx context.Context, creatorID uuid.UUID, from time.Time, limit int64) ([]models.Supporter, error) {
+	if limit == 0 {
+		limit = models.DefaultSupportersLimit
+	}
+	if limit < 0 || limit > models.MaxSupportersLimit {
+		return nil, models.WrongData
+	}
+	return uc.repo.TopSupporters(ctx, creatorID, from, limit)
+}
+
+func (uc *CreatorUsecase) CreatorLedger(ctx context.Context, filter models.LedgerFilter) (models.LedgerStatement, error) {
+	if !filter.IsValid() {
+		return models.LedgerStatement{}, models.WrongData
+	}
+	if filter.Limit == 0 {
+		filter.Limit = models.DefaultLedgerLimit
+	}
+	if filter.To.IsZero() {
+		filter.To = time.Now()
+	}
+	balance, err := uc.repo.GetCreatorBalance(ctx, filter.CreatorId)
+	if err != nil {
+		return models.LedgerStatement{}, err
+	}
+	entries, err := uc.repo.CreatorLedger(ctx, filter)
+	if err != nil {
+		return models.LedgerStatement{}, err
+	}
+	return models.LedgerStatement{Balance: balance, Entries: entries}, nil
+}
+
+func (uc *CreatorUsecase) CheckLedger(ctx context.Context) ([]models.LedgerMismatch, error) {
+	return uc.repo.LedgerMismatches(ctx)
+}
+
+// CreateCommissionRate заводит ставку комиссии: общую, для одного автора или промо на время.
+// Ставки меняют только администраторы платформы.
+func (uc *CreatorUsecase) CreateCommissionRate(ctx context.Context, adminID uuid.UUID, rate models.CommissionRate) (models.CommissionRate, error) {
+	if err := uc.checkAdmin(ctx, adminID); err != nil {
+		return models.CommissionRate{}, err
+	}
+	if rate.StartsAt.IsZero() {
+		rate.StartsAt = time.Now()
+	}
+	if !rate.IsValid() {
+		return models.CommissionRate{}, models.WrongData
+	}
+	return uc.repo.AddCommissionRate(ctx, rate)
+}
+
+// EndCommissionRate завершает ставку в момент endsAt, по умолчанию сейчас
+func (uc *CreatorUsecase) EndCommissionRate(ctx context.Context, adminID, rateID uuid.UUID, endsAt time.Time) error {
+	if err := uc.checkAdmin(ctx, adminID); err != nil {
+		return err
+	}
+	if endsAt.IsZero() {
+		endsAt = time.Now()
+	}
+	return uc.repo.EndCommissionRate(ctx, rateID, endsAt)
+}
+
+// SetDefaultCommissionRate применяет общую ставку из конфигурации сервиса, поэтому без проверки администратора
+func (uc *CreatorUsecase) SetDefaultCommissionRate(ctx context.Context, rateBP int64) error {
+	if rateBP < 0 || rateBP > models.MaxCommissionRateBP {
+		return models.WrongData
+	}
+	return uc.repo.SetDefaultCommissionRate(ctx, rateBP)
+}
+
+func (uc *CreatorUsecase) checkAdmin(ctx context.Context, userID uuid.UUID) error {
+	isAdmin, err := uc.repo.IsAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return models.Forbbiden
+	}
+	return nil
 }
@@ -6,13 +6,22 @@ import (
 	"fmt"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	mock "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/mocks"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/encryption"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout/fake"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"testing"
+	"time"
 )
 
+func testKeyring(t *testing.T) *encryption.Keyring {
+	keyring, err := encryption.NewKeyring("test", map[string][]byte{"test": make([]byte, 32)})
+	require.NoError(t, err)
+	return keyring
+}
+
 func TestNewCreatorUsecase(t *testing.T) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
@@ -28,7 +37,7 @@ func TestNewCreatorUsecase(t *testing.T) {
 		}
 	}(logger)
 	zapSugar := logger.Sugar()
-	testusecase := NewCreatorUsecase(mockCreatorRepo, zapSugar)
+	testusecase := NewCreatorUsecase(mockCreatorRepo, fake.NewProvider(), testKeyring(t), zapSugar)
 	if testusecase.repo != mockCreatorRepo {
 		t.Error("bad constructor")
 	}
@@ -240,6 +249,120 @@ func TestCreatorUsecase_GetFeed(t *testing.T) {
 	})
 }
 
+func TestCreatorUsecase_GetCreatorBalance(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	logger := zap.NewNop()
+
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	creatorID := uuid.New()
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedRes models.CreatorBalance
+		expectedErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mockCreatorRepo.EXPECT().GetCreatorBalance(gomock.Any(), creatorID).Return(models.NewMoney(5000), nil)
+				mockCreatorRepo.EXPECT().CreatorIncome(gomock.Any(), creatorID).Return(models.NewMoney(10000), models.NewMoney(1000), nil)
+			},
+			expectedRes: models.CreatorBalance{
+				Balance:     models.NewMoney(5000),
+				GrossIncome: models.NewMoney(10000),
+				Commission:  models.NewMoney(1000),
+				NetIncome:   models.NewMoney(9000),
+			},
+		},
+		{
+			name: "Income error",
+			mock: func() {
+				mockCreatorRepo.EXPECT().GetCreatorBalance(gomock.Any(), creatorID).Return(models.NewMoney(5000), nil)
+				mockCreatorRepo.EXPECT().CreatorIncome(gomock.Any(), creatorID).Return(models.Money{}, models.Money{}, models.InternalError)
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorUsecase{
+				repo:   mockCreatorRepo,
+				logger: zapSugar,
+			}
+			test.mock()
+
+			balance, err := h.GetCreatorBalance(context.Background(), creatorID)
+			require.Equal(t, test.expectedErr, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedErr, err))
+			require.Equal(t, test.expectedRes, balance)
+		})
+	}
+}
+
+func TestCreatorUsecase_CheckStorageQuota(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	creatorID := uuid.New()
+	usage := models.StorageUsage{Plan: "free", Quota: 1000, Used: 900}
+
+	tests := []struct {
+		name        string
+		size        int64
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "Fits",
+			size: 100,
+			mock: func() {
+				mockCreatorRepo.EXPECT().GetStorageUsage(gomock.Any(), creatorID).Return(usage, nil)
+			},
+		},
+		{
+			name: "Over quota",
+			size: 101,
+			mock: func() {
+				mockCreatorRepo.EXPECT().GetStorageUsage(gomock.Any(), creatorID).Return(usage, nil)
+			},
+			expectedErr: models.QuotaExceeded,
+		},
+		{
+			name: "No creator",
+			size: 1,
+			mock: func() {
+				mockCreatorRepo.EXPECT().GetStorageUsage(gomock.Any(), creatorID).Return(models.StorageUsage{}, models.WrongData)
+			},
+			expectedErr: models.WrongData,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorUsecase{
+				repo:   mockCreatorRepo,
+				logger: zap.NewNop().Sugar(),
+			}
+			test.mock()
+
+			err := h.CheckStorageQuota(context.Background(), creatorID, test.size)
+			require.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
 func TestCreatorUsecase_DeleteCoverPhoto(t *testing.T) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
@@ -469,6 +592,72 @@ func TestCreatorUsecase_CreateAim(t *testing.T) {
 	}
 }
 
+func TestCreatorUsecase_UpdateAim(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		mock        func()
+		input       models.Aim
+		expectedErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mockCreatorRepo.EXPECT().UpdateAim(gomock.Any(), gomock.Any()).Return(models.Aim{}, nil)
+			},
+			input:       models.Aim{Id: uuid.New(), Description: "test", MoneyNeeded: models.NewMoney(10000)},
+			expectedErr: nil,
+		},
+		{
+			name:        "Empty description",
+			mock:        func() {},
+			input:       models.Aim{Id: uuid.New(), MoneyNeeded: models.NewMoney(10000)},
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "Deadline in the past",
+			mock:        func() {},
+			input:       models.Aim{Id: uuid.New(), Description: "test", MoneyNeeded: models.NewMoney(10000), Deadline: &past},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Not found",
+			mock: func() {
+				mockCreatorRepo.EXPECT().UpdateAim(gomock.Any(), gomock.Any()).Return(models.Aim{}, models.NotFound)
+			},
+			input:       models.Aim{Id: uuid.New(), Description: "test", MoneyNeeded: models.NewMoney(10000)},
+			expectedErr: models.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &CreatorUsecase{
+				repo:   mockCreatorRepo,
+				logger: zapSugar,
+			}
+			test.mock()
+
+			_, err := h.UpdateAim(context.Background(), test.input)
+			require.Equal(t, test.expectedErr, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedErr, err))
+		})
+	}
+}
+
 var creators = make([]models.Creator, 1)
 
 func TestCreatorUsecase_GetAllCreators(t *testing.T) {
@@ -529,3 +718,452 @@ func TestCreatorUsecase_GetAllCreators(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatorUsecase_RequestPayout(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	zapSugar := zap.NewNop().Sugar()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	input := models.Payout{CreatorID: uuid.New(), Money: models.NewMoney(10000), PhoneNumber: "+79999999999", IdempotencyKey: "key"}
+	keyring := testKeyring(t)
+	encryptedPhone, err := keyring.Encrypt(input.PhoneNumber)
+	require.NoError(t, err)
+
+	// createAndClaim ожидает создание новой выплаты и её захват на первую попытку
+	createAndClaim := func() {
+		var created models.Payout
+		mockCreatorRepo.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, payout models.Payout) (models.Payout, error) {
+				// в базу номер попадает только зашифрованным
+				require.True(t, encryption.IsEncrypted(payout.PhoneNumber))
+				payout.Status = models.PayoutStatusRequested
+				created = payout
+				return payout, nil
+			})
+		mockCreatorRepo.EXPECT().ClaimPayout(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, payoutID uuid.UUID) (models.Payout, error) {
+				require.Equal(t, created.Id, payoutID)
+				created.Attempts = 1
+				return created, nil
+			})
+	}
+
+	tests := []struct {
+		name           string
+		input          models.Payout
+		mock           func(provider *fake.Provider)
+		expectedStatus string
+		expectedPaid   int
+		expectedErr    error
+	}{
+		{
+			name:  "OK",
+			input: input,
+			mock: func(provider *fake.Provider) {
+				createAndClaim()
+				mockCreatorRepo.EXPECT().UpdatePayoutStatus(gomock.Any(), gomock.Any(), models.PayoutStatusRequested).DoAndReturn(
+					func(_ context.Context, payout models.Payout, _ string) error {
+						require.Equal(t, models.PayoutStatusProcessing, payout.Status)
+						require.NotEmpty(t, payout.ProviderRequestID)
+						return nil
+					})
+				mockCreatorRepo.EXPECT().CompletePayout(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, payout models.Payout) (models.Payout, error) {
+						payout.Status = models.PayoutStatusSucceeded
+						return payout, nil
+					})
+			},
+			expectedStatus: models.PayoutStatusSucceeded,
+			expectedPaid:   1,
+		},
+		{
+			name:  "Same idempotency key",
+			input: input,
+			mock: func(provider *fake.Provider) {
+				mockCreatorRepo.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Return(models.Payout{
+					Id: uuid.New(), Status: models.PayoutStatusSucceeded, PhoneNumber: encryptedPhone}, nil)
+			},
+			expectedStatus: models.PayoutStatusSucceeded,
+		},
+		{
+			name:  "Same idempotency key, other phone number",
+			input: input,
+			mock: func(provider *fake.Provider) {
+				otherPhone, err := keyring.Encrypt("+78888888888")
+				require.NoError(t, err)
+				mockCreatorRepo.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Return(models.Payout{
+					Id: uuid.New(), Status: models.PayoutStatusSucceeded, PhoneNumber: otherPhone}, nil)
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name:  "Rejected by provider",
+			input: input,
+			mock: func(provider *fake.Provider) {
+				provider.FailRequests(models.PayoutRejected)
+				createAndClaim()
+				mockCreatorRepo.EXPECT().UpdatePayoutStatus(gomock.Any(), gomock.Any(), models.PayoutStatusRequested).DoAndReturn(
+					func(_ context.Context, payout models.Payout, _ string) error {
+						require.Equal(t, models.PayoutStatusFailed, payout.Status)
+						require.Equal(t, models.PayoutRejected.Error(), payout.LastError)
+						return nil
+					})
+			},
+			expectedStatus: models.PayoutStatusFailed,
+		},
+		{
+			name:  "Provider unavailable",
+			input: input,
+			mock: func(provider *fake.Provider) {
+				provider.FailProcessing(errors.New("test"))
+				createAndClaim()
+				mockCreatorRepo.EXPECT().UpdatePayoutStatus(gomock.Any(), gomock.Any(), models.PayoutStatusRequested).Return(nil)
+				mockCreatorRepo.EXPECT().UpdatePayoutStatus(gomock.Any(), gomock.Any(), models.PayoutStatusProcessing).DoAndReturn(
+					func(_ context.Context, payout models.Payout, _ string) error {
+						require.Equal(t, models.PayoutStatusProcessing, payout.Status)
+						require.Equal(t, "test", payout.LastError)
+						return nil
+					})
+			},
+			expectedStatus: models.PayoutStatusProcessing,
+		},
+		{
+			name:  "Not enough money",
+			input: input,
+			mock: func(provider *fake.Provider) {
+				mockCreatorRepo.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Return(models.Payout{}, models.WrongData)
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "Wrong phone number",
+			input:       models.Payout{CreatorID: uuid.New(), Money: models.NewMoney(10000), PhoneNumber: "phone", IdempotencyKey: "key"},
+			mock:        func(provider *fake.Provider) {},
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "No idempotency key",
+			input:       models.Payout{CreatorID: uuid.New(), Money: models.NewMoney(10000), PhoneNumber: "+79999999999"},
+			mock:        func(provider *fake.Provider) {},
+			expectedErr: models.WrongData,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := fake.NewProvider()
+			h := NewCreatorUsecase(mockCreatorRepo, provider, keyring, zapSugar)
+			test.mock(provider)
+
+			payout, err := h.RequestPayout(context.Background(), test.input)
+			require.Equal(t, test.expectedErr, err)
+			require.Equal(t, test.expectedStatus, payout.Status)
+			require.Len(t, provider.Paid(), test.expectedPaid)
+			if test.expectedErr == nil {
+				require.Equal(t, test.input.PhoneNumber, payout.PhoneNumber)
+			}
+			for _, paid := range provider.Paid() {
+				require.Equal(t, test.input.PhoneNumber, paid.PhoneNumber)
+			}
+		})
+	}
+}
+
+func TestCreatorUsecase_RetryPayouts(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	provider := fake.NewProvider()
+	h := NewCreatorUsecase(mockCreatorRepo, provider, testKeyring(t), zap.NewNop().Sugar())
+
+	requestID, err := provider.RequestPayout(context.Background(), models.Payout{})
+	require.NoError(t, err)
+	processing := models.Payout{Id: uuid.New(), Status: models.PayoutStatusProcessing, ProviderRequestID: requestID, Attempts: 3}
+	exhausted := models.Payout{Id: uuid.New(), Status: models.PayoutStatusRequested, Attempts: models.MaxPayoutAttempts}
+
+	// выплата в processing проводится повторно с тем же requestID, до провайдера доходит один перевод
+	mockCreatorRepo.EXPECT().ClaimDuePayouts(gomock.Any(), gomock.Any()).Return([]models.Payout{processing}, nil)
+	mockCreatorRepo.EXPECT().CompletePayout(gomock.Any(), processing).Return(models.Payout{Id: processing.Id, Status: models.PayoutStatusSucceeded}, nil)
+	payouts, err := h.RetryPayouts(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, models.PayoutStatusSucceeded, payouts[0].Status)
+	require.Equal(t, 1, provider.ProcessCount(requestID))
+
+	// провайдер недоступен, попытки закончились - выплата завершается в failed и резерв освобождается
+	provider.FailRequests(errors.New("test"))
+	mockCreatorRepo.EXPECT().ClaimDuePayouts(gomock.Any(), gomock.Any()).Return([]models.Payout{exhausted}, nil)
+	mockCreatorRepo.EXPECT().UpdatePayoutStatus(gomock.Any(), gomock.Any(), models.PayoutStatusRequested).Return(nil)
+	payouts, err = h.RetryPayouts(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, models.PayoutStatusFailed, payouts[0].Status)
+
+	// выплату уже перевёл в другое состояние параллельный обработчик
+	mockCreatorRepo.EXPECT().ClaimDuePayouts(gomock.Any(), gomock.Any()).Return([]models.Payout{exhausted}, nil)
+	mockCreatorRepo.EXPECT().UpdatePayoutStatus(gomock.Any(), gomock.Any(), models.PayoutStatusRequested).Return(models.WrongData)
+	payouts, err = h.RetryPayouts(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, payouts)
+}
+
+func TestCreatorUsecase_AddPayoutDestination(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	keyring := testKeyring(t)
+	creatorID := uuid.New()
+	input := models.PayoutDestination{CreatorID: creatorID, PhoneNumber: "+79999999999"}
+	saved, err := keyring.Encrypt(input.PhoneNumber)
+	require.NoError(t, err)
+	savedID := uuid.New()
+
+	tests := []struct {
+		name        string
+		mock        func(provider *fake.Provider)
+		expectedID  uuid.UUID
+		expectedErr error
+	}{
+		{
+			name: "OK",
+			mock: func(provider *fake.Provider) {
+				mockCreatorRepo.EXPECT().PayoutDestinations(gomock.Any(), creatorID).Return([]models.PayoutDestination{}, nil)
+				mockCreatorRepo.EXPECT().AddPayoutDestination(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, destination models.PayoutDestination) (models.PayoutDestination, error) {
+						require.True(t, encryption.IsEncrypted(destination.PhoneNumber))
+						destination.Id = savedID
+						return destination, nil
+					})
+			},
+			expectedID: savedID,
+		},
+		{
+			name: "Already saved",
+			mock: func(provider *fake.Provider) {
+				mockCreatorRepo.EXPECT().PayoutDestinations(gomock.Any(), creatorID).Return([]models.PayoutDestination{
+					{Id: savedID, CreatorID: creatorID, PhoneNumber: saved}}, nil)
+			},
+			expectedID: savedID,
+		},
+		{
+			name: "Too many destinations",
+			mock: func(provider *fake.Provider) {
+				mockCreatorRepo.EXPECT().PayoutDestinations(gomock.Any(), creatorID).
+					Return(make([]models.PayoutDestination, models.MaxPayoutDestinations), nil)
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Rejected by provider",
+			mock: func(provider *fake.Provider) {
+				provider.FailRequests(models.PayoutRejected)
+				mockCreatorRepo.EXPECT().PayoutDestinations(gomock.Any(), creatorID).Return([]models.PayoutDestination{}, nil)
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Provider unavailable",
+			mock: func(provider *fake.Provider) {
+				provider.FailRequests(errors.New("test"))
+				mockCreatorRepo.EXPECT().PayoutDestinations(gomock.Any(), creatorID).Return([]models.PayoutDestination{}, nil)
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := fake.NewProvider()
+			h := NewCreatorUsecase(mockCreatorRepo, provider, keyring, zap.NewNop().Sugar())
+			test.mock(provider)
+
+			destination, err := h.AddPayoutDestination(context.Background(), input)
+			require.Equal(t, test.expectedErr, err)
+			require.Equal(t, test.expectedID, destination.Id)
+			if test.expectedErr == nil {
+				require.Equal(t, input.PhoneNumber, destination.PhoneNumber)
+			}
+			// проверочный перевод не проводится
+			require.Empty(t, provider.Paid())
+		})
+	}
+}
+
+func TestCreatorUsecase_SetPayoutSchedule(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	h := NewCreatorUsecase(mockCreatorRepo, fake.NewProvider(), testKeyring(t), zap.NewNop().Sugar())
+	schedule := models.PayoutSchedule{CreatorID: uuid.New(), DestinationID: uuid.New(), Period: models.PayoutPeriodWeekly, Threshold: models.MinPayoutThreshold}
+
+	mockCreatorRepo.EXPECT().GetPayoutDestination(gomock.Any(), schedule.CreatorID, schedule.DestinationID).Return(models.PayoutDestination{}, nil)
+	mockCreatorRepo.EXPECT().SetPayoutSchedule(gomock.Any(), gomock.Any()).Return(nil)
+	got, err := h.SetPayoutSchedule(context.Background(), schedule)
+	require.NoError(t, err)
+	require.True(t, got.NextRunAt.After(time.Now()))
+
+	// реквизиты другого автора
+	mockCreatorRepo.EXPECT().GetPayoutDestination(gomock.Any(), schedule.CreatorID, schedule.DestinationID).Return(models.PayoutDestination{}, models.NotFound)
+	_, err = h.SetPayoutSchedule(context.Background(), schedule)
+	require.Equal(t, models.WrongData, err)
+
+	schedule.Threshold = models.NewMoney(100)
+	_, err = h.SetPayoutSchedule(context.Background(), schedule)
+	require.Equal(t, models.WrongData, err)
+}
+
+func TestCreatorUsecase_RunPayoutSchedules(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	keyring := testKeyring(t)
+	provider := fake.NewProvider()
+	h := NewCreatorUsecase(mockCreatorRepo, provider, keyring, zap.NewNop().Sugar())
+
+	phone, err := keyring.Encrypt("+79999999999")
+	require.NoError(t, err)
+	runAt := time.Date(2023, 5, 15, 0, 0, 0, 0, time.UTC)
+	due := models.PayoutSchedule{CreatorID: uuid.New(), DestinationID: uuid.New(), Period: models.PayoutPeriodWeekly, Threshold: models.NewMoney(10000), NextRunAt: runAt}
+	low := models.PayoutSchedule{CreatorID: uuid.New(), DestinationID: uuid.New(), Period: models.PayoutPeriodMonthly, Threshold: models.NewMoney(50000), NextRunAt: runAt}
+
+	mockCreatorRepo.EXPECT().ClaimDuePayoutSchedules(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.PayoutSchedule{due, low}, nil)
+	mockCreatorRepo.EXPECT().GetAvailableBalance(gomock.Any(), due.CreatorID).Return(models.NewMoney(25000), nil)
+	// баланс ниже порога - выплата не создаётся, расписание переносится
+	mockCreatorRepo.EXPECT().GetAvailableBalance(gomock.Any(), low.CreatorID).Return(models.NewMoney(25000), nil)
+	mockCreatorRepo.EXPECT().MovePayoutSchedule(gomock.Any(), low, gomock.Any()).Return(nil)
+	mockCreatorRepo.EXPECT().MovePayoutSchedule(gomock.Any(), due, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ models.PayoutSchedule, next time.Time) error {
+			require.True(t, next.After(time.Now()))
+			return nil
+		})
+	mockCreatorRepo.EXPECT().GetPayoutDestination(gomock.Any(), due.CreatorID, due.DestinationID).Return(models.PayoutDestination{
+		Id: due.DestinationID, CreatorID: due.CreatorID, PhoneNumber: phone}, nil)
+
+	var created models.Payout
+	mockCreatorRepo.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, payout models.Payout) (models.Payout, error) {
+			require.Equal(t, models.NewMoney(25000), payout.Money)
+			require.Equal(t, "schedule:2023-05-15", payout.IdempotencyKey)
+			require.Equal(t, due.DestinationID, payout.DestinationID)
+			payout.Status = models.PayoutStatusRequested
+			created = payout
+			return payout, nil
+		})
+	mockCreatorRepo.EXPECT().ClaimPayout(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID) (models.Payout, error) {
+			return created, nil
+		})
+	mockCreatorRepo.EXPECT().UpdatePayoutStatus(gomock.Any(), gomock.Any(), models.PayoutStatusRequested).Return(nil)
+	mockCreatorRepo.EXPECT().CompletePayout(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, payout models.Payout) (models.Payout, error) {
+			payout.Status = models.PayoutStatusSucceeded
+			return payout, nil
+		})
+
+	payouts, err := h.RunPayoutSchedules(context.Background())
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+	require.Equal(t, models.PayoutStatusSucceeded, payouts[0].Status)
+	require.Len(t, provider.Paid(), 1)
+	require.Equal(t, "+79999999999", provider.Paid()[0].PhoneNumber)
+
+	// выплата не создана из-за временной ошибки: автор узнаёт о неудаче, запуск повторится после захвата
+	mockCreatorRepo.EXPECT().ClaimDuePayoutSchedules(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.PayoutSchedule{due}, nil)
+	mockCreatorRepo.EXPECT().GetAvailableBalance(gomock.Any(), due.CreatorID).Return(models.NewMoney(25000), nil)
+	mockCreatorRepo.EXPECT().GetPayoutDestination(gomock.Any(), due.CreatorID, due.DestinationID).Return(models.PayoutDestination{
+		Id: due.DestinationID, CreatorID: due.CreatorID, PhoneNumber: phone}, nil)
+	mockCreatorRepo.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Return(models.Payout{}, models.InternalError)
+	payouts, err = h.RunPayoutSchedules(context.Background())
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+	require.Equal(t, models.PayoutStatusFailed, payouts[0].Status)
+	require.Equal(t, models.NewMoney(25000), payouts[0].Money)
+
+	// реквизиты удалены: этот период пропускается
+	mockCreatorRepo.EXPECT().ClaimDuePayoutSchedules(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.PayoutSchedule{due}, nil)
+	mockCreatorRepo.EXPECT().GetAvailableBalance(gomock.Any(), due.CreatorID).Return(models.NewMoney(25000), nil)
+	mockCreatorRepo.EXPECT().GetPayoutDestination(gomock.Any(), due.CreatorID, due.DestinationID).Return(models.PayoutDestination{}, models.NotFound)
+	mockCreatorRepo.EXPECT().MovePayoutSchedule(gomock.Any(), due, gomock.Any()).Return(nil)
+	payouts, err = h.RunPayoutSchedules(context.Background())
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+	require.Equal(t, models.PayoutStatusFailed, payouts[0].Status)
+
+	mockCreatorRepo.EXPECT().ClaimDuePayoutSchedules(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, models.InternalError)
+	_, err = h.RunPayoutSchedules(context.Background())
+	require.Equal(t, models.InternalError, err)
+}
+
+func TestCreatorUsecase_CreateCommissionRate(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	h := NewCreatorUsecase(mockCreatorRepo, fake.NewProvider(), testKeyring(t), zap.NewNop().Sugar())
+	adminID := uuid.New()
+	endsAt := time.Now().Add(7 * 24 * time.Hour)
+	promo := models.CommissionRate{CreatorId: uuid.New(), RateBP: 500, EndsAt: &endsAt, Description: "Промо"}
+
+	mockCreatorRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(true, nil)
+	mockCreatorRepo.EXPECT().AddCommissionRate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, rate models.CommissionRate) (models.CommissionRate, error) {
+			require.False(t, rate.StartsAt.IsZero())
+			rate.Id = uuid.New()
+			return rate, nil
+		})
+	got, err := h.CreateCommissionRate(context.Background(), adminID, promo)
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.Nil, got.Id)
+
+	// не администратор
+	mockCreatorRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(false, nil)
+	_, err = h.CreateCommissionRate(context.Background(), adminID, promo)
+	require.Equal(t, models.Forbbiden, err)
+
+	// ставка больше 100%
+	promo.RateBP = models.MaxCommissionRateBP + 1
+	mockCreatorRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(true, nil)
+	_, err = h.CreateCommissionRate(context.Background(), adminID, promo)
+	require.Equal(t, models.WrongData, err)
+
+	// промо заканчивается раньше, чем начинается
+	promo.RateBP = 500
+	promo.StartsAt = endsAt.Add(time.Hour)
+	mockCreatorRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(true, nil)
+	_, err = h.CreateCommissionRate(context.Background(), adminID, promo)
+	require.Equal(t, models.WrongData, err)
+}
+
+func TestCreatorUsecase_EndCommissionRate(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	h := NewCreatorUsecase(mockCreatorRepo, fake.NewProvider(), testKeyring(t), zap.NewNop().Sugar())
+	adminID, rateID := uuid.New(), uuid.New()
+
+	mockCreatorRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(true, nil)
+	mockCreatorRepo.EXPECT().EndCommissionRate(gomock.Any(), rateID, gomock.Any()).Return(nil)
+	require.NoError(t, h.EndCommissionRate(context.Background(), adminID, rateID, time.Time{}))
+
+	mockCreatorRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(false, nil)
+	require.Equal(t, models.Forbbiden, h.EndCommissionRate(context.Background(), adminID, rateID, time.Time{}))
+
+	mockCreatorRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(false, models.InternalError)
+	require.Equal(t, models.InternalError, h.EndCommissionRate(context.Background(), adminID, rateID, time.Time{}))
+}
+
+func TestCreatorUsecase_SetDefaultCommissionRate(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockCreatorRepo := mock.NewMockCreatorRepo(ctl)
+	h := NewCreatorUsecase(mockCreatorRepo, fake.NewProvider(), testKeyring(t), zap.NewNop().Sugar())
+
+	mockCreatorRepo.EXPECT().SetDefaultCommissionRate(gomock.Any(), int64(1000)).Return(nil)
+	require.NoError(t, h.SetDefaultCommissionRate(context.Background(), 1000))
+	require.Equal(t, models.WrongData, h.SetDefaultCommissionRate(context.Background(), -1))
+}
@@ -0,0 +1,8 @@
+package encryption
+
+// Cipher шифрует отдельные поля перед записью в базу.
+// Зашифрованное значение - строка, которую можно хранить в text-колонке.
+type Cipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(value string) (string, error)
+}
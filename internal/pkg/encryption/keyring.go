@@ -0,0 +1,216 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// envelopePrefix - признак зашифрованного значения: enc:v1:{id ключа}:{ключ данных}:{данные}
+	envelopePrefix = "enc:v1:"
+	keySize        = 32
+)
+
+var ErrWrongCiphertext = errors.New("wrong ciphertext")
+
+// Keyring - конвертное шифрование полей. Каждое значение шифруется своим случайным ключом данных (AES-256-GCM),
+// ключ данных шифруется мастер-ключом из конфигурации. Для ротации мастер-ключа достаточно
+// перешифровать ключи данных (Rewrap), сами значения при этом не меняются.
+type Keyring struct {
+	keys   map[string][]byte
+	active string
+}
+
+// NewKeyring принимает мастер-ключи по идентификаторам; новые значения шифруются ключом active
+func NewKeyring(active string, keys map[string][]byte) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no encryption keys")
+	}
+	for id, key := range keys {
+		if len(id) == 0 || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("wrong encryption key id %q", id)
+		}
+		if len(key) != keySize {
+			return nil, fmt.Errorf("encryption key %s must be %d bytes", id, keySize)
+		}
+	}
+	if _, ok := keys[active]; !ok {
+		return nil, fmt.Errorf("no active encryption key %s", active)
+	}
+	return &Keyring{keys: keys, active: active}, nil
+}
+
+// NewKeyringFromEnv читает мастер-ключи из ENCRYPTION_KEYS ("id:base64,id2:base64")
+// и идентификатор ключа для новых значений из ENCRYPTION_ACTIVE_KEY.
+// Старые ключи остаются в ENCRYPTION_KEYS, пока все значения не перешифрованы командой reencrypt.
+func NewKeyringFromEnv() (*Keyring, error) {
+	value, flag := os.LookupEnv("ENCRYPTION_KEYS")
+	if !flag {
+		return nil, errors.New("no encryption keys")
+	}
+	active, flag := os.LookupEnv("ENCRYPTION_ACTIVE_KEY")
+	if !flag {
+		return nil, errors.New("no active encryption key")
+	}
+	keys := make(map[string][]byte)
+	for _, item := range strings.Split(value, ",") {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok {
+			return nil, errors.New("wrong ENCRYPTION_KEYS value")
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("wrong encryption key %s: %w", id, err)
+		}
+		keys[id] = key
+	}
+	return NewKeyring(active, keys)
+}
+
+// ActiveKey возвращает идентификатор ключа, которым шифруются новые значения
+func (k *Keyring) ActiveKey() string {
+	return k.active
+}
+
+// ActivePrefix - начало значений, зашифрованных активным ключом; по нему ищутся значения для ротации
+func (k *Keyring) ActivePrefix() string {
+	return envelopePrefix + k.active + ":"
+}
+
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	data, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := seal(k.keys[k.active], dataKey)
+	if err != nil {
+		return "", err
+	}
+	return envelope(k.active, wrapped, data), nil
+}
+
+// Decrypt расшифровывает значение. Значение без префикса считается записанным до включения шифрования
+// и возвращается как есть, команда reencrypt шифрует такие значения.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	id, wrapped, data, err := parseEnvelope(value)
+	if err != nil {
+		return "", err
+	}
+	dataKey, err := k.unwrap(id, wrapped)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(dataKey, data)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// Rewrap перешифровывает ключ данных активным мастер-ключом, незашифрованное значение шифрует.
+// Возвращает false, если значение уже зашифровано активным ключом.
+func (k *Keyring) Rewrap(value string) (string, bool, error) {
+	if !IsEncrypted(value) {
+		encrypted, err := k.Encrypt(value)
+		if err != nil {
+			return "", false, err
+		}
+		return encrypted, true, nil
+	}
+	id, wrapped, data, err := parseEnvelope(value)
+	if err != nil {
+		return "", false, err
+	}
+	if id == k.active {
+		return value, false, nil
+	}
+	dataKey, err := k.unwrap(id, wrapped)
+	if err != nil {
+		return "", false, err
+	}
+	if wrapped, err = seal(k.keys[k.active], dataKey); err != nil {
+		return "", false, err
+	}
+	return envelope(k.active, wrapped, data), true, nil
+}
+
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+func (k *Keyring) unwrap(id string, wrapped []byte) ([]byte, error) {
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key %s", id)
+	}
+	return open(key, wrapped)
+}
+
+func envelope(id string, wrapped, data []byte) string {
+	return envelopePrefix + id + ":" + base64.RawStdEncoding.EncodeToString(wrapped) + ":" + base64.RawStdEncoding.EncodeToString(data)
+}
+
+func parseEnvelope(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, envelopePrefix), ":")
+	if len(parts) != 3 {
+		return "", nil, nil, ErrWrongCiphertext
+	}
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, ErrWrongCiphertext
+	}
+	data, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, ErrWrongCiphertext
+	}
+	return parts[0], wrapped, data, nil
+}
+
+// seal шифрует AES-256-GCM, случайный nonce записывается перед шифротекстом
+func seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrWrongCiphertext
+	}
+	plaintext, err := aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrWrongCiphertext
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
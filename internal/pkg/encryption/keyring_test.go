@@ -0,0 +1,110 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func testKeys() map[string][]byte {
+	return map[string][]byte{
+		"old": bytes.Repeat([]byte{1}, keySize),
+		"new": bytes.Repeat([]byte{2}, keySize),
+	}
+}
+
+func TestNewKeyring(t *testing.T) {
+	tests := []struct {
+		name    string
+		active  string
+		keys    map[string][]byte
+		wantErr bool
+	}{
+		{name: "OK", active: "old", keys: testKeys()},
+		{name: "No keys", active: "old", keys: map[string][]byte{}, wantErr: true},
+		{name: "No active key", active: "other", keys: testKeys(), wantErr: true},
+		{name: "Short key", active: "old", keys: map[string][]byte{"old": make([]byte, 16)}, wantErr: true},
+		{name: "Wrong id", active: "a:b", keys: map[string][]byte{"a:b": make([]byte, keySize)}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewKeyring(test.active, test.keys)
+			require.Equal(t, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestNewKeyringFromEnv(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, keySize))
+	t.Setenv("ENCRYPTION_KEYS", "old:"+key+", new:"+key)
+	t.Setenv("ENCRYPTION_ACTIVE_KEY", "new")
+	keyring, err := NewKeyringFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, "new", keyring.ActiveKey())
+
+	t.Setenv("ENCRYPTION_KEYS", "old")
+	_, err = NewKeyringFromEnv()
+	require.Error(t, err)
+}
+
+func TestKeyring_EncryptDecrypt(t *testing.T) {
+	keyring, err := NewKeyring("old", testKeys())
+	require.NoError(t, err)
+
+	first, err := keyring.Encrypt("+79999999999")
+	require.NoError(t, err)
+	second, err := keyring.Encrypt("+79999999999")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(first, keyring.ActivePrefix()))
+	require.NotContains(t, first, "79999999999")
+	require.NotEqual(t, first, second)
+
+	plaintext, err := keyring.Decrypt(first)
+	require.NoError(t, err)
+	require.Equal(t, "+79999999999", plaintext)
+
+	// значение, записанное до включения шифрования
+	plaintext, err = keyring.Decrypt("+79999999999")
+	require.NoError(t, err)
+	require.Equal(t, "+79999999999", plaintext)
+
+	_, err = keyring.Decrypt(first[:len(first)-2])
+	require.ErrorIs(t, err, ErrWrongCiphertext)
+	_, err = keyring.Decrypt("enc:v1:old:broken")
+	require.ErrorIs(t, err, ErrWrongCiphertext)
+
+	other, err := NewKeyring("other", map[string][]byte{"other": make([]byte, keySize)})
+	require.NoError(t, err)
+	_, err = other.Decrypt(first)
+	require.Error(t, err)
+}
+
+func TestKeyring_Rewrap(t *testing.T) {
+	oldKeyring, err := NewKeyring("old", testKeys())
+	require.NoError(t, err)
+	newKeyring, err := NewKeyring("new", testKeys())
+	require.NoError(t, err)
+
+	encrypted, err := oldKeyring.Encrypt("+79999999999")
+	require.NoError(t, err)
+
+	rewrapped, changed, err := newKeyring.Rewrap(encrypted)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.True(t, strings.HasPrefix(rewrapped, newKeyring.ActivePrefix()))
+	plaintext, err := newKeyring.Decrypt(rewrapped)
+	require.NoError(t, err)
+	require.Equal(t, "+79999999999", plaintext)
+
+	same, changed, err := newKeyring.Rewrap(rewrapped)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, rewrapped, same)
+
+	legacy, changed, err := newKeyring.Rewrap("+79999999999")
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.True(t, IsEncrypted(legacy))
+}
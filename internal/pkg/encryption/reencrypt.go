@@ -0,0 +1,89 @@
+package encryption
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Column - колонка таблицы с персональными данными, которая хранится зашифрованной
+type Column struct {
+	Table string
+	Key   string
+	Name  string
+}
+
+// PIIColumns - все зашифрованные колонки; новую колонку с персональными данными нужно добавить сюда,
+// чтобы её перешифровывала команда reencrypt
+var PIIColumns = []Column{
+	{Table: "payout", Key: "payout_id", Name: "phone_number"},
+}
+
+// Reencrypt перешифровывает активным ключом все значения колонки, зашифрованные старыми ключами,
+// и шифрует значения, записанные до включения шифрования. Возвращает число изменённых строк.
+// С dryRun только считает такие строки.
+func Reencrypt(ctx context.Context, db *sql.DB, keyring *Keyring, column Column, batch int, dryRun bool) (int, error) {
+	if dryRun {
+		var count int
+		query := fmt.Sprintf("SELECT count(*) FROM %s WHERE left(%s, $2) <> $1", column.Table, column.Name)
+		prefix := keyring.ActivePrefix()
+		if err := db.QueryRowContext(ctx, query, prefix, utf8.RuneCountInString(prefix)).Scan(&count); err != nil {
+			return 0, err
+		}
+		return count, nil
+	}
+
+	total := 0
+	for {
+		updated, err := reencryptBatch(ctx, db, keyring, column, batch)
+		if err != nil {
+			return total, err
+		}
+		total += updated
+		if updated < batch {
+			return total, nil
+		}
+	}
+}
+
+// reencryptBatch обрабатывает одну пачку строк. Обновлённые значения начинаются с префикса активного ключа
+// и в следующую пачку не попадают. Значение, изменённое параллельно, не перезаписывается.
+// Префикс сравнивается через left, а не LIKE: "_" и "%" в идентификаторе ключа не должны работать как шаблон.
+func reencryptBatch(ctx context.Context, db *sql.DB, keyring *Keyring, column Column, batch int) (int, error) {
+	query := fmt.Sprintf("SELECT %s, %s FROM %s WHERE left(%s, $2) <> $1 LIMIT $3",
+		column.Key, column.Name, column.Table, column.Name)
+	prefix := keyring.ActivePrefix()
+	rows, err := db.QueryContext(ctx, query, prefix, utf8.RuneCountInString(prefix), batch)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	keys := make([]string, 0, batch)
+	values := make([]string, 0, batch)
+	for rows.Next() {
+		var key, value string
+		if err = rows.Scan(&key, &value); err != nil {
+			return 0, err
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	update := fmt.Sprintf("UPDATE %s SET %s = $1 WHERE %s = $2 AND %s = $3",
+		column.Table, column.Name, column.Key, column.Name)
+	for i, value := range values {
+		rewrapped, _, err := keyring.Rewrap(value)
+		if err != nil {
+			return i, fmt.Errorf("%s.%s %s: %w", column.Table, column.Name, keys[i], err)
+		}
+		if _, err = db.ExecContext(ctx, update, rewrapped, keys[i], value); err != nil {
+			return i, err
+		}
+	}
+	return len(values), nil
+}
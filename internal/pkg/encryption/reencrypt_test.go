@@ -0,0 +1,86 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestReencrypt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	oldKeyring, err := NewKeyring("old", testKeys())
+	require.NoError(t, err)
+	keyring, err := NewKeyring("new", testKeys())
+	require.NoError(t, err)
+	encrypted, err := oldKeyring.Encrypt("+79999999999")
+	require.NoError(t, err)
+
+	column := PIIColumns[0]
+	selectQuery := regexp.QuoteMeta("SELECT payout_id, phone_number FROM payout WHERE left(phone_number, $2) <> $1 LIMIT $3")
+	updateQuery := regexp.QuoteMeta("UPDATE payout SET phone_number = $1 WHERE payout_id = $2 AND phone_number = $3")
+	isActive := sqlmock.Argument(activeArg{keyring})
+
+	mock.ExpectQuery(selectQuery).WithArgs("enc:v1:new:", 11, 2).WillReturnRows(
+		sqlmock.NewRows([]string{"payout_id", "phone_number"}).AddRow("1", encrypted).AddRow("2", "+78888888888"))
+	mock.ExpectExec(updateQuery).WithArgs(isActive, "1", encrypted).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(updateQuery).WithArgs(isActive, "2", "+78888888888").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(selectQuery).WithArgs("enc:v1:new:", 11, 2).WillReturnRows(
+		sqlmock.NewRows([]string{"payout_id", "phone_number"}))
+
+	count, err := Reencrypt(context.Background(), db, keyring, column, 2, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// ключа, которым зашифровано значение, уже нет в конфигурации
+	mock.ExpectQuery(selectQuery).WithArgs("enc:v1:new:", 11, 2).WillReturnRows(
+		sqlmock.NewRows([]string{"payout_id", "phone_number"}).AddRow("3", "enc:v1:lost:AAAA:AAAA"))
+	_, err = Reencrypt(context.Background(), db, keyring, column, 2, false)
+	require.Error(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM payout WHERE left(phone_number, $2) <> $1")).
+		WithArgs("enc:v1:new:", 11).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	count, err = Reencrypt(context.Background(), db, keyring, column, 2, true)
+	require.NoError(t, err)
+	require.Equal(t, 5, count)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// activeArg проверяет, что в базу записывается значение, зашифрованное активным ключом
+type activeArg struct {
+	keyring *Keyring
+}
+
+func (a activeArg) Match(value driver.Value) bool {
+	str, ok := value.(string)
+	if !ok {
+		return false
+	}
+	_, changed, err := a.keyring.Rewrap(str)
+	return err == nil && !changed
+}
+
+func TestReencrypt_KeyIDWithWildcards(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// "_" в LIKE совпадает с любым символом, поэтому значения ключа "key1" считались бы уже перешифрованными
+	keyring, err := NewKeyring("key_", map[string][]byte{"key_": bytes.Repeat([]byte{3}, keySize)})
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM payout WHERE left(phone_number, $2) <> $1")).
+		WithArgs("enc:v1:key_:", 12).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	count, err := Reencrypt(context.Background(), db, keyring, PIIColumns[0], 2, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
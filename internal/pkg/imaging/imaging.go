@@ -0,0 +1,208 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"math"
+)
+
+const (
+	// MaxPixels - ограничение на число пикселей исходного изображения, чтобы декодирование не съело всю память
+	MaxPixels = 40_000_000
+	// MaxSide - ограничение на сторону исходного изображения
+	MaxSide = 12000
+
+	jpegQuality         = 85
+	jpegOriginalQuality = 90
+)
+
+// Spec - размер, который нужно получить из исходного изображения.
+// Без Crop изображение вписывается в Width x Height (0 - без ограничения), с Crop обрезается по центру до пропорций размера.
+// Изображение никогда не увеличивается.
+type Spec struct {
+	Name   string
+	Width  int
+	Height int
+	Crop   bool
+}
+
+var (
+	// AvatarSpecs - размеры фотографии профиля
+	AvatarSpecs = []Spec{
+		{Name: models.RenditionAvatar64, Width: 64, Height: 64, Crop: true},
+		{Name: models.RenditionAvatar128, Width: 128, Height: 128, Crop: true},
+		{Name: models.RenditionAvatar256, Width: 256, Height: 256, Crop: true},
+		{Name: models.RenditionOriginal, Width: 2048, Height: 2048},
+	}
+	// CoverSpecs - размеры обложки автора
+	CoverSpecs = []Spec{
+		{Name: models.RenditionFeed, Width: 1080},
+		{Name: models.RenditionOriginal, Width: 4096, Height: 4096},
+	}
+	// AttachmentSpecs - размеры изображения во вложении поста
+	AttachmentSpecs = []Spec{
+		{Name: models.RenditionThumbnail, Width: 320, Height: 320, Crop: true},
+		{Name: models.RenditionFeed, Width: 1080},
+		{Name: models.RenditionOriginal, Width: 4096, Height: 4096},
+	}
+)
+
+// Options - настройки обработки
+type Options struct {
+	// Flatten - положить изображение на белый фон и сохранить все размеры в JPEG.
+	// Нужно для фотографий профиля и обложек, которые фронтенд всегда получает как .jpg
+	Flatten bool
+}
+
+// Image - закодированный размер изображения
+type Image struct {
+	Rendition models.Rendition
+	Data      []byte
+}
+
+// IsImage - поддерживается ли тип файла обработкой
+func IsImage(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return true
+	}
+	return false
+}
+
+// Process декодирует и проверяет изображение, применяет поворот из EXIF и кодирует все размеры заново,
+// поэтому ни EXIF, ни другие метаданные исходного файла в результат не попадают.
+// Непрозрачные изображения сохраняются в JPEG, изображения с прозрачностью - в WebP без потерь.
+// Ошибки: models.Unsupported - формат не поддерживается, models.WrongData - файл повреждён или слишком большой.
+func Process(r io.Reader, specs []Spec, options Options) ([]Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if errors.Is(err, image.ErrFormat) {
+		return nil, models.Unsupported
+	}
+	if err != nil {
+		return nil, models.WrongData
+	}
+	if config.Width < 1 || config.Height < 1 || config.Width > MaxSide || config.Height > MaxSide ||
+		config.Width*config.Height > MaxPixels {
+		return nil, models.WrongData
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, models.WrongData
+	}
+	if format == "jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	opaque := isOpaque(img)
+	if options.Flatten && !opaque {
+		img = flatten(img)
+		opaque = true
+	}
+
+	images := make([]Image, 0, len(specs))
+	for _, spec := range specs {
+		resized := resize(img, spec)
+		buf := &bytes.Buffer{}
+		contentType := "image/jpeg"
+		if opaque {
+			quality := jpegQuality
+			if spec.Name == models.RenditionOriginal {
+				quality = jpegOriginalQuality
+			}
+			err = jpeg.Encode(buf, resized, &jpeg.Options{Quality: quality})
+		} else {
+			contentType = "image/webp"
+			err = EncodeWebP(buf, resized)
+		}
+		if err != nil {
+			return nil, err
+		}
+		bounds := resized.Bounds()
+		images = append(images, Image{
+			Rendition: models.Rendition{
+				Name:        spec.Name,
+				Width:       bounds.Dx(),
+				Height:      bounds.Dy(),
+				ContentType: contentType,
+			},
+			Data: buf.Bytes(),
+		})
+	}
+	return images, nil
+}
+
+// resize вырезает и уменьшает изображение по spec
+func resize(img image.Image, spec Spec) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	source := bounds
+	var dstWidth, dstHeight int
+
+	if spec.Crop {
+		// наибольший прямоугольник с пропорциями размера по центру
+		cropWidth, cropHeight := width, width*spec.Height/spec.Width
+		if cropHeight > height {
+			cropWidth, cropHeight = height*spec.Width/spec.Height, height
+		}
+		if cropWidth < 1 {
+			cropWidth = 1
+		}
+		if cropHeight < 1 {
+			cropHeight = 1
+		}
+		offset := image.Pt((width-cropWidth)/2, (height-cropHeight)/2)
+		source = image.Rectangle{Min: bounds.Min.Add(offset), Max: bounds.Min.Add(offset).Add(image.Pt(cropWidth, cropHeight))}
+		dstWidth, dstHeight = spec.Width, spec.Height
+		if cropWidth < spec.Width {
+			dstWidth, dstHeight = cropWidth, cropHeight
+		}
+	} else {
+		scale := 1.0
+		if spec.Width > 0 {
+			scale = math.Min(scale, float64(spec.Width)/float64(width))
+		}
+		if spec.Height > 0 {
+			scale = math.Min(scale, float64(spec.Height)/float64(height))
+		}
+		dstWidth = int(math.Max(1, math.Round(float64(width)*scale)))
+		dstHeight = int(math.Max(1, math.Round(float64(height)*scale)))
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	if dstWidth == source.Dx() && dstHeight == source.Dy() {
+		draw.Copy(dst, image.Point{}, img, source, draw.Src, nil)
+	} else {
+		draw.CatmullRom.Scale(dst, dst.Bounds(), img, source, draw.Src, nil)
+	}
+	return dst
+}
+
+func isOpaque(img image.Image) bool {
+	if opaque, ok := img.(interface{ Opaque() bool }); ok {
+		return opaque.Opaque()
+	}
+	return false
+}
+
+func flatten(img image.Image) image.Image {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Over)
+	return dst
+}
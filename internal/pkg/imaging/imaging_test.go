@@ -0,0 +1,229 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// withOrientation вставляет в JPEG сегмент APP1 с EXIF, в котором записана ориентация
+func withOrientation(data []byte, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+12+4)
+	copy(tiff, "MM")
+	binary.BigEndian.PutUint16(tiff[2:], 42)
+	binary.BigEndian.PutUint32(tiff[4:], 8)
+	binary.BigEndian.PutUint16(tiff[8:], 1)
+	binary.BigEndian.PutUint16(tiff[10:], exifOrientationTag)
+	binary.BigEndian.PutUint16(tiff[12:], exifTypeShort)
+	binary.BigEndian.PutUint32(tiff[14:], 1)
+	binary.BigEndian.PutUint16(tiff[18:], orientation)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	header := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(header[2:], uint16(len(segment)+2))
+
+	result := append([]byte{}, data[:2]...)
+	result = append(result, header...)
+	result = append(result, segment...)
+	return append(result, data[2:]...)
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	buf := &bytes.Buffer{}
+	if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func renditionsOf(images []Image) map[string]models.Rendition {
+	result := make(map[string]models.Rendition)
+	for _, img := range images {
+		result[img.Rendition.Name] = img.Rendition
+	}
+	return result
+}
+
+func TestProcess_Sizes(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2000, 1000))
+	for i := range img.Pix {
+		img.Pix[i] = 200
+	}
+
+	images, err := Process(bytes.NewReader(encodeJPEG(t, img)), AttachmentSpecs, Options{})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	got := renditionsOf(images)
+	want := map[string]models.Rendition{
+		models.RenditionThumbnail: {Name: models.RenditionThumbnail, Width: 320, Height: 320, ContentType: "image/jpeg"},
+		models.RenditionFeed:      {Name: models.RenditionFeed, Width: 1080, Height: 540, ContentType: "image/jpeg"},
+		models.RenditionOriginal:  {Name: models.RenditionOriginal, Width: 2000, Height: 1000, ContentType: "image/jpeg"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Process() renditions = %v", got)
+	}
+	for name, rendition := range want {
+		if got[name] != rendition {
+			t.Errorf("rendition %s = %+v, want %+v", name, got[name], rendition)
+		}
+	}
+	for _, processed := range images {
+		config, err := jpeg.DecodeConfig(bytes.NewReader(processed.Data))
+		if err != nil || config.Width != processed.Rendition.Width || config.Height != processed.Rendition.Height {
+			t.Errorf("rendition %s decoded = %+v, %v", processed.Rendition.Name, config, err)
+		}
+	}
+
+	// маленькое изображение не увеличивается
+	small := image.NewRGBA(image.Rect(0, 0, 100, 50))
+	images, err = Process(bytes.NewReader(encodePNG(t, small)), AvatarSpecs, Options{Flatten: true})
+	if err != nil {
+		t.Fatalf("Process() small error = %v", err)
+	}
+	for _, rendition := range renditionsOf(images) {
+		if rendition.Name == models.RenditionOriginal && (rendition.Width != 100 || rendition.Height != 50) ||
+			rendition.Name != models.RenditionOriginal && (rendition.Width != 50 || rendition.Height != 50) {
+			t.Errorf("small rendition = %+v", rendition)
+		}
+	}
+}
+
+func TestProcess_Orientation(t *testing.T) {
+	// левая половина красная, правая синяя
+	img := image.NewRGBA(image.Rect(0, 0, 200, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 200; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 100 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	data := withOrientation(encodeJPEG(t, img), 6)
+	if orientation := jpegOrientation(data); orientation != 6 {
+		t.Fatalf("jpegOrientation() = %d, want 6", orientation)
+	}
+
+	images, err := Process(bytes.NewReader(data), []Spec{{Name: models.RenditionOriginal}}, Options{})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if bytes.Contains(images[0].Data, []byte("Exif")) {
+		t.Errorf("processed image still contains EXIF")
+	}
+	decoded, err := jpeg.Decode(bytes.NewReader(images[0].Data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Bounds().Dx() != 100 || decoded.Bounds().Dy() != 200 {
+		t.Fatalf("rotated size = %v", decoded.Bounds())
+	}
+	// после поворота на 90 по часовой красная половина сверху
+	if r, _, b, _ := decoded.At(50, 20).RGBA(); r < b {
+		t.Errorf("top pixel is not red")
+	}
+	if r, _, b, _ := decoded.At(50, 180).RGBA(); r > b {
+		t.Errorf("bottom pixel is not blue")
+	}
+}
+
+func TestOrient(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	for i := 0; i < 6; i++ {
+		img.Pix[i*4] = uint8(i + 1)
+	}
+	// значения пикселей исходного изображения:
+	// 1 2 3
+	// 4 5 6
+	tests := map[int][]uint8{
+		1: {1, 2, 3, 4, 5, 6},
+		2: {3, 2, 1, 6, 5, 4},
+		3: {6, 5, 4, 3, 2, 1},
+		4: {4, 5, 6, 1, 2, 3},
+		5: {1, 4, 2, 5, 3, 6},
+		6: {4, 1, 5, 2, 6, 3},
+		7: {6, 3, 5, 2, 4, 1},
+		8: {3, 6, 2, 5, 1, 4},
+	}
+	for orientation, want := range tests {
+		result := orient(img, orientation)
+		bounds := result.Bounds()
+		got := make([]uint8, 0, 6)
+		for y := 0; y < bounds.Dy(); y++ {
+			for x := 0; x < bounds.Dx(); x++ {
+				r, _, _, _ := result.At(x, y).RGBA()
+				got = append(got, uint8(r>>8))
+			}
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("orient(%d) = %v, want %v", orientation, got, want)
+		}
+	}
+}
+
+func TestProcess_Transparency(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 64, 64))
+	for i := 0; i < len(img.Pix); i += 4 {
+		copy(img.Pix[i:], []byte{0, 128, 0, uint8(i / 4 % 64 * 4)})
+	}
+	data := encodePNG(t, img)
+
+	images, err := Process(bytes.NewReader(data), AttachmentSpecs, Options{})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	for _, processed := range images {
+		if processed.Rendition.ContentType != "image/webp" {
+			t.Errorf("rendition %s content type = %s", processed.Rendition.Name, processed.Rendition.ContentType)
+		}
+	}
+
+	images, err = Process(bytes.NewReader(data), AvatarSpecs, Options{Flatten: true})
+	if err != nil {
+		t.Fatalf("Process() flatten error = %v", err)
+	}
+	for _, processed := range images {
+		if processed.Rendition.ContentType != "image/jpeg" {
+			t.Errorf("flattened rendition %s content type = %s", processed.Rendition.Name, processed.Rendition.ContentType)
+		}
+	}
+}
+
+func TestProcess_Errors(t *testing.T) {
+	valid := encodePNG(t, image.NewRGBA(image.Rect(0, 0, 10, 10)))
+	huge := encodePNG(t, image.NewGray(image.Rect(0, 0, MaxSide+1, 1)))
+
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{name: "Not an image", data: []byte("%PDF-1.4"), err: models.Unsupported},
+		{name: "Truncated", data: valid[:len(valid)/2], err: models.WrongData},
+		{name: "Too large", data: huge, err: models.WrongData},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Process(bytes.NewReader(test.data), AttachmentSpecs, Options{})
+			if !errors.Is(err, test.err) {
+				t.Errorf("Process() error = %v, want %v", err, test.err)
+			}
+		})
+	}
+}
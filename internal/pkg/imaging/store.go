@@ -0,0 +1,36 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+)
+
+// Save сохраняет размеры изображения id в хранилище. Если сохранить не удалось, уже записанные размеры удаляются.
+func Save(ctx context.Context, store blob.BlobStore, id string, images []Image) ([]models.Rendition, error) {
+	renditions := make([]models.Rendition, 0, len(images))
+	for _, img := range images {
+		if err := store.Put(ctx, img.Rendition.Key(id), bytes.NewReader(img.Data), int64(len(img.Data)), img.Rendition.ContentType); err != nil {
+			for _, rendition := range renditions {
+				_ = store.Delete(ctx, rendition.Key(id))
+			}
+			return nil, err
+		}
+		renditions = append(renditions, img.Rendition)
+	}
+	return renditions, nil
+}
+
+// SavePhoto сохраняет размеры фотографии профиля или обложки под models.PhotoPrefix
+func SavePhoto(ctx context.Context, store blob.BlobStore, id string, images []Image) ([]models.Rendition, error) {
+	return Save(ctx, store, models.PhotoStorageId(id), images)
+}
+
+// DeletePhoto удаляет все размеры фотографии профиля или обложки, в том числе файл, сохранённый до обработки изображений
+func DeletePhoto(ctx context.Context, store blob.BlobStore, id string) error {
+	if err := store.Delete(ctx, models.PhotoKey(id)); err != nil {
+		return err
+	}
+	return blob.DeletePrefix(ctx, store, models.RenditionPrefix(models.PhotoStorageId(id)))
+}
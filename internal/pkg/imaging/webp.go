@@ -0,0 +1,462 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"io"
+	"math/bits"
+	"sort"
+)
+
+// Кодировщик WebP без потерь (VP8L). В x/image есть только декодер, а изображения с прозрачностью
+// нельзя сохранить в JPEG. Используются преобразование «вычесть зелёный», повторы левого и верхнего
+// пикселя и коды Хаффмана - этого хватает, чтобы файлы были заметно меньше PNG на скриншотах и графике.
+
+const (
+	vp8lSignature   = 0x2f
+	vp8lMaxSide     = 1 << 14
+	maxCodeLength   = 15
+	maxCLCodeLength = 7
+	minMatchLength  = 3
+	maxMatchLength  = 4096
+	numLengthCodes  = 24
+	numDistCodes    = 40
+	// коды расстояний VP8L для соседних пикселей: 1 - пиксель сверху, 2 - пиксель слева
+	distCodeAbove = 1
+	distCodeLeft  = 2
+)
+
+// порядок, в котором записываются длины кодов для алфавита длин кодов
+var codeLengthOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+type bitWriter struct {
+	buf   []byte
+	acc   uint64
+	nbits uint
+}
+
+// writeBits пишет младшие n бит value, начиная с младшего бита
+func (w *bitWriter) writeBits(value uint32, n uint) {
+	w.acc |= uint64(value) << w.nbits
+	w.nbits += n
+	for w.nbits >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nbits -= 8
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.nbits > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.nbits = 0, 0
+	}
+	return w.buf
+}
+
+// pixelToken - либо пиксель как есть, либо повтор length пикселей с расстоянием distCode
+type pixelToken struct {
+	argb     uint32
+	length   int
+	distCode int
+}
+
+// EncodeWebP кодирует изображение в WebP без потерь
+func EncodeWebP(w io.Writer, img image.Image) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > vp8lMaxSide || height > vp8lMaxSide {
+		return errors.New("imaging: wrong size for webp")
+	}
+
+	src := toNRGBA(img)
+	pixels := make([]uint32, 0, width*height)
+	hasAlpha := false
+	for y := 0; y < height; y++ {
+		row := src.Pix[y*src.Stride : y*src.Stride+width*4]
+		for x := 0; x < len(row); x += 4 {
+			r, g, b, a := uint32(row[x]), uint32(row[x+1]), uint32(row[x+2]), uint32(row[x+3])
+			if a != 0xff {
+				hasAlpha = true
+			}
+			if a == 0 {
+				// цвет полностью прозрачного пикселя не виден, обнуляем его для лучшего сжатия
+				r, g, b = 0, 0, 0
+			}
+			// преобразование «вычесть зелёный»
+			pixels = append(pixels, a<<24|((r-g)&0xff)<<16|g<<8|((b-g)&0xff))
+		}
+	}
+
+	tokens := tokenize(pixels, width)
+
+	green := make([]int, 256+numLengthCodes)
+	red := make([]int, 256)
+	blue := make([]int, 256)
+	alpha := make([]int, 256)
+	dist := make([]int, numDistCodes)
+	for _, token := range tokens {
+		if token.length == 0 {
+			green[token.argb>>8&0xff]++
+			red[token.argb>>16&0xff]++
+			blue[token.argb&0xff]++
+			alpha[token.argb>>24]++
+			continue
+		}
+		lengthCode, _, _ := prefixEncode(token.length)
+		green[256+lengthCode]++
+		distCode, _, _ := prefixEncode(token.distCode)
+		dist[distCode]++
+	}
+
+	bw := &bitWriter{}
+	bw.writeBits(vp8lSignature, 8)
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	if hasAlpha {
+		bw.writeBits(1, 1)
+	} else {
+		bw.writeBits(0, 1)
+	}
+	bw.writeBits(0, 3) // версия
+
+	// единственное преобразование - вычесть зелёный (тип 2)
+	bw.writeBits(1, 1)
+	bw.writeBits(2, 2)
+	bw.writeBits(0, 1)
+
+	bw.writeBits(0, 1) // без цветового кэша
+	bw.writeBits(0, 1) // один набор кодов на всё изображение
+
+	codes := make([]*prefixCode, 0, 5)
+	for _, histogram := range [][]int{green, red, blue, alpha, dist} {
+		code := newPrefixCode(huffmanLengths(histogram, maxCodeLength))
+		code.writeTo(bw)
+		codes = append(codes, code)
+	}
+
+	greenCode, redCode, blueCode, alphaCode, distCode := codes[0], codes[1], codes[2], codes[3], codes[4]
+	for _, token := range tokens {
+		if token.length == 0 {
+			greenCode.write(bw, int(token.argb>>8&0xff))
+			redCode.write(bw, int(token.argb>>16&0xff))
+			blueCode.write(bw, int(token.argb&0xff))
+			alphaCode.write(bw, int(token.argb>>24))
+			continue
+		}
+		code, extraBits, extra := prefixEncode(token.length)
+		greenCode.write(bw, 256+code)
+		bw.writeBits(uint32(extra), uint(extraBits))
+		code, extraBits, extra = prefixEncode(token.distCode)
+		distCode.write(bw, code)
+		bw.writeBits(uint32(extra), uint(extraBits))
+	}
+
+	return writeRIFF(w, bw.bytes())
+}
+
+func writeRIFF(w io.Writer, data []byte) error {
+	padding := len(data) & 1
+	header := make([]byte, 20)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(4+8+len(data)+padding))
+	copy(header[8:], "WEBPVP8L")
+	binary.LittleEndian.PutUint32(header[16:], uint32(len(data)))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if padding != 0 {
+		_, err := w.Write([]byte{0})
+		return err
+	}
+	return nil
+}
+
+func toNRGBA(img image.Image) *image.NRGBA {
+	if nrgba, ok := img.(*image.NRGBA); ok && nrgba.Rect.Min == (image.Point{}) {
+		return nrgba
+	}
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	rgba, ok := img.(*image.RGBA)
+	if !ok {
+		draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+		return dst
+	}
+	// уменьшенные размеры приходят в *image.RGBA, цвет в нём умножен на прозрачность
+	for y := 0; y < bounds.Dy(); y++ {
+		src := rgba.Pix[rgba.PixOffset(bounds.Min.X, bounds.Min.Y+y):]
+		row := dst.Pix[y*dst.Stride : y*dst.Stride+bounds.Dx()*4]
+		for x := 0; x < len(row); x += 4 {
+			a := uint32(src[x+3])
+			row[x+3] = byte(a)
+			if a == 0 {
+				continue
+			}
+			row[x] = byte(uint32(src[x]) * 0xff / a)
+			row[x+1] = byte(uint32(src[x+1]) * 0xff / a)
+			row[x+2] = byte(uint32(src[x+2]) * 0xff / a)
+		}
+	}
+	return dst
+}
+
+// tokenize заменяет серии пикселей, совпадающих с левыми или верхними, на повторы
+func tokenize(pixels []uint32, width int) []pixelToken {
+	tokens := make([]pixelToken, 0, len(pixels)/2)
+	for i := 0; i < len(pixels); {
+		best, code := 0, 0
+		if i >= 1 {
+			best, code = matchLength(pixels, i, i-1), distCodeLeft
+		}
+		if i >= width {
+			if length := matchLength(pixels, i, i-width); length > best {
+				best, code = length, distCodeAbove
+			}
+		}
+		if best >= minMatchLength {
+			tokens = append(tokens, pixelToken{length: best, distCode: code})
+			i += best
+			continue
+		}
+		tokens = append(tokens, pixelToken{argb: pixels[i]})
+		i++
+	}
+	return tokens
+}
+
+func matchLength(pixels []uint32, pos int, ref int) int {
+	length := 0
+	for pos+length < len(pixels) && length < maxMatchLength && pixels[pos+length] == pixels[ref+length] {
+		length++
+	}
+	return length
+}
+
+// prefixEncode разбивает значение (длину или код расстояния) на префиксный код и дополнительные биты
+func prefixEncode(value int) (code int, extraBits int, extra int) {
+	value--
+	if value < 4 {
+		return value, 0, 0
+	}
+	highest := bits.Len(uint(value)) - 1
+	second := value >> (highest - 1) & 1
+	extraBits = highest - 1
+	return 2*highest + second, extraBits, value & (1<<extraBits - 1)
+}
+
+// prefixCode - канонический код Хаффмана, коды хранятся развёрнутыми, так как поток пишется с младшего бита
+type prefixCode struct {
+	lengths []uint8
+	codes   []uint32
+	single  bool
+}
+
+func newPrefixCode(lengths []uint8) *prefixCode {
+	code := &prefixCode{lengths: lengths, codes: make([]uint32, len(lengths))}
+	used := 0
+	for _, length := range lengths {
+		if length > 0 {
+			used++
+		}
+	}
+	// код из одного символа декодер читает без единого бита
+	code.single = used <= 1
+
+	var count [maxCodeLength + 1]uint32
+	for _, length := range lengths {
+		count[length]++
+	}
+	count[0] = 0
+	var next [maxCodeLength + 2]uint32
+	for length := 1; length <= maxCodeLength; length++ {
+		next[length+1] = (next[length] + count[length]) << 1
+	}
+	for symbol, length := range lengths {
+		if length == 0 {
+			continue
+		}
+		value := next[length]
+		next[length]++
+		code.codes[symbol] = bits.Reverse32(value) >> (32 - uint(length))
+	}
+	return code
+}
+
+func (c *prefixCode) write(w *bitWriter, symbol int) {
+	if c.single {
+		return
+	}
+	w.writeBits(c.codes[symbol], uint(c.lengths[symbol]))
+}
+
+// writeTo записывает код в поток: код из одного символа меньше 256 - простым кодом, остальные - обычным
+func (c *prefixCode) writeTo(w *bitWriter) {
+	used, last := 0, 0
+	for symbol, length := range c.lengths {
+		if length > 0 {
+			used++
+			last = symbol
+		}
+	}
+	if used <= 1 && last < 256 {
+		w.writeBits(1, 1) // простой код
+		w.writeBits(0, 1) // один символ
+		if last < 2 {
+			w.writeBits(0, 1)
+			w.writeBits(uint32(last), 1)
+		} else {
+			w.writeBits(1, 1)
+			w.writeBits(uint32(last), 8)
+		}
+		return
+	}
+
+	// длины кодов сжимаются сами: 0-15 - длина, 17 и 18 - серии нулей
+	type lengthToken struct {
+		symbol     int
+		extra      uint32
+		extraWidth uint
+	}
+	tokens := make([]lengthToken, 0, len(c.lengths))
+	histogram := make([]int, len(codeLengthOrder))
+	for i := 0; i < len(c.lengths); {
+		if c.lengths[i] != 0 {
+			tokens = append(tokens, lengthToken{symbol: int(c.lengths[i])})
+			histogram[c.lengths[i]]++
+			i++
+			continue
+		}
+		run := 0
+		for i+run < len(c.lengths) && c.lengths[i+run] == 0 && run < 138 {
+			run++
+		}
+		switch {
+		case run >= 11:
+			tokens = append(tokens, lengthToken{symbol: 18, extra: uint32(run - 11), extraWidth: 7})
+		case run >= 3:
+			tokens = append(tokens, lengthToken{symbol: 17, extra: uint32(run - 3), extraWidth: 3})
+		default:
+			for k := 0; k < run; k++ {
+				tokens = append(tokens, lengthToken{symbol: 0})
+			}
+			histogram[0] += run
+			i += run
+			continue
+		}
+		histogram[tokens[len(tokens)-1].symbol]++
+		i += run
+	}
+
+	lengthCode := newPrefixCode(huffmanLengths(histogram, maxCLCodeLength))
+	w.writeBits(0, 1) // обычный код
+	count := len(codeLengthOrder)
+	for count > 4 && lengthCode.lengths[codeLengthOrder[count-1]] == 0 {
+		count--
+	}
+	w.writeBits(uint32(count-4), 4)
+	for _, symbol := range codeLengthOrder[:count] {
+		w.writeBits(uint32(lengthCode.lengths[symbol]), 3)
+	}
+	w.writeBits(0, 1) // длины записаны для всего алфавита
+	for _, token := range tokens {
+		lengthCode.write(w, token.symbol)
+		w.writeBits(token.extra, token.extraWidth)
+	}
+}
+
+// huffmanLengths строит длины кодов Хаффмана не длиннее maxLength. Если дерево получилось глубже,
+// частоты редких символов поднимаются и дерево строится заново.
+func huffmanLengths(histogram []int, maxLength int) []uint8 {
+	lengths := make([]uint8, len(histogram))
+	used := make([]int, 0, len(histogram))
+	for symbol, count := range histogram {
+		if count > 0 {
+			used = append(used, symbol)
+		}
+	}
+	switch len(used) {
+	case 0:
+		return lengths
+	case 1:
+		lengths[used[0]] = 1
+		return lengths
+	}
+
+	for minCount := 1; ; minCount *= 2 {
+		depths := huffmanDepths(histogram, used, minCount)
+		maxDepth := 0
+		for _, depth := range depths {
+			if depth > maxDepth {
+				maxDepth = depth
+			}
+		}
+		if maxDepth <= maxLength {
+			for i, symbol := range used {
+				lengths[symbol] = uint8(depths[i])
+			}
+			return lengths
+		}
+	}
+}
+
+// huffmanDepths возвращает глубины листьев для символов used
+func huffmanDepths(histogram []int, used []int, minCount int) []int {
+	type node struct {
+		weight      int
+		left, right int
+	}
+	nodes := make([]node, 0, 2*len(used))
+	for _, symbol := range used {
+		weight := histogram[symbol]
+		if weight < minCount {
+			weight = minCount
+		}
+		nodes = append(nodes, node{weight: weight, left: -1, right: -1})
+	}
+	leaves := make([]int, len(used))
+	for i := range leaves {
+		leaves[i] = i
+	}
+	sort.SliceStable(leaves, func(i, j int) bool {
+		return nodes[leaves[i]].weight < nodes[leaves[j]].weight
+	})
+
+	// две очереди: листья по возрастанию веса и внутренние узлы, которые создаются уже упорядоченными
+	var internal []int
+	pop := func() int {
+		if len(internal) == 0 || (len(leaves) > 0 && nodes[leaves[0]].weight <= nodes[internal[0]].weight) {
+			index := leaves[0]
+			leaves = leaves[1:]
+			return index
+		}
+		index := internal[0]
+		internal = internal[1:]
+		return index
+	}
+	for len(leaves)+len(internal) > 1 {
+		left, right := pop(), pop()
+		nodes = append(nodes, node{weight: nodes[left].weight + nodes[right].weight, left: left, right: right})
+		internal = append(internal, len(nodes)-1)
+	}
+
+	depths := make([]int, len(used))
+	type item struct{ index, depth int }
+	stack := []item{{index: len(nodes) - 1}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		n := nodes[current.index]
+		if n.left < 0 {
+			depths[current.index] = current.depth
+			continue
+		}
+		stack = append(stack, item{n.left, current.depth + 1}, item{n.right, current.depth + 1})
+	}
+	return depths
+}
@@ -0,0 +1,126 @@
+package imaging
+
+import (
+	"bytes"
+	"golang.org/x/image/webp"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+)
+
+func checkWebPRoundTrip(t *testing.T, img *image.NRGBA) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	if err := EncodeWebP(buf, img); err != nil {
+		t.Fatalf("EncodeWebP() error = %v", err)
+	}
+	decoded, err := webp.Decode(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("webp.Decode() error = %v", err)
+	}
+	bounds := img.Bounds()
+	if decoded.Bounds().Dx() != bounds.Dx() || decoded.Bounds().Dy() != bounds.Dy() {
+		t.Fatalf("decoded size = %v, want %v", decoded.Bounds(), bounds)
+	}
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			want := img.NRGBAAt(x, y)
+			if want.A == 0 {
+				want = color.NRGBA{}
+			}
+			got := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+			if got != want {
+				t.Fatalf("pixel (%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestEncodeWebP(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	noise := image.NewNRGBA(image.Rect(0, 0, 37, 23))
+	random.Read(noise.Pix)
+
+	// горизонтальные полосы и повторяющиеся строки - проверка повторов слева и сверху
+	stripes := image.NewNRGBA(image.Rect(0, 0, 300, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 300; x++ {
+			stripes.SetNRGBA(x, y, color.NRGBA{R: uint8(x / 50 * 40), G: uint8(y / 20 * 20), B: 200, A: uint8(255 - x%3)})
+		}
+	}
+
+	single := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	single.SetNRGBA(0, 0, color.NRGBA{R: 10, G: 20, B: 30, A: 40})
+
+	// один цвет на всё изображение - коды из одного символа
+	flat := image.NewNRGBA(image.Rect(0, 0, 5000, 3))
+	for i := 0; i < len(flat.Pix); i += 4 {
+		copy(flat.Pix[i:], []byte{255, 0, 0, 128})
+	}
+
+	// мало цветов с сильно неравными частотами - ограничение длины кодов
+	skewed := image.NewNRGBA(image.Rect(0, 0, 256, 256))
+	for y := 0; y < 256; y++ {
+		for x := 0; x < 256; x++ {
+			value := uint8(0)
+			if random.Intn(1<<(x%20)) == 0 {
+				value = uint8(x)
+			}
+			skewed.SetNRGBA(x, y, color.NRGBA{R: value, G: uint8(y % 3), B: value ^ 7, A: 255})
+		}
+	}
+
+	transparent := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+	random.Read(transparent.Pix)
+	for i := 3; i < len(transparent.Pix); i += 8 {
+		transparent.Pix[i] = 0
+	}
+
+	for name, img := range map[string]*image.NRGBA{
+		"noise":       noise,
+		"stripes":     stripes,
+		"single":      single,
+		"flat":        flat,
+		"skewed":      skewed,
+		"transparent": transparent,
+	} {
+		t.Run(name, func(t *testing.T) {
+			checkWebPRoundTrip(t, img)
+		})
+	}
+
+	data := checkWebPRoundTrip(t, stripes)
+	if len(data) > len(stripes.Pix)/20 {
+		t.Errorf("stripes encoded to %d bytes, want compression", len(data))
+	}
+}
+
+func TestEncodeWebP_WrongSize(t *testing.T) {
+	if err := EncodeWebP(&bytes.Buffer{}, image.NewNRGBA(image.Rect(0, 0, 0, 10))); err == nil {
+		t.Errorf("EncodeWebP() of empty image error = nil")
+	}
+}
+
+func TestHuffmanLengths(t *testing.T) {
+	histogram := make([]int, 40)
+	for i := range histogram {
+		histogram[i] = 1 << i / 1024
+		if histogram[i] == 0 {
+			histogram[i] = 1
+		}
+	}
+	lengths := huffmanLengths(histogram, 15)
+	kraft := 0.0
+	for _, length := range lengths {
+		if length == 0 || length > 15 {
+			t.Fatalf("lengths = %v", lengths)
+		}
+		kraft += 1 / float64(uint(1)<<length)
+	}
+	if kraft != 1 {
+		t.Errorf("code is not complete, kraft sum = %v", kraft)
+	}
+}
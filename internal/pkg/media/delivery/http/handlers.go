@@ -0,0 +1,254 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/token"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// AttachmentPath - адрес, по которому отдаются вложения по подписанным ссылкам
+	AttachmentPath = "/api/media/attachment/"
+	// photoCacheControl - фотография при замене получает новый id, поэтому её можно кэшировать навсегда
+	photoCacheControl = "public, max-age=31536000, immutable"
+)
+
+// photoSizes - размеры фотографий профиля и обложек, они всегда сохраняются в JPEG
+var photoSizes = map[string]bool{
+	models.RenditionAvatar64:  true,
+	models.RenditionAvatar128: true,
+	models.RenditionAvatar256: true,
+	models.RenditionFeed:      true,
+	models.RenditionOriginal:  true,
+}
+
+type MediaHandler struct {
+	creatorClient generatedCreator.CreatorServiceClient
+	store         blob.BlobStore
+	signer        *media.URLSigner
+	logger        *zap.SugaredLogger
+	now           func() time.Time
+}
+
+func NewMediaHandler(creatorClient generatedCreator.CreatorServiceClient, store blob.BlobStore, signer *media.URLSigner, logger *zap.SugaredLogger) *MediaHandler {
+	return &MediaHandler{
+		creatorClient: creatorClient,
+		store:         store,
+		signer:        signer,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// AttachmentURL выдаёт подписанную ссылку на вложение поста, если пост доступен пользователю
+func (h *MediaHandler) AttachmentURL(w http.ResponseWriter, r *http.Request) {
+	var userID uuid.UUID
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+	if err == nil {
+		userID = userDataJWT.Id
+	}
+
+	postID, err := uuid.Parse(mux.Vars(r)["post-uuid"])
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	attachID, err := uuid.Parse(mux.Vars(r)["attachment-uuid"])
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	available, err := h.creatorClient.IsPostAvailable(r.Context(), &generatedCreator.PostUserMessage{
+		UserID: userID.String(),
+		PostID: postID.String(),
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if available.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+	if available.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	attach, err := h.creatorClient.GetAttachment(r.Context(), &generatedCreator.PostAttachMessage{
+		PostID:     postID.String(),
+		Attachment: &generatedCreator.Attachment{ID: attachID.String()},
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if attach.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+	if attach.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	var attachment models.Attachment
+	if err = attachment.AttachToModel(attach.Attachment); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	// файл из карантина, отклонённый или так и не проверенный не отдаётся никому, в том числе автору
+	if !attachment.ScanPassed() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+	// у обработанных изображений ссылка ведёт на один из размеров, по умолчанию на исходный
+	key := models.AttachmentKey(attachment.StorageId(), attach.Extension)
+	if len(attachment.Renditions) != 0 {
+		size := r.URL.Query().Get("size")
+		if len(size) == 0 {
+			size = models.RenditionOriginal
+		}
+		rendition, ok := models.FindRendition(attachment.Renditions, size)
+		if !ok {
+			utils.Response(w, http.StatusNotFound, nil)
+			return
+		}
+		key = rendition.Key(attachment.StorageId())
+	}
+
+	// документы и архивы скачиваются под исходным именем, остальные файлы - если клиент просит скачать.
+	// Скачивание засчитывается, когда файл отдаётся по ссылке, а не когда ссылка выдаётся.
+	var filename, ref string
+	if attach.Download || r.URL.Query().Get("download") == "true" {
+		filename = attachment.Filename
+		if len(filename) == 0 {
+			filename = models.AttachmentKey(attach.Attachment.ID, attach.Extension)
+		}
+		ref = postID.String() + ":" + attachID.String()
+	}
+	query, expiresAt := h.signer.SignDownload(key, filename, ref, h.now())
+	w.Header().Set("Cache-Control", "no-store")
+	utils.Response(w, http.StatusOK, models.MediaURL{
+		URL:       AttachmentPath + key + "?" + query.Encode(),
+		ExpiresAt: expiresAt,
+	})
+}
+
+// Attachment отдаёт вложение по подписанной ссылке, поддерживает Range-запросы для аудио и видео
+func (h *MediaHandler) Attachment(w http.ResponseWriter, r *http.Request) {
+	key := mux.Vars(r)["key"]
+	expiresAt, err := h.signer.Verify(key, r.URL.Query(), h.now())
+	if err != nil {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+
+	if filename := r.URL.Query().Get("filename"); len(filename) != 0 {
+		disposition := mime.FormatMediaType("attachment", map[string]string{"filename": filename})
+		if len(disposition) == 0 {
+			disposition = "attachment"
+		}
+		w.Header().Set("Content-Disposition", disposition)
+	}
+	// ссылку нельзя кэшировать дольше, чем она действительна
+	maxAge := int(expiresAt.Sub(h.now()).Seconds())
+	if !h.serve(w, r, key, fmt.Sprintf("private, max-age=%d", maxAge)) {
+		return
+	}
+
+	// докачка продолжает уже засчитанное скачивание, HEAD ничего не скачивает;
+	// по одной ссылке скачивание засчитывается один раз, повторы запроса счётчик не увеличивают
+	ref := r.URL.Query().Get("ref")
+	if len(ref) == 0 || r.Method != http.MethodGet || !startsFromBeginning(r.Header.Get("Range")) {
+		return
+	}
+	postID, attachID, ok := strings.Cut(ref, ":")
+	if !ok {
+		return
+	}
+	download, err := h.creatorClient.AddDownload(r.Context(), &generatedCreator.DownloadMessage{
+		PostID:       postID,
+		AttachmentID: attachID,
+		Key:          r.URL.Query().Get("signature"),
+		ExpiresAt:    expiresAt.Format(time.RFC3339),
+	})
+	// из-за счётчика скачивание не должно ломаться, файл к этому моменту уже отдан
+	if err != nil {
+		h.logger.Error(err)
+	} else if download.Error != "" {
+		h.logger.Error(download.Error)
+	}
+}
+
+// startsFromBeginning - запрос без Range или с Range с первого байта
+func startsFromBeginning(rangeValue string) bool {
+	return len(rangeValue) == 0 || strings.HasPrefix(rangeValue, "bytes=0-")
+}
+
+// Photo отдаёт фотографию профиля или обложку, они доступны всем. Без размера отдаётся исходный,
+// а для фотографий, загруженных до обработки изображений, - файл как есть.
+// Ключи строятся только под models.PhotoPrefix, поэтому вложения отсюда не отдаются.
+func (h *MediaHandler) Photo(w http.ResponseWriter, r *http.Request) {
+	imageID, err := uuid.Parse(mux.Vars(r)["image-uuid"])
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	storageID := models.PhotoStorageId(imageID.String())
+	size, ok := mux.Vars(r)["size"]
+	if !ok {
+		key := models.RenditionKey(storageID, models.RenditionOriginal, "jpg")
+		if _, err = h.store.Stat(r.Context(), key); errors.Is(err, models.NotFound) {
+			key = models.PhotoKey(imageID.String())
+		}
+		h.serve(w, r, key, photoCacheControl)
+		return
+	}
+	if !photoSizes[size] {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+	h.serve(w, r, models.RenditionKey(storageID, size, "jpg"), photoCacheControl)
+}
+
+// serve отдаёт файл key и возвращает false, если файла нет или его не удалось прочитать
+func (h *MediaHandler) serve(w http.ResponseWriter, r *http.Request, key string, cacheControl string) bool {
+	info, err := h.store.Stat(r.Context(), key)
+	if errors.Is(err, models.NotFound) || errors.Is(err, models.WrongData) {
+		utils.Response(w, http.StatusNotFound, nil)
+		return false
+	}
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return false
+	}
+
+	reader := blob.NewReader(r.Context(), h.store, key, info.Size)
+	defer reader.Close()
+
+	if len(info.ContentType) != 0 {
+		w.Header().Set("Content-Type", info.ContentType)
+	}
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, key, info.ModifiedAt, reader)
+	return true
+}
@@ -0,0 +1,451 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	generatedCommon "github.com/go-park-mail-ru/2023_1_4from5/internal/models/proto"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/usecase"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/local"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	mockCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/mocks"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediainfo"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func setJWTToken(r *http.Request, token string) {
+	r.AddCookie(&http.Cookie{
+		Name:     "SSID",
+		Value:    token,
+		Expires:  time.Time{},
+		HttpOnly: true,
+	})
+}
+
+func newTestHandler(t *testing.T, creatorClient generated.CreatorServiceClient) (*MediaHandler, *mux.Router) {
+	store, err := local.NewStore(t.TempDir())
+	require.NoError(t, err)
+	h := NewMediaHandler(creatorClient, store, media.NewURLSigner([]byte("secret"), time.Minute), zap.NewNop().Sugar())
+	h.now = func() time.Time { return testNow }
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/media/post/{post-uuid}/{attachment-uuid}", h.AttachmentURL).Methods(http.MethodGet)
+	r.HandleFunc("/api/media/attachment/{key:.+}", h.Attachment).Methods(http.MethodGet, http.MethodHead)
+	r.HandleFunc("/api/media/photo/{image-uuid}", h.Photo).Methods(http.MethodGet, http.MethodHead)
+	r.HandleFunc("/api/media/photo/{image-uuid}/{size}", h.Photo).Methods(http.MethodGet, http.MethodHead)
+	return h, r
+}
+
+func TestMediaHandler_AttachmentURL(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+	h, router := newTestHandler(t, creatorClient)
+	content := []byte(strings.Repeat("mp4", 100))
+	postID, attachID, userID := uuid.New(), uuid.New(), uuid.New()
+	blobID := mediainfo.Checksum(content)
+	require.NoError(t, h.store.Put(context.Background(), models.AttachmentKey(attachID.String(), "mp4"), bytes.NewReader(content), int64(len(content)), "video/mp4"))
+	require.NoError(t, h.store.Put(context.Background(), models.AttachmentKey(blobID, "mp4"), bytes.NewReader(content), int64(len(content)), "video/mp4"))
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	bdy, _ := tkn.GetJWTToken(context.Background(), models.User{Login: "test", Id: userID})
+	path := "/api/media/post/" + postID.String() + "/" + attachID.String()
+
+	tests := []struct {
+		name           string
+		mock           func() *http.Request
+		expectedStatus int
+		storageID      string
+	}{
+		{
+			name: "OK",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, path, nil)
+				setJWTToken(r, bdy)
+				creatorClient.EXPECT().IsPostAvailable(gomock.Any(), &generated.PostUserMessage{
+					UserID: userID.String(), PostID: postID.String()}).Return(&generatedCommon.Empty{}, nil)
+				creatorClient.EXPECT().GetAttachment(gomock.Any(), gomock.Any()).Return(&generated.AttachmentMessage{
+					Attachment: &generated.Attachment{ID: attachID.String(), Type: "video/mp4"}, Extension: "mp4"}, nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+			storageID:      attachID.String(),
+		},
+		{
+			name: "OK stored by content",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, path, nil)
+				setJWTToken(r, bdy)
+				creatorClient.EXPECT().IsPostAvailable(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+				creatorClient.EXPECT().GetAttachment(gomock.Any(), gomock.Any()).Return(&generated.AttachmentMessage{
+					Attachment: &generated.Attachment{ID: attachID.String(), Type: "video/mp4", BlobID: blobID}, Extension: "mp4"}, nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+			storageID:      blobID,
+		},
+		{
+			name: "OK without user",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, path, nil)
+				creatorClient.EXPECT().IsPostAvailable(gomock.Any(), &generated.PostUserMessage{
+					UserID: uuid.Nil.String(), PostID: postID.String()}).Return(&generatedCommon.Empty{}, nil)
+				creatorClient.EXPECT().GetAttachment(gomock.Any(), gomock.Any()).Return(&generated.AttachmentMessage{
+					Attachment: &generated.Attachment{ID: attachID.String(), Type: "video/mp4"}, Extension: "mp4"}, nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+			storageID:      attachID.String(),
+		},
+		{
+			name: "No subscription",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, path, nil)
+				setJWTToken(r, bdy)
+				creatorClient.EXPECT().IsPostAvailable(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{
+					Error: models.WrongData.Error()}, nil)
+				return r
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Attachment of other post",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, path, nil)
+				setJWTToken(r, bdy)
+				creatorClient.EXPECT().IsPostAvailable(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+				creatorClient.EXPECT().GetAttachment(gomock.Any(), gomock.Any()).Return(&generated.AttachmentMessage{
+					Error: models.NotFound.Error()}, nil)
+				return r
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "Quarantined",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, path, nil)
+				setJWTToken(r, bdy)
+				creatorClient.EXPECT().IsPostAvailable(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+				creatorClient.EXPECT().GetAttachment(gomock.Any(), gomock.Any()).Return(&generated.AttachmentMessage{
+					Attachment: &generated.Attachment{ID: attachID.String(), Type: "video/mp4", ScanStatus: models.ScanPending}, Extension: "mp4"}, nil)
+				return r
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "Rejected",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, path, nil)
+				setJWTToken(r, bdy)
+				creatorClient.EXPECT().IsPostAvailable(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+				creatorClient.EXPECT().GetAttachment(gomock.Any(), gomock.Any()).Return(&generated.AttachmentMessage{
+					Attachment: &generated.Attachment{ID: attachID.String(), Type: "video/mp4", ScanStatus: models.ScanRejected}, Extension: "mp4"}, nil)
+				return r
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "Err from IsPostAvailable",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodGet, path, nil)
+				creatorClient.EXPECT().IsPostAvailable(gomock.Any(), gomock.Any()).Return(nil, errors.New("test"))
+				return r
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Wrong attachment id",
+			mock: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/api/media/post/"+postID.String()+"/1", nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, test.mock())
+			require.Equal(t, test.expectedStatus, w.Code)
+			if w.Code != http.StatusOK {
+				return
+			}
+
+			var mediaURL models.MediaURL
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &mediaURL))
+			require.True(t, mediaURL.ExpiresAt.Equal(testNow.Add(time.Minute)))
+			require.True(t, strings.HasPrefix(mediaURL.URL, AttachmentPath+test.storageID+".mp4?"))
+			require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+			// по выданной ссылке файл отдаётся без авторизации
+			w = httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, mediaURL.URL, nil))
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, content, w.Body.Bytes())
+		})
+	}
+}
+
+func TestMediaHandler_AttachmentURLRenditions(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+	h, router := newTestHandler(t, creatorClient)
+	postID, attachID := uuid.New(), uuid.New()
+	renditions := []models.Rendition{
+		{Name: models.RenditionThumbnail, Width: 320, Height: 320, ContentType: "image/webp"},
+		{Name: models.RenditionOriginal, Width: 800, Height: 600, ContentType: "image/webp"},
+	}
+	attachment := models.Attachment{Id: attachID, Type: "image/png", Renditions: renditions}
+	for _, rendition := range renditions {
+		require.NoError(t, h.store.Put(context.Background(), rendition.Key(attachID.String()), strings.NewReader(rendition.Name), int64(len(rendition.Name)), rendition.ContentType))
+	}
+	path := "/api/media/post/" + postID.String() + "/" + attachID.String()
+
+	tests := []struct {
+		name           string
+		size           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "Original by default", expectedStatus: http.StatusOK, expectedBody: models.RenditionOriginal},
+		{name: "Thumbnail", size: models.RenditionThumbnail, expectedStatus: http.StatusOK, expectedBody: models.RenditionThumbnail},
+		{name: "Unknown size", size: models.RenditionFeed, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creatorClient.EXPECT().IsPostAvailable(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+			creatorClient.EXPECT().GetAttachment(gomock.Any(), gomock.Any()).Return(&generated.AttachmentMessage{
+				Attachment: attachment.ToProto(), Extension: "png"}, nil)
+
+			url := path
+			if len(test.size) != 0 {
+				url += "?size=" + test.size
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+			require.Equal(t, test.expectedStatus, w.Code)
+			if w.Code != http.StatusOK {
+				return
+			}
+
+			var mediaURL models.MediaURL
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &mediaURL))
+			w = httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, mediaURL.URL, nil))
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, test.expectedBody, w.Body.String())
+			require.Equal(t, "image/webp", w.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestMediaHandler_AttachmentURLDownload(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+	h, router := newTestHandler(t, creatorClient)
+	postID, attachID := uuid.New(), uuid.New()
+	content := []byte("%PDF-1.7")
+	require.NoError(t, h.store.Put(context.Background(), models.AttachmentKey(attachID.String(), "pdf"), bytes.NewReader(content), int64(len(content)), "application/pdf"))
+	require.NoError(t, h.store.Put(context.Background(), models.AttachmentKey(attachID.String(), "mp4"), bytes.NewReader(content), int64(len(content)), "video/mp4"))
+	path := "/api/media/post/" + postID.String() + "/" + attachID.String()
+
+	tests := []struct {
+		name                string
+		url                 string
+		attach              *generated.AttachmentMessage
+		addDownload         *generatedCommon.Empty
+		expectedDisposition string
+	}{
+		{
+			name: "Document",
+			url:  path,
+			attach: &generated.AttachmentMessage{Attachment: &generated.Attachment{ID: attachID.String(), Type: "application/pdf",
+				Filename: "отчёт.pdf"}, Extension: "pdf", Download: true},
+			addDownload:         &generatedCommon.Empty{},
+			expectedDisposition: "attachment; filename*=utf-8''%D0%BE%D1%82%D1%87%D1%91%D1%82.pdf",
+		},
+		{
+			name: "Document without filename",
+			url:  path,
+			attach: &generated.AttachmentMessage{Attachment: &generated.Attachment{ID: attachID.String(), Type: "application/pdf"},
+				Extension: "pdf", Download: true},
+			addDownload:         &generatedCommon.Empty{Error: models.InternalError.Error()},
+			expectedDisposition: "attachment; filename=" + attachID.String() + ".pdf",
+		},
+		{
+			name: "Video download",
+			url:  path + "?download=true",
+			attach: &generated.AttachmentMessage{Attachment: &generated.Attachment{ID: attachID.String(), Type: "video/mp4",
+				Filename: "clip.mp4"}, Extension: "mp4"},
+			addDownload:         &generatedCommon.Empty{},
+			expectedDisposition: "attachment; filename=clip.mp4",
+		},
+		{
+			name: "Video",
+			url:  path,
+			attach: &generated.AttachmentMessage{Attachment: &generated.Attachment{ID: attachID.String(), Type: "video/mp4",
+				Filename: "clip.mp4"}, Extension: "mp4"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creatorClient.EXPECT().IsPostAvailable(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+			creatorClient.EXPECT().GetAttachment(gomock.Any(), gomock.Any()).Return(test.attach, nil)
+
+			// выдача ссылки скачивание не засчитывает
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, test.url, nil))
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var mediaURL models.MediaURL
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &mediaURL))
+			if test.addDownload != nil {
+				signed, err := url.Parse(mediaURL.URL)
+				require.NoError(t, err)
+				// скачивание засчитывается по подписи ссылки, повтор запроса по ней отсеивает сервис авторов
+				creatorClient.EXPECT().AddDownload(gomock.Any(), &generated.DownloadMessage{
+					PostID: postID.String(), AttachmentID: attachID.String(), Key: signed.Query().Get("signature"),
+					ExpiresAt: mediaURL.ExpiresAt.Format(time.RFC3339)}).Return(test.addDownload, nil)
+			}
+			w = httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, mediaURL.URL, nil))
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, test.expectedDisposition, w.Header().Get("Content-Disposition"))
+
+			// докачка и HEAD-запрос не засчитываются повторно
+			r := httptest.NewRequest(http.MethodGet, mediaURL.URL, nil)
+			r.Header.Set("Range", "bytes=4-")
+			w = httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+			require.Equal(t, http.StatusPartialContent, w.Code)
+			w = httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodHead, mediaURL.URL, nil))
+			require.Equal(t, http.StatusOK, w.Code)
+
+			// имя файла подписано, подменить его в ссылке нельзя
+			if len(test.expectedDisposition) != 0 {
+				w = httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, strings.Replace(mediaURL.URL, "filename=", "filename=x", 1), nil))
+				require.Equal(t, http.StatusForbidden, w.Code)
+				w = httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, strings.Replace(mediaURL.URL, "ref="+postID.String(), "ref="+uuid.NewString(), 1), nil))
+				require.Equal(t, http.StatusForbidden, w.Code)
+			}
+		})
+	}
+}
+
+func TestMediaHandler_Attachment(t *testing.T) {
+	h, router := newTestHandler(t, nil)
+	content := []byte(strings.Repeat("0123456789", 100))
+	key := models.AttachmentKey(uuid.New().String(), "mp3")
+	require.NoError(t, h.store.Put(context.Background(), key, bytes.NewReader(content), int64(len(content)), "audio/mpeg"))
+	query, _ := h.signer.Sign(key, testNow.Add(-30*time.Second))
+	expired, _ := h.signer.Sign(key, testNow.Add(-time.Hour))
+	missingKey := models.AttachmentKey(uuid.New().String(), "mp3")
+	missing, _ := h.signer.Sign(missingKey, testNow)
+
+	tests := []struct {
+		name           string
+		url            string
+		rangeValue     string
+		expectedStatus int
+		expectedBody   []byte
+	}{
+		{name: "Full", url: AttachmentPath + key + "?" + query.Encode(), expectedStatus: http.StatusOK, expectedBody: content},
+		{name: "Range", url: AttachmentPath + key + "?" + query.Encode(), rangeValue: "bytes=100-199",
+			expectedStatus: http.StatusPartialContent, expectedBody: content[100:200]},
+		{name: "Range out of file", url: AttachmentPath + key + "?" + query.Encode(), rangeValue: "bytes=5000-",
+			expectedStatus: http.StatusRequestedRangeNotSatisfiable},
+		{name: "Expired", url: AttachmentPath + key + "?" + expired.Encode(), expectedStatus: http.StatusForbidden},
+		{name: "Without signature", url: AttachmentPath + key, expectedStatus: http.StatusForbidden},
+		{name: "Signature of other file", url: AttachmentPath + missingKey + "?" + query.Encode(), expectedStatus: http.StatusForbidden},
+		{name: "Missing file", url: AttachmentPath + missingKey + "?" + missing.Encode(), expectedStatus: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, test.url, nil)
+			if len(test.rangeValue) != 0 {
+				r.Header.Set("Range", test.rangeValue)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+			require.Equal(t, test.expectedStatus, w.Code)
+			if test.expectedBody == nil {
+				return
+			}
+			require.Equal(t, test.expectedBody, w.Body.Bytes())
+			require.Equal(t, "audio/mpeg", w.Header().Get("Content-Type"))
+			require.Equal(t, "bytes", w.Header().Get("Accept-Ranges"))
+			require.Equal(t, "private, max-age=30", w.Header().Get("Cache-Control"))
+		})
+	}
+}
+
+func TestMediaHandler_Photo(t *testing.T) {
+	h, router := newTestHandler(t, nil)
+	ctx := context.Background()
+	imageID, processedID, attachID := uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, h.store.Put(ctx, models.PhotoKey(imageID.String()), strings.NewReader("jpeg"), 4, "image/jpeg"))
+	for _, size := range []string{models.RenditionOriginal, models.RenditionAvatar64} {
+		require.NoError(t, h.store.Put(ctx, models.RenditionKey(models.PhotoStorageId(processedID.String()), size, "jpg"), strings.NewReader(size), int64(len(size)), "image/jpeg"))
+	}
+	// платное вложение-изображение не должно отдаваться по публичному адресу фотографии
+	require.NoError(t, h.store.Put(ctx, models.AttachmentKey(attachID.String(), "jpg"), strings.NewReader("paid"), 4, "image/jpeg"))
+	require.NoError(t, h.store.Put(ctx, models.RenditionKey(attachID.String(), models.RenditionFeed, "jpg"), strings.NewReader("paid"), 4, "image/jpeg"))
+
+	tests := []struct {
+		name           string
+		url            string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "OK", url: "/api/media/photo/" + imageID.String(), expectedStatus: http.StatusOK, expectedBody: "jpeg"},
+		{name: "Original", url: "/api/media/photo/" + processedID.String(), expectedStatus: http.StatusOK, expectedBody: models.RenditionOriginal},
+		{name: "Size", url: "/api/media/photo/" + processedID.String() + "/" + models.RenditionAvatar64, expectedStatus: http.StatusOK, expectedBody: models.RenditionAvatar64},
+		{name: "Missing size", url: "/api/media/photo/" + processedID.String() + "/" + models.RenditionAvatar128, expectedStatus: http.StatusNotFound},
+		{name: "Unknown size", url: "/api/media/photo/" + processedID.String() + "/huge", expectedStatus: http.StatusNotFound},
+		{name: "Missing photo", url: "/api/media/photo/" + uuid.New().String(), expectedStatus: http.StatusNotFound},
+		{name: "Attachment", url: "/api/media/photo/" + attachID.String(), expectedStatus: http.StatusNotFound},
+		{name: "Attachment size", url: "/api/media/photo/" + attachID.String() + "/" + models.RenditionFeed, expectedStatus: http.StatusNotFound},
+		{name: "Wrong id", url: "/api/media/photo/1", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, test.url, nil))
+			require.Equal(t, test.expectedStatus, w.Code)
+			if w.Code == http.StatusOK {
+				require.Equal(t, test.expectedBody, w.Body.String())
+				require.Equal(t, photoCacheControl, w.Header().Get("Cache-Control"))
+				require.Equal(t, "image/jpeg", w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
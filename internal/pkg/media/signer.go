@@ -0,0 +1,104 @@
+package media
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+const defaultURLTTL = 5 * time.Minute
+
+// URLSigner выдаёт короткоживущие ссылки на файлы: в ссылке время истечения и HMAC-SHA256 от ключа файла и этого времени.
+// Ссылка не привязана к пользователю, поэтому срок её жизни должен быть небольшим.
+type URLSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewURLSigner(secret []byte, ttl time.Duration) *URLSigner {
+	return &URLSigner{
+		secret: secret,
+		ttl:    ttl,
+	}
+}
+
+// NewURLSignerFromEnv берёт секрет из MEDIA_SECRET и время жизни ссылки из MEDIA_URL_TTL (по умолчанию 5m)
+func NewURLSignerFromEnv() (*URLSigner, error) {
+	secret, ok := os.LookupEnv("MEDIA_SECRET")
+	if !ok || len(secret) == 0 {
+		return nil, errors.New("no media secret")
+	}
+	ttl := defaultURLTTL
+	if value, ok := os.LookupEnv("MEDIA_URL_TTL"); ok {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("wrong MEDIA_URL_TTL " + value)
+		}
+		ttl = parsed
+	}
+	return NewURLSigner([]byte(secret), ttl), nil
+}
+
+// Sign возвращает параметры ссылки на файл key и время, до которого она действительна
+func (s *URLSigner) Sign(key string, now time.Time) (url.Values, time.Time) {
+	return s.SignDownload(key, "", "", now)
+}
+
+// SignDownload - то же, что Sign, но по ссылке файл скачивается под именем filename,
+// а ref (если не пустой) говорит, чьё скачивание засчитать при отдаче файла.
+// Имя и ref входят в подпись, чтобы их нельзя было подменить в ссылке.
+func (s *URLSigner) SignDownload(key string, filename string, ref string, now time.Time) (url.Values, time.Time) {
+	expiresAt := now.Add(s.ttl).Truncate(time.Second)
+	expires := expiresAt.Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	if len(filename) != 0 {
+		query.Set("filename", filename)
+	}
+	if len(ref) != 0 {
+		query.Set("ref", ref)
+	}
+	query.Set("signature", s.signature(key, expires, filename, ref))
+	return query, expiresAt
+}
+
+// Verify проверяет подпись ссылки: models.WrongData - подпись неверна, models.ExpiredToken - срок ссылки истёк.
+// Возвращает время истечения ссылки.
+func (s *URLSigner) Verify(key string, query url.Values, now time.Time) (time.Time, error) {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return time.Time{}, models.WrongData
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(query.Get("signature"))
+	if err != nil {
+		return time.Time{}, models.WrongData
+	}
+	expected, _ := base64.RawURLEncoding.DecodeString(s.signature(key, expires, query.Get("filename"), query.Get("ref")))
+	if !hmac.Equal(signature, expected) {
+		return time.Time{}, models.WrongData
+	}
+	expiresAt := time.Unix(expires, 0)
+	if !now.Before(expiresAt) {
+		return time.Time{}, models.ExpiredToken
+	}
+	return expiresAt, nil
+}
+
+func (s *URLSigner) signature(key string, expires int64, filename string, ref string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	message := key + "\n" + strconv.FormatInt(expires, 10)
+	if len(filename) != 0 {
+		message += "\n" + filename
+	}
+	if len(ref) != 0 {
+		message += "\nref:" + ref
+	}
+	mac.Write([]byte(message))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
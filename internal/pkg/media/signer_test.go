@@ -0,0 +1,85 @@
+package media
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestURLSigner_Verify(t *testing.T) {
+	signer := NewURLSigner([]byte("secret"), time.Minute)
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	query, expiresAt := signer.Sign("a.mp4", now)
+	if !expiresAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("Sign() expires at %v", expiresAt)
+	}
+
+	download, _ := signer.SignDownload("a.mp4", "отчёт.pdf", "", now)
+	renamed, _ := signer.SignDownload("a.mp4", "отчёт.pdf", "", now)
+	renamed.Set("filename", "other.pdf")
+	counted, _ := signer.SignDownload("a.mp4", "отчёт.pdf", "post:attach", now)
+	recounted, _ := signer.SignDownload("a.mp4", "отчёт.pdf", "post:attach", now)
+	recounted.Set("ref", "post:other")
+
+	tampered := NewURLSigner([]byte("secret"), time.Minute)
+	longer, _ := tampered.Sign("a.mp4", now.Add(time.Hour))
+	longer.Set("signature", query.Get("signature"))
+
+	tests := []struct {
+		name    string
+		signer  *URLSigner
+		key     string
+		query   map[string][]string
+		now     time.Time
+		wantErr error
+	}{
+		{name: "OK", signer: signer, key: "a.mp4", query: query, now: now.Add(30 * time.Second)},
+		{name: "Expired", signer: signer, key: "a.mp4", query: query, now: now.Add(time.Minute), wantErr: models.ExpiredToken},
+		{name: "Other key", signer: signer, key: "b.mp4", query: query, now: now, wantErr: models.WrongData},
+		{name: "Other secret", signer: NewURLSigner([]byte("other"), time.Minute), key: "a.mp4", query: query, now: now, wantErr: models.WrongData},
+		{name: "Extended expiry", signer: signer, key: "a.mp4", query: longer, now: now, wantErr: models.WrongData},
+		{name: "Download", signer: signer, key: "a.mp4", query: download, now: now},
+		{name: "Other filename", signer: signer, key: "a.mp4", query: renamed, now: now, wantErr: models.WrongData},
+		{name: "Counted download", signer: signer, key: "a.mp4", query: counted, now: now},
+		{name: "Other ref", signer: signer, key: "a.mp4", query: recounted, now: now, wantErr: models.WrongData},
+		{name: "No ref", signer: signer, key: "a.mp4", query: map[string][]string{"expires": counted["expires"], "filename": counted["filename"], "signature": counted["signature"]}, now: now, wantErr: models.WrongData},
+		{name: "No filename", signer: signer, key: "a.mp4", query: map[string][]string{"expires": download["expires"], "signature": download["signature"]}, now: now, wantErr: models.WrongData},
+		{name: "No signature", signer: signer, key: "a.mp4", query: map[string][]string{"expires": query["expires"]}, now: now, wantErr: models.WrongData},
+		{name: "Wrong expires", signer: signer, key: "a.mp4", query: map[string][]string{"expires": {"x"}, "signature": query["signature"]}, now: now, wantErr: models.WrongData},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.signer.Verify(test.key, test.query, test.now)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, test.wantErr)
+			}
+			if err == nil && !got.Equal(expiresAt) {
+				t.Errorf("Verify() = %v, want %v", got, expiresAt)
+			}
+		})
+	}
+}
+
+func TestNewURLSignerFromEnv(t *testing.T) {
+	os.Unsetenv("MEDIA_SECRET")
+	if _, err := NewURLSignerFromEnv(); err == nil {
+		t.Errorf("NewURLSignerFromEnv() without secret returned no error")
+	}
+
+	t.Setenv("MEDIA_SECRET", "secret")
+	signer, err := NewURLSignerFromEnv()
+	if err != nil || signer.ttl != defaultURLTTL {
+		t.Fatalf("NewURLSignerFromEnv() = %v, %v", signer, err)
+	}
+
+	t.Setenv("MEDIA_URL_TTL", "30s")
+	if signer, err = NewURLSignerFromEnv(); err != nil || signer.ttl != 30*time.Second {
+		t.Errorf("NewURLSignerFromEnv() with ttl = %v, %v", signer, err)
+	}
+	t.Setenv("MEDIA_URL_TTL", "-1s")
+	if _, err = NewURLSignerFromEnv(); err == nil {
+		t.Errorf("NewURLSignerFromEnv() with negative ttl returned no error")
+	}
+}
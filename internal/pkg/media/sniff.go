@@ -0,0 +1,52 @@
+package media
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// signatures - сигнатуры форматов, которые не распознаёт http.DetectContentType
+var signatures = []struct {
+	prefix      []byte
+	contentType string
+}{
+	{prefix: []byte("fLaC"), contentType: "audio/flac"},
+	{prefix: []byte("7z\xbc\xaf\x27\x1c"), contentType: "application/x-7z-compressed"},
+}
+
+var (
+	zipSignature = []byte("PK\x03\x04")
+	// epubMimetype - EPUB - это ZIP, первым файлом в котором лежит несжатый mimetype с типом книги
+	epubMimetype = []byte("mimetypeapplication/epub+zip")
+	// audioBrands - бренды MP4 файлов без видео: музыка и аудиокниги
+	audioBrands = [][]byte{[]byte("M4A "), []byte("M4B ")}
+)
+
+// ftypOffset - смещение бренда в боксе ftyp файла MP4
+const ftypOffset = 8
+
+// DetectContentType определяет тип файла по первым байтам. В отличие от http.DetectContentType
+// узнаёт FLAC, 7z, EPUB среди ZIP-архивов и аудио в контейнере MP4.
+func DetectContentType(data []byte) string {
+	for _, signature := range signatures {
+		if bytes.HasPrefix(data, signature.prefix) {
+			return signature.contentType
+		}
+	}
+	// имя первого файла ZIP начинается с 30 байта локального заголовка
+	if bytes.HasPrefix(data, zipSignature) && len(data) >= 30+len(epubMimetype) &&
+		bytes.Equal(data[30:30+len(epubMimetype)], epubMimetype) {
+		return "application/epub+zip"
+	}
+
+	contentType := http.DetectContentType(data)
+	if contentType == "video/mp4" && len(data) >= ftypOffset+4 {
+		brand := data[ftypOffset : ftypOffset+4]
+		for _, audio := range audioBrands {
+			if bytes.Equal(brand, audio) {
+				return "audio/mp4"
+			}
+		}
+	}
+	return contentType
+}
@@ -0,0 +1,88 @@
+package media
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+)
+
+// MediaType - тип файла, который можно прикрепить к посту
+type MediaType struct {
+	ContentType string `json:"content_type"`
+	// Extension - расширение файла в хранилище, по нему строится ключ вложения, поэтому у существующего типа его менять нельзя
+	Extension string `json:"extension"`
+	// MaxSize - наибольший размер файла в байтах, 0 - без ограничения
+	MaxSize int64 `json:"max_size"`
+	// Download - файл отдаётся для скачивания с исходным именем, а не показывается в браузере
+	Download bool `json:"download"`
+}
+
+const (
+	imageMaxSize    = 20 << 20
+	audioMaxSize    = 500 << 20
+	videoMaxSize    = 2 << 30
+	documentMaxSize = 200 << 20
+	archiveMaxSize  = 2 << 30
+)
+
+// DefaultTypes - типы вложений, если MEDIA_TYPES не задан
+var DefaultTypes = []MediaType{
+	{ContentType: "image/jpeg", Extension: "jpeg", MaxSize: imageMaxSize},
+	{ContentType: "image/png", Extension: "png", MaxSize: imageMaxSize},
+	{ContentType: "image/webp", Extension: "webp", MaxSize: imageMaxSize},
+	{ContentType: "image/gif", Extension: "gif", MaxSize: imageMaxSize},
+	{ContentType: "video/mpeg", Extension: "mpeg", MaxSize: videoMaxSize},
+	{ContentType: "video/mp4", Extension: "mp4", MaxSize: videoMaxSize},
+	{ContentType: "audio/mp4", Extension: "m4a", MaxSize: audioMaxSize},
+	{ContentType: "audio/mpeg", Extension: "mp3", MaxSize: audioMaxSize},
+	{ContentType: "audio/flac", Extension: "flac", MaxSize: audioMaxSize},
+	{ContentType: "application/pdf", Extension: "pdf", MaxSize: documentMaxSize, Download: true},
+	{ContentType: "application/epub+zip", Extension: "epub", MaxSize: documentMaxSize, Download: true},
+	{ContentType: "application/zip", Extension: "zip", MaxSize: archiveMaxSize, Download: true},
+}
+
+// TypeRegistry - разрешённые типы вложений
+type TypeRegistry struct {
+	types map[string]MediaType
+}
+
+func NewTypeRegistry(types ...MediaType) *TypeRegistry {
+	registry := &TypeRegistry{types: make(map[string]MediaType, len(types))}
+	for _, mediaType := range types {
+		registry.types[mediaType.ContentType] = mediaType
+	}
+	return registry
+}
+
+// NewTypeRegistryFromEnv читает типы из JSON-файла, путь к которому в MEDIA_TYPES, без него берёт DefaultTypes
+func NewTypeRegistryFromEnv() (*TypeRegistry, error) {
+	path, ok := os.LookupEnv("MEDIA_TYPES")
+	if !ok || len(path) == 0 {
+		return NewTypeRegistry(DefaultTypes...), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var types []MediaType
+	if err = json.Unmarshal(data, &types); err != nil {
+		return nil, err
+	}
+	for _, mediaType := range types {
+		if len(mediaType.ContentType) == 0 || !isValidExtension(mediaType.Extension) || mediaType.MaxSize < 0 {
+			return nil, errors.New("wrong media type " + mediaType.ContentType)
+		}
+	}
+	return NewTypeRegistry(types...), nil
+}
+
+// Lookup ищет тип по Content-Type файла
+func (r *TypeRegistry) Lookup(contentType string) (MediaType, bool) {
+	mediaType, ok := r.types[contentType]
+	return mediaType, ok
+}
+
+func isValidExtension(extension string) bool {
+	return len(extension) != 0 && !strings.ContainsAny(extension, "./\\")
+}
@@ -0,0 +1,92 @@
+package media
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewTypeRegistryFromEnv(t *testing.T) {
+	os.Unsetenv("MEDIA_TYPES")
+	registry, err := NewTypeRegistryFromEnv()
+	if err != nil {
+		t.Fatalf("NewTypeRegistryFromEnv() error = %v", err)
+	}
+	if pdf, ok := registry.Lookup("application/pdf"); !ok || pdf.Extension != "pdf" || !pdf.Download {
+		t.Errorf("Lookup(application/pdf) = %v, %v", pdf, ok)
+	}
+	if m4a, ok := registry.Lookup("audio/mp4"); !ok || m4a.Extension != "m4a" {
+		t.Errorf("Lookup(audio/mp4) = %v, %v", m4a, ok)
+	}
+	if _, ok := registry.Lookup("text/html; charset=utf-8"); ok {
+		t.Errorf("Lookup(text/html) found type")
+	}
+
+	dir := t.TempDir()
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{name: "OK", config: `[{"content_type": "application/x-7z-compressed", "extension": "7z", "max_size": 100, "download": true}]`},
+		{name: "Wrong json", config: `{`, wantErr: true},
+		{name: "Wrong extension", config: `[{"content_type": "application/zip", "extension": "../zip"}]`, wantErr: true},
+		{name: "Negative size", config: `[{"content_type": "application/zip", "extension": "zip", "max_size": -1}]`, wantErr: true},
+	}
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(dir, test.name+".json")
+			if err := os.WriteFile(path, []byte(test.config), 0600); err != nil {
+				t.Fatal(err)
+			}
+			t.Setenv("MEDIA_TYPES", path)
+			registry, err := NewTypeRegistryFromEnv()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("%d: NewTypeRegistryFromEnv() error = %v", i, err)
+			}
+			if err != nil {
+				return
+			}
+			archive, ok := registry.Lookup("application/x-7z-compressed")
+			if !ok || archive.MaxSize != 100 || !archive.Download {
+				t.Errorf("Lookup(application/x-7z-compressed) = %v, %v", archive, ok)
+			}
+			if _, ok = registry.Lookup("image/jpeg"); ok {
+				t.Errorf("config does not replace default types")
+			}
+		})
+	}
+
+	t.Setenv("MEDIA_TYPES", filepath.Join(dir, "missing.json"))
+	if _, err = NewTypeRegistryFromEnv(); err == nil {
+		t.Errorf("NewTypeRegistryFromEnv() with missing file returned no error")
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	zip := append([]byte("PK\x03\x04"), make([]byte, 26)...)
+	epub := append(append([]byte{}, zip...), "mimetypeapplication/epub+zip"...)
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{name: "FLAC", data: []byte("fLaC\x00\x00\x00\x22"), want: "audio/flac"},
+		{name: "7z", data: []byte("7z\xbc\xaf\x27\x1c\x00\x04"), want: "application/x-7z-compressed"},
+		{name: "EPUB", data: epub, want: "application/epub+zip"},
+		{name: "ZIP", data: append(zip, "project/main.go"...), want: "application/zip"},
+		{name: "PDF", data: []byte("%PDF-1.7\n"), want: "application/pdf"},
+		{name: "GIF", data: []byte("GIF89a\x01\x00\x01\x00"), want: "image/gif"},
+		{name: "M4A", data: []byte("\x00\x00\x00\x1cftypM4A \x00\x00\x00\x00M4A mp42isom"), want: "audio/mp4"},
+		{name: "MP4", data: []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom"), want: "video/mp4"},
+		{name: "Text", data: []byte("text"), want: "text/plain; charset=utf-8"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := DetectContentType(test.data); got != test.want {
+				t.Errorf("DetectContentType() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package mediagc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediainfo"
+	"github.com/google/uuid"
+	"io"
+)
+
+const (
+	CountLegacy   = `SELECT count(*) FROM "attachment" WHERE blob_id IS NULL`
+	LegacyBatch   = `SELECT attachment_id, coalesce(attachment_type, ''), renditions::text, coalesce(checksum, '') FROM "attachment" WHERE blob_id IS NULL AND attachment_id > $1 ORDER BY attachment_id LIMIT $2`
+	SetAttachBlob = `UPDATE "attachment" SET blob_id = $1, checksum = coalesce(checksum, NULLIF($2, '')) WHERE attachment_id = $3 AND blob_id IS NULL`
+)
+
+// legacyAttachment - вложение, файлы которого лежат под его id
+type legacyAttachment struct {
+	id          uuid.UUID
+	contentType string
+	renditions  []models.Rendition
+	checksum    string
+}
+
+// Deduplicate переносит файлы вложений, сохранённых до хранения по содержимому, под контрольную сумму.
+// Одинаковые файлы после переноса хранятся один раз. Вложения, файлов которых нет или тип которых неизвестен,
+// пропускаются. Возвращает число перенесённых и пропущенных вложений, с dryRun только считает вложения для переноса.
+func Deduplicate(ctx context.Context, db *sql.DB, store blob.BlobStore, types *media.TypeRegistry, batch int, dryRun bool) (int, int, error) {
+	if dryRun {
+		var count int
+		if err := db.QueryRowContext(ctx, CountLegacy).Scan(&count); err != nil {
+			return 0, 0, err
+		}
+		return count, 0, nil
+	}
+
+	moved, skipped := 0, 0
+	// пропущенные вложения остаются без blob_id, поэтому пачки выбираются по возрастанию id
+	last := uuid.Nil
+	for {
+		attachments, err := legacyBatch(ctx, db, last, batch)
+		if err != nil {
+			return moved, skipped, err
+		}
+		for _, attach := range attachments {
+			ok, err := deduplicate(ctx, db, store, types, attach)
+			if err != nil {
+				return moved, skipped, fmt.Errorf("attachment %s: %w", attach.id, err)
+			}
+			if ok {
+				moved++
+			} else {
+				skipped++
+			}
+		}
+		if len(attachments) < batch {
+			return moved, skipped, nil
+		}
+		last = attachments[len(attachments)-1].id
+	}
+}
+
+func legacyBatch(ctx context.Context, db *sql.DB, after uuid.UUID, batch int) ([]legacyAttachment, error) {
+	rows, err := db.QueryContext(ctx, LegacyBatch, after, batch)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attachments := make([]legacyAttachment, 0, batch)
+	for rows.Next() {
+		var attach legacyAttachment
+		var renditions sql.NullString
+		if err = rows.Scan(&attach.id, &attach.contentType, &renditions, &attach.checksum); err != nil {
+			return nil, err
+		}
+		if attach.renditions, err = models.ParseRenditions(renditions.String); err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attach)
+	}
+	return attachments, rows.Err()
+}
+
+// deduplicate копирует файлы вложения под контрольную сумму, записывает её в базу и удаляет старые файлы.
+// Старые файлы удаляются только после записи в базу: до этого ссылка на вложение ведёт на них.
+func deduplicate(ctx context.Context, db *sql.DB, store blob.BlobStore, types *media.TypeRegistry, attach legacyAttachment) (bool, error) {
+	key := func(id string) []string {
+		keys := make([]string, 0, len(attach.renditions))
+		for _, rendition := range attach.renditions {
+			keys = append(keys, rendition.Key(id))
+		}
+		return keys
+	}
+	if len(attach.renditions) == 0 {
+		mediaType, ok := types.Lookup(attach.contentType)
+		if !ok {
+			return false, nil
+		}
+		key = func(id string) []string {
+			return []string{models.AttachmentKey(id, mediaType.Extension)}
+		}
+	}
+	oldKeys := key(attach.id.String())
+	for _, oldKey := range oldKeys {
+		if _, err := store.Stat(ctx, oldKey); errors.Is(err, models.NotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+	}
+
+	// сумма до обработки изображений не сохранялась, тогда считается сумма файла или исходного размера
+	blobID, checksum := attach.checksum, ""
+	if len(blobID) == 0 {
+		hashed := oldKeys[0]
+		if original, ok := models.FindRendition(attach.renditions, models.RenditionOriginal); ok {
+			hashed = original.Key(attach.id.String())
+		}
+		sum, err := storedChecksum(ctx, store, hashed)
+		if err != nil {
+			return false, err
+		}
+		blobID = sum
+		if len(attach.renditions) == 0 {
+			checksum = sum
+		}
+	}
+
+	newKeys := key(blobID)
+	for i, newKey := range newKeys {
+		if err := copyBlob(ctx, store, oldKeys[i], newKey); err != nil {
+			return false, err
+		}
+	}
+	if _, err := db.ExecContext(ctx, SetAttachBlob, blobID, checksum, attach.id); err != nil {
+		return false, err
+	}
+	// неудалённые старые файлы потом удалит mediagc
+	for _, oldKey := range oldKeys {
+		_ = store.Delete(ctx, oldKey)
+	}
+	return true, nil
+}
+
+func storedChecksum(ctx context.Context, store blob.BlobStore, key string) (string, error) {
+	body, err := store.Get(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+	data := mediainfo.NewChecksumReader(body)
+	if _, err = io.Copy(io.Discard, data); err != nil {
+		return "", err
+	}
+	return data.Sum(), nil
+}
+
+// copyBlob копирует файл, если под новым ключом ещё ничего нет: одинаковый файл мог уже быть перенесён
+func copyBlob(ctx context.Context, store blob.BlobStore, from, to string) error {
+	if _, err := store.Stat(ctx, to); err == nil {
+		return nil
+	} else if !errors.Is(err, models.NotFound) {
+		return err
+	}
+	info, err := store.Stat(ctx, from)
+	if err != nil {
+		return err
+	}
+	body, err := store.Get(ctx, from)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return store.Put(ctx, to, body, info.Size, info.ContentType)
+}
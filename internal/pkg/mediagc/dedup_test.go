@@ -0,0 +1,82 @@
+package mediagc
+
+import (
+	"context"
+	"errors"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/local"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediainfo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestDeduplicate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	store, err := local.NewStore(t.TempDir())
+	require.NoError(t, err)
+	put := func(key, content string) {
+		require.NoError(t, store.Put(ctx, key, strings.NewReader(content), int64(len(content)), ""))
+	}
+	exists := func(key string) bool {
+		_, err := store.Stat(ctx, key)
+		if errors.Is(err, models.NotFound) {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	// два одинаковых файла: у первого суммы ещё нет, у второго она уже сохранена
+	video, sameVideo, image, missing, unknown := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	videoSum := mediainfo.Checksum([]byte("video"))
+	imageSum := strings.Repeat("ab", 32)
+	renditions := `[{"name": "original", "content_type": "image/webp"}, {"name": "thumbnail", "content_type": "image/webp"}]`
+	put(models.AttachmentKey(video.String(), "mp4"), "video")
+	put(models.AttachmentKey(sameVideo.String(), "mp4"), "video")
+	put(models.RenditionKey(image.String(), models.RenditionOriginal, "webp"), "original")
+	put(models.RenditionKey(image.String(), models.RenditionThumbnail, "webp"), "thumbnail")
+	put(unknown.String()+".txt", "text")
+
+	selectQuery := regexp.QuoteMeta(LegacyBatch)
+	updateQuery := regexp.QuoteMeta(SetAttachBlob)
+	columns := []string{"attachment_id", "attachment_type", "renditions", "checksum"}
+	mock.ExpectQuery(selectQuery).WithArgs(uuid.Nil, 3).WillReturnRows(sqlmock.NewRows(columns).
+		AddRow(video, "video/mp4", nil, "").
+		AddRow(sameVideo, "video/mp4", nil, videoSum).
+		AddRow(image, "image/png", renditions, imageSum))
+	mock.ExpectExec(updateQuery).WithArgs(videoSum, videoSum, video).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(updateQuery).WithArgs(videoSum, "", sameVideo).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(updateQuery).WithArgs(imageSum, "", image).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(selectQuery).WithArgs(image, 3).WillReturnRows(sqlmock.NewRows(columns).
+		AddRow(missing, "video/mp4", nil, "").
+		AddRow(unknown, "text/plain", nil, ""))
+
+	moved, skipped, err := Deduplicate(ctx, db, store, media.NewTypeRegistry(media.DefaultTypes...), 3, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, moved)
+	require.Equal(t, 2, skipped)
+
+	require.True(t, exists(models.AttachmentKey(videoSum, "mp4")))
+	require.False(t, exists(models.AttachmentKey(video.String(), "mp4")))
+	require.False(t, exists(models.AttachmentKey(sameVideo.String(), "mp4")))
+	require.True(t, exists(models.RenditionKey(imageSum, models.RenditionOriginal, "webp")))
+	require.True(t, exists(models.RenditionKey(imageSum, models.RenditionThumbnail, "webp")))
+	require.False(t, exists(models.RenditionKey(image.String(), models.RenditionOriginal, "webp")))
+	require.True(t, exists(unknown.String()+".txt"))
+
+	mock.ExpectQuery(regexp.QuoteMeta(CountLegacy)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	moved, _, err = Deduplicate(ctx, db, store, media.NewTypeRegistry(media.DefaultTypes...), 3, true)
+	require.NoError(t, err)
+	require.Equal(t, 2, moved)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
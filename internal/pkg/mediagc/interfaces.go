@@ -0,0 +1,18 @@
+package mediagc
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/mediagc_mock.go -package=mock
+
+type MediaGCUsecase interface {
+	Collect(ctx context.Context, grace time.Duration, dryRun bool) (models.MediaGCReport, error)
+}
+
+type MediaGCRepo interface {
+	MediaRefs(ctx context.Context) ([]models.MediaRef, error)
+	HeldBlobs(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMediaGCUsecase is a mock of MediaGCUsecase interface.
+type MockMediaGCUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaGCUsecaseMockRecorder
+}
+
+// MockMediaGCUsecaseMockRecorder is the mock recorder for MockMediaGCUsecase.
+type MockMediaGCUsecaseMockRecorder struct {
+	mock *MockMediaGCUsecase
+}
+
+// NewMockMediaGCUsecase creates a new mock instance.
+func NewMockMediaGCUsecase(ctrl *gomock.Controller) *MockMediaGCUsecase {
+	mock := &MockMediaGCUsecase{ctrl: ctrl}
+	mock.recorder = &MockMediaGCUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaGCUsecase) EXPECT() *MockMediaGCUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Collect mocks base method.
+func (m *MockMediaGCUsecase) Collect(ctx context.Context, grace time.Duration, dryRun bool) (models.MediaGCReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collect", ctx, grace, dryRun)
+	ret0, _ := ret[0].(models.MediaGCReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Collect indicates an expected call of Collect.
+func (mr *MockMediaGCUsecaseMockRecorder) Collect(ctx, grace, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockMediaGCUsecase)(nil).Collect), ctx, grace, dryRun)
+}
+
+// MockMediaGCRepo is a mock of MediaGCRepo interface.
+type MockMediaGCRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaGCRepoMockRecorder
+}
+
+// MockMediaGCRepoMockRecorder is the mock recorder for MockMediaGCRepo.
+type MockMediaGCRepoMockRecorder struct {
+	mock *MockMediaGCRepo
+}
+
+// NewMockMediaGCRepo creates a new mock instance.
+func NewMockMediaGCRepo(ctrl *gomock.Controller) *MockMediaGCRepo {
+	mock := &MockMediaGCRepo{ctrl: ctrl}
+	mock.recorder = &MockMediaGCRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaGCRepo) EXPECT() *MockMediaGCRepoMockRecorder {
+	return m.recorder
+}
+
+// HeldBlobs mocks base method.
+func (m *MockMediaGCRepo) HeldBlobs(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeldBlobs", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeldBlobs indicates an expected call of HeldBlobs.
+func (mr *MockMediaGCRepoMockRecorder) HeldBlobs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeldBlobs", reflect.TypeOf((*MockMediaGCRepo)(nil).HeldBlobs), ctx)
+}
+
+// MediaRefs mocks base method.
+func (m *MockMediaGCRepo) MediaRefs(ctx context.Context) ([]models.MediaRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaRefs", ctx)
+	ret0, _ := ret[0].([]models.MediaRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MediaRefs indicates an expected call of MediaRefs.
+func (mr *MockMediaGCRepoMockRecorder) MediaRefs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaRefs", reflect.TypeOf((*MockMediaGCRepo)(nil).MediaRefs), ctx)
+}
@@ -0,0 +1,88 @@
+package mediagc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+	"github.com/google/uuid"
+)
+
+const (
+	PhotoIDs = `SELECT profile_photo FROM "user" WHERE profile_photo IS NOT NULL UNION SELECT profile_photo FROM "creator" WHERE profile_photo IS NOT NULL UNION SELECT cover_photo FROM "creator" WHERE cover_photo IS NOT NULL`
+)
+
+// MovePhotos переносит фотографии профилей и обложки, сохранённые рядом с вложениями, под models.PhotoPrefix.
+// Старые файлы удаляются после копирования. Возвращает число фотографий, которые перенесены
+// (с dryRun - которые нужно перенести).
+func MovePhotos(ctx context.Context, db *sql.DB, store blob.BlobStore, dryRun bool) (int, error) {
+	ids, err := photoIDs(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+
+	moved := 0
+	for _, id := range ids {
+		oldKeys, err := legacyPhotoKeys(ctx, store, id.String())
+		if err != nil {
+			return moved, fmt.Errorf("photo %s: %w", id, err)
+		}
+		if len(oldKeys) == 0 {
+			continue
+		}
+		if dryRun {
+			moved++
+			continue
+		}
+		for _, oldKey := range oldKeys {
+			if err = copyBlob(ctx, store, oldKey, models.PhotoPrefix+oldKey); err != nil {
+				return moved, fmt.Errorf("photo %s: %w", id, err)
+			}
+		}
+		// неудалённые старые файлы потом удалит mediagc
+		for _, oldKey := range oldKeys {
+			_ = store.Delete(ctx, oldKey)
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+func photoIDs(ctx context.Context, db *sql.DB) ([]uuid.UUID, error) {
+	rows, err := db.QueryContext(ctx, PhotoIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// legacyPhotoKeys - файлы фотографии под старыми ключами: {id}.jpg и {id}/{размер}.jpg
+func legacyPhotoKeys(ctx context.Context, store blob.BlobStore, id string) ([]string, error) {
+	blobs, err := store.List(ctx, models.RenditionPrefix(id))
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(blobs)+1)
+	for _, info := range blobs {
+		keys = append(keys, info.Key)
+	}
+	legacy := id + ".jpg"
+	if _, err = store.Stat(ctx, legacy); err == nil {
+		keys = append(keys, legacy)
+	} else if !errors.Is(err, models.NotFound) {
+		return nil, err
+	}
+	return keys, nil
+}
@@ -0,0 +1,69 @@
+package mediagc
+
+import (
+	"context"
+	"errors"
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/local"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestMovePhotos(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	store, err := local.NewStore(t.TempDir())
+	require.NoError(t, err)
+	put := func(key string) {
+		require.NoError(t, store.Put(ctx, key, strings.NewReader(key), int64(len(key)), "image/jpeg"))
+	}
+	exists := func(key string) bool {
+		_, err := store.Stat(ctx, key)
+		if errors.Is(err, models.NotFound) {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	processed, legacy, moved, attachment := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	put(models.RenditionKey(processed.String(), models.RenditionOriginal, "jpg"))
+	put(models.RenditionKey(processed.String(), models.RenditionAvatar64, "jpg"))
+	put(legacy.String() + ".jpg")
+	put(models.RenditionKey(models.PhotoStorageId(moved.String()), models.RenditionOriginal, "jpg"))
+	// вложения не фотографии и остаются на месте
+	put(models.AttachmentKey(attachment.String(), "jpg"))
+
+	query := regexp.QuoteMeta(PhotoIDs)
+	rows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"profile_photo"}).AddRow(processed).AddRow(legacy).AddRow(moved)
+	}
+
+	mock.ExpectQuery(query).WillReturnRows(rows())
+	count, err := MovePhotos(ctx, db, store, true)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.True(t, exists(legacy.String()+".jpg"))
+
+	mock.ExpectQuery(query).WillReturnRows(rows())
+	count, err = MovePhotos(ctx, db, store, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	require.True(t, exists(models.RenditionKey(models.PhotoStorageId(processed.String()), models.RenditionOriginal, "jpg")))
+	require.True(t, exists(models.RenditionKey(models.PhotoStorageId(processed.String()), models.RenditionAvatar64, "jpg")))
+	require.False(t, exists(models.RenditionKey(processed.String(), models.RenditionOriginal, "jpg")))
+	require.True(t, exists(models.PhotoKey(legacy.String())))
+	require.False(t, exists(legacy.String()+".jpg"))
+	require.True(t, exists(models.RenditionKey(models.PhotoStorageId(moved.String()), models.RenditionOriginal, "jpg")))
+	require.True(t, exists(models.AttachmentKey(attachment.String(), "jpg")))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"go.uber.org/zap"
+)
+
+const (
+	HeldBlobs = `SELECT blob_id FROM "media_blob" WHERE held_until > now()`
+	MediaRefs = `SELECT attachment_id, 'attachment', coalesce(attachment_type, ''), renditions::text, coalesce(blob_id, '') FROM "attachment" WHERE scan_status <> 'rejected' UNION ALL SELECT profile_photo, 'user.profile_photo', '', NULL, '' FROM "user" WHERE profile_photo IS NOT NULL UNION ALL SELECT profile_photo, 'creator.profile_photo', '', NULL, '' FROM "creator" WHERE profile_photo IS NOT NULL UNION ALL SELECT cover_photo, 'creator.cover_photo', '', NULL, '' FROM "creator" WHERE cover_photo IS NOT NULL;`
+)
+
+type MediaGCRepo struct {
+	db     *sql.DB
+	logger *zap.SugaredLogger
+}
+
+func NewMediaGCRepo(db *sql.DB, logger *zap.SugaredLogger) *MediaGCRepo {
+	return &MediaGCRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// MediaRefs возвращает все вложения, кроме отклонённых проверкой, фотографии профилей и обложки
+func (r *MediaGCRepo) MediaRefs(ctx context.Context) ([]models.MediaRef, error) {
+	var refs = make([]models.MediaRef, 0)
+	rows, err := r.db.QueryContext(ctx, MediaRefs)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ref models.MediaRef
+		var renditions sql.NullString
+		if err = rows.Scan(&ref.Id, &ref.Source, &ref.Type, &renditions, &ref.BlobId); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		if ref.Renditions, err = models.ParseRenditions(renditions.String); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		refs = append(refs, ref)
+	}
+	if err = rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	return refs, nil
+}
+
+// HeldBlobs возвращает файлы по содержимому, которые держат ещё не сохранённые вложения
+func (r *MediaGCRepo) HeldBlobs(ctx context.Context) ([]string, error) {
+	var held = make([]string, 0)
+	rows, err := r.db.QueryContext(ctx, HeldBlobs)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var blobID string
+		if err = rows.Scan(&blobID); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		held = append(held, blobID)
+	}
+	if err = rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	return held, nil
+}
@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestMediaGCRepo_MediaRefs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewMediaGCRepo(db, zap.NewNop().Sugar())
+	attachID, photoID := uuid.New(), uuid.New()
+	columns := []string{"id", "source", "type", "renditions", "blob_id"}
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedRes []models.MediaRef
+		expectedErr error
+	}{
+		{
+			name: "Ok",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(attachID, models.MediaSourceAttachment, "image/png", `[{"name": "original", "width": 10, "height": 20, "content_type": "image/webp"}]`, "abc").
+					AddRow(photoID, models.MediaSourceCreatorCover, "", nil, "")
+				mock.ExpectQuery(`SELECT attachment_id, 'attachment'`).WillReturnRows(rows)
+			},
+			expectedRes: []models.MediaRef{
+				{Id: attachID, Source: models.MediaSourceAttachment, Type: "image/png",
+					Renditions: []models.Rendition{{Name: models.RenditionOriginal, Width: 10, Height: 20, ContentType: "image/webp"}}, BlobId: "abc"},
+				{Id: photoID, Source: models.MediaSourceCreatorCover},
+			},
+		},
+		{
+			name: "Wrong renditions",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow(attachID, models.MediaSourceAttachment, "image/png", "{", "")
+				mock.ExpectQuery(`SELECT attachment_id, 'attachment'`).WillReturnRows(rows)
+			},
+			expectedErr: models.InternalError,
+		},
+		{
+			name: "InternalError",
+			mock: func() {
+				mock.ExpectQuery(`SELECT attachment_id, 'attachment'`).WillReturnError(errors.New("test"))
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			refs, err := r.MediaRefs(context.Background())
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedRes, refs)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMediaGCRepo_HeldBlobs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewMediaGCRepo(db, zap.NewNop().Sugar())
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedRes []string
+		expectedErr error
+	}{
+		{
+			name: "Ok",
+			mock: func() {
+				mock.ExpectQuery(`SELECT blob_id FROM "media_blob"`).WillReturnRows(sqlmock.NewRows([]string{"blob_id"}).AddRow("abc"))
+			},
+			expectedRes: []string{"abc"},
+		},
+		{
+			name: "InternalError",
+			mock: func() {
+				mock.ExpectQuery(`SELECT blob_id FROM "media_blob"`).WillReturnError(errors.New("test"))
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			held, err := r.HeldBlobs(context.Background())
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedRes, held)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
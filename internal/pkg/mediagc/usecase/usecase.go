@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediagc"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload"
+	"go.uber.org/zap"
+	"sort"
+	"strings"
+	"time"
+)
+
+type MediaGCUsecase struct {
+	repo   mediagc.MediaGCRepo
+	store  blob.BlobStore
+	types  *media.TypeRegistry
+	logger *zap.SugaredLogger
+	now    func() time.Time
+}
+
+func NewMediaGCUsecase(repo mediagc.MediaGCRepo, store blob.BlobStore, types *media.TypeRegistry, logger *zap.SugaredLogger) *MediaGCUsecase {
+	return &MediaGCUsecase{
+		repo:   repo,
+		store:  store,
+		types:  types,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Collect сверяет файлы в хранилище с вложениями, фотографиями профилей и обложками в базе.
+// Файлы, на которые ничего не ссылается, удаляются, если это не dryRun. Файлы новее grace не трогаются:
+// вложение сохраняется в хранилище раньше, чем запись о нём в базе. Записи без файлов только попадают в отчёт.
+// Незавершённые загрузки лежат под upload.UploadsPrefix, их удаляет upload.CleanupJob, поэтому они не проверяются.
+func (uc *MediaGCUsecase) Collect(ctx context.Context, grace time.Duration, dryRun bool) (models.MediaGCReport, error) {
+	if grace < 0 {
+		return models.MediaGCReport{}, models.WrongData
+	}
+	now := uc.now()
+	report := models.MediaGCReport{
+		DryRun:     dryRun,
+		CheckedAt:  now,
+		GraceUntil: now.Add(-grace),
+		Orphans:    make([]models.OrphanBlob, 0),
+		Missing:    make([]models.MissingBlob, 0),
+	}
+
+	// файлы перечисляются раньше, чем читаются записи: запись, появившаяся между этими шагами,
+	// уже будет прочитана, а файл, сохранённый после списка, в список не попадёт
+	blobs, err := uc.store.List(ctx, "")
+	if err != nil {
+		uc.logger.Error(err)
+		return models.MediaGCReport{}, models.InternalError
+	}
+	refs, err := uc.repo.MediaRefs(ctx)
+	if err != nil {
+		return models.MediaGCReport{}, err
+	}
+	report.References = len(refs)
+	// загрузка могла решить не записывать уже сохранённый файл: он старше grace, но скоро получит ссылку
+	held, err := uc.repo.HeldBlobs(ctx)
+	if err != nil {
+		return models.MediaGCReport{}, err
+	}
+
+	referenced := make(map[string]bool, len(refs)+len(held))
+	for _, ref := range refs {
+		referenced[ref.StorageId()] = true
+	}
+	for _, blobID := range held {
+		referenced[blobID] = true
+	}
+	keys := make(map[string]bool, len(blobs))
+	for _, info := range blobs {
+		if strings.HasPrefix(info.Key, upload.UploadsPrefix) {
+			continue
+		}
+		report.Blobs++
+		keys[info.Key] = true
+		if referenced[blobID(info.Key)] {
+			continue
+		}
+		if info.ModifiedAt.After(report.GraceUntil) {
+			report.Recent++
+			continue
+		}
+		report.Orphans = append(report.Orphans, models.OrphanBlob{
+			Key:        info.Key,
+			Size:       info.Size,
+			ModifiedAt: info.ModifiedAt,
+		})
+	}
+
+	for _, ref := range refs {
+		missing, err := uc.missingKeys(ctx, ref, keys)
+		if err != nil {
+			uc.logger.Error(err)
+			return models.MediaGCReport{}, models.InternalError
+		}
+		for _, key := range missing {
+			report.Missing = append(report.Missing, models.MissingBlob{Id: ref.Id, Source: ref.Source, Key: key})
+		}
+	}
+	sort.Slice(report.Orphans, func(i, j int) bool {
+		return report.Orphans[i].Key < report.Orphans[j].Key
+	})
+
+	if dryRun {
+		return report, nil
+	}
+	for i, orphan := range report.Orphans {
+		if err = uc.store.Delete(ctx, orphan.Key); err != nil {
+			// один неудалённый файл не должен мешать удалить остальные
+			uc.logger.Error(err)
+			report.Orphans[i].DeleteError = err.Error()
+			continue
+		}
+		report.Orphans[i].Deleted = true
+		report.Deleted++
+		report.FreedBytes += orphan.Size
+	}
+	return report, nil
+}
+
+// missingKeys возвращает ключи файлов записи, которых нет в хранилище. Ключ, которого нет в списке,
+// проверяется ещё раз: файл мог быть сохранён уже после того, как список был получен.
+func (uc *MediaGCUsecase) missingKeys(ctx context.Context, ref models.MediaRef, keys map[string]bool) ([]string, error) {
+	var expected [][]string // для каждого файла - ключи, под которыми он может лежать
+	switch {
+	case ref.Source != models.MediaSourceAttachment:
+		// фотография, загруженная до обработки изображений, лежит одним файлом
+		expected = [][]string{{models.RenditionKey(ref.StorageId(), models.RenditionOriginal, "jpg"), models.PhotoKey(ref.Id.String())}}
+	case len(ref.Renditions) != 0:
+		for _, rendition := range ref.Renditions {
+			expected = append(expected, []string{rendition.Key(ref.StorageId())})
+		}
+	default:
+		mediaType, ok := uc.types.Lookup(ref.Type)
+		if !ok {
+			// без типа ключ не построить, поэтому файлом считается любой файл с id вложения
+			for key := range keys {
+				if blobID(key) == ref.StorageId() {
+					return nil, nil
+				}
+			}
+			return []string{ref.StorageId()}, nil
+		}
+		expected = [][]string{{models.AttachmentKey(ref.StorageId(), mediaType.Extension)}}
+	}
+
+	var missing []string
+	for _, alternatives := range expected {
+		found := false
+		for _, key := range alternatives {
+			if keys[key] {
+				found = true
+				break
+			}
+			_, err := uc.store.Stat(ctx, key)
+			if err == nil {
+				found = true
+				break
+			}
+			if !errors.Is(err, models.NotFound) {
+				return nil, err
+			}
+		}
+		if !found {
+			missing = append(missing, alternatives[0])
+		}
+	}
+	return missing, nil
+}
+
+// blobID - id, под которым лежит файл: {id}.{расширение} или {id}/{размер}.{расширение}.
+// Для вложений, сохранённых по содержимому, это контрольная сумма, а не id записи,
+// у фотографий id начинается с models.PhotoPrefix.
+func blobID(key string) string {
+	if strings.HasPrefix(key, models.PhotoPrefix) {
+		return models.PhotoPrefix + blobID(strings.TrimPrefix(key, models.PhotoPrefix))
+	}
+	if i := strings.IndexByte(key, '/'); i >= 0 {
+		return key[:i]
+	}
+	if i := strings.IndexByte(key, '.'); i >= 0 {
+		return key[:i]
+	}
+	return key
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/local"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	mock "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediagc/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMediaGCUsecase_Collect(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockRepo := mock.NewMockMediaGCRepo(ctl)
+	now := time.Date(2023, 5, 2, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+
+	video, image, unknown, avatar, legacyPhoto, cover := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	orphan, orphanImage, recent := uuid.New(), uuid.New(), uuid.New()
+	// одинаковые файлы двух вложений хранятся один раз под контрольной суммой
+	shared := strings.Repeat("ab", 32)
+	// файл, который держит загрузка, ещё без вложения
+	held := strings.Repeat("cd", 32)
+	renditions := []models.Rendition{
+		{Name: models.RenditionOriginal, ContentType: "image/jpeg"},
+		{Name: models.RenditionThumbnail, ContentType: "image/jpeg"},
+	}
+	refs := []models.MediaRef{
+		{Id: video, Source: models.MediaSourceAttachment, Type: "video/mp4"},
+		{Id: image, Source: models.MediaSourceAttachment, Type: "image/png", Renditions: renditions},
+		{Id: unknown, Source: models.MediaSourceAttachment, Type: "text/plain"},
+		{Id: avatar, Source: models.MediaSourceUserPhoto},
+		{Id: legacyPhoto, Source: models.MediaSourceCreatorPhoto},
+		{Id: cover, Source: models.MediaSourceCreatorCover},
+		{Id: uuid.New(), Source: models.MediaSourceAttachment, Type: "audio/mpeg", BlobId: shared},
+		{Id: uuid.New(), Source: models.MediaSourceAttachment, Type: "audio/mpeg", BlobId: shared},
+	}
+
+	newStore := func(t *testing.T) (string, *local.Store) {
+		root := t.TempDir()
+		store, err := local.NewStore(root)
+		require.NoError(t, err)
+		put := func(key string, modifiedAt time.Time) {
+			require.NoError(t, store.Put(context.Background(), key, strings.NewReader(key), int64(len(key)), ""))
+			require.NoError(t, os.Chtimes(filepath.Join(root, filepath.FromSlash(key)), modifiedAt, modifiedAt))
+		}
+		put(models.AttachmentKey(video.String(), "mp4"), old)
+		put(models.RenditionKey(image.String(), models.RenditionOriginal, "jpg"), old)
+		put(models.RenditionKey(models.PhotoStorageId(avatar.String()), models.RenditionOriginal, "jpg"), old)
+		put(models.PhotoKey(legacyPhoto.String()), old)
+		put(models.AttachmentKey(orphan.String(), "mp3"), old)
+		put(models.RenditionKey(orphanImage.String(), models.RenditionFeed, "jpg"), old)
+		put(models.AttachmentKey(recent.String(), "mp4"), now.Add(-time.Minute))
+		put(models.AttachmentKey(shared, "mp3"), old)
+		put(models.AttachmentKey(held, "mp4"), old)
+		put("uploads/"+uuid.NewString()+"/info.json", old)
+		return root, store
+	}
+
+	expectedMissing := []models.MissingBlob{
+		{Id: image, Source: models.MediaSourceAttachment, Key: models.RenditionKey(image.String(), models.RenditionThumbnail, "jpg")},
+		{Id: unknown, Source: models.MediaSourceAttachment, Key: unknown.String()},
+		{Id: cover, Source: models.MediaSourceCreatorCover, Key: models.RenditionKey(models.PhotoStorageId(cover.String()), models.RenditionOriginal, "jpg")},
+	}
+	orphanKeys := []string{models.AttachmentKey(orphan.String(), "mp3"), models.RenditionKey(orphanImage.String(), models.RenditionFeed, "jpg")}
+	if orphanKeys[0] > orphanKeys[1] {
+		orphanKeys[0], orphanKeys[1] = orphanKeys[1], orphanKeys[0]
+	}
+
+	tests := []struct {
+		name   string
+		dryRun bool
+	}{
+		{name: "Dry run", dryRun: true},
+		{name: "Delete", dryRun: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root, store := newStore(t)
+			uc := NewMediaGCUsecase(mockRepo, store, media.NewTypeRegistry(media.DefaultTypes...), zap.NewNop().Sugar())
+			uc.now = func() time.Time { return now }
+			mockRepo.EXPECT().MediaRefs(gomock.Any()).Return(refs, nil)
+			mockRepo.EXPECT().HeldBlobs(gomock.Any()).Return([]string{held}, nil)
+
+			report, err := uc.Collect(context.Background(), 24*time.Hour, test.dryRun)
+			require.NoError(t, err)
+			require.Equal(t, test.dryRun, report.DryRun)
+			require.Equal(t, now.Add(-24*time.Hour), report.GraceUntil)
+			require.Equal(t, 9, report.Blobs)
+			require.Equal(t, len(refs), report.References)
+			require.Equal(t, 1, report.Recent)
+			require.Equal(t, expectedMissing, report.Missing)
+			require.Len(t, report.Orphans, 2)
+			for i, orphan := range report.Orphans {
+				require.Equal(t, orphanKeys[i], orphan.Key)
+				require.Equal(t, !test.dryRun, orphan.Deleted)
+				_, err = os.Stat(filepath.Join(root, filepath.FromSlash(orphan.Key)))
+				require.Equal(t, !test.dryRun, errors.Is(err, os.ErrNotExist))
+			}
+			if test.dryRun {
+				require.Equal(t, 0, report.Deleted)
+				return
+			}
+			require.Equal(t, 2, report.Deleted)
+			require.Equal(t, int64(len(orphanKeys[0])+len(orphanKeys[1])), report.FreedBytes)
+			// файлы, на которые есть ссылки, и свежие файлы остаются
+			_, err = store.Stat(context.Background(), models.AttachmentKey(recent.String(), "mp4"))
+			require.NoError(t, err)
+			_, err = store.Stat(context.Background(), models.AttachmentKey(video.String(), "mp4"))
+			require.NoError(t, err)
+			_, err = store.Stat(context.Background(), models.AttachmentKey(shared, "mp3"))
+			require.NoError(t, err)
+			_, err = store.Stat(context.Background(), models.AttachmentKey(held, "mp4"))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMediaGCUsecase_CollectErrors(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockRepo := mock.NewMockMediaGCRepo(ctl)
+	store, err := local.NewStore(t.TempDir())
+	require.NoError(t, err)
+	uc := NewMediaGCUsecase(mockRepo, store, media.NewTypeRegistry(media.DefaultTypes...), zap.NewNop().Sugar())
+
+	_, err = uc.Collect(context.Background(), -time.Hour, true)
+	require.Equal(t, models.WrongData, err)
+
+	mockRepo.EXPECT().MediaRefs(gomock.Any()).Return(nil, models.InternalError)
+	_, err = uc.Collect(context.Background(), time.Hour, true)
+	require.Equal(t, models.InternalError, err)
+
+	mockRepo.EXPECT().MediaRefs(gomock.Any()).Return([]models.MediaRef{}, nil)
+	mockRepo.EXPECT().HeldBlobs(gomock.Any()).Return(nil, models.InternalError)
+	_, err = uc.Collect(context.Background(), time.Hour, true)
+	require.Equal(t, models.InternalError, err)
+}
@@ -0,0 +1,77 @@
+package mediainfo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"hash"
+	"image"
+	"io"
+)
+
+// Info - сведения, которые удалось прочитать из заголовков файла. Нулевые поля - сведений нет.
+type Info struct {
+	Width  int
+	Height int
+	// Duration - длительность в секундах
+	Duration float64
+}
+
+// Probe читает размеры и длительность из заголовков файла, не загружая его целиком.
+// Поддерживаются MP4 (видео и аудио), MP3, MPEG-1/2 видео и изображения.
+// Для других типов возвращается пустое Info, для повреждённых файлов - models.WrongData.
+func Probe(r io.ReadSeeker, size int64, contentType string) (Info, error) {
+	switch contentType {
+	case "video/mp4", "audio/mp4":
+		return probeMP4(r, size)
+	case "audio/mpeg":
+		return probeMP3(r, size)
+	case "video/mpeg":
+		return probeMPEG(r)
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		config, _, err := image.DecodeConfig(r)
+		if err != nil {
+			return Info{}, models.WrongData
+		}
+		return Info{Width: config.Width, Height: config.Height}, nil
+	}
+	return Info{}, nil
+}
+
+// Checksum - SHA-256 данных в hex
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// ChecksumReader считает SHA-256 и размер прочитанных через него данных,
+// чтобы получить контрольную сумму файла, пока он сохраняется в хранилище
+type ChecksumReader struct {
+	reader io.Reader
+	hash   hash.Hash
+	size   int64
+}
+
+func NewChecksumReader(r io.Reader) *ChecksumReader {
+	return &ChecksumReader{
+		reader: r,
+		hash:   sha256.New(),
+	}
+}
+
+func (r *ChecksumReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.hash.Write(p[:n])
+	r.size += int64(n)
+	return n, err
+}
+
+// Sum - SHA-256 прочитанных данных в hex
+func (r *ChecksumReader) Sum() string {
+	return hex.EncodeToString(r.hash.Sum(nil))
+}
+
+// Size - число прочитанных байт
+func (r *ChecksumReader) Size() int64 {
+	return r.size
+}
@@ -0,0 +1,162 @@
+package mediainfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+)
+
+func box(kind string, payload ...[]byte) []byte {
+	data := bytes.Join(payload, nil)
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint32(header, uint32(len(data)+8))
+	copy(header[4:], kind)
+	return append(header, data...)
+}
+
+func mvhd(timescale, duration uint32) []byte {
+	data := make([]byte, 100)
+	binary.BigEndian.PutUint32(data[12:], timescale)
+	binary.BigEndian.PutUint32(data[16:], duration)
+	return box("mvhd", data)
+}
+
+func tkhd(width, height uint32) []byte {
+	data := make([]byte, 84)
+	binary.BigEndian.PutUint32(data[76:], width<<16)
+	binary.BigEndian.PutUint32(data[80:], height<<16)
+	return box("tkhd", data)
+}
+
+func testMP4(moovFirst bool) []byte {
+	ftyp := box("ftyp", []byte("isom\x00\x00\x02\x00isomiso2mp41"))
+	moov := box("moov", mvhd(1000, 12500), box("trak", tkhd(0, 0)), box("trak", tkhd(1920, 1080)))
+	mdat := box("mdat", bytes.Repeat([]byte{0}, 1000))
+	if moovFirst {
+		return bytes.Join([][]byte{ftyp, moov, mdat}, nil)
+	}
+	return bytes.Join([][]byte{ftyp, mdat, moov}, nil)
+}
+
+// testMP3Frame - кадр MPEG-1 Layer III 128 кбит/с 44100 Гц стерео, длина 417 байт
+func testMP3Frame() []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+	return frame
+}
+
+func TestProbe(t *testing.T) {
+	xing := testMP3Frame()
+	copy(xing[4+32:], "Xing")
+	binary.BigEndian.PutUint32(xing[4+32+4:], 0x01)
+	binary.BigEndian.PutUint32(xing[4+32+8:], 100)
+
+	id3 := append([]byte("ID3\x03\x00\x00\x00\x00\x00\x0a"), make([]byte, 10)...)
+	cbr := append(id3, bytes.Repeat(testMP3Frame(), 10)...)
+
+	sequence := append(make([]byte, 20), 0x00, 0x00, 0x01, 0xb3, 0x2d, 0x02, 0x40, 0x13)
+
+	img := &bytes.Buffer{}
+	require.NoError(t, png.Encode(img, image.NewRGBA(image.Rect(0, 0, 30, 20))))
+
+	tests := []struct {
+		name        string
+		data        []byte
+		contentType string
+		expected    Info
+		expectedErr error
+	}{
+		{
+			name:        "MP4 moov first",
+			data:        testMP4(true),
+			contentType: "video/mp4",
+			expected:    Info{Width: 1920, Height: 1080, Duration: 12.5},
+		},
+		{
+			name:        "MP4 moov last",
+			data:        testMP4(false),
+			contentType: "video/mp4",
+			expected:    Info{Width: 1920, Height: 1080, Duration: 12.5},
+		},
+		{
+			name:        "MP4 audio",
+			data:        bytes.Join([][]byte{box("ftyp", []byte("M4A ")), box("moov", mvhd(44100, 441000), box("trak", tkhd(0, 0)))}, nil),
+			contentType: "audio/mp4",
+			expected:    Info{Duration: 10},
+		},
+		{
+			name:        "MP4 broken box",
+			data:        append(box("ftyp", []byte("isom")), 0, 0, 0xff, 0xff, 'm', 'o', 'o', 'v'),
+			contentType: "video/mp4",
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "MP4 without moov",
+			data:        box("ftyp", []byte("isom")),
+			contentType: "video/mp4",
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "MP3 Xing",
+			data:        xing,
+			contentType: "audio/mpeg",
+			expected:    Info{Duration: 100 * 1152 / 44100.0},
+		},
+		{
+			name:        "MP3 constant bitrate",
+			data:        cbr,
+			contentType: "audio/mpeg",
+			expected:    Info{Duration: 4170 * 8 / 128000.0},
+		},
+		{
+			name:        "MP3 no frames",
+			data:        []byte(strings.Repeat("not mp3", 10)),
+			contentType: "audio/mpeg",
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "MPEG",
+			data:        sequence,
+			contentType: "video/mpeg",
+			expected:    Info{Width: 720, Height: 576},
+		},
+		{
+			name:        "Image",
+			data:        img.Bytes(),
+			contentType: "image/png",
+			expected:    Info{Width: 30, Height: 20},
+		},
+		{
+			name:        "Unknown type",
+			data:        []byte("text"),
+			contentType: "text/plain",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info, err := Probe(bytes.NewReader(test.data), int64(len(test.data)), test.contentType)
+			require.Equal(t, test.expectedErr, err)
+			require.Equal(t, test.expected.Width, info.Width)
+			require.Equal(t, test.expected.Height, info.Height)
+			require.InDelta(t, test.expected.Duration, info.Duration, 1e-9)
+		})
+	}
+}
+
+func TestChecksumReader(t *testing.T) {
+	data := []byte("attachment")
+	r := NewChecksumReader(bytes.NewReader(data))
+	read, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, read)
+	require.Equal(t, int64(len(data)), r.Size())
+	require.Equal(t, Checksum(data), r.Sum())
+	require.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Checksum(nil))
+}
@@ -0,0 +1,163 @@
+package mediainfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+)
+
+// maxSyncSearch - сколько байт после тега ID3v2 просматривается в поисках первого кадра
+const maxSyncSearch = 64 << 10
+
+// битрейты в кбит/с по индексу из заголовка кадра: [MPEG-1][слой] и [MPEG-2/2.5][слой]
+var (
+	mpeg1Bitrates = [3][16]int{
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+	}
+	mpeg2Bitrates = [3][16]int{
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	}
+	mpeg1SampleRates = [3]int{44100, 48000, 32000}
+)
+
+type mp3Frame struct {
+	mpeg1           bool
+	layer           int // 1, 2 или 3
+	bitrate         int // бит/с
+	sampleRate      int
+	mono            bool
+	samplesPerFrame int
+}
+
+// probeMP3 считает длительность по числу кадров из заголовка Xing/Info или VBRI,
+// а для файлов без них - по битрейту первого кадра, как для постоянного битрейта
+func probeMP3(r io.ReadSeeker, size int64) (Info, error) {
+	start, err := id3v2Size(r)
+	if err != nil {
+		return Info{}, err
+	}
+	if _, err = r.Seek(start, io.SeekStart); err != nil {
+		return Info{}, err
+	}
+	buf := make([]byte, maxSyncSearch)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && n == 0 {
+		return Info{}, models.WrongData
+	}
+	buf = buf[:n]
+
+	for i := 0; i+4 <= len(buf); i++ {
+		frame, ok := parseMP3Header(buf[i:])
+		if !ok {
+			continue
+		}
+		if frames := vbrFrames(buf[i:], frame); frames > 0 {
+			return Info{Duration: float64(frames) * float64(frame.samplesPerFrame) / float64(frame.sampleRate)}, nil
+		}
+		audioSize := size - start - int64(i)
+		if hasID3v1(r, size) {
+			audioSize -= 128
+		}
+		if audioSize <= 0 {
+			return Info{}, models.WrongData
+		}
+		return Info{Duration: float64(audioSize) * 8 / float64(frame.bitrate)}, nil
+	}
+	return Info{}, models.WrongData
+}
+
+// id3v2Size - размер тега ID3v2 в начале файла, 0 - тега нет
+func id3v2Size(r io.ReadSeeker) (int64, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	header := make([]byte, 10)
+	if _, err := io.ReadFull(r, header); err != nil || !bytes.HasPrefix(header, []byte("ID3")) {
+		return 0, nil
+	}
+	// размер записан в 4 байтах по 7 бит
+	size := int64(header[6]&0x7f)<<21 | int64(header[7]&0x7f)<<14 | int64(header[8]&0x7f)<<7 | int64(header[9]&0x7f)
+	size += 10
+	if header[5]&0x10 != 0 {
+		// есть footer
+		size += 10
+	}
+	return size, nil
+}
+
+func hasID3v1(r io.ReadSeeker, size int64) bool {
+	if size < 128 {
+		return false
+	}
+	if _, err := r.Seek(size-128, io.SeekStart); err != nil {
+		return false
+	}
+	tag := make([]byte, 3)
+	_, err := io.ReadFull(r, tag)
+	return err == nil && string(tag) == "TAG"
+}
+
+func parseMP3Header(data []byte) (mp3Frame, bool) {
+	if len(data) < 4 || data[0] != 0xff || data[1]&0xe0 != 0xe0 {
+		return mp3Frame{}, false
+	}
+	version := (data[1] >> 3) & 0x03 // 0 - MPEG-2.5, 2 - MPEG-2, 3 - MPEG-1
+	layerBits := (data[1] >> 1) & 0x03
+	bitrateIndex := data[2] >> 4
+	sampleRateIndex := (data[2] >> 2) & 0x03
+	if version == 1 || layerBits == 0 || bitrateIndex == 0 || bitrateIndex == 0x0f || sampleRateIndex == 3 {
+		return mp3Frame{}, false
+	}
+
+	frame := mp3Frame{
+		mpeg1: version == 3,
+		layer: 4 - int(layerBits),
+		mono:  data[3]>>6 == 3,
+	}
+	frame.sampleRate = mpeg1SampleRates[sampleRateIndex]
+	if frame.mpeg1 {
+		frame.bitrate = mpeg1Bitrates[frame.layer-1][bitrateIndex] * 1000
+	} else {
+		frame.bitrate = mpeg2Bitrates[frame.layer-1][bitrateIndex] * 1000
+		frame.sampleRate /= 2
+		if version == 0 {
+			frame.sampleRate /= 2
+		}
+	}
+	switch {
+	case frame.layer == 1:
+		frame.samplesPerFrame = 384
+	case frame.layer == 3 && !frame.mpeg1:
+		frame.samplesPerFrame = 576
+	default:
+		frame.samplesPerFrame = 1152
+	}
+	return frame, true
+}
+
+// vbrFrames - число кадров из заголовка Xing/Info или VBRI в первом кадре, 0 - заголовка нет
+func vbrFrames(data []byte, frame mp3Frame) uint32 {
+	sideInfo := 17
+	switch {
+	case frame.mpeg1 && !frame.mono:
+		sideInfo = 32
+	case !frame.mpeg1 && frame.mono:
+		sideInfo = 9
+	}
+	if xing := 4 + sideInfo; len(data) >= xing+12 {
+		tag := string(data[xing : xing+4])
+		flags := binary.BigEndian.Uint32(data[xing+4:])
+		if (tag == "Xing" || tag == "Info") && flags&0x01 != 0 {
+			return binary.BigEndian.Uint32(data[xing+8:])
+		}
+	}
+	if vbri := 4 + 32; len(data) >= vbri+18 && string(data[vbri:vbri+4]) == "VBRI" {
+		return binary.BigEndian.Uint32(data[vbri+14:])
+	}
+	return 0
+}
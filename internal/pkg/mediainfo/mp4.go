@@ -0,0 +1,166 @@
+package mediainfo
+
+import (
+	"encoding/binary"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+)
+
+const (
+	// maxBoxes - ограничение на число просмотренных боксов, чтобы повреждённый файл не читался бесконечно
+	maxBoxes = 10000
+	// maxHeaderBox - наибольший размер mvhd и tkhd, которые читаются в память целиком
+	maxHeaderBox = 1 << 10
+)
+
+type mp4Box struct {
+	kind   string
+	offset int64 // начало содержимого
+	size   int64 // размер содержимого
+}
+
+// probeMP4 читает длительность из moov/mvhd и размеры кадра из первого trak/tkhd с ненулевыми размерами.
+// Бокс moov может быть и в начале, и в конце файла, поэтому боксы верхнего уровня обходятся через Seek.
+func probeMP4(r io.ReadSeeker, size int64) (Info, error) {
+	boxes := 0
+	top, err := mp4Boxes(r, 0, size, &boxes)
+	if err != nil {
+		return Info{}, err
+	}
+	if len(top) == 0 || top[0].kind != "ftyp" {
+		return Info{}, models.WrongData
+	}
+
+	var info Info
+	for _, moov := range top {
+		if moov.kind != "moov" {
+			continue
+		}
+		children, err := mp4Boxes(r, moov.offset, moov.offset+moov.size, &boxes)
+		if err != nil {
+			return Info{}, err
+		}
+		for _, child := range children {
+			switch child.kind {
+			case "mvhd":
+				data, err := readBox(r, child)
+				if err != nil {
+					return Info{}, err
+				}
+				info.Duration = mvhdDuration(data)
+			case "trak":
+				if info.Width != 0 {
+					continue
+				}
+				tracks, err := mp4Boxes(r, child.offset, child.offset+child.size, &boxes)
+				if err != nil {
+					return Info{}, err
+				}
+				for _, tkhd := range tracks {
+					if tkhd.kind != "tkhd" {
+						continue
+					}
+					data, err := readBox(r, tkhd)
+					if err != nil {
+						return Info{}, err
+					}
+					info.Width, info.Height = tkhdSize(data)
+				}
+			}
+		}
+		return info, nil
+	}
+	return Info{}, models.WrongData
+}
+
+// mp4Boxes возвращает боксы, лежащие подряд между start и end
+func mp4Boxes(r io.ReadSeeker, start, end int64, boxes *int) ([]mp4Box, error) {
+	var result []mp4Box
+	header := make([]byte, 16)
+	for offset := start; offset+8 <= end; {
+		*boxes++
+		if *boxes > maxBoxes {
+			return nil, models.WrongData
+		}
+		if _, err := r.Seek(offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(r, header[:8]); err != nil {
+			return nil, models.WrongData
+		}
+		boxSize := int64(binary.BigEndian.Uint32(header))
+		headerSize := int64(8)
+		switch boxSize {
+		case 0:
+			// бокс до конца файла
+			boxSize = end - offset
+		case 1:
+			if _, err := io.ReadFull(r, header[8:16]); err != nil {
+				return nil, models.WrongData
+			}
+			boxSize = int64(binary.BigEndian.Uint64(header[8:]))
+			headerSize = 16
+		}
+		if boxSize < headerSize || offset+boxSize > end || offset+boxSize < offset {
+			return nil, models.WrongData
+		}
+		result = append(result, mp4Box{
+			kind:   string(header[4:8]),
+			offset: offset + headerSize,
+			size:   boxSize - headerSize,
+		})
+		offset += boxSize
+	}
+	return result, nil
+}
+
+func readBox(r io.ReadSeeker, box mp4Box) ([]byte, error) {
+	if box.size > maxHeaderBox {
+		return nil, models.WrongData
+	}
+	if _, err := r.Seek(box.offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data := make([]byte, box.size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, models.WrongData
+	}
+	return data, nil
+}
+
+func mvhdDuration(data []byte) float64 {
+	var timescale, duration uint64
+	switch {
+	case len(data) >= 32 && data[0] == 1:
+		timescale = uint64(binary.BigEndian.Uint32(data[20:]))
+		duration = binary.BigEndian.Uint64(data[24:])
+	case len(data) >= 20 && data[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(data[12:]))
+		duration = uint64(binary.BigEndian.Uint32(data[16:]))
+		if duration == 0xffffffff {
+			// длительность неизвестна
+			return 0
+		}
+	}
+	if timescale == 0 {
+		return 0
+	}
+	return float64(duration) / float64(timescale)
+}
+
+// tkhdSize - размеры кадра дорожки, хранятся в формате 16.16 с фиксированной точкой
+func tkhdSize(data []byte) (int, int) {
+	offset := 76
+	if len(data) > 0 && data[0] == 1 {
+		offset = 88
+	}
+	if len(data) < offset+8 {
+		return 0, 0
+	}
+	width := int(binary.BigEndian.Uint32(data[offset:]) >> 16)
+	height := int(binary.BigEndian.Uint32(data[offset+4:]) >> 16)
+	if width == 0 || height == 0 {
+		return 0, 0
+	}
+	return width, height
+}
@@ -0,0 +1,38 @@
+package mediainfo
+
+import (
+	"bytes"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+)
+
+// maxSequenceSearch - сколько байт от начала файла просматривается в поисках заголовка последовательности
+const maxSequenceSearch = 256 << 10
+
+var sequenceHeader = []byte{0x00, 0x00, 0x01, 0xb3}
+
+// probeMPEG читает размеры кадра из заголовка последовательности MPEG-1/2 видео.
+// Длительность без разбора всего потока не узнать, поэтому она не заполняется.
+func probeMPEG(r io.ReadSeeker) (Info, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return Info{}, err
+	}
+	buf := make([]byte, maxSequenceSearch)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && n == 0 {
+		return Info{}, models.WrongData
+	}
+	buf = buf[:n]
+
+	i := bytes.Index(buf, sequenceHeader)
+	if i < 0 || i+7 > len(buf) {
+		return Info{}, models.WrongData
+	}
+	data := buf[i+4:]
+	width := int(data[0])<<4 | int(data[1])>>4
+	height := int(data[1]&0x0f)<<8 | int(data[2])
+	if width == 0 || height == 0 {
+		return Info{}, models.WrongData
+	}
+	return Info{Width: width, Height: height}, nil
+}
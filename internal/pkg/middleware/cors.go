@@ -1,17 +1,34 @@
 package middleware
 
-import "net/http"
+import (
+	"net/http"
+	"strings"
+)
 
-func CORSMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Methods", "POST,PUT,DELETE,GET")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type,X-CSRF-Token")
-		w.Header().Set("Access-Control-Allow-Credentials", "true")
-		w.Header().Set("Access-Control-Expose-Headers", "X-CSRF-Token")
-		w.Header().Set("Access-Control-Allow-Origin", r.Header.Get("Origin"))
-		if r.Method == http.MethodOptions {
-			return
+// NewCORSMiddleware выставляет CORS-заголовки и сам отвечает на OPTIONS. Исключение - адреса под optionsPrefixes:
+// там OPTIONS доходит до обработчика, например загрузки по tus отвечают на него заголовками протокола.
+func NewCORSMiddleware(optionsPrefixes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Access-Control-Allow-Methods", "POST,PUT,PATCH,DELETE,GET,HEAD")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type,X-CSRF-Token,Tus-Resumable,Upload-Length,Upload-Offset,Upload-Metadata")
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+			w.Header().Set("Access-Control-Expose-Headers", "X-CSRF-Token,Location,Tus-Resumable,Tus-Version,Tus-Extension,Tus-Max-Size,Upload-Offset,Upload-Length,Upload-Metadata,Upload-Expires")
+			w.Header().Set("Access-Control-Allow-Origin", r.Header.Get("Origin"))
+			if r.Method == http.MethodOptions && !hasPrefix(r.URL.Path, optionsPrefixes) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// hasPrefix - путь совпадает с одним из префиксов или лежит под ним
+func hasPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
 		}
-		next.ServeHTTP(w, r)
-	})
+	}
+	return false
 }
@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/golang/mock/gomock"
+)
+
+// topicMatcher проверяет, что уведомление уходит в заданный топик
+type topicMatcher string
+
+// Topic - матчер для SendUserNotification, уведомление с любым текстом в топик topic
+func Topic(topic string) gomock.Matcher {
+	return topicMatcher(topic)
+}
+
+func (t topicMatcher) Matches(x interface{}) bool {
+	notification, ok := x.(models.Notification)
+	return ok && notification.Topic == string(t)
+}
+
+func (t topicMatcher) String() string {
+	return "notification to " + string(t)
+}
@@ -0,0 +1,113 @@
+package fake
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/google/uuid"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+)
+
+const ProviderName = "fake"
+
+// Gateway принимает уведомления, подписанные Signer, - для сквозных тестов и локального запуска без платёжной системы
+type Gateway struct {
+	secret string
+}
+
+func NewGateway(secret string) *Gateway {
+	return &Gateway{secret: secret}
+}
+
+func (g *Gateway) ParseNotification(r *http.Request) (models.PaymentEvent, error) {
+	if err := r.ParseForm(); err != nil {
+		return models.PaymentEvent{}, models.WrongData
+	}
+	signature, err := hex.DecodeString(r.PostForm.Get("signature"))
+	if err != nil || !hmac.Equal(signature, sign(r.PostForm, g.secret)) {
+		return models.PaymentEvent{}, models.Forbbiden
+	}
+
+	event := models.PaymentEvent{
+		Provider:    ProviderName,
+		OperationID: r.PostForm.Get("operation_id"),
+		Kind:        r.PostForm.Get("kind"),
+		Sender:      r.PostForm.Get("sender"),
+	}
+	// как и у ЮMoney, возврат - исходящий перевод, его проводит сверка, а не входящее уведомление
+	if event.Kind == models.PaymentKindRefund {
+		return models.PaymentEvent{}, models.WrongData
+	}
+	if event.TargetID, err = uuid.Parse(r.PostForm.Get("target_id")); err != nil {
+		return models.PaymentEvent{}, models.WrongData
+	}
+	if paymentInfo := r.PostForm.Get("payment_info"); len(paymentInfo) != 0 {
+		if event.PaymentInfo, err = uuid.Parse(paymentInfo); err != nil {
+			return models.PaymentEvent{}, models.WrongData
+		}
+	}
+	if event.Money, err = models.ParseMoney(r.PostForm.Get("amount")); err != nil {
+		return models.PaymentEvent{}, models.WrongData
+	}
+	// paid - сумма с комиссией, без неё плательщик заплатил ровно amount
+	event.Paid = event.Money
+	if paid := r.PostForm.Get("paid"); len(paid) != 0 {
+		if event.Paid, err = models.ParseMoney(paid); err != nil {
+			return models.PaymentEvent{}, models.WrongData
+		}
+	}
+
+	if !event.IsValid() {
+		return models.PaymentEvent{}, models.WrongData
+	}
+	return event, nil
+}
+
+// Signer формирует уведомления, которые принимает Gateway с тем же секретом
+type Signer struct {
+	secret string
+}
+
+func NewSigner(secret string) *Signer {
+	return &Signer{secret: secret}
+}
+
+// Sign возвращает подписанную форму уведомления о событии
+func (s *Signer) Sign(event models.PaymentEvent) url.Values {
+	form := url.Values{}
+	form.Set("operation_id", event.OperationID)
+	form.Set("kind", event.Kind)
+	form.Set("target_id", event.TargetID.String())
+	if event.PaymentInfo != uuid.Nil {
+		form.Set("payment_info", event.PaymentInfo.String())
+	}
+	form.Set("amount", event.Money.String())
+	if event.Paid.IsPositive() {
+		form.Set("paid", event.Paid.String())
+	}
+	if len(event.Sender) != 0 {
+		form.Set("sender", event.Sender)
+	}
+	form.Set("signature", hex.EncodeToString(sign(form, s.secret)))
+	return form
+}
+
+// NewRequest возвращает запрос с подписанным уведомлением о событии
+func (s *Signer) NewRequest(target string, event models.PaymentEvent) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(s.Sign(event).Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func sign(form url.Values, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	for _, field := range []string{"operation_id", "kind", "target_id", "payment_info", "amount", "paid", "sender"} {
+		mac.Write([]byte(form.Get(field)))
+		mac.Write([]byte{0})
+	}
+	return mac.Sum(nil)
+}
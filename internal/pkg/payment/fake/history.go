@@ -0,0 +1,47 @@
+package fake
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"sync"
+	"time"
+)
+
+// History - история операций в памяти для тестов сверки.
+// Ошибка, заданная через Fail, возвращается до тех пор, пока её не сбросят.
+type History struct {
+	mu         sync.Mutex
+	operations []models.ProviderOperation
+	err        error
+}
+
+func NewHistory(operations ...models.ProviderOperation) *History {
+	return &History{operations: operations}
+}
+
+func (h *History) Add(operations ...models.ProviderOperation) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.operations = append(h.operations, operations...)
+}
+
+func (h *History) Fail(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.err = err
+}
+
+func (h *History) Operations(_ context.Context, from, to time.Time) ([]models.ProviderOperation, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.err != nil {
+		return nil, h.err
+	}
+	var operations = make([]models.ProviderOperation, 0)
+	for _, v := range h.operations {
+		if !v.Datetime.Before(from) && !v.Datetime.After(to) {
+			operations = append(operations, v)
+		}
+	}
+	return operations, nil
+}
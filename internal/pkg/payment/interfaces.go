@@ -0,0 +1,21 @@
+package payment
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"net/http"
+	"time"
+)
+
+// PaymentGateway - платёжная система, которая присылает уведомления о входящих платежах
+type PaymentGateway interface {
+	// ParseNotification проверяет подпись уведомления и приводит его к общему виду.
+	// Возвращает models.Forbbiden при неверной подписи и models.WrongData при некорректном содержимом.
+	ParseNotification(r *http.Request) (models.PaymentEvent, error)
+}
+
+// OperationHistory - история операций в платёжной системе, по ней сверяются наши платежи и выплаты
+type OperationHistory interface {
+	// Operations возвращает успешные входящие и исходящие операции, проведённые в промежутке [from, to]
+	Operations(ctx context.Context, from, to time.Time) ([]models.ProviderOperation, error)
+}
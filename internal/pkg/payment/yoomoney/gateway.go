@@ -0,0 +1,80 @@
+package yoomoney
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const ProviderName = "yoomoney"
+
+// signedFields - поля HTTP-уведомления ЮMoney в том порядке, в котором они входят в строку для sha1_hash.
+// Секрет уведомлений вставляется перед label.
+var signedFields = []string{"notification_type", "operation_id", "amount", "currency", "datetime", "sender", "codepro"}
+
+// Gateway разбирает HTTP-уведомления ЮMoney, в label платежа лежит метка models.ParsePaymentLabel
+type Gateway struct {
+	secret string
+}
+
+func NewGateway(secret string) *Gateway {
+	return &Gateway{secret: secret}
+}
+
+// Sign считает sha1_hash уведомления так же, как ЮMoney
+func Sign(form url.Values, secret string) string {
+	values := make([]string, 0, len(signedFields)+2)
+	for _, field := range signedFields {
+		values = append(values, form.Get(field))
+	}
+	values = append(values, secret, form.Get("label"))
+
+	hash := sha1.Sum([]byte(strings.Join(values, "&")))
+	return fmt.Sprintf("%x", hash)
+}
+
+func (g *Gateway) ParseNotification(r *http.Request) (models.PaymentEvent, error) {
+	if err := r.ParseForm(); err != nil {
+		return models.PaymentEvent{}, models.WrongData
+	}
+	if subtle.ConstantTimeCompare([]byte(Sign(r.PostForm, g.secret)), []byte(r.PostForm.Get("sha1_hash"))) != 1 {
+		return models.PaymentEvent{}, models.Forbbiden
+	}
+
+	// sender - кошелёк плательщика, есть только у переводов из кошелька, при оплате картой он пуст
+	event := models.PaymentEvent{
+		Provider:    ProviderName,
+		OperationID: r.PostForm.Get("operation_id"),
+		Sender:      r.PostForm.Get("sender"),
+	}
+	var err error
+	if event.Kind, event.TargetID, event.PaymentInfo, err = models.ParsePaymentLabel(r.PostForm.Get("label")); err != nil {
+		return models.PaymentEvent{}, err
+	}
+	// ЮMoney не присылает уведомлений о возвратах, а метку входящего платежа задаёт плательщик:
+	// платёж с меткой возврата не должен откатывать чужую оплату. Возврат у ЮMoney - исходящий перевод
+	// с меткой возврата, его проводит сверка по истории операций (cmd/reconcile -fix)
+	if event.Kind == models.PaymentKindRefund {
+		return models.PaymentEvent{}, models.WrongData
+	}
+	if event.Money, err = models.ParseMoney(r.PostForm.Get("amount")); err != nil {
+		return models.PaymentEvent{}, models.WrongData
+	}
+	// amount - сумма за вычетом комиссии ЮMoney, плательщик заплатил withdraw_amount.
+	// withdraw_amount не входит в sha1_hash, поэтому IsValid проверяет, что он не меньше amount
+	event.Paid = event.Money
+	if withdrawAmount := r.PostForm.Get("withdraw_amount"); len(withdrawAmount) != 0 {
+		if event.Paid, err = models.ParseMoney(withdrawAmount); err != nil {
+			return models.PaymentEvent{}, models.WrongData
+		}
+	}
+
+	if !event.IsValid() {
+		return models.PaymentEvent{}, models.WrongData
+	}
+	return event, nil
+}
@@ -0,0 +1,145 @@
+package yoomoney
+
+import (
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func notification(label, amount string) url.Values {
+	form := url.Values{}
+	form.Set("notification_type", "p2p-incoming")
+	form.Set("operation_id", "1234567")
+	form.Set("amount", amount)
+	form.Set("currency", "643")
+	form.Set("datetime", "2023-05-01T12:00:00Z")
+	form.Set("sender", "41001000040")
+	form.Set("codepro", "false")
+	form.Set("label", label)
+	return form
+}
+
+func withdrawAmount(form url.Values, amount string) url.Values {
+	form.Set("withdraw_amount", amount)
+	return form
+}
+
+func newRequest(form url.Values) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/payment", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestGateway_ParseNotification(t *testing.T) {
+	gateway := NewGateway("secret")
+	creatorID := uuid.New()
+	paymentInfo := uuid.New()
+
+	tests := []struct {
+		name          string
+		form          url.Values
+		secret        string
+		expectedEvent models.PaymentEvent
+		expectedErr   error
+	}{
+		{
+			name:   "OK",
+			form:   notification("subscribe;"+creatorID.String(), "100.50"),
+			secret: "secret",
+			expectedEvent: models.PaymentEvent{
+				Provider:    ProviderName,
+				OperationID: "1234567",
+				Kind:        models.PaymentKindSubscribe,
+				TargetID:    creatorID,
+				Money:       models.NewMoney(10050),
+				Paid:        models.NewMoney(10050),
+				Sender:      "41001000040",
+			},
+		},
+		{
+			name:   "OK with fee",
+			form:   withdrawAmount(notification("subscribe;"+creatorID.String(), "100.50"), "101.00"),
+			secret: "secret",
+			expectedEvent: models.PaymentEvent{
+				Provider:    ProviderName,
+				OperationID: "1234567",
+				Kind:        models.PaymentKindSubscribe,
+				TargetID:    creatorID,
+				Money:       models.NewMoney(10050),
+				Paid:        models.NewMoney(10100),
+				Sender:      "41001000040",
+			},
+		},
+		{
+			name:   "OK donate with payment info",
+			form:   notification("donate;"+creatorID.String()+";"+paymentInfo.String(), "10"),
+			secret: "secret",
+			expectedEvent: models.PaymentEvent{
+				Provider:    ProviderName,
+				OperationID: "1234567",
+				Kind:        models.PaymentKindDonate,
+				TargetID:    creatorID,
+				PaymentInfo: paymentInfo,
+				Money:       models.NewMoney(1000),
+				Paid:        models.NewMoney(1000),
+				Sender:      "41001000040",
+			},
+		},
+		{
+			name:        "Wrong signature",
+			form:        notification("subscribe;"+creatorID.String(), "100.50"),
+			secret:      "wrong",
+			expectedErr: models.Forbbiden,
+		},
+		{
+			name:        "Wrong label",
+			form:        notification("subscribe", "100.50"),
+			secret:      "secret",
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "Wrong target",
+			form:        notification("subscribe;1", "100.50"),
+			secret:      "secret",
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "Unknown kind",
+			form:        notification("test;"+creatorID.String(), "100.50"),
+			secret:      "secret",
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "Refund label",
+			form:        notification("refund;"+creatorID.String(), "100.50"),
+			secret:      "secret",
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "Withdraw amount less than amount",
+			form:        withdrawAmount(notification("subscribe;"+creatorID.String(), "100.50"), "100.00"),
+			secret:      "secret",
+			expectedErr: models.WrongData,
+		},
+		{
+			name:        "Wrong amount",
+			form:        notification("subscribe;"+creatorID.String(), "abc"),
+			secret:      "secret",
+			expectedErr: models.WrongData,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.form.Set("sha1_hash", Sign(test.form, test.secret))
+			event, err := gateway.ParseNotification(newRequest(test.form))
+			require.Equal(t, test.expectedErr, err)
+			require.Equal(t, test.expectedEvent, event)
+		})
+	}
+}
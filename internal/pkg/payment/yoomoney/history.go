@@ -0,0 +1,105 @@
+package yoomoney
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/mailru/easyjson"
+	"go.uber.org/zap"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const historyPageSize = "100"
+
+// History читает историю операций кошелька ЮMoney, токену нужно право operation-history
+type History struct {
+	token      string
+	historyURL string
+	client     *http.Client
+	logger     *zap.SugaredLogger
+}
+
+func NewHistory(token string, client *http.Client, logger *zap.SugaredLogger) *History {
+	return &History{
+		token:      token,
+		historyURL: models.OperationHistoryURL,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+func (h *History) Operations(ctx context.Context, from, to time.Time) ([]models.ProviderOperation, error) {
+	var operations = make([]models.ProviderOperation, 0)
+	startRecord := ""
+	for {
+		form := url.Values{}
+		form.Set("type", "deposition payment")
+		form.Set("from", from.Format(time.RFC3339))
+		form.Set("till", to.Format(time.RFC3339))
+		form.Set("records", historyPageSize)
+		if len(startRecord) != 0 {
+			form.Set("start_record", startRecord)
+		}
+
+		response, err := h.call(ctx, form)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Error) != 0 {
+			return nil, fmt.Errorf("yoomoney operation history: %s", response.Error)
+		}
+
+		for _, v := range response.Operations {
+			if v.Status != models.PaymentStatusSuccess {
+				continue
+			}
+			money, err := models.ParseMoney(v.Amount)
+			if err != nil {
+				return nil, fmt.Errorf("operation %s: %w", v.OperationID, err)
+			}
+			operations = append(operations, models.ProviderOperation{
+				Provider:    ProviderName,
+				OperationID: v.OperationID,
+				Direction:   v.Direction,
+				Label:       v.Label,
+				Money:       money,
+				Datetime:    v.Datetime,
+			})
+		}
+
+		if len(response.NextRecord) == 0 {
+			return operations, nil
+		}
+		startRecord = response.NextRecord
+	}
+}
+
+func (h *History) call(ctx context.Context, form url.Values) (models.OperationHistoryResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.historyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return models.OperationHistoryResponse{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+h.token)
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		h.logger.Error(err)
+		return models.OperationHistoryResponse{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return models.OperationHistoryResponse{}, fmt.Errorf("yoomoney responded with status %d", res.StatusCode)
+	}
+
+	var response models.OperationHistoryResponse
+	if err = easyjson.UnmarshalFromReader(res.Body, &response); err != nil {
+		h.logger.Error(err)
+		return models.OperationHistoryResponse{}, err
+	}
+	return response, nil
+}
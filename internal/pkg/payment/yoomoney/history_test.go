@@ -0,0 +1,61 @@
+package yoomoney
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHistory_Operations(t *testing.T) {
+	to := time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC)
+	from := to.Add(-24 * time.Hour)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, from.Format(time.RFC3339), r.PostForm.Get("from"))
+		require.Equal(t, to.Format(time.RFC3339), r.PostForm.Get("till"))
+		switch r.PostForm.Get("start_record") {
+		case "":
+			_, _ = w.Write([]byte(`{"next_record":"2","operations":[` +
+				`{"operation_id":"1","status":"success","datetime":"2023-05-01T10:00:00Z","direction":"in","amount":"100.50","label":"donate;0"},` +
+				`{"operation_id":"2","status":"refused","datetime":"2023-05-01T11:00:00Z","direction":"out","amount":"10.00"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"operations":[` +
+				`{"operation_id":"3","status":"success","datetime":"2023-05-01T12:00:00Z","direction":"out","amount":"70.00","label":"payout;1"}]}`))
+		default:
+			t.Fatalf("unexpected start_record %s", r.PostForm.Get("start_record"))
+		}
+	}))
+	defer server.Close()
+
+	history := NewHistory("token", server.Client(), zap.NewNop().Sugar())
+	history.historyURL = server.URL
+
+	operations, err := history.Operations(context.Background(), from, to)
+	require.NoError(t, err)
+	require.Equal(t, []models.ProviderOperation{
+		{Provider: ProviderName, OperationID: "1", Direction: models.OperationDirectionIn, Label: "donate;0",
+			Money: models.NewMoney(10050), Datetime: time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)},
+		{Provider: ProviderName, OperationID: "3", Direction: models.OperationDirectionOut, Label: "payout;1",
+			Money: models.NewMoney(7000), Datetime: time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)},
+	}, operations)
+}
+
+func TestHistory_OperationsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"error":"illegal_param_type"}`))
+	}))
+	defer server.Close()
+
+	history := NewHistory("token", server.Client(), zap.NewNop().Sugar())
+	history.historyURL = server.URL
+
+	_, err := history.Operations(context.Background(), time.Now().Add(-time.Hour), time.Now())
+	require.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package fake
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/google/uuid"
+	"sync"
+)
+
+// Provider - реализация PayoutProvider в памяти для тестов и локального запуска.
+// Ошибки, заданные через FailRequests и FailProcessing, возвращаются до тех пор, пока их не сбросят.
+type Provider struct {
+	mu         sync.Mutex
+	requests   map[string]models.Payout
+	processed  map[string]int
+	refunds    map[string]models.Refund
+	requestErr error
+	processErr error
+}
+
+func NewProvider() *Provider {
+	return &Provider{
+		requests:  make(map[string]models.Payout),
+		processed: make(map[string]int),
+		refunds:   make(map[string]models.Refund),
+	}
+}
+
+func (p *Provider) RequestPayout(_ context.Context, payout models.Payout) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.requestErr != nil {
+		return "", p.requestErr
+	}
+	requestID := uuid.NewString()
+	p.requests[requestID] = payout
+	return requestID, nil
+}
+
+func (p *Provider) ProcessPayout(_ context.Context, requestID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.processErr != nil {
+		return p.processErr
+	}
+	if _, ok := p.requests[requestID]; !ok {
+		return models.PayoutRejected
+	}
+	p.processed[requestID]++
+	return nil
+}
+
+// Refund запоминает возврат и возвращает идентификатор операции, ошибка FailRequests действует и на возвраты
+func (p *Provider) Refund(_ context.Context, refund models.Refund, _ string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.requestErr != nil {
+		return "", p.requestErr
+	}
+	operationID := uuid.NewString()
+	p.refunds[operationID] = refund
+	return operationID, nil
+}
+
+// Refunds возвращает проведённые возвраты
+func (p *Provider) Refunds() []models.Refund {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	refunds := make([]models.Refund, 0, len(p.refunds))
+	for _, refund := range p.refunds {
+		refunds = append(refunds, refund)
+	}
+	return refunds
+}
+
+// FailRequests задаёт ошибку для следующих вызовов RequestPayout, nil её сбрасывает
+func (p *Provider) FailRequests(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requestErr = err
+}
+
+// FailProcessing задаёт ошибку для следующих вызовов ProcessPayout, nil её сбрасывает
+func (p *Provider) FailProcessing(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.processErr = err
+}
+
+// Paid возвращает выплаты, по которым деньги действительно ушли, каждую по одному разу
+func (p *Provider) Paid() []models.Payout {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	paid := make([]models.Payout, 0, len(p.processed))
+	for requestID := range p.processed {
+		paid = append(paid, p.requests[requestID])
+	}
+	return paid
+}
+
+// ProcessCount возвращает, сколько раз проводился перевод с этим requestID
+func (p *Provider) ProcessCount(requestID string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.processed[requestID]
+}
@@ -0,0 +1,25 @@
+package payout
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+)
+
+// PayoutProvider - платёжная система, через которую деньги уходят автору.
+// Перевод проходит в два шага: RequestPayout регистрирует его, ProcessPayout проводит.
+// Ошибки models.PayoutRejected и models.PayoutInProgress означают окончательный отказ
+// и незавершённый перевод соответственно, любую другую ошибку можно повторить.
+type PayoutProvider interface {
+	// RequestPayout регистрирует перевод и возвращает идентификатор запроса у провайдера
+	RequestPayout(ctx context.Context, payout models.Payout) (string, error)
+	// ProcessPayout проводит зарегистрированный перевод, повторный вызов с тем же requestID не переводит деньги второй раз
+	ProcessPayout(ctx context.Context, requestID string) error
+}
+
+// RefundProvider возвращает плательщику деньги переводом с кошелька платформы.
+// Ошибки те же, что у PayoutProvider: models.PayoutRejected - перевод отклонён,
+// models.PayoutInProgress - перевод не завершён, его запишет сверка, когда он появится в истории операций.
+type RefundProvider interface {
+	// Refund переводит refund.Money на счёт плательщика account с меткой возврата и возвращает идентификатор операции
+	Refund(ctx context.Context, refund models.Refund, account string) (string, error)
+}
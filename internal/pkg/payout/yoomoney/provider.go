@@ -0,0 +1,133 @@
+package yoomoney
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/mailru/easyjson"
+	"go.uber.org/zap"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Provider переводит деньги на кошелёк ЮMoney, привязанный к номеру телефона автора,
+// и возвращает платежи на кошелёк плательщика
+type Provider struct {
+	token      string
+	requestURL string
+	processURL string
+	client     *http.Client
+	logger     *zap.SugaredLogger
+}
+
+func NewProvider(token string, client *http.Client, logger *zap.SugaredLogger) *Provider {
+	return &Provider{
+		token:      token,
+		requestURL: models.RequestPaymentURL,
+		processURL: models.ProcessPaymentURL,
+		client:     client,
+		logger:     logger,
+	}
+}
+
+func (p *Provider) RequestPayout(ctx context.Context, payout models.Payout) (string, error) {
+	form := url.Values{}
+	form.Set("pattern_id", "p2p")
+	form.Set("to", payout.PhoneNumber)
+	form.Set("identifier_type", "phone")
+	form.Set("amount", payout.Money.String())
+	form.Set("comment", "Payment to authorID="+payout.CreatorID.String())
+	form.Set("message", "Payment from SubMe")
+	// по метке выплата находится в истории операций при сверке
+	form.Set("label", models.PaymentKindPayout+";"+payout.Id.String())
+
+	response, err := p.call(ctx, p.requestURL, form)
+	if err != nil {
+		return "", err
+	}
+	if response.Status != models.PaymentStatusSuccess {
+		return "", fmt.Errorf("%w: %s", models.PayoutRejected, response.Error)
+	}
+	return response.RequestID, nil
+}
+
+func (p *Provider) ProcessPayout(ctx context.Context, requestID string) error {
+	form := url.Values{}
+	form.Set("request_id", requestID)
+
+	response, err := p.call(ctx, p.processURL, form)
+	if err != nil {
+		return err
+	}
+	switch response.Status {
+	case models.PaymentStatusSuccess:
+		return nil
+	case models.PaymentStatusInProgress:
+		return models.PayoutInProgress
+	default:
+		return fmt.Errorf("%w: %s", models.PayoutRejected, response.Error)
+	}
+}
+
+// Refund возвращает платёж на кошелёк плательщика: request-payment и сразу process-payment.
+// По метке возврата перевод находит сверка, если его не удалось записать.
+func (p *Provider) Refund(ctx context.Context, refund models.Refund, account string) (string, error) {
+	form := url.Values{}
+	form.Set("pattern_id", "p2p")
+	form.Set("to", account)
+	form.Set("identifier_type", "account")
+	form.Set("amount", refund.Money.String())
+	form.Set("comment", "Refund of paymentID="+refund.PaymentId.String())
+	form.Set("message", "Refund from SubMe")
+	form.Set("label", models.PaymentKindRefund+";"+refund.PaymentId.String())
+
+	response, err := p.call(ctx, p.requestURL, form)
+	if err != nil {
+		return "", err
+	}
+	if response.Status != models.PaymentStatusSuccess {
+		return "", fmt.Errorf("%w: %s", models.PayoutRejected, response.Error)
+	}
+
+	form = url.Values{}
+	form.Set("request_id", response.RequestID)
+	if response, err = p.call(ctx, p.processURL, form); err != nil {
+		return "", err
+	}
+	switch response.Status {
+	case models.PaymentStatusSuccess:
+		return response.PaymentID, nil
+	case models.PaymentStatusInProgress:
+		return "", models.PayoutInProgress
+	default:
+		return "", fmt.Errorf("%w: %s", models.PayoutRejected, response.Error)
+	}
+}
+
+func (p *Provider) call(ctx context.Context, endpoint string, form url.Values) (models.PaymentResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return models.PaymentResponse{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+p.token)
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		p.logger.Error(err)
+		return models.PaymentResponse{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return models.PaymentResponse{}, fmt.Errorf("yoomoney responded with status %d", res.StatusCode)
+	}
+
+	var response models.PaymentResponse
+	if err = easyjson.UnmarshalFromReader(res.Body, &response); err != nil {
+		p.logger.Error(err)
+		return models.PaymentResponse{}, err
+	}
+	return response, nil
+}
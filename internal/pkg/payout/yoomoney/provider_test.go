@@ -0,0 +1,159 @@
+package yoomoney
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	provider := NewProvider("token", server.Client(), zap.NewNop().Sugar())
+	provider.requestURL = server.URL + "/request-payment"
+	provider.processURL = server.URL + "/process-payment"
+	return provider
+}
+
+func TestProvider_RequestPayout(t *testing.T) {
+	payout := models.Payout{Id: uuid.New(), CreatorID: uuid.New(), Money: models.NewMoney(12345), PhoneNumber: "+79999999999"}
+
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		expectedID  string
+		expectedErr error
+	}{
+		{
+			name:       "OK",
+			status:     http.StatusOK,
+			body:       `{"status":"success","request_id":"request"}`,
+			expectedID: "request",
+		},
+		{
+			name:        "Refused",
+			status:      http.StatusOK,
+			body:        `{"status":"refused","error":"illegal_param_to"}`,
+			expectedErr: models.PayoutRejected,
+		},
+		{
+			name:   "Unauthorized",
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/request-payment", r.URL.Path)
+				require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				require.NoError(t, r.ParseForm())
+				require.Equal(t, "+79999999999", r.PostForm.Get("to"))
+				require.Equal(t, "123.45", r.PostForm.Get("amount"))
+				require.Equal(t, "payout;"+payout.Id.String(), r.PostForm.Get("label"))
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			})
+
+			requestID, err := provider.RequestPayout(context.Background(), payout)
+			if test.status != http.StatusOK {
+				require.Error(t, err)
+				require.False(t, errors.Is(err, models.PayoutRejected))
+				return
+			}
+			require.ErrorIs(t, err, test.expectedErr)
+			require.Equal(t, test.expectedID, requestID)
+		})
+	}
+}
+
+func TestProvider_ProcessPayout(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectedErr error
+	}{
+		{name: "OK", body: `{"status":"success"}`},
+		{name: "In progress", body: `{"status":"in_progress"}`, expectedErr: models.PayoutInProgress},
+		{name: "Refused", body: `{"status":"refused","error":"not_enough_funds"}`, expectedErr: models.PayoutRejected},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/process-payment", r.URL.Path)
+				require.NoError(t, r.ParseForm())
+				require.Equal(t, "request", r.PostForm.Get("request_id"))
+				_, _ = w.Write([]byte(test.body))
+			})
+
+			require.ErrorIs(t, provider.ProcessPayout(context.Background(), "request"), test.expectedErr)
+		})
+	}
+}
+
+func TestProvider_Refund(t *testing.T) {
+	refund := models.Refund{PaymentId: uuid.New(), Money: models.NewMoney(5000)}
+
+	tests := []struct {
+		name        string
+		request     string
+		process     string
+		expectedID  string
+		expectedErr error
+	}{
+		{
+			name:       "OK",
+			request:    `{"status":"success","request_id":"request"}`,
+			process:    `{"status":"success","payment_id":"operation"}`,
+			expectedID: "operation",
+		},
+		{
+			name:        "Refused",
+			request:     `{"status":"refused","error":"illegal_param_to"}`,
+			expectedErr: models.PayoutRejected,
+		},
+		{
+			name:        "In progress",
+			request:     `{"status":"success","request_id":"request"}`,
+			process:     `{"status":"in_progress"}`,
+			expectedErr: models.PayoutInProgress,
+		},
+		{
+			name:        "Not enough funds",
+			request:     `{"status":"success","request_id":"request"}`,
+			process:     `{"status":"refused","error":"not_enough_funds"}`,
+			expectedErr: models.PayoutRejected,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, r.ParseForm())
+				if r.URL.Path == "/process-payment" {
+					require.Equal(t, "request", r.PostForm.Get("request_id"))
+					_, _ = w.Write([]byte(test.process))
+					return
+				}
+				require.Equal(t, "41001000040", r.PostForm.Get("to"))
+				require.Equal(t, "account", r.PostForm.Get("identifier_type"))
+				require.Equal(t, "50.00", r.PostForm.Get("amount"))
+				require.Equal(t, "refund;"+refund.PaymentId.String(), r.PostForm.Get("label"))
+				_, _ = w.Write([]byte(test.request))
+			})
+
+			operationID, err := provider.Refund(context.Background(), refund, "41001000040")
+			require.ErrorIs(t, err, test.expectedErr)
+			require.Equal(t, test.expectedID, operationID)
+		})
+	}
+}
@@ -1,13 +1,21 @@
 package http
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	generatedCommon "github.com/go-park-mail-ru/2023_1_4from5/internal/models/proto"
 	generatedAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/grpc/generated"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
 	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/imaging"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/media"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediainfo"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/token"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -15,8 +23,6 @@ import (
 	"go.uber.org/zap"
 	"io"
 	"net/http"
-	"os"
-	"path/filepath"
 )
 
 type PostHandler struct {
@@ -24,14 +30,18 @@ type PostHandler struct {
 	creatorClient   generatedCreator.CreatorServiceClient
 	logger          *zap.SugaredLogger
 	notificationApp notification.NotificationApp
+	store           blob.BlobStore
+	uploads         upload.UploadStore
 }
 
-func NewPostHandler(auc generatedAuth.AuthServiceClient, csc generatedCreator.CreatorServiceClient, logger *zap.SugaredLogger, app notification.NotificationApp) *PostHandler {
+func NewPostHandler(auc generatedAuth.AuthServiceClient, csc generatedCreator.CreatorServiceClient, logger *zap.SugaredLogger, app notification.NotificationApp, store blob.BlobStore, uploads upload.UploadStore) *PostHandler {
 	return &PostHandler{
 		authClient:      auc,
 		creatorClient:   csc,
 		logger:          logger,
 		notificationApp: app,
+		store:           store,
+		uploads:         uploads,
 	}
 }
 
@@ -82,6 +92,7 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 
 	postValues := r.MultipartForm.Value
 	var postData models.PostCreationData
+	var processed [][]imaging.Image
 
 	_, ok := postValues["creator"]
 	if !ok {
@@ -162,6 +173,7 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 		h.logger.Info(postFilesTmp)
 
 		postData.Attachments = make([]models.AttachmentData, len(postFilesTmp))
+		processed = make([][]imaging.Image, len(postFilesTmp))
 		for i, file := range postFilesTmp {
 			tmpFile, err := file.Open()
 			if err != nil {
@@ -180,7 +192,20 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 				utils.Response(w, http.StatusBadRequest, nil)
 				return
 			}
-			postData.Attachments[i].Type = http.DetectContentType(buf)
+			postData.Attachments[i].Size = int64(len(buf))
+			postData.Attachments[i].Type = media.DetectContentType(buf)
+			postData.Attachments[i].Filename = models.CleanFilename(file.Filename)
+			// подписи передаются в том же порядке, что и файлы
+			if altTexts := postValues["alt_text"]; i < len(altTexts) {
+				postData.Attachments[i].AltText = altTexts[i]
+			}
+			if captions := postValues["caption"]; i < len(captions) {
+				postData.Attachments[i].Caption = captions[i]
+			}
+			if !postData.Attachments[i].IsValid() {
+				utils.Response(w, http.StatusBadRequest, nil)
+				return
+			}
 
 			attachmentType, err := h.creatorClient.GetFileExtension(r.Context(), &generatedCreator.KeywordMessage{Keyword: postData.Attachments[i].Type})
 
@@ -195,10 +220,26 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 				return
 
 			}
+			if isTooLarge(attachmentType, int64(len(buf))) {
+				utils.Response(w, http.StatusRequestEntityTooLarge, nil)
+				return
+			}
+			if processed[i], err = processAttachment(&postData.Attachments[i], buf); err != nil {
+				h.attachmentError(w, err)
+				return
+			}
 			postData.Attachments[i].Id = uuid.New()
 		}
 	}
 
+	var attachmentsSize int64
+	for _, attach := range postData.Attachments {
+		attachmentsSize += attach.Size
+	}
+	if attachmentsSize > 0 && !h.checkStorageQuota(w, r, postData.Creator.String(), attachmentsSize) {
+		return
+	}
+
 	tmpSubs, ok := postValues["subscriptions"]
 	if ok {
 		postData.AvailableSubscriptions = make([]uuid.UUID, len(tmpSubs))
@@ -213,10 +254,8 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 	postData.Id = uuid.New()
 	var attachProto []*generatedCreator.Attachment
 	for _, attach := range postData.Attachments {
-		attachProto = append(attachProto, &generatedCreator.Attachment{
-			ID:   attach.Id.String(),
-			Type: attach.Type,
-		})
+		attachment := attach.ToAttachment()
+		attachProto = append(attachProto, attachment.ToProto())
 	}
 
 	var subsProto []string
@@ -239,6 +278,10 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if errMessage.Error == models.QuotaExceeded.Error() {
+		utils.Response(w, http.StatusRequestEntityTooLarge, errMessage.Error)
+		return
+	}
 	if errMessage.Error != "" {
 		_, _ = h.creatorClient.DeleteAttachmentsFiles(r.Context(), &generatedCreator.Attachments{Attachments: attachProto})
 		h.logger.Error(errMessage.Error)
@@ -283,30 +326,7 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 			utils.Response(w, http.StatusUnsupportedMediaType, nil)
 			return
 		}
-		f, err := os.Create(fmt.Sprintf("%s.%s", filepath.Join(models.FolderPath, attach.Id.String()), attachmentType.Extension))
-		if err != nil {
-			h.logger.Error(err)
-			errMessage, err = h.creatorClient.DeleteAttachmentsFiles(r.Context(), &generatedCreator.Attachments{Attachments: attachProto[:i]})
-
-			if err != nil {
-				h.logger.Error(err)
-				utils.Response(w, http.StatusInternalServerError, nil)
-				return
-			}
-
-			if errMessage.Error != "" {
-				utils.Response(w, http.StatusInternalServerError, nil)
-				return
-			}
-			_, err = h.creatorClient.DeletePost(r.Context(), &generatedCommon.UUIDMessage{Value: postData.Id.String()})
-			if err != nil {
-				h.logger.Error(err)
-			}
-			utils.Response(w, http.StatusInternalServerError, nil)
-			return
-		}
-
-		if _, err := io.Copy(f, attach.Data); err != nil {
+		if err = h.saveAttachment(r.Context(), attach, processed[i], attachmentType.Extension); err != nil {
 			h.logger.Error(err)
 			errMessage, err = h.creatorClient.DeleteAttachmentsFiles(r.Context(), &generatedCreator.Attachments{Attachments: attachProto[:i]})
 
@@ -328,7 +348,6 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 			utils.Response(w, http.StatusInternalServerError, nil)
 			return
 		}
-		_ = f.Close()
 	}
 
 	creatorInfo, err := h.creatorClient.CreatorNotificationInfo(r.Context(), &generatedCommon.UUIDMessage{Value: postData.Creator.String()})
@@ -874,8 +893,15 @@ func (h *PostHandler) AddAttach(w http.ResponseWriter, r *http.Request) {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
-	attach.Type = http.DetectContentType(buf)
+	attach.Type = media.DetectContentType(buf)
 	attach.Id = uuid.New()
+	attach.Filename = models.CleanFilename(postFilesTmp[0].Filename)
+	attach.AltText = r.FormValue("alt_text")
+	attach.Caption = r.FormValue("caption")
+	if !attach.IsValid() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
 
 	attachmentType, err := h.creatorClient.GetFileExtension(r.Context(), &generatedCreator.KeywordMessage{Keyword: attach.Type})
 	if err != nil {
@@ -887,26 +913,28 @@ func (h *PostHandler) AddAttach(w http.ResponseWriter, r *http.Request) {
 		utils.Response(w, http.StatusUnsupportedMediaType, nil)
 		return
 	}
-	f, err := os.Create(fmt.Sprintf("%s.%s", filepath.Join(models.FolderPath, attach.Id.String()), attachmentType.Extension))
+	if isTooLarge(attachmentType, int64(len(buf))) {
+		utils.Response(w, http.StatusRequestEntityTooLarge, nil)
+		return
+	}
+	if !h.checkUserStorageQuota(w, r, userDataJWT.Id, int64(len(buf))) {
+		return
+	}
+	images, err := processAttachment(&attach, buf)
 	if err != nil {
-		h.logger.Error(err)
-		utils.Response(w, http.StatusInternalServerError, nil)
+		h.attachmentError(w, err)
 		return
 	}
-
-	if _, err := io.Copy(f, attach.Data); err != nil {
+	if err = h.saveAttachment(r.Context(), attach, images, attachmentType.Extension); err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
-	_ = f.Close()
 
+	attachment := attach.ToAttachment()
 	out, err := h.creatorClient.AddAttach(r.Context(), &generatedCreator.PostAttachMessage{
-		PostID: postID.String(),
-		Attachment: &generatedCreator.Attachment{
-			ID:   attach.Id.String(),
-			Type: attach.Type,
-		},
+		PostID:     postID.String(),
+		Attachment: attachment.ToProto(),
 	})
 
 	if err != nil {
@@ -916,19 +944,213 @@ func (h *PostHandler) AddAttach(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if out.Error != "" {
-		var attachProto = []*generatedCreator.Attachment{{ID: attach.Id.String(), Type: attach.Type}}
+		var attachProto = []*generatedCreator.Attachment{attachment.ToProto()}
 		_, err = h.creatorClient.DeleteAttachmentsFiles(r.Context(), &generatedCreator.Attachments{Attachments: attachProto})
 
 		if err != nil {
 			h.logger.Error(err)
 		}
 
+		if out.Error == models.QuotaExceeded.Error() {
+			utils.Response(w, http.StatusRequestEntityTooLarge, out.Error)
+			return
+		}
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
 	utils.Response(w, http.StatusOK, nil)
 }
 
+// AddUpload прикрепляет к посту файл, загруженный по частям через /upload. Изображения обрабатываются
+// так же, как в AddAttach, остальные файлы переносятся в хранилище вложений без чтения в память.
+// nolint:gocognit
+func (h *PostHandler) AddUpload(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	uv, err := h.authClient.CheckUserVersion(r.Context(), &generatedAuth.AccessDetails{
+		Login:       userDataJWT.Login,
+		Id:          userDataJWT.Id.String(),
+		UserVersion: userDataJWT.UserVersion,
+	})
+	if err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if len(uv.Error) != 0 {
+		utils.Cookie(w, "", "SSID")
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+	if r.Method == http.MethodGet {
+		tokenCSRF, err := token.GetCSRFToken(models.User{Login: userDataJWT.Login, Id: userDataJWT.Id, UserVersion: userDataJWT.UserVersion})
+		if err != nil {
+			utils.Response(w, http.StatusUnauthorized, nil)
+			return
+		}
+		utils.ResponseWithCSRF(w, tokenCSRF)
+		return
+	}
+
+	userDataCSRF, err := token.ExtractCSRFTokenMetadata(r)
+	if err != nil || *userDataCSRF != *userDataJWT {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+
+	postID, err := uuid.Parse(mux.Vars(r)["post-uuid"])
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	uploadID, err := uuid.Parse(mux.Vars(r)["upload-uuid"])
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	isPostOwner, err := h.creatorClient.IsPostOwner(r.Context(), &generatedCreator.PostUserMessage{
+		UserID: userDataJWT.Id.String(),
+		PostID: postID.String(),
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if isPostOwner.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	if isPostOwner.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if !isPostOwner.Flag {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+
+	uploaded, err := h.uploads.Get(r.Context(), uploadID)
+	if errors.Is(err, models.NotFound) || err == nil && uploaded.UserId != userDataJWT.Id {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if !uploaded.IsComplete() {
+		utils.Response(w, http.StatusConflict, nil)
+		return
+	}
+
+	head, err := h.uploads.ReadHead(r.Context(), uploaded, 512)
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	attach := models.AttachmentData{
+		Id:   uuid.New(),
+		Type: media.DetectContentType(head),
+	}
+	attach.Size = uploaded.Length
+	attach.Filename = models.CleanFilename(uploaded.Metadata[models.UploadMetadataFilename])
+	attach.AltText = uploaded.Metadata[models.UploadMetadataAltText]
+	attach.Caption = uploaded.Metadata[models.UploadMetadataCaption]
+	if !attach.IsValid() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	attachmentType, err := h.creatorClient.GetFileExtension(r.Context(), &generatedCreator.KeywordMessage{Keyword: attach.Type})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if !attachmentType.Flag {
+		utils.Response(w, http.StatusUnsupportedMediaType, nil)
+		return
+	}
+	if isTooLarge(attachmentType, attach.Size) {
+		utils.Response(w, http.StatusRequestEntityTooLarge, nil)
+		return
+	}
+	if !h.checkUserStorageQuota(w, r, userDataJWT.Id, attach.Size) {
+		return
+	}
+
+	var images []imaging.Image
+	if isProcessedImage(attach.Type) {
+		// изображение обрабатывается целиком в памяти, поэтому для него действует прежнее ограничение
+		if uploaded.Length > int64(models.MaxFileSize) {
+			utils.Response(w, http.StatusRequestEntityTooLarge, nil)
+			return
+		}
+		body, err := h.uploads.Open(r.Context(), uploaded)
+		if err != nil {
+			h.logger.Error(err)
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+		buf, err := io.ReadAll(body)
+		_ = body.Close()
+		if err != nil {
+			h.logger.Error(err)
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+		if images, err = processAttachment(&attach, buf); err != nil {
+			h.attachmentError(w, err)
+			return
+		}
+		err = h.saveAttachment(r.Context(), attach, images, attachmentType.Extension)
+	} else {
+		err = h.saveStream(r.Context(), &attach, attachmentType.Extension, func() (io.ReadCloser, error) {
+			return h.uploads.Open(r.Context(), uploaded)
+		})
+	}
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	attachment := attach.ToAttachment()
+	out, err := h.creatorClient.AddAttach(r.Context(), &generatedCreator.PostAttachMessage{
+		PostID:     postID.String(),
+		Attachment: attachment.ToProto(),
+	})
+	if err == nil && out.Error != "" {
+		err = errors.New(out.Error)
+	}
+	if err != nil {
+		h.logger.Error(err)
+		_, deleteErr := h.creatorClient.DeleteAttachmentsFiles(r.Context(), &generatedCreator.Attachments{Attachments: []*generatedCreator.Attachment{attachment.ToProto()}})
+		if deleteErr != nil {
+			h.logger.Error(deleteErr)
+		}
+		// загрузка остаётся, её можно прикрепить снова, когда освободится место
+		if err.Error() == models.QuotaExceeded.Error() {
+			utils.Response(w, http.StatusRequestEntityTooLarge, err.Error())
+			return
+		}
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if err = h.uploads.Delete(r.Context(), uploaded.Id); err != nil {
+		// файл уже во вложениях, остатки загрузки удалит задача очистки
+		h.logger.Error(err)
+	}
+	utils.Response(w, http.StatusOK, attachment)
+}
+
 func (h *PostHandler) DeleteAttach(w http.ResponseWriter, r *http.Request) {
 	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
 	if err != nil {
@@ -1039,3 +1261,168 @@ func (h *PostHandler) DeleteAttach(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.Response(w, http.StatusOK, nil)
 }
+
+// processAttachment заполняет сведения о файле вложения, для изображения проверяет его и готовит размеры.
+// Сведения из заголовков аудио и видео необязательны: если их не удалось прочитать, файл всё равно принимается.
+func processAttachment(attach *models.AttachmentData, data []byte) ([]imaging.Image, error) {
+	attach.Size = int64(len(data))
+	attach.Checksum = mediainfo.Checksum(data)
+	attach.BlobId = attach.Checksum
+	if !isProcessedImage(attach.Type) {
+		if info, err := mediainfo.Probe(bytes.NewReader(data), attach.Size, attach.Type); err == nil {
+			attach.Width, attach.Height, attach.Duration = info.Width, info.Height, info.Duration
+		}
+		return nil, nil
+	}
+
+	images, err := imaging.Process(bytes.NewReader(data), imaging.AttachmentSpecs, imaging.Options{})
+	if err != nil {
+		return nil, err
+	}
+	attach.Renditions = nil
+	for _, img := range images {
+		attach.Renditions = append(attach.Renditions, img.Rendition)
+	}
+	if original, ok := models.FindRendition(attach.Renditions, models.RenditionOriginal); ok {
+		attach.Width, attach.Height = original.Width, original.Height
+	}
+	return images, nil
+}
+
+// saveAttachment сохраняет размеры обработанного изображения или сам файл вложения.
+// Файлы лежат под контрольной суммой содержимого, поэтому уже сохранённый файл повторно не записывается.
+func (h *PostHandler) saveAttachment(ctx context.Context, attach models.AttachmentData, images []imaging.Image, extension string) error {
+	key := models.AttachmentKey(attach.BlobId, extension)
+	if images != nil {
+		// размеры сохраняются по порядку, поэтому последний из них есть, только если сохранены все
+		key = images[len(images)-1].Rendition.Key(attach.BlobId)
+	}
+	if exists, err := h.holdBlob(ctx, attach.BlobId, key); err != nil || exists {
+		return err
+	}
+	if images != nil {
+		_, err := imaging.Save(ctx, h.store, attach.BlobId, images)
+		return err
+	}
+	return h.store.Put(ctx, key, attach.Data, attach.Size, attach.Type)
+}
+
+// holdBlob не даёт удалить файл с содержимым blobID, пока вложение на него ещё не сослалось, и проверяет,
+// сохранён ли уже файл key. Без этого файл, который решили не записывать повторно, мог быть удалён
+// вместе с последним ссылавшимся на него вложением.
+func (h *PostHandler) holdBlob(ctx context.Context, blobID string, key string) (bool, error) {
+	out, err := h.creatorClient.HoldBlob(ctx, &generatedCreator.KeywordMessage{Keyword: blobID})
+	if err != nil {
+		return false, err
+	}
+	if out.Error != "" {
+		return false, errors.New(out.Error)
+	}
+	_, err = h.store.Stat(ctx, key)
+	if errors.Is(err, models.NotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// isProcessedImage - изображение, которое сохраняется в обработанных размерах.
+// GIF сохраняется как есть: после обработки от анимации остался бы один кадр.
+func isProcessedImage(contentType string) bool {
+	return imaging.IsImage(contentType) && contentType != "image/gif"
+}
+
+// checkStorageQuota проверяет, что ещё size байт вложений поместятся в хранилище автора, до того как файлы
+// обрабатываются и сохраняются. Окончательно квота проверяется при добавлении вложения в базу.
+// Если нет или проверить это не удалось, ответ уже записан и возвращается false.
+func (h *PostHandler) checkStorageQuota(w http.ResponseWriter, r *http.Request, creatorID string, size int64) bool {
+	out, err := h.creatorClient.CheckStorageQuota(r.Context(), &generatedCreator.StorageQuotaMessage{
+		CreatorID: creatorID,
+		Size:      size,
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return false
+	}
+	if out.Error == models.QuotaExceeded.Error() {
+		utils.Response(w, http.StatusRequestEntityTooLarge, out.Error)
+		return false
+	}
+	if out.Error != "" {
+		h.logger.Error(out.Error)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return false
+	}
+	return true
+}
+
+// checkUserStorageQuota - checkStorageQuota для автора, которым является пользователь
+func (h *PostHandler) checkUserStorageQuota(w http.ResponseWriter, r *http.Request, userID uuid.UUID, size int64) bool {
+	creatorID, err := h.creatorClient.CheckIfCreator(r.Context(), &generatedCommon.UUIDMessage{Value: userID.String()})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return false
+	}
+	if creatorID.Error != "" {
+		h.logger.Error(creatorID.Error)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return false
+	}
+	return h.checkStorageQuota(w, r, creatorID.Value, size)
+}
+
+// isTooLarge - файл больше, чем допускается для его типа
+func isTooLarge(attachmentType *generatedCreator.Extension, size int64) bool {
+	return attachmentType.MaxSize > 0 && size > attachmentType.MaxSize
+}
+
+// saveStream сохраняет файл вложения, который слишком велик, чтобы читать его в память.
+// Ключ зависит от контрольной суммы, поэтому файл читается дважды: сначала для подсчёта суммы,
+// потом для сохранения, если такого файла ещё нет. Заголовки читаются уже из хранилища.
+func (h *PostHandler) saveStream(ctx context.Context, attach *models.AttachmentData, extension string, open func() (io.ReadCloser, error)) error {
+	body, err := open()
+	if err != nil {
+		return err
+	}
+	data := mediainfo.NewChecksumReader(body)
+	_, err = io.Copy(io.Discard, data)
+	_ = body.Close()
+	if err != nil {
+		return err
+	}
+	attach.Checksum = data.Sum()
+	attach.BlobId = attach.Checksum
+
+	key := models.AttachmentKey(attach.BlobId, extension)
+	exists, err := h.holdBlob(ctx, attach.BlobId, key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if body, err = open(); err != nil {
+			return err
+		}
+		err = h.store.Put(ctx, key, body, attach.Size, attach.Type)
+		_ = body.Close()
+		if err != nil {
+			return err
+		}
+	}
+	info, err := mediainfo.Probe(blob.NewReader(ctx, h.store, key, attach.Size), attach.Size, attach.Type)
+	if err != nil {
+		h.logger.Info(err)
+		return nil
+	}
+	attach.Width, attach.Height, attach.Duration = info.Width, info.Height, info.Duration
+	return nil
+}
+
+func (h *PostHandler) attachmentError(w http.ResponseWriter, err error) {
+	if errors.Is(err, models.WrongData) || errors.Is(err, models.Unsupported) {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	h.logger.Error(err)
+	utils.Response(w, http.StatusInternalServerError, nil)
+}
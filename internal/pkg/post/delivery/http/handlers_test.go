@@ -11,19 +11,24 @@ import (
 	generatedAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/grpc/generated"
 	mockAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/mocks"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/usecase"
+	mockBlob "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/mocks"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
 	mockCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/mocks"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/mediainfo"
 	mockNotification "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/mocks"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/token"
+	mockUpload "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -62,6 +67,7 @@ func TestNewPostHandler(t *testing.T) {
 	authClient := mockAuth.NewMockAuthServiceClient(ctl)
 	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
 	notify := mockNotification.NewMockNotificationApp(ctl)
+	store := mockBlob.NewMockBlobStore(ctl)
 	logger, err := zap.NewProduction()
 	if err != nil {
 		t.Error(err.Error())
@@ -74,8 +80,10 @@ func TestNewPostHandler(t *testing.T) {
 	}(logger)
 	zapSugar := logger.Sugar()
 
-	testHandler := NewPostHandler(authClient, creatorClient, zapSugar, notify)
-	if testHandler.authClient != authClient || testHandler.creatorClient != creatorClient || testHandler.notificationApp != notify {
+	uploads := mockUpload.NewMockUploadStore(ctl)
+
+	testHandler := NewPostHandler(authClient, creatorClient, zapSugar, notify, store, uploads)
+	if testHandler.authClient != authClient || testHandler.creatorClient != creatorClient || testHandler.notificationApp != notify || testHandler.store != store || testHandler.uploads != uploads {
 		t.Error("bad constructor")
 	}
 }
@@ -1539,3 +1547,248 @@ func TestPostHandler_GetPost(t *testing.T) {
 		})
 	}
 }*/
+
+func TestPostHandler_AddUpload(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	os.Setenv("TOKEN_SECRET", "TEST")
+	os.Setenv("CSRF_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	id := uuid.New()
+	bdy, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: id})
+	tokenCSRF, _ := token.GetCSRFToken(models.User{Login: testUser.Login, Id: id})
+
+	authClient := mockAuth.NewMockAuthServiceClient(ctl)
+	creatorClient := mockCreator.NewMockCreatorServiceClient(ctl)
+	store := mockBlob.NewMockBlobStore(ctl)
+	uploads := mockUpload.NewMockUploadStore(ctl)
+
+	postID := uuid.New()
+	complete := models.Upload{Id: uuid.New(), UserId: id, Length: 4, Offset: 4}
+	video := []byte("\x00\x00\x00\x18ftypmp42")
+
+	request := func(upload models.Upload) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/api/post/addUpload/"+postID.String()+"/"+upload.Id.String(), nil)
+		r = mux.SetURLVars(r, map[string]string{"post-uuid": postID.String(), "upload-uuid": upload.Id.String()})
+		setJWTToken(r, bdy)
+		setCSRFToken(r, tokenCSRF)
+		authClient.EXPECT().CheckUserVersion(gomock.Any(), gomock.Any()).Return(&generatedAuth.UserVersion{UserVersion: 0}, nil)
+		return r
+	}
+	owner := func() {
+		creatorClient.EXPECT().IsPostOwner(gomock.Any(), gomock.Any()).Return(&generated.FlagMessage{Flag: true}, nil)
+	}
+	creatorID := uuid.New()
+	quota := func(err string) {
+		creatorClient.EXPECT().CheckIfCreator(gomock.Any(), &generatedCommon.UUIDMessage{Value: id.String()}).
+			Return(&generatedCommon.UUIDResponse{Value: creatorID.String()}, nil)
+		creatorClient.EXPECT().CheckStorageQuota(gomock.Any(), &generated.StorageQuotaMessage{CreatorID: creatorID.String(), Size: complete.Length}).
+			Return(&generatedCommon.Empty{Error: err}, nil)
+	}
+	// файл читается дважды: для контрольной суммы и для сохранения
+	open := func(times int) {
+		uploads.EXPECT().Open(gomock.Any(), complete).DoAndReturn(func(context.Context, models.Upload) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(video)), nil
+		}).Times(times)
+	}
+	// файл с тем же содержимым держится до того, как решить, записывать ли его
+	hold := func(err string) {
+		creatorClient.EXPECT().HoldBlob(gomock.Any(), &generated.KeywordMessage{Keyword: mediainfo.Checksum(video)}).
+			Return(&generatedCommon.Empty{Error: err}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		mock           func() *http.Request
+		expectedStatus int
+	}{
+		{
+			name: "OK",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(complete, nil)
+				uploads.EXPECT().ReadHead(gomock.Any(), complete, int64(512)).Return(video, nil)
+				creatorClient.EXPECT().GetFileExtension(gomock.Any(), gomock.Any()).Return(&generated.Extension{Extension: "mp4", Flag: true}, nil)
+				quota("")
+				open(2)
+				hold("")
+				store.EXPECT().Stat(gomock.Any(), models.AttachmentKey(mediainfo.Checksum(video), "mp4")).Return(models.BlobInfo{}, models.NotFound)
+				store.EXPECT().Put(gomock.Any(), models.AttachmentKey(mediainfo.Checksum(video), "mp4"), gomock.Any(), complete.Length, gomock.Any()).Return(nil)
+				creatorClient.EXPECT().AddAttach(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+				uploads.EXPECT().Delete(gomock.Any(), complete.Id).Return(nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Same file already stored",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(complete, nil)
+				uploads.EXPECT().ReadHead(gomock.Any(), complete, int64(512)).Return(video, nil)
+				creatorClient.EXPECT().GetFileExtension(gomock.Any(), gomock.Any()).Return(&generated.Extension{Extension: "mp4", Flag: true}, nil)
+				quota("")
+				open(1)
+				hold("")
+				store.EXPECT().Stat(gomock.Any(), models.AttachmentKey(mediainfo.Checksum(video), "mp4")).Return(models.BlobInfo{Size: complete.Length}, nil)
+				creatorClient.EXPECT().AddAttach(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+				uploads.EXPECT().Delete(gomock.Any(), complete.Id).Return(nil)
+				return r
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not post owner",
+			mock: func() *http.Request {
+				r := request(complete)
+				creatorClient.EXPECT().IsPostOwner(gomock.Any(), gomock.Any()).Return(&generated.FlagMessage{Flag: false}, nil)
+				return r
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Other user upload",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				other := complete
+				other.UserId = uuid.New()
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(other, nil)
+				return r
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "Incomplete upload",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				incomplete := complete
+				incomplete.Offset = 2
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(incomplete, nil)
+				return r
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "Alt text too long",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				described := complete
+				described.Metadata = map[string]string{models.UploadMetadataAltText: strings.Repeat("a", models.MaxAltTextLength+1)}
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(described, nil)
+				uploads.EXPECT().ReadHead(gomock.Any(), described, int64(512)).Return(video, nil)
+				return r
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Unsupported type",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(complete, nil)
+				uploads.EXPECT().ReadHead(gomock.Any(), complete, int64(512)).Return([]byte("text"), nil)
+				creatorClient.EXPECT().GetFileExtension(gomock.Any(), gomock.Any()).Return(&generated.Extension{Flag: false}, nil)
+				return r
+			},
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name: "Too large for type",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(complete, nil)
+				uploads.EXPECT().ReadHead(gomock.Any(), complete, int64(512)).Return(video, nil)
+				creatorClient.EXPECT().GetFileExtension(gomock.Any(), gomock.Any()).Return(&generated.Extension{Extension: "mp4", Flag: true, MaxSize: complete.Length - 1}, nil)
+				return r
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "Over quota",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(complete, nil)
+				uploads.EXPECT().ReadHead(gomock.Any(), complete, int64(512)).Return(video, nil)
+				creatorClient.EXPECT().GetFileExtension(gomock.Any(), gomock.Any()).Return(&generated.Extension{Extension: "mp4", Flag: true}, nil)
+				quota(models.QuotaExceeded.Error())
+				return r
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "Hold error",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(complete, nil)
+				uploads.EXPECT().ReadHead(gomock.Any(), complete, int64(512)).Return(video, nil)
+				creatorClient.EXPECT().GetFileExtension(gomock.Any(), gomock.Any()).Return(&generated.Extension{Extension: "mp4", Flag: true}, nil)
+				quota("")
+				open(1)
+				hold(models.InternalError.Error())
+				return r
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "AddAttach error",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(complete, nil)
+				uploads.EXPECT().ReadHead(gomock.Any(), complete, int64(512)).Return(video, nil)
+				creatorClient.EXPECT().GetFileExtension(gomock.Any(), gomock.Any()).Return(&generated.Extension{Extension: "mp4", Flag: true}, nil)
+				quota("")
+				open(2)
+				hold("")
+				store.EXPECT().Stat(gomock.Any(), models.AttachmentKey(mediainfo.Checksum(video), "mp4")).Return(models.BlobInfo{}, models.NotFound)
+				store.EXPECT().Put(gomock.Any(), models.AttachmentKey(mediainfo.Checksum(video), "mp4"), gomock.Any(), complete.Length, gomock.Any()).Return(nil)
+				creatorClient.EXPECT().AddAttach(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{Error: models.InternalError.Error()}, nil)
+				creatorClient.EXPECT().DeleteAttachmentsFiles(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+				return r
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Quota exceeded by concurrent upload",
+			mock: func() *http.Request {
+				r := request(complete)
+				owner()
+				uploads.EXPECT().Get(gomock.Any(), complete.Id).Return(complete, nil)
+				uploads.EXPECT().ReadHead(gomock.Any(), complete, int64(512)).Return(video, nil)
+				creatorClient.EXPECT().GetFileExtension(gomock.Any(), gomock.Any()).Return(&generated.Extension{Extension: "mp4", Flag: true}, nil)
+				quota("")
+				open(1)
+				hold("")
+				store.EXPECT().Stat(gomock.Any(), models.AttachmentKey(mediainfo.Checksum(video), "mp4")).Return(models.BlobInfo{Size: complete.Length}, nil)
+				creatorClient.EXPECT().AddAttach(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{Error: models.QuotaExceeded.Error()}, nil)
+				creatorClient.EXPECT().DeleteAttachmentsFiles(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+				return r
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &PostHandler{
+				authClient:    authClient,
+				creatorClient: creatorClient,
+				logger:        zap.NewNop().Sugar(),
+				store:         store,
+				uploads:       uploads,
+			}
+			w := httptest.NewRecorder()
+			h.AddUpload(w, test.mock())
+			require.Equal(t, test.expectedStatus, w.Code, fmt.Errorf("%s :  expected %d, got %d",
+				test.name, test.expectedStatus, w.Code))
+		})
+	}
+}
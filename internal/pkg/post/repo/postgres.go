@@ -12,8 +12,10 @@ import (
 
 const (
 	InsertPost                 = `INSERT INTO "post"(post_id, creator_id, title, post_text) VALUES($1, $2, $3, $4);`
-	InsertAttach               = `INSERT INTO "attachment"(attachment_id, post_id, attachment_type) VALUES($1, $2, $3);`
+	InsertAttach               = `INSERT INTO "attachment"(attachment_id, post_id, attachment_type, renditions, size, checksum, filename, width, height, duration, alt_text, caption, blob_id, scan_status) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NULLIF($13, ''), coalesce(NULLIF($14, ''), 'pending'));`
 	IncPostCount               = `UPDATE "creator" SET posts_count = posts_count+1 WHERE creator_id = $1;`
+	LockStorageQuota           = `SELECT sp.quota FROM "creator" c JOIN "storage_plan" sp ON sp.name = c.storage_plan WHERE c.creator_id = $1 FOR UPDATE OF c`
+	StorageUsed                = `SELECT coalesce(sum(bytes), 0) FROM "creator_storage" WHERE creator_id = $1`
 	UpdatePostInfo             = `UPDATE "post" SET title = $1, post_text = $2 WHERE post_id = $3;`
 	DeletePostSubscriptions    = `DELETE FROM "post_subscription" WHERE post_id = $1;`
 	AddSubscriptionsToPost     = `INSERT INTO "post_subscription"(post_id, subscription_id) VALUES($1,$2);`
@@ -29,7 +31,7 @@ const (
 	IsPostAvailableWithSub     = `SELECT user_id FROM "user_subscription" INNER JOIN "post_subscription" p on "user_subscription".subscription_id = p.subscription_id WHERE user_id = $1 AND post_id = $2 AND expire_date > now()`
 	IsPostAvailableForEveryone = `SELECT post_id FROM post_subscription WHERE post_id = $1`
 	IsCreator                  = `SELECT user_id FROM "creator" WHERE creator_id = $1;`
-	GetPost                    = `SELECT "post".post_id, "post".creator_id, creation_date, title, post_text, likes_count, "post".comments_count, array_agg(attachment_id), array_agg(attachment_type), array_agg(DISTINCT subscription_id) FROM "post" LEFT JOIN "attachment" a on "post".post_id = a.post_id LEFT JOIN "post_subscription" ps on "post".post_id = ps.post_id WHERE "post".post_id = $1 GROUP BY "post".post_id, creation_date, title, post_text;`
+	GetPost                    = `SELECT "post".post_id, "post".creator_id, creation_date, title, post_text, likes_count, "post".comments_count, array_agg(attachment_id), array_agg(attachment_type), array_agg(a.renditions::text), array_agg(json_build_object('size', a.size, 'checksum', a.checksum, 'filename', a.filename, 'width', a.width, 'height', a.height, 'duration', a.duration, 'alt_text', a.alt_text, 'caption', a.caption, 'downloads', a.downloads, 'scan_status', a.scan_status)::text), array_agg(DISTINCT subscription_id) FROM "post" LEFT JOIN "attachment" a on "post".post_id = a.post_id LEFT JOIN "post_subscription" ps on "post".post_id = ps.post_id WHERE "post".post_id = $1 GROUP BY "post".post_id, creation_date, title, post_text;`
 	GetSubInfo                 = `SELECT creator_id, month_cost, title, description FROM "subscription" WHERE subscription_id = $1;`
 	GetComments                = `SELECT comment_id, u.user_id, u.display_name, u.profile_photo, c.post_id, c.comment_text, c.creation_date, c.likes_count FROM comment c JOIN "user" u on c.user_id = u.user_id WHERE post_id = $1;`
 	IsLikedComment             = `SELECT comment_id FROM "like_comment" WHERE comment_id = $1 AND user_id = $2;`
@@ -74,6 +76,7 @@ func (r *PostRepo) IsPostAvailable(ctx context.Context, userID, postID uuid.UUID
 	return nil
 }
 
+// CreatePost создаёт пост с вложениями. Если вложения не помещаются в квоту тарифа автора - models.QuotaExceeded.
 func (r *PostRepo) CreatePost(ctx context.Context, postData models.PostCreationData) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -81,6 +84,17 @@ func (r *PostRepo) CreatePost(ctx context.Context, postData models.PostCreationD
 		return models.InternalError
 	}
 
+	var size int64
+	for _, attach := range postData.Attachments {
+		size += attach.Size
+	}
+	if size > 0 {
+		if err = r.checkStorageQuota(ctx, tx, postData.Creator, size); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
 	row, err := tx.QueryContext(ctx, InsertPost, postData.Id, postData.Creator, postData.Title, postData.Text)
 	if err != nil {
 		_ = tx.Rollback()
@@ -93,7 +107,14 @@ func (r *PostRepo) CreatePost(ctx context.Context, postData models.PostCreationD
 	}
 
 	for _, attach := range postData.Attachments {
-		if _, err = tx.ExecContext(ctx, InsertAttach, attach.Id, postData.Id, attach.Type); err != nil {
+		renditions, err := models.RenditionsJSON(attach.Renditions)
+		if err != nil {
+			_ = tx.Rollback()
+			r.logger.Error(err)
+			return models.InternalError
+		}
+		if _, err = tx.ExecContext(ctx, InsertAttach, attach.Id, postData.Id, attach.Type, renditions, attach.Size,
+			attach.Checksum, attach.Filename, attach.Width, attach.Height, attach.Duration, attach.AltText, attach.Caption, attach.BlobId, attach.ScanStatus); err != nil {
 			_ = tx.Rollback()
 			r.logger.Error(err)
 			return models.InternalError
@@ -122,6 +143,28 @@ func (r *PostRepo) CreatePost(ctx context.Context, postData models.PostCreationD
 	return nil
 }
 
+// checkStorageQuota блокирует запись автора до конца транзакции и проверяет, что ещё size байт
+// поместятся в квоту: одновременные загрузки того же автора ждут блокировку и видят уже добавленные вложения
+func (r *PostRepo) checkStorageQuota(ctx context.Context, tx *sql.Tx, creatorID uuid.UUID, size int64) error {
+	var usage models.StorageUsage
+	row := tx.QueryRowContext(ctx, LockStorageQuota, creatorID)
+	if err := row.Scan(&usage.Quota); errors.Is(err, sql.ErrNoRows) {
+		return models.WrongData
+	} else if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	row = tx.QueryRowContext(ctx, StorageUsed, creatorID)
+	if err := row.Scan(&usage.Used); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	if !usage.Fits(size) {
+		return models.QuotaExceeded
+	}
+	return nil
+}
+
 func (r *PostRepo) GetSubsByID(ctx context.Context, subsIDs ...uuid.UUID) ([]models.Subscription, error) {
 	subsInfo := make([]models.Subscription, 0)
 	for i, v := range subsIDs {
@@ -187,10 +230,12 @@ func (r *PostRepo) GetPost(ctx context.Context, postID, userID uuid.UUID) (model
 	var postTextTmp sql.NullString
 	attachs := make([]uuid.UUID, 0)
 	types := make([]sql.NullString, 0)
+	renditions := make([]sql.NullString, 0)
+	metadata := make([]sql.NullString, 0)
 	subs := make([]uuid.UUID, 0)
 	row := r.db.QueryRowContext(ctx, GetPost, postID)
 	err := row.Scan(&post.Id, &post.Creator, &post.Creation, &post.Title,
-		&postTextTmp, &post.LikesCount, &post.CommentsCount, pq.Array(&attachs), pq.Array(&types), pq.Array(&subs)) //подписки, при которыз пост доступен
+		&postTextTmp, &post.LikesCount, &post.CommentsCount, pq.Array(&attachs), pq.Array(&types), pq.Array(&renditions), pq.Array(&metadata), pq.Array(&subs)) //подписки, при которыз пост доступен
 	if err != nil && errors.Is(sql.ErrNoRows, err) {
 		return models.Post{}, models.WrongData
 	}
@@ -210,9 +255,21 @@ func (r *PostRepo) GetPost(ctx context.Context, postID, userID uuid.UUID) (model
 		if v == uuid.Nil {
 			continue
 		}
+		attachRenditions, err := models.ParseRenditions(renditions[i].String)
+		if err != nil {
+			r.logger.Error(err)
+			return models.Post{}, models.InternalError
+		}
+		attachMetadata, err := models.ParseAttachmentMetadata(metadata[i].String)
+		if err != nil {
+			r.logger.Error(err)
+			return models.Post{}, models.InternalError
+		}
 		post.Attachments = append(post.Attachments, models.Attachment{
-			Id:   v,
-			Type: types[i].String,
+			Id:                 v,
+			Type:               types[i].String,
+			Renditions:         attachRenditions,
+			AttachmentMetadata: attachMetadata,
 		})
 	}
 	post.Subscriptions, err = r.GetSubsByID(ctx, subs...)
@@ -50,6 +50,19 @@ func (u *PostUsecase) GetPost(ctx context.Context, postID, userID uuid.UUID) (mo
 		postWithComments.Post.Text = ""
 		postWithComments.Comments = nil
 	}
+	// число скачиваний и вложения, не прошедшие проверку, видит только автор
+	if len(postWithComments.Post.Attachments) != 0 {
+		isOwner, err := u.repo.IsPostOwner(ctx, userID, postID)
+		if err != nil {
+			return models.PostWithComments{}, err
+		}
+		if !isOwner {
+			postWithComments.Post.Attachments = models.ScannedAttachments(postWithComments.Post.Attachments)
+			for i := range postWithComments.Post.Attachments {
+				postWithComments.Post.Attachments[i].Downloads = 0
+			}
+		}
+	}
 
 	postWithComments.Post.IsAvailable = isAvailable
 	return postWithComments, nil
@@ -0,0 +1,22 @@
+package reconciliation
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/reconciliation_mock.go -package=mock
+
+type ReconciliationUsecase interface {
+	Reconcile(ctx context.Context, from, to time.Time, fix bool) (models.ReconciliationReport, error)
+}
+
+type ReconciliationRepo interface {
+	LocalOperations(ctx context.Context, from, to time.Time) ([]models.LocalOperation, error)
+}
+
+// PaymentReplayer повторно проводит входящий платёж, уведомление о котором потерялось или упало на полпути
+type PaymentReplayer interface {
+	Replay(ctx context.Context, event models.PaymentEvent) error
+}
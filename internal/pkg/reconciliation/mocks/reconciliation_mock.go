@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReconciliationUsecase is a mock of ReconciliationUsecase interface.
+type MockReconciliationUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationUsecaseMockRecorder
+}
+
+// MockReconciliationUsecaseMockRecorder is the mock recorder for MockReconciliationUsecase.
+type MockReconciliationUsecaseMockRecorder struct {
+	mock *MockReconciliationUsecase
+}
+
+// NewMockReconciliationUsecase creates a new mock instance.
+func NewMockReconciliationUsecase(ctrl *gomock.Controller) *MockReconciliationUsecase {
+	mock := &MockReconciliationUsecase{ctrl: ctrl}
+	mock.recorder = &MockReconciliationUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationUsecase) EXPECT() *MockReconciliationUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockReconciliationUsecase) Reconcile(ctx context.Context, from, to time.Time, fix bool) (models.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, from, to, fix)
+	ret0, _ := ret[0].(models.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReconciliationUsecaseMockRecorder) Reconcile(ctx, from, to, fix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReconciliationUsecase)(nil).Reconcile), ctx, from, to, fix)
+}
+
+// MockReconciliationRepo is a mock of ReconciliationRepo interface.
+type MockReconciliationRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationRepoMockRecorder
+}
+
+// MockReconciliationRepoMockRecorder is the mock recorder for MockReconciliationRepo.
+type MockReconciliationRepoMockRecorder struct {
+	mock *MockReconciliationRepo
+}
+
+// NewMockReconciliationRepo creates a new mock instance.
+func NewMockReconciliationRepo(ctrl *gomock.Controller) *MockReconciliationRepo {
+	mock := &MockReconciliationRepo{ctrl: ctrl}
+	mock.recorder = &MockReconciliationRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationRepo) EXPECT() *MockReconciliationRepoMockRecorder {
+	return m.recorder
+}
+
+// LocalOperations mocks base method.
+func (m *MockReconciliationRepo) LocalOperations(ctx context.Context, from, to time.Time) ([]models.LocalOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocalOperations", ctx, from, to)
+	ret0, _ := ret[0].([]models.LocalOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocalOperations indicates an expected call of LocalOperations.
+func (mr *MockReconciliationRepoMockRecorder) LocalOperations(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalOperations", reflect.TypeOf((*MockReconciliationRepo)(nil).LocalOperations), ctx, from, to)
+}
+
+// MockPaymentReplayer is a mock of PaymentReplayer interface.
+type MockPaymentReplayer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentReplayerMockRecorder
+}
+
+// MockPaymentReplayerMockRecorder is the mock recorder for MockPaymentReplayer.
+type MockPaymentReplayerMockRecorder struct {
+	mock *MockPaymentReplayer
+}
+
+// NewMockPaymentReplayer creates a new mock instance.
+func NewMockPaymentReplayer(ctrl *gomock.Controller) *MockPaymentReplayer {
+	mock := &MockPaymentReplayer{ctrl: ctrl}
+	mock.recorder = &MockPaymentReplayerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentReplayer) EXPECT() *MockPaymentReplayerMockRecorder {
+	return m.recorder
+}
+
+// Replay mocks base method.
+func (m *MockPaymentReplayer) Replay(ctx context.Context, event models.PaymentEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockPaymentReplayerMockRecorder) Replay(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockPaymentReplayer)(nil).Replay), ctx, event)
+}
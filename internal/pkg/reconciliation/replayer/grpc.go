@@ -0,0 +1,103 @@
+package replayer
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
+	"github.com/google/uuid"
+)
+
+// Replayer проводит платёж через сервис пользователей так же, как вебхук платёжной системы:
+// событие захватывается по operation_id, поэтому одновременно пришедшее уведомление не проведёт его второй раз
+type Replayer struct {
+	userClient generatedUser.UserServiceClient
+}
+
+func NewReplayer(userClient generatedUser.UserServiceClient) *Replayer {
+	return &Replayer{userClient: userClient}
+}
+
+func (r *Replayer) Replay(ctx context.Context, event models.PaymentEvent) error {
+	claim, err := r.userClient.ClaimPaymentEvent(ctx, event.ToProto())
+	if err != nil {
+		return err
+	}
+	if claim.Error != "" {
+		return errors.New(claim.Error)
+	}
+	if claim.Status == models.PaymentEventProcessed {
+		return nil
+	}
+	if claim.Status != models.PaymentEventClaimed {
+		return errors.New("payment event is being processed")
+	}
+
+	processErr := r.process(ctx, event)
+	var lastError string
+	if processErr != nil {
+		lastError = processErr.Error()
+	}
+	out, err := r.userClient.FinishPaymentEvent(ctx, &generatedUser.PaymentEventResult{
+		OperationID: event.OperationID,
+		LastError:   lastError,
+	})
+	if processErr != nil {
+		return processErr
+	}
+	if err != nil {
+		return err
+	}
+	if out.Error != "" {
+		return errors.New(out.Error)
+	}
+	return nil
+}
+
+func (r *Replayer) process(ctx context.Context, event models.PaymentEvent) error {
+	var outErr string
+	switch event.Kind {
+	case models.PaymentKindSubscribe:
+		out, err := r.userClient.Subscribe(ctx, &generatedUser.PaymentInfo{PaymentID: event.TargetID.String(),
+			Money: event.Money.ToProto(), Paid: event.Paid.ToProto(), OperationID: event.OperationID})
+		if err != nil {
+			return err
+		}
+		outErr = out.Error
+	case models.PaymentKindDonate:
+		var paymentInfo string
+		if event.PaymentInfo != uuid.Nil {
+			paymentInfo = event.PaymentInfo.String()
+		}
+		out, err := r.userClient.Donate(ctx, &generatedUser.DonateMessage{
+			MoneyCount:  event.Money.ToProto(),
+			CreatorID:   event.TargetID.String(),
+			OperationID: event.OperationID,
+			PaymentInfo: paymentInfo})
+		if err != nil {
+			return err
+		}
+		outErr = out.Error
+	case models.PaymentKindGift:
+		out, err := r.userClient.PayGift(ctx, &generatedUser.PaymentInfo{PaymentID: event.TargetID.String(),
+			Money: event.Money.ToProto(), Paid: event.Paid.ToProto(), OperationID: event.OperationID})
+		if err != nil {
+			return err
+		}
+		outErr = out.Error
+	case models.PaymentKindRefund:
+		out, err := r.userClient.RefundPayment(ctx, &generatedUser.Refund{PaymentID: event.TargetID.String(),
+			Money: event.Money.ToProto(), Reason: models.RefundReasonTransfer, Source: models.RefundSourceProvider,
+			OperationID: event.OperationID})
+		if err != nil {
+			return err
+		}
+		outErr = out.Error
+	default:
+		return models.WrongData
+	}
+	if outErr != "" {
+		return errors.New(outErr)
+	}
+	return nil
+}
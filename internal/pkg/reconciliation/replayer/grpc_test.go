@@ -0,0 +1,82 @@
+package replayer
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	generatedCommon "github.com/go-park-mail-ru/2023_1_4from5/internal/models/proto"
+	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
+	mock "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReplayer_Replay(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	userClient := mock.NewMockUserServiceClient(ctl)
+	event := models.PaymentEvent{Provider: "fake", OperationID: "1", Kind: models.PaymentKindGift, TargetID: uuid.New(), Money: models.NewMoney(10000), Paid: models.NewMoney(10000)}
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				userClient.EXPECT().ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+					Return(&generatedUser.PaymentEventStatus{Status: models.PaymentEventClaimed}, nil)
+				userClient.EXPECT().PayGift(gomock.Any(), gomock.Any()).Return(&generatedUser.GiftInfo{}, nil)
+				userClient.EXPECT().FinishPaymentEvent(gomock.Any(), &generatedUser.PaymentEventResult{OperationID: "1"}).
+					Return(&generatedCommon.Empty{}, nil)
+			},
+		},
+		{
+			name: "Already processed",
+			mock: func() {
+				userClient.EXPECT().ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+					Return(&generatedUser.PaymentEventStatus{Status: models.PaymentEventProcessed}, nil)
+			},
+		},
+		{
+			name: "Processing failed",
+			mock: func() {
+				userClient.EXPECT().ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+					Return(&generatedUser.PaymentEventStatus{Status: models.PaymentEventClaimed}, nil)
+				userClient.EXPECT().PayGift(gomock.Any(), gomock.Any()).Return(&generatedUser.GiftInfo{Error: models.WrongData.Error()}, nil)
+				userClient.EXPECT().FinishPaymentEvent(gomock.Any(), &generatedUser.PaymentEventResult{OperationID: "1", LastError: models.WrongData.Error()}).
+					Return(&generatedCommon.Empty{}, nil)
+			},
+			expectedErr: errors.New(models.WrongData.Error()),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			err := NewReplayer(userClient).Replay(context.Background(), event)
+			require.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
+func TestReplayer_ReplayRefund(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	userClient := mock.NewMockUserServiceClient(ctl)
+	paymentID := uuid.New()
+	event := models.PaymentEvent{Provider: "yoomoney", OperationID: "2", Kind: models.PaymentKindRefund, TargetID: paymentID, Money: models.NewMoney(5000), Paid: models.NewMoney(5000)}
+
+	userClient.EXPECT().ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+		Return(&generatedUser.PaymentEventStatus{Status: models.PaymentEventClaimed}, nil)
+	userClient.EXPECT().RefundPayment(gomock.Any(), &generatedUser.Refund{PaymentID: paymentID.String(), Money: models.NewMoney(5000).ToProto(),
+		Reason: models.RefundReasonTransfer, Source: models.RefundSourceProvider, OperationID: "2"}).Return(&generatedUser.RefundMessage{}, nil)
+	userClient.EXPECT().FinishPaymentEvent(gomock.Any(), &generatedUser.PaymentEventResult{OperationID: "2"}).
+		Return(&generatedCommon.Empty{}, nil)
+	require.NoError(t, NewReplayer(userClient).Replay(context.Background(), event))
+}
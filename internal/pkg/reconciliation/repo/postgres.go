@@ -0,0 +1,47 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	LocalOperations = `SELECT kind, reference, operation_id, money, status, created_at FROM (SELECT 'subscribe' AS kind, payment_info AS reference, coalesce(operation_id, '') AS operation_id, money, 'succeeded' AS status, payment_timestamp AS created_at FROM "user_payments" WHERE money > 0 UNION ALL SELECT 'donate', donation_id::text, coalesce(operation_id, ''), money_count, 'succeeded', donation_date FROM "donation" UNION ALL SELECT 'gift', gift_id::text, coalesce(operation_id, ''), money, 'succeeded', paid_at FROM "gift" WHERE paid_at IS NOT NULL UNION ALL SELECT 'payout', payout_id::text, coalesce(provider_request_id, ''), money, status, updated_at FROM "payout" UNION ALL SELECT 'refund', payment_id::text, operation_id, money, 'succeeded', created_at FROM "refund" WHERE operation_id IS NOT NULL) AS o WHERE created_at BETWEEN $1 AND $2 ORDER BY created_at;`
+)
+
+type ReconciliationRepo struct {
+	db     *sql.DB
+	logger *zap.SugaredLogger
+}
+
+func NewReconciliationRepo(db *sql.DB, logger *zap.SugaredLogger) *ReconciliationRepo {
+	return &ReconciliationRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// LocalOperations возвращает проведённые подписки, донаты, подарки, возвраты от платёжной системы
+// и все выплаты за промежуток [from, to]
+func (r *ReconciliationRepo) LocalOperations(ctx context.Context, from, to time.Time) ([]models.LocalOperation, error) {
+	var operations = make([]models.LocalOperation, 0)
+	rows, err := r.db.QueryContext(ctx, LocalOperations, from, to)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var operation models.LocalOperation
+		if err = rows.Scan(&operation.Kind, &operation.Reference, &operation.OperationID, &operation.Money, &operation.Status, &operation.CreatedAt); err != nil {
+			r.logger.Error(err)
+			return nil, models.InternalError
+		}
+		operations = append(operations, operation)
+	}
+	return operations, nil
+}
@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestReconciliationRepo_LocalOperations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	r := NewReconciliationRepo(db, zap.NewNop().Sugar())
+	to := time.Now()
+	from := to.Add(-time.Hour)
+	reference := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedRes []models.LocalOperation
+		expectedErr error
+	}{
+		{
+			name: "Ok",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"kind", "reference", "operation_id", "money", "status", "created_at"}).
+					AddRow(models.PaymentKindSubscribe, reference, "1", 10000, models.PayoutStatusSucceeded, from)
+				mock.ExpectQuery(`SELECT kind, reference, operation_id, money, status, created_at FROM`).WithArgs(from, to).WillReturnRows(rows)
+			},
+			expectedRes: []models.LocalOperation{{Kind: models.PaymentKindSubscribe, Reference: reference, OperationID: "1",
+				Money: models.NewMoney(10000), Status: models.PayoutStatusSucceeded, CreatedAt: from}},
+		},
+		{
+			name: "InternalError",
+			mock: func() {
+				mock.ExpectQuery(`SELECT kind, reference, operation_id, money, status, created_at FROM`).WithArgs(from, to).WillReturnError(errors.New("test"))
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			operations, err := r.LocalOperations(context.Background(), from, to)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedRes, operations)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
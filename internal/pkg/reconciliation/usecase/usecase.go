@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payment"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/reconciliation"
+	"go.uber.org/zap"
+	"time"
+)
+
+// reconcileMargin - запас по краям периода: операция у провайдера и у нас может попасть по разные стороны границы
+const reconcileMargin = time.Hour
+
+type ReconciliationUsecase struct {
+	repo     reconciliation.ReconciliationRepo
+	history  payment.OperationHistory
+	replayer reconciliation.PaymentReplayer
+	logger   *zap.SugaredLogger
+}
+
+// NewReconciliationUsecase создаёт сверку, replayer нужен только для исправления расхождений и может быть nil
+func NewReconciliationUsecase(repo reconciliation.ReconciliationRepo, history payment.OperationHistory, replayer reconciliation.PaymentReplayer, logger *zap.SugaredLogger) *ReconciliationUsecase {
+	return &ReconciliationUsecase{
+		repo:     repo,
+		history:  history,
+		replayer: replayer,
+		logger:   logger,
+	}
+}
+
+// Reconcile сверяет операции платёжной системы с нашими платежами и выплатами за промежуток [from, to].
+// При fix входящие платежи и возвраты, которых у нас нет, проводятся заново, остальные расхождения только попадают в отчёт.
+func (uc *ReconciliationUsecase) Reconcile(ctx context.Context, from, to time.Time, fix bool) (models.ReconciliationReport, error) {
+	if !from.Before(to) || (fix && uc.replayer == nil) {
+		return models.ReconciliationReport{}, models.WrongData
+	}
+	providerOperations, err := uc.history.Operations(ctx, from.Add(-reconcileMargin), to.Add(reconcileMargin))
+	if err != nil {
+		uc.logger.Error(err)
+		return models.ReconciliationReport{}, models.InternalError
+	}
+	localOperations, err := uc.repo.LocalOperations(ctx, from.Add(-reconcileMargin), to.Add(reconcileMargin))
+	if err != nil {
+		return models.ReconciliationReport{}, err
+	}
+
+	report := models.ReconciliationReport{From: from, To: to, Mismatches: make([]models.ReconciliationMismatch, 0)}
+	inPeriod := func(t time.Time) bool {
+		return !t.Before(from) && !t.After(to)
+	}
+
+	local := make(map[string]models.LocalOperation, len(localOperations))
+	for _, v := range localOperations {
+		local[operationKey(v.Kind, v.Reference, v.OperationID)] = v
+		if inPeriod(v.CreatedAt) {
+			report.LocalOperations++
+		}
+	}
+
+	matched := make(map[string]bool, len(providerOperations))
+	for _, v := range providerOperations {
+		kind, targetID, paymentInfo, err := models.ParsePaymentLabel(v.Label)
+		event := models.PaymentEvent{
+			Provider:    v.Provider,
+			OperationID: v.OperationID,
+			Kind:        kind,
+			TargetID:    targetID,
+			PaymentInfo: paymentInfo,
+			Money:       v.Money,
+			// в истории операций есть только зачисленная сумма: если её не хватает на цену,
+			// платёж сохраняется как неразобранный, а не теряется
+			Paid: v.Money,
+		}
+		// исходящие переводы с нашей меткой - выплаты авторам и возвраты плательщикам: ЮMoney не присылает
+		// уведомлений о возвратах, возврат переводом с кошелька платформы проводится у нас по истории операций
+		if v.Direction == models.OperationDirectionOut && (err != nil || (kind != models.PaymentKindPayout && kind != models.PaymentKindRefund)) {
+			continue // перевод сделан не нами
+		}
+		// входящий платёж с меткой возврата мы не проводили - сверять его не с чем
+		if v.Direction != models.OperationDirectionOut && (err != nil || kind == models.PaymentKindRefund || !event.IsValid()) {
+			if inPeriod(v.Datetime) {
+				report.ProviderOperations++
+				report.Mismatches = append(report.Mismatches, models.ReconciliationMismatch{
+					Type:          models.MismatchUnrecognized,
+					OperationID:   v.OperationID,
+					ProviderMoney: v.Money,
+				})
+			}
+			continue
+		}
+
+		key := operationKey(kind, targetID.String(), v.OperationID)
+		matched[key] = true
+		if !inPeriod(v.Datetime) {
+			continue
+		}
+		report.ProviderOperations++
+
+		mismatch := models.ReconciliationMismatch{
+			Kind:          kind,
+			OperationID:   v.OperationID,
+			Reference:     targetID.String(),
+			ProviderMoney: v.Money,
+		}
+		operation, ok := local[key]
+		switch {
+		case !ok:
+			mismatch.Type = models.MismatchMissingLocal
+			if fix && kind != models.PaymentKindPayout {
+				uc.replay(ctx, &mismatch, event)
+			}
+		case kind == models.PaymentKindPayout && !isSettledPayout(operation.Status):
+			continue // выплата ещё проводится
+		case operation.Status != models.PayoutStatusSucceeded:
+			mismatch.Type = models.MismatchStatus
+		case operation.Money != v.Money:
+			mismatch.Type = models.MismatchAmount
+		default:
+			continue
+		}
+		mismatch.LocalMoney = operation.Money
+		mismatch.LocalStatus = operation.Status
+		report.Mismatches = append(report.Mismatches, mismatch)
+	}
+
+	for _, v := range localOperations {
+		if !inPeriod(v.CreatedAt) || matched[operationKey(v.Kind, v.Reference, v.OperationID)] || v.Status != models.PayoutStatusSucceeded {
+			continue
+		}
+		report.Mismatches = append(report.Mismatches, models.ReconciliationMismatch{
+			Type:        models.MismatchMissingProvider,
+			Kind:        v.Kind,
+			OperationID: v.OperationID,
+			Reference:   v.Reference,
+			LocalMoney:  v.Money,
+			LocalStatus: v.Status,
+		})
+	}
+	return report, nil
+}
+
+func (uc *ReconciliationUsecase) replay(ctx context.Context, mismatch *models.ReconciliationMismatch, event models.PaymentEvent) {
+	if err := uc.replayer.Replay(ctx, event); err != nil {
+		uc.logger.Error(err)
+		mismatch.FixError = err.Error()
+		return
+	}
+	mismatch.Fixed = true
+}
+
+// operationKey - ключ сопоставления операций: входящие платежи сопоставляются по операции у провайдера,
+// выплаты - по своему id из метки перевода
+func operationKey(kind, reference, operationID string) string {
+	if kind == models.PaymentKindPayout {
+		return kind + ";" + reference
+	}
+	return kind + ";" + operationID
+}
+
+func isSettledPayout(status string) bool {
+	return status == models.PayoutStatusSucceeded || status == models.PayoutStatusFailed
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payment/fake"
+	mock "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/reconciliation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestReconciliationUsecase_Reconcile(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockRepo := mock.NewMockReconciliationRepo(ctl)
+	mockReplayer := mock.NewMockPaymentReplayer(ctl)
+	zapSugar := zap.NewNop().Sugar()
+
+	to := time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC)
+	from := to.Add(-24 * time.Hour)
+	at := from.Add(time.Hour)
+	paymentInfo, creatorID, payoutID := uuid.New(), uuid.New(), uuid.New()
+
+	subscription := models.ProviderOperation{Provider: "fake", OperationID: "1", Direction: models.OperationDirectionIn,
+		Label: "subscribe;" + paymentInfo.String(), Money: models.NewMoney(10000), Datetime: at}
+	donation := models.ProviderOperation{Provider: "fake", OperationID: "2", Direction: models.OperationDirectionIn,
+		Label: "donate;" + creatorID.String(), Money: models.NewMoney(5000), Datetime: at}
+	payout := models.ProviderOperation{Provider: "fake", OperationID: "3", Direction: models.OperationDirectionOut,
+		Label: "payout;" + payoutID.String(), Money: models.NewMoney(7000), Datetime: at}
+
+	localSubscription := models.LocalOperation{Kind: models.PaymentKindSubscribe, Reference: paymentInfo.String(), OperationID: "1",
+		Money: models.NewMoney(10000), Status: models.PayoutStatusSucceeded, CreatedAt: at}
+	localDonation := models.LocalOperation{Kind: models.PaymentKindDonate, Reference: uuid.NewString(), OperationID: "2",
+		Money: models.NewMoney(5000), Status: models.PayoutStatusSucceeded, CreatedAt: at}
+	refund := models.ProviderOperation{Provider: "fake", OperationID: "6", Direction: models.OperationDirectionOut,
+		Label: "refund;" + paymentInfo.String(), Money: models.NewMoney(3000), Datetime: at}
+	localRefund := models.LocalOperation{Kind: models.PaymentKindRefund, Reference: paymentInfo.String(), OperationID: "6",
+		Money: models.NewMoney(3000), Status: models.PayoutStatusSucceeded, CreatedAt: at}
+	localPayout := models.LocalOperation{Kind: models.PaymentKindPayout, Reference: payoutID.String(), OperationID: "request",
+		Money: models.NewMoney(7000), Status: models.PayoutStatusSucceeded, CreatedAt: at}
+
+	tests := []struct {
+		name               string
+		provider           []models.ProviderOperation
+		local              []models.LocalOperation
+		fix                bool
+		mock               func()
+		expectedMismatches []models.ReconciliationMismatch
+		expectedErr        error
+	}{
+		{
+			name:               "OK",
+			provider:           []models.ProviderOperation{subscription, donation, payout, refund},
+			local:              []models.LocalOperation{localSubscription, localDonation, localPayout, localRefund},
+			mock:               func() {},
+			expectedMismatches: []models.ReconciliationMismatch{},
+		},
+		{
+			name:     "Missing local",
+			provider: []models.ProviderOperation{subscription, donation},
+			local:    []models.LocalOperation{localDonation},
+			mock:     func() {},
+			expectedMismatches: []models.ReconciliationMismatch{{
+				Type: models.MismatchMissingLocal, Kind: models.PaymentKindSubscribe, OperationID: "1",
+				Reference: paymentInfo.String(), ProviderMoney: models.NewMoney(10000),
+			}},
+		},
+		{
+			name:     "Missing local fixed",
+			provider: []models.ProviderOperation{subscription},
+			local:    []models.LocalOperation{},
+			fix:      true,
+			mock: func() {
+				mockReplayer.EXPECT().Replay(gomock.Any(), models.PaymentEvent{Provider: "fake", OperationID: "1",
+					Kind: models.PaymentKindSubscribe, TargetID: paymentInfo, Money: models.NewMoney(10000), Paid: models.NewMoney(10000)}).Return(nil)
+			},
+			expectedMismatches: []models.ReconciliationMismatch{{
+				Type: models.MismatchMissingLocal, Kind: models.PaymentKindSubscribe, OperationID: "1",
+				Reference: paymentInfo.String(), ProviderMoney: models.NewMoney(10000), Fixed: true,
+			}},
+		},
+		{
+			name:     "Refund transfer fixed",
+			provider: []models.ProviderOperation{refund},
+			local:    []models.LocalOperation{},
+			fix:      true,
+			mock: func() {
+				mockReplayer.EXPECT().Replay(gomock.Any(), models.PaymentEvent{Provider: "fake", OperationID: "6",
+					Kind: models.PaymentKindRefund, TargetID: paymentInfo, Money: models.NewMoney(3000), Paid: models.NewMoney(3000)}).Return(nil)
+			},
+			expectedMismatches: []models.ReconciliationMismatch{{
+				Type: models.MismatchMissingLocal, Kind: models.PaymentKindRefund, OperationID: "6",
+				Reference: paymentInfo.String(), ProviderMoney: models.NewMoney(3000), Fixed: true,
+			}},
+		},
+		{
+			name:     "Fix failed",
+			provider: []models.ProviderOperation{donation},
+			local:    []models.LocalOperation{},
+			fix:      true,
+			mock: func() {
+				mockReplayer.EXPECT().Replay(gomock.Any(), gomock.Any()).Return(errors.New("test"))
+			},
+			expectedMismatches: []models.ReconciliationMismatch{{
+				Type: models.MismatchMissingLocal, Kind: models.PaymentKindDonate, OperationID: "2",
+				Reference: creatorID.String(), ProviderMoney: models.NewMoney(5000), FixError: "test",
+			}},
+		},
+		{
+			name:     "Amount mismatch",
+			provider: []models.ProviderOperation{subscription},
+			local: []models.LocalOperation{{Kind: models.PaymentKindSubscribe, Reference: paymentInfo.String(), OperationID: "1",
+				Money: models.NewMoney(9000), Status: models.PayoutStatusSucceeded, CreatedAt: at}},
+			mock: func() {},
+			expectedMismatches: []models.ReconciliationMismatch{{
+				Type: models.MismatchAmount, Kind: models.PaymentKindSubscribe, OperationID: "1", Reference: paymentInfo.String(),
+				ProviderMoney: models.NewMoney(10000), LocalMoney: models.NewMoney(9000), LocalStatus: models.PayoutStatusSucceeded,
+			}},
+		},
+		{
+			name:     "Payout failed locally",
+			provider: []models.ProviderOperation{payout},
+			local: []models.LocalOperation{{Kind: models.PaymentKindPayout, Reference: payoutID.String(),
+				Money: models.NewMoney(7000), Status: models.PayoutStatusFailed, CreatedAt: at}},
+			fix:  true,
+			mock: func() {},
+			expectedMismatches: []models.ReconciliationMismatch{{
+				Type: models.MismatchStatus, Kind: models.PaymentKindPayout, OperationID: "3", Reference: payoutID.String(),
+				ProviderMoney: models.NewMoney(7000), LocalMoney: models.NewMoney(7000), LocalStatus: models.PayoutStatusFailed,
+			}},
+		},
+		{
+			name:     "Payout in progress",
+			provider: []models.ProviderOperation{payout},
+			local: []models.LocalOperation{{Kind: models.PaymentKindPayout, Reference: payoutID.String(),
+				Money: models.NewMoney(7000), Status: models.PayoutStatusProcessing, CreatedAt: at}},
+			mock:               func() {},
+			expectedMismatches: []models.ReconciliationMismatch{},
+		},
+		{
+			name:     "Missing provider",
+			provider: []models.ProviderOperation{},
+			local:    []models.LocalOperation{localPayout},
+			mock:     func() {},
+			expectedMismatches: []models.ReconciliationMismatch{{
+				Type: models.MismatchMissingProvider, Kind: models.PaymentKindPayout, OperationID: "request",
+				Reference: payoutID.String(), LocalMoney: models.NewMoney(7000), LocalStatus: models.PayoutStatusSucceeded,
+			}},
+		},
+		{
+			name: "Unrecognized and foreign",
+			provider: []models.ProviderOperation{
+				{OperationID: "4", Direction: models.OperationDirectionIn, Label: "", Money: models.NewMoney(100), Datetime: at},
+				{OperationID: "5", Direction: models.OperationDirectionOut, Label: "", Money: models.NewMoney(100), Datetime: at},
+			},
+			local: []models.LocalOperation{},
+			mock:  func() {},
+			expectedMismatches: []models.ReconciliationMismatch{{
+				Type: models.MismatchUnrecognized, OperationID: "4", ProviderMoney: models.NewMoney(100),
+			}},
+		},
+		{
+			name: "Outside period",
+			provider: []models.ProviderOperation{{Provider: "fake", OperationID: "1", Direction: models.OperationDirectionIn,
+				Label: "subscribe;" + paymentInfo.String(), Money: models.NewMoney(10000), Datetime: to.Add(30 * time.Minute)}},
+			local:              []models.LocalOperation{},
+			mock:               func() {},
+			expectedMismatches: []models.ReconciliationMismatch{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			uc := NewReconciliationUsecase(mockRepo, fake.NewHistory(test.provider...), mockReplayer, zapSugar)
+			mockRepo.EXPECT().LocalOperations(gomock.Any(), from.Add(-reconcileMargin), to.Add(reconcileMargin)).Return(test.local, nil)
+			test.mock()
+
+			report, err := uc.Reconcile(context.Background(), from, to, test.fix)
+			require.Equal(t, test.expectedErr, err)
+			require.Equal(t, test.expectedMismatches, report.Mismatches)
+		})
+	}
+}
+
+func TestReconciliationUsecase_ReconcileErrors(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockRepo := mock.NewMockReconciliationRepo(ctl)
+	zapSugar := zap.NewNop().Sugar()
+	to := time.Now()
+	from := to.Add(-time.Hour)
+
+	history := fake.NewHistory()
+	uc := NewReconciliationUsecase(mockRepo, history, nil, zapSugar)
+
+	_, err := uc.Reconcile(context.Background(), to, from, false)
+	require.Equal(t, models.WrongData, err)
+
+	_, err = uc.Reconcile(context.Background(), from, to, true)
+	require.Equal(t, models.WrongData, err)
+
+	history.Fail(errors.New("test"))
+	_, err = uc.Reconcile(context.Background(), from, to, false)
+	require.Equal(t, models.InternalError, err)
+
+	history.Fail(nil)
+	mockRepo.EXPECT().LocalOperations(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, models.InternalError)
+	_, err = uc.Reconcile(context.Background(), from, to, false)
+	require.Equal(t, models.InternalError, err)
+}
@@ -0,0 +1,128 @@
+package scan
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	clamavChunkSize    = 64 << 10
+	clamavMaxReplySize = 1 << 10
+)
+
+// ClamAVScanner проверяет файлы через clamd командой INSTREAM: файл передаётся частями,
+// перед каждой - её длина в 4 байтах big-endian, конец файла - часть нулевой длины.
+// clamd отвечает "stream: OK", "stream: <сигнатура> FOUND" или "<причина> ERROR".
+type ClamAVScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamAVScanner - клиент clamd по адресу host:port, абсолютный путь - unix-сокет.
+// timeout ограничивает проверку одного файла вместе с подключением.
+func NewClamAVScanner(address string, timeout time.Duration) *ClamAVScanner {
+	network := "tcp"
+	if strings.HasPrefix(address, "/") {
+		network = "unix"
+	}
+	return &ClamAVScanner{
+		network: network,
+		address: address,
+		timeout: timeout,
+	}
+}
+
+func (s *ClamAVScanner) Scan(ctx context.Context, data io.Reader) (models.ScanVerdict, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return models.ScanVerdict{}, err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return models.ScanVerdict{}, err
+	}
+
+	if sent, err := s.stream(conn, data); err != nil {
+		// clamd закрывает соединение с ответом об ошибке, например, если файл больше StreamMaxLength
+		if !sent {
+			if reply, replyErr := readClamAVReply(conn); replyErr == nil {
+				return parseClamAVReply(reply)
+			}
+		}
+		return models.ScanVerdict{}, err
+	}
+	reply, err := readClamAVReply(conn)
+	if err != nil {
+		return models.ScanVerdict{}, err
+	}
+	return parseClamAVReply(reply)
+}
+
+// stream передаёт файл в clamd; sent = false, если не удалось записать в соединение, тогда у clamd может быть ответ
+func (s *ClamAVScanner) stream(conn net.Conn, data io.Reader) (bool, error) {
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return false, err
+	}
+	chunk := make([]byte, 4+clamavChunkSize)
+	for {
+		n, err := io.ReadFull(data, chunk[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(chunk[:4], uint32(n))
+			if _, writeErr := conn.Write(chunk[:4+n]); writeErr != nil {
+				return false, writeErr
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return true, err
+		}
+	}
+	if _, err := conn.Write([]byte{0, 0, 0, 0}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// readClamAVReply читает ответ до нулевого байта, clamd может и закрыть соединение без него
+func readClamAVReply(conn net.Conn) (string, error) {
+	reply, err := bufio.NewReader(io.LimitReader(conn, clamavMaxReplySize)).ReadBytes(0)
+	if err != nil && (len(reply) == 0 || !errors.Is(err, io.EOF)) {
+		return "", err
+	}
+	return string(bytes.TrimRight(reply, "\x00\n")), nil
+}
+
+func parseClamAVReply(reply string) (models.ScanVerdict, error) {
+	result := strings.TrimSpace(strings.TrimPrefix(reply, "stream:"))
+	switch {
+	case result == "OK":
+		return models.ScanVerdict{Status: models.ScanClean}, nil
+	case strings.HasSuffix(result, " FOUND"):
+		return models.ScanVerdict{
+			Status:    models.ScanRejected,
+			Signature: strings.TrimSpace(strings.TrimSuffix(result, " FOUND")),
+		}, nil
+	case strings.Contains(result, "size limit exceeded"):
+		// файл больше StreamMaxLength clamd, повторная проверка ничего не изменит
+		return models.ScanVerdict{}, fmt.Errorf("clamav: %s: %w", reply, models.TooLarge)
+	default:
+		return models.ScanVerdict{}, fmt.Errorf("clamav: %s", reply)
+	}
+}
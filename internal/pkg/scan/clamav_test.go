@@ -0,0 +1,196 @@
+package scan
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// fakeClamd - подмена clamd: принимает INSTREAM и отвечает по содержимому, как clamd с одной сигнатурой.
+// Файл больше maxSize не дочитывается, как при превышении StreamMaxLength.
+type fakeClamd struct {
+	listener net.Listener
+	maxSize  int
+	reply    string
+	received chan []byte
+}
+
+func newFakeClamd(t *testing.T, network, address string) *fakeClamd {
+	listener, err := net.Listen(network, address)
+	require.NoError(t, err)
+	clamd := &fakeClamd{listener: listener, maxSize: 1 << 20, received: make(chan []byte, 1)}
+	t.Cleanup(func() { listener.Close() })
+	go clamd.serve()
+	return clamd
+}
+
+func (c *fakeClamd) serve() {
+	for {
+		conn, err := c.listener.Accept()
+		if err != nil {
+			return
+		}
+		c.handle(conn)
+	}
+}
+
+func (c *fakeClamd) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	command, err := reader.ReadString(0)
+	if err != nil || command != "zINSTREAM\x00" {
+		_, _ = conn.Write([]byte("UNKNOWN COMMAND\x00"))
+		return
+	}
+	var data bytes.Buffer
+	for {
+		var size uint32
+		if err = binary.Read(reader, binary.BigEndian, &size); err != nil {
+			return
+		}
+		if size == 0 {
+			break
+		}
+		if data.Len()+int(size) > c.maxSize {
+			_, _ = conn.Write([]byte("INSTREAM size limit exceeded. ERROR\x00"))
+			return
+		}
+		if _, err = io.CopyN(&data, reader, int64(size)); err != nil {
+			return
+		}
+	}
+	c.received <- data.Bytes()
+
+	reply := c.reply
+	if len(reply) == 0 {
+		reply = "stream: OK"
+		if strings.Contains(data.String(), eicar) {
+			reply = "stream: Win.Test.EICAR_HDB-1 FOUND"
+		}
+	}
+	_, _ = conn.Write([]byte(reply + "\x00"))
+}
+
+func TestClamAVScanner_Scan(t *testing.T) {
+	clamd := newFakeClamd(t, "tcp", "127.0.0.1:0")
+	scanner := NewClamAVScanner(clamd.listener.Addr().String(), 5*time.Second)
+
+	large := bytes.Repeat([]byte("a"), 3*clamavChunkSize+17)
+	tests := []struct {
+		name        string
+		data        []byte
+		reply       string
+		expected    models.ScanVerdict
+		expectedErr bool
+	}{
+		{
+			name:     "Clean",
+			data:     []byte("hello"),
+			expected: models.ScanVerdict{Status: models.ScanClean},
+		},
+		{
+			name:     "Several chunks",
+			data:     large,
+			expected: models.ScanVerdict{Status: models.ScanClean},
+		},
+		{
+			name:     "Empty",
+			data:     []byte{},
+			expected: models.ScanVerdict{Status: models.ScanClean},
+		},
+		{
+			name:     "Found",
+			data:     []byte("prefix " + eicar),
+			expected: models.ScanVerdict{Status: models.ScanRejected, Signature: "Win.Test.EICAR_HDB-1"},
+		},
+		{
+			name:        "Error",
+			data:        []byte("hello"),
+			reply:       "Can't allocate memory ERROR",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clamd.reply = test.reply
+			verdict, err := scanner.Scan(context.Background(), bytes.NewReader(test.data))
+			if test.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, verdict)
+			}
+			require.Equal(t, len(test.data), len(<-clamd.received))
+		})
+	}
+	clamd.reply = ""
+}
+
+func TestClamAVScanner_SizeLimit(t *testing.T) {
+	clamd := newFakeClamd(t, "tcp", "127.0.0.1:0")
+	clamd.maxSize = clamavChunkSize
+	scanner := NewClamAVScanner(clamd.listener.Addr().String(), 5*time.Second)
+
+	_, err := scanner.Scan(context.Background(), bytes.NewReader(bytes.Repeat([]byte("a"), 64*clamavChunkSize)))
+	require.ErrorIs(t, err, models.TooLarge)
+}
+
+func TestClamAVScanner_Unix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clamd.sock")
+	clamd := newFakeClamd(t, "unix", path)
+	scanner := NewClamAVScanner(path, 5*time.Second)
+
+	verdict, err := scanner.Scan(context.Background(), strings.NewReader(eicar))
+	require.NoError(t, err)
+	require.Equal(t, models.ScanRejected, verdict.Status)
+	<-clamd.received
+}
+
+func TestClamAVScanner_Unavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	_, err = NewClamAVScanner(address, time.Second).Scan(context.Background(), strings.NewReader("hello"))
+	require.Error(t, err)
+}
+
+func TestNewScannerFromEnv(t *testing.T) {
+	t.Setenv("UPLOAD_SCANNER", "")
+	scanner, err := NewScannerFromEnv()
+	require.NoError(t, err)
+	require.False(t, Quarantines(scanner))
+
+	t.Setenv("UPLOAD_SCANNER", "clamav")
+	t.Setenv("CLAMAV_ADDRESS", "")
+	_, err = NewScannerFromEnv()
+	require.Error(t, err)
+
+	t.Setenv("CLAMAV_ADDRESS", "127.0.0.1:3310")
+	t.Setenv("CLAMAV_TIMEOUT", "day")
+	_, err = NewScannerFromEnv()
+	require.Error(t, err)
+
+	t.Setenv("CLAMAV_TIMEOUT", "10s")
+	scanner, err = NewScannerFromEnv()
+	require.NoError(t, err)
+	require.True(t, Quarantines(scanner))
+	require.Equal(t, &ClamAVScanner{network: "tcp", address: "127.0.0.1:3310", timeout: 10 * time.Second}, scanner)
+
+	t.Setenv("UPLOAD_SCANNER", "sophos")
+	_, err = NewScannerFromEnv()
+	require.Error(t, err)
+}
@@ -0,0 +1,15 @@
+package scan
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/scan_mock.go -package=mock
+
+// UploadScanner проверяет загруженный файл на вредоносное и запрещённое содержимое до того, как вложение увидят читатели.
+// Найденное содержимое - вердикт models.ScanRejected, а не ошибка; ошибка значит, что файл проверить не удалось.
+type UploadScanner interface {
+	Scan(ctx context.Context, data io.Reader) (models.ScanVerdict, error)
+}
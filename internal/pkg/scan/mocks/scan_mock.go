@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUploadScanner is a mock of UploadScanner interface.
+type MockUploadScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadScannerMockRecorder
+}
+
+// MockUploadScannerMockRecorder is the mock recorder for MockUploadScanner.
+type MockUploadScannerMockRecorder struct {
+	mock *MockUploadScanner
+}
+
+// NewMockUploadScanner creates a new mock instance.
+func NewMockUploadScanner(ctrl *gomock.Controller) *MockUploadScanner {
+	mock := &MockUploadScanner{ctrl: ctrl}
+	mock.recorder = &MockUploadScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadScanner) EXPECT() *MockUploadScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockUploadScanner) Scan(ctx context.Context, data io.Reader) (models.ScanVerdict, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, data)
+	ret0, _ := ret[0].(models.ScanVerdict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockUploadScannerMockRecorder) Scan(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockUploadScanner)(nil).Scan), ctx, data)
+}
@@ -0,0 +1,20 @@
+package scan
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"io"
+)
+
+// NopScanner пропускает все файлы без проверки, используется, если проверка не настроена
+type NopScanner struct{}
+
+func (NopScanner) Scan(ctx context.Context, data io.Reader) (models.ScanVerdict, error) {
+	return models.ScanVerdict{Status: models.ScanSkipped}, nil
+}
+
+// Quarantines - держит ли сканер новые вложения в карантине до проверки. Без проверки вложения видны сразу.
+func Quarantines(scanner UploadScanner) bool {
+	_, nop := scanner.(NopScanner)
+	return !nop
+}
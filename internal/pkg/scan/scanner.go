@@ -0,0 +1,35 @@
+package scan
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+const defaultClamAVTimeout = 30 * time.Second
+
+// NewScannerFromEnv выбирает проверку загрузок по UPLOAD_SCANNER: none (по умолчанию) - без проверки,
+// clamav - clamd по адресу CLAMAV_ADDRESS (host:port или путь к unix-сокету) с таймаутом CLAMAV_TIMEOUT.
+func NewScannerFromEnv() (UploadScanner, error) {
+	kind, _ := os.LookupEnv("UPLOAD_SCANNER")
+	switch kind {
+	case "", "none":
+		return NopScanner{}, nil
+	case "clamav":
+		address, ok := os.LookupEnv("CLAMAV_ADDRESS")
+		if !ok || len(address) == 0 {
+			return nil, fmt.Errorf("CLAMAV_ADDRESS is not set")
+		}
+		timeout := defaultClamAVTimeout
+		if env, ok := os.LookupEnv("CLAMAV_TIMEOUT"); ok {
+			tmp, err := time.ParseDuration(env)
+			if err != nil || tmp <= 0 {
+				return nil, fmt.Errorf("wrong CLAMAV_TIMEOUT value")
+			}
+			timeout = tmp
+		}
+		return NewClamAVScanner(address, timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown UPLOAD_SCANNER %q", kind)
+	}
+}
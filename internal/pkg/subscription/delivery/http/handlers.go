@@ -99,11 +99,12 @@ func (h *SubscriptionHandler) CreateSubscription(w http.ResponseWriter, r *http.
 	subscriptionInfo.Id = uuid.New()
 
 	out, err := h.creatorClient.CreateSubscription(r.Context(), &generatedCommon.Subscription{
-		Id:          subscriptionInfo.Id.String(),
-		Creator:     creatorId.Value,
-		MonthCost:   subscriptionInfo.MonthCost,
-		Title:       subscriptionInfo.Title,
-		Description: subscriptionInfo.Description,
+		Id:             subscriptionInfo.Id.String(),
+		Creator:        creatorId.Value,
+		MonthCost:      subscriptionInfo.MonthCost.ToProto(),
+		Title:          subscriptionInfo.Title,
+		Description:    subscriptionInfo.Description,
+		BillingOptions: models.BillingOptionsToProto(subscriptionInfo.BillingOptions),
 	})
 
 	if err != nil {
@@ -117,6 +118,7 @@ func (h *SubscriptionHandler) CreateSubscription(w http.ResponseWriter, r *http.
 		return
 	}
 
+	subscriptionInfo.SetBillingPrices()
 	utils.Response(w, http.StatusOK, subscriptionInfo)
 }
 
@@ -293,11 +295,12 @@ func (h *SubscriptionHandler) EditSubscription(w http.ResponseWriter, r *http.Re
 	}
 
 	out, err := h.creatorClient.EditSubscription(r.Context(), &generatedCommon.Subscription{
-		Id:          subscriptionID,
-		Creator:     creatorId.Value,
-		MonthCost:   subscriptionInfo.MonthCost,
-		Title:       subscriptionInfo.Title,
-		Description: subscriptionInfo.Description,
+		Id:             subscriptionID,
+		Creator:        creatorId.Value,
+		MonthCost:      subscriptionInfo.MonthCost.ToProto(),
+		Title:          subscriptionInfo.Title,
+		Description:    subscriptionInfo.Description,
+		BillingOptions: models.BillingOptionsToProto(subscriptionInfo.BillingOptions),
 	})
 	if err != nil {
 		h.logger.Error(err)
@@ -13,6 +13,8 @@ const (
 	CreateSubscription = `INSERT INTO "subscription"(subscription_id,creator_id, month_cost, title, description) VALUES ($1, $2, $3, $4, $5);`
 	DeleteSubscription = `UPDATE "subscription" SET is_available = false WHERE subscription_id = $1 AND creator_id = $2;`
 	EditSubscription   = `UPDATE "subscription" SET month_cost = $1, title = $2, description = $3 WHERE subscription_id = $4;`
+	DeleteBilling      = `DELETE FROM "subscription_billing_option" WHERE subscription_id = $1;`
+	AddBillingOption   = `INSERT INTO "subscription_billing_option" (subscription_id, month_count, price, discount_percent) VALUES ($1, $2, $3, $4);`
 )
 
 type SubscriptionRepo struct {
@@ -28,13 +30,27 @@ func NewSubscriptionRepo(db *sql.DB, logger *zap.SugaredLogger) *SubscriptionRep
 }
 
 func (r *SubscriptionRepo) CreateSubscription(ctx context.Context, subscriptionInfo models.Subscription) error {
-	row := r.db.QueryRowContext(ctx, CreateSubscription, subscriptionInfo.Id, subscriptionInfo.Creator, subscriptionInfo.MonthCost, subscriptionInfo.Title, subscriptionInfo.Description)
-	if err := row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	if _, err = tx.ExecContext(ctx, CreateSubscription, subscriptionInfo.Id, subscriptionInfo.Creator, subscriptionInfo.MonthCost, subscriptionInfo.Title, subscriptionInfo.Description); err != nil {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	}
+	if err = r.setBillingOptions(ctx, tx, subscriptionInfo); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
 		r.logger.Error(err)
 		return models.InternalError
 	}
 	return nil
 }
+
 func (r *SubscriptionRepo) DeleteSubscription(ctx context.Context, subscriptionID, creatorID uuid.UUID) error {
 	row := r.db.QueryRowContext(ctx, DeleteSubscription, subscriptionID, creatorID)
 	if err := row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -45,10 +61,44 @@ func (r *SubscriptionRepo) DeleteSubscription(ctx context.Context, subscriptionI
 }
 
 func (r *SubscriptionRepo) EditSubscription(ctx context.Context, subscriptionNewInfo models.Subscription) error {
-	row := r.db.QueryRowContext(ctx, EditSubscription, subscriptionNewInfo.MonthCost, subscriptionNewInfo.Title, subscriptionNewInfo.Description, subscriptionNewInfo.Id)
-	if err := row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	if _, err = tx.ExecContext(ctx, EditSubscription, subscriptionNewInfo.MonthCost, subscriptionNewInfo.Title, subscriptionNewInfo.Description, subscriptionNewInfo.Id); err != nil {
+		r.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	}
+	if err = r.setBillingOptions(ctx, tx, subscriptionNewInfo); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+// setBillingOptions заменяет варианты оплаты уровня; у варианта со скидкой цена не хранится
+func (r *SubscriptionRepo) setBillingOptions(ctx context.Context, tx *sql.Tx, subscriptionInfo models.Subscription) error {
+	if _, err := tx.ExecContext(ctx, DeleteBilling, subscriptionInfo.Id); err != nil {
 		r.logger.Error(err)
 		return models.InternalError
 	}
+	for _, option := range subscriptionInfo.BillingOptions {
+		var price, discount sql.NullInt64
+		if option.DiscountPercent != 0 {
+			discount = sql.NullInt64{Int64: option.DiscountPercent, Valid: true}
+		} else {
+			price = sql.NullInt64{Int64: option.Price.Amount, Valid: true}
+		}
+		if _, err := tx.ExecContext(ctx, AddBillingOption, subscriptionInfo.Id, option.MonthCount, price, discount); err != nil {
+			r.logger.Error(err)
+			return models.InternalError
+		}
+	}
 	return nil
 }
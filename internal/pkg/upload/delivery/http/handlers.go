@@ -0,0 +1,387 @@
+package http
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	generatedCommon "github.com/go-park-mail-ru/2023_1_4from5/internal/models/proto"
+	generatedAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/grpc/generated"
+	generatedCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/token"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// UploadPath - адрес, по которому создаются загрузки, к нему добавляется id загрузки
+	UploadPath = "/api/upload/"
+
+	tusVersion   = "1.0.0"
+	tusExtension = "creation,termination,expiration"
+	offsetStream = "application/offset+octet-stream"
+)
+
+// UploadHandler принимает файлы по протоколу tus (https://tus.io/protocols/resumable-upload):
+// OPTIONS описывает возможности сервера, POST создаёт загрузку, HEAD возвращает загруженное смещение,
+// PATCH дописывает кусок, DELETE отменяет загрузку.
+// Завершённую загрузку можно прикрепить к посту через /post/addUpload.
+type UploadHandler struct {
+	authClient    generatedAuth.AuthServiceClient
+	creatorClient generatedCreator.CreatorServiceClient
+	uploads       upload.UploadStore
+	logger        *zap.SugaredLogger
+	now           func() time.Time
+}
+
+func NewUploadHandler(auc generatedAuth.AuthServiceClient, csc generatedCreator.CreatorServiceClient, uploads upload.UploadStore, logger *zap.SugaredLogger) *UploadHandler {
+	return &UploadHandler{
+		authClient:    auc,
+		creatorClient: csc,
+		uploads:       uploads,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// Options отвечает на OPTIONS по tus без авторизации. Наибольший размер файла свой у каждого автора,
+// поэтому Tus-Max-Size отдаётся, только если в запросе есть ?creator={uuid}.
+func (h *UploadHandler) Options(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Tus-Version", tusVersion)
+	w.Header().Set("Tus-Extension", tusExtension)
+	if creator := r.URL.Query().Get("creator"); len(creator) != 0 {
+		creatorID, err := uuid.Parse(creator)
+		if err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+		limit, err := h.creatorClient.GetUploadLimit(r.Context(), &generatedCommon.UUIDMessage{Value: creatorID.String()})
+		if err != nil {
+			h.logger.Error(err)
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+		if limit.Error == models.WrongData.Error() {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+		if limit.Error != "" {
+			h.logger.Error(limit.Error)
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+		w.Header().Set("Tus-Max-Size", strconv.FormatInt(limit.MaxSize, 10))
+	}
+	utils.Response(w, http.StatusNoContent, nil)
+}
+
+func (h *UploadHandler) CreateUpload(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, ok := h.authorize(w, r, true)
+	if !ok {
+		return
+	}
+
+	if r.Header.Get("Upload-Defer-Length") != "" {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length < 0 {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	metadata, err := parseMetadata(r.Header.Get("Upload-Metadata"))
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	creatorID, err := uuid.Parse(metadata[models.UploadMetadataCreator])
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	out, err := h.creatorClient.IsCreator(r.Context(), &generatedCreator.UserCreatorMessage{
+		UserID:    userDataJWT.Id.String(),
+		CreatorID: creatorID.String(),
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	if out.Error != "" {
+		h.logger.Error(out.Error)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if !out.Flag {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+
+	limit, err := h.creatorClient.GetUploadLimit(r.Context(), &generatedCommon.UUIDMessage{Value: creatorID.String()})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if limit.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	if limit.Error != "" {
+		h.logger.Error(limit.Error)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if length > limit.MaxSize {
+		w.Header().Set("Tus-Max-Size", strconv.FormatInt(limit.MaxSize, 10))
+		utils.Response(w, http.StatusRequestEntityTooLarge, nil)
+		return
+	}
+	// квота проверяется ещё раз, когда файл прикрепляется к посту, а здесь - чтобы не загружать файл зря
+	quota, err := h.creatorClient.CheckStorageQuota(r.Context(), &generatedCreator.StorageQuotaMessage{
+		CreatorID: creatorID.String(),
+		Size:      length,
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if quota.Error == models.QuotaExceeded.Error() {
+		utils.Response(w, http.StatusRequestEntityTooLarge, quota.Error)
+		return
+	}
+	if quota.Error != "" {
+		h.logger.Error(quota.Error)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	now := h.now().UTC()
+	newUpload := models.Upload{
+		Id:        uuid.New(),
+		UserId:    userDataJWT.Id,
+		CreatorId: creatorID,
+		Length:    length,
+		Metadata:  metadata,
+		CreatedAt: now,
+		ExpiresAt: now.Add(h.uploads.TTL()),
+	}
+	if err = h.uploads.Create(r.Context(), newUpload); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	w.Header().Set("Location", UploadPath+newUpload.Id.String())
+	w.Header().Set("Upload-Expires", newUpload.ExpiresAt.Format(http.TimeFormat))
+	utils.Response(w, http.StatusCreated, nil)
+}
+
+func (h *UploadHandler) UploadOffset(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, ok := h.authorize(w, r, false)
+	if !ok {
+		return
+	}
+	existing, ok := h.getUpload(w, r, userDataJWT.Id)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Upload-Offset", strconv.FormatInt(existing.Offset, 10))
+	w.Header().Set("Upload-Length", strconv.FormatInt(existing.Length, 10))
+	w.Header().Set("Upload-Expires", existing.ExpiresAt.Format(http.TimeFormat))
+	if len(existing.Metadata) != 0 {
+		w.Header().Set("Upload-Metadata", formatMetadata(existing.Metadata))
+	}
+	utils.Response(w, http.StatusOK, nil)
+}
+
+// AppendUpload дописывает кусок без CSRF-токена: tus-клиенты его не передают. Писать можно только в свою загрузку,
+// а её адрес со случайным id знает только тот, кто её создал, поэтому сторонний сайт не подставит чужой кусок.
+func (h *UploadHandler) AppendUpload(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, ok := h.authorize(w, r, false)
+	if !ok {
+		return
+	}
+	if r.Header.Get("Content-Type") != offsetStream {
+		utils.Response(w, http.StatusUnsupportedMediaType, nil)
+		return
+	}
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	existing, ok := h.getUpload(w, r, userDataJWT.Id)
+	if !ok {
+		return
+	}
+
+	existing, err = h.uploads.Append(r.Context(), existing, offset, r.Body, r.ContentLength)
+	if errors.Is(err, models.OffsetMismatch) {
+		utils.Response(w, http.StatusConflict, nil)
+		return
+	}
+	if errors.Is(err, models.TooLarge) {
+		utils.Response(w, http.StatusRequestEntityTooLarge, nil)
+		return
+	}
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	w.Header().Set("Upload-Offset", strconv.FormatInt(existing.Offset, 10))
+	w.Header().Set("Upload-Expires", existing.ExpiresAt.Format(http.TimeFormat))
+	utils.Response(w, http.StatusNoContent, nil)
+}
+
+func (h *UploadHandler) DeleteUpload(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, ok := h.authorize(w, r, true)
+	if !ok {
+		return
+	}
+	existing, ok := h.getUpload(w, r, userDataJWT.Id)
+	if !ok {
+		return
+	}
+	if err := h.uploads.Delete(r.Context(), existing.Id); err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	utils.Response(w, http.StatusNoContent, nil)
+}
+
+// authorize проверяет версию протокола, JWT и, если csrf, CSRF-токен.
+// На GET к изменяющему адресу, как и в остальных обработчиках, отдаётся CSRF-токен.
+func (h *UploadHandler) authorize(w http.ResponseWriter, r *http.Request, csrf bool) (*models.AccessDetails, bool) {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	if r.Method != http.MethodGet && r.Header.Get("Tus-Resumable") != tusVersion {
+		w.Header().Set("Tus-Version", tusVersion)
+		utils.Response(w, http.StatusPreconditionFailed, nil)
+		return nil, false
+	}
+
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return nil, false
+	}
+	uv, err := h.authClient.CheckUserVersion(r.Context(), &generatedAuth.AccessDetails{
+		Login:       userDataJWT.Login,
+		Id:          userDataJWT.Id.String(),
+		UserVersion: userDataJWT.UserVersion,
+	})
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return nil, false
+	}
+	if len(uv.Error) != 0 {
+		utils.Cookie(w, "", "SSID")
+		utils.Response(w, http.StatusForbidden, nil)
+		return nil, false
+	}
+	if !csrf {
+		return userDataJWT, true
+	}
+
+	if r.Method == http.MethodGet {
+		tokenCSRF, err := token.GetCSRFToken(models.User{Login: userDataJWT.Login, Id: userDataJWT.Id, UserVersion: userDataJWT.UserVersion})
+		if err != nil {
+			utils.Response(w, http.StatusUnauthorized, nil)
+			return nil, false
+		}
+		utils.ResponseWithCSRF(w, tokenCSRF)
+		return nil, false
+	}
+	userDataCSRF, err := token.ExtractCSRFTokenMetadata(r)
+	if err != nil || *userDataCSRF != *userDataJWT {
+		utils.Response(w, http.StatusForbidden, nil)
+		return nil, false
+	}
+	return userDataJWT, true
+}
+
+// getUpload находит загрузку пользователя. Чужая загрузка не отличается от несуществующей.
+func (h *UploadHandler) getUpload(w http.ResponseWriter, r *http.Request, userID uuid.UUID) (models.Upload, bool) {
+	uploadID, err := uuid.Parse(mux.Vars(r)["upload-uuid"])
+	if err != nil {
+		utils.Response(w, http.StatusNotFound, nil)
+		return models.Upload{}, false
+	}
+	existing, err := h.uploads.Get(r.Context(), uploadID)
+	if errors.Is(err, models.NotFound) || err == nil && existing.UserId != userID {
+		utils.Response(w, http.StatusNotFound, nil)
+		return models.Upload{}, false
+	}
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return models.Upload{}, false
+	}
+	if existing.IsExpired(h.now()) {
+		utils.Response(w, http.StatusGone, nil)
+		return models.Upload{}, false
+	}
+	return existing, true
+}
+
+// parseMetadata разбирает Upload-Metadata: пары "ключ значение-в-base64" через запятую, значение может отсутствовать
+func parseMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if strings.TrimSpace(header) == "" {
+		return metadata, nil
+	}
+	for _, pair := range strings.Split(header, ",") {
+		fields := strings.Fields(pair)
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, models.WrongData
+		}
+		if _, ok := metadata[fields[0]]; ok {
+			return nil, models.WrongData
+		}
+		value := ""
+		if len(fields) == 2 {
+			decoded, err := base64.StdEncoding.DecodeString(fields[1])
+			if err != nil {
+				return nil, models.WrongData
+			}
+			value = string(decoded)
+		}
+		metadata[fields[0]] = value
+	}
+	return metadata, nil
+}
+
+func formatMetadata(metadata map[string]string) string {
+	pairs := make([]string, 0, len(metadata))
+	for key, value := range metadata {
+		if value == "" {
+			pairs = append(pairs, key)
+			continue
+		}
+		pairs = append(pairs, key+" "+base64.StdEncoding.EncodeToString([]byte(value)))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
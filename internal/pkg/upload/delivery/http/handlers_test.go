@@ -0,0 +1,342 @@
+package http
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	generatedCommon "github.com/go-park-mail-ru/2023_1_4from5/internal/models/proto"
+	generatedAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/grpc/generated"
+	mockAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/mocks"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/usecase"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/local"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/delivery/grpc/generated"
+	mockCreator "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/creator/mocks"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/token"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func setCSRFToken(r *http.Request, token string) {
+	r.Header.Set("X-CSRF-Token", token)
+}
+
+func setJWTToken(r *http.Request, token string) {
+	r.AddCookie(&http.Cookie{
+		Name:     "SSID",
+		Value:    token,
+		Expires:  time.Time{},
+		HttpOnly: true,
+	})
+}
+
+type testEnv struct {
+	handler       *UploadHandler
+	router        *mux.Router
+	authClient    *mockAuth.MockAuthServiceClient
+	creatorClient *mockCreator.MockCreatorServiceClient
+	jwt, csrf     string
+	userID        uuid.UUID
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	ctl := gomock.NewController(t)
+	t.Cleanup(ctl.Finish)
+
+	blobs, err := local.NewStore(t.TempDir())
+	require.NoError(t, err)
+	env := &testEnv{
+		authClient:    mockAuth.NewMockAuthServiceClient(ctl),
+		creatorClient: mockCreator.NewMockCreatorServiceClient(ctl),
+		userID:        uuid.New(),
+	}
+	env.handler = NewUploadHandler(env.authClient, env.creatorClient, upload.NewStore(blobs, time.Hour), zap.NewNop().Sugar())
+	env.handler.now = func() time.Time { return testNow }
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	os.Setenv("CSRF_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	env.jwt, _ = tkn.GetJWTToken(context.Background(), models.User{Login: "test", Id: env.userID})
+	env.csrf, _ = token.GetCSRFToken(models.User{Login: "test", Id: env.userID})
+
+	env.router = mux.NewRouter()
+	env.router.Use(middleware.NewCORSMiddleware("/api/upload"))
+	env.router.HandleFunc("/api/upload", env.handler.Options).Methods(http.MethodOptions)
+	env.router.HandleFunc("/api/upload/{upload-uuid}", env.handler.Options).Methods(http.MethodOptions)
+	env.router.HandleFunc("/api/upload", env.handler.CreateUpload).Methods(http.MethodPost, http.MethodGet)
+	env.router.HandleFunc("/api/upload/{upload-uuid}", env.handler.UploadOffset).Methods(http.MethodHead)
+	env.router.HandleFunc("/api/upload/{upload-uuid}", env.handler.AppendUpload).Methods(http.MethodPatch)
+	env.router.HandleFunc("/api/upload/{upload-uuid}", env.handler.DeleteUpload).Methods(http.MethodDelete)
+	return env
+}
+
+func (env *testEnv) request(method, path string, body string, headers map[string]string) *http.Request {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	setJWTToken(r, env.jwt)
+	setCSRFToken(r, env.csrf)
+	r.Header.Set("Tus-Resumable", tusVersion)
+	for key, value := range headers {
+		r.Header.Set(key, value)
+	}
+	env.authClient.EXPECT().CheckUserVersion(gomock.Any(), gomock.Any()).Return(&generatedAuth.UserVersion{UserVersion: 0}, nil)
+	return r
+}
+
+func (env *testEnv) serve(r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	env.router.ServeHTTP(w, r)
+	return w
+}
+
+func (env *testEnv) create(t *testing.T, creatorID uuid.UUID, length string) string {
+	env.creatorClient.EXPECT().IsCreator(gomock.Any(), gomock.Any()).Return(&generated.FlagMessage{Flag: true}, nil)
+	env.creatorClient.EXPECT().GetUploadLimit(gomock.Any(), gomock.Any()).Return(&generated.UploadLimitMessage{MaxSize: 100}, nil)
+	env.creatorClient.EXPECT().CheckStorageQuota(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{}, nil)
+	w := env.serve(env.request(http.MethodPost, "/api/upload", "", map[string]string{
+		"Upload-Length":   length,
+		"Upload-Metadata": "creator " + base64.StdEncoding.EncodeToString([]byte(creatorID.String())) + ",filename " + base64.StdEncoding.EncodeToString([]byte("video.mp4")),
+	}))
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.True(t, strings.HasPrefix(w.Header().Get("Location"), UploadPath))
+	return w.Header().Get("Location")
+}
+
+func TestUploadHandler_CreateUpload(t *testing.T) {
+	env := newTestEnv(t)
+	creatorID := uuid.New()
+	metadata := "creator " + base64.StdEncoding.EncodeToString([]byte(creatorID.String()))
+
+	tests := []struct {
+		name           string
+		mock           func() *http.Request
+		expectedStatus int
+	}{
+		{
+			name: "OK",
+			mock: func() *http.Request {
+				env.creatorClient.EXPECT().IsCreator(gomock.Any(), &generated.UserCreatorMessage{
+					UserID: env.userID.String(), CreatorID: creatorID.String()}).Return(&generated.FlagMessage{Flag: true}, nil)
+				env.creatorClient.EXPECT().GetUploadLimit(gomock.Any(), gomock.Any()).Return(&generated.UploadLimitMessage{MaxSize: 100}, nil)
+				env.creatorClient.EXPECT().CheckStorageQuota(gomock.Any(), &generated.StorageQuotaMessage{
+					CreatorID: creatorID.String(), Size: 100}).Return(&generatedCommon.Empty{}, nil)
+				return env.request(http.MethodPost, "/api/upload", "", map[string]string{"Upload-Length": "100", "Upload-Metadata": metadata})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "No Tus-Resumable",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/api/upload", nil)
+				r.Header.Set("Upload-Length", "100")
+				return r
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name: "Unauthorized",
+			mock: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/api/upload", nil)
+				r.Header.Set("Tus-Resumable", tusVersion)
+				return r
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Deferred length",
+			mock: func() *http.Request {
+				return env.request(http.MethodPost, "/api/upload", "", map[string]string{"Upload-Defer-Length": "1", "Upload-Metadata": metadata})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Wrong metadata",
+			mock: func() *http.Request {
+				return env.request(http.MethodPost, "/api/upload", "", map[string]string{"Upload-Length": "100", "Upload-Metadata": "creator !!!"})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "No creator",
+			mock: func() *http.Request {
+				return env.request(http.MethodPost, "/api/upload", "", map[string]string{"Upload-Length": "100"})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not creator",
+			mock: func() *http.Request {
+				env.creatorClient.EXPECT().IsCreator(gomock.Any(), gomock.Any()).Return(&generated.FlagMessage{Flag: false}, nil)
+				return env.request(http.MethodPost, "/api/upload", "", map[string]string{"Upload-Length": "100", "Upload-Metadata": metadata})
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Over creator limit",
+			mock: func() *http.Request {
+				env.creatorClient.EXPECT().IsCreator(gomock.Any(), gomock.Any()).Return(&generated.FlagMessage{Flag: true}, nil)
+				env.creatorClient.EXPECT().GetUploadLimit(gomock.Any(), gomock.Any()).Return(&generated.UploadLimitMessage{MaxSize: 99}, nil)
+				return env.request(http.MethodPost, "/api/upload", "", map[string]string{"Upload-Length": "100", "Upload-Metadata": metadata})
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "Over storage quota",
+			mock: func() *http.Request {
+				env.creatorClient.EXPECT().IsCreator(gomock.Any(), gomock.Any()).Return(&generated.FlagMessage{Flag: true}, nil)
+				env.creatorClient.EXPECT().GetUploadLimit(gomock.Any(), gomock.Any()).Return(&generated.UploadLimitMessage{MaxSize: 100}, nil)
+				env.creatorClient.EXPECT().CheckStorageQuota(gomock.Any(), gomock.Any()).Return(&generatedCommon.Empty{
+					Error: models.QuotaExceeded.Error()}, nil)
+				return env.request(http.MethodPost, "/api/upload", "", map[string]string{"Upload-Length": "100", "Upload-Metadata": metadata})
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "Limit error",
+			mock: func() *http.Request {
+				env.creatorClient.EXPECT().IsCreator(gomock.Any(), gomock.Any()).Return(&generated.FlagMessage{Flag: true}, nil)
+				env.creatorClient.EXPECT().GetUploadLimit(gomock.Any(), gomock.Any()).Return(&generated.UploadLimitMessage{Error: models.InternalError.Error()}, nil)
+				return env.request(http.MethodPost, "/api/upload", "", map[string]string{"Upload-Length": "100", "Upload-Metadata": metadata})
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := env.serve(test.mock())
+			require.Equal(t, test.expectedStatus, w.Code)
+			require.Equal(t, tusVersion, w.Header().Get("Tus-Resumable"))
+		})
+	}
+}
+
+func TestUploadHandler_Upload(t *testing.T) {
+	env := newTestEnv(t)
+	location := env.create(t, uuid.New(), "10")
+	patch := func(offset, body string) *http.Request {
+		return env.request(http.MethodPatch, location, body, map[string]string{"Content-Type": offsetStream, "Upload-Offset": offset})
+	}
+
+	w := env.serve(env.request(http.MethodHead, location, "", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "0", w.Header().Get("Upload-Offset"))
+	require.Equal(t, "10", w.Header().Get("Upload-Length"))
+	require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	require.Contains(t, w.Header().Get("Upload-Metadata"), "filename "+base64.StdEncoding.EncodeToString([]byte("video.mp4")))
+
+	w = env.serve(patch("0", "01234"))
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Equal(t, "5", w.Header().Get("Upload-Offset"))
+
+	w = env.serve(patch("0", "01234"))
+	require.Equal(t, http.StatusConflict, w.Code)
+
+	w = env.serve(patch("5", "56789abc"))
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	w = env.serve(env.request(http.MethodPatch, location, "56789", map[string]string{"Content-Type": "text/plain", "Upload-Offset": "5"}))
+	require.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+
+	w = env.serve(patch("5", "56789"))
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Equal(t, "10", w.Header().Get("Upload-Offset"))
+
+	w = env.serve(env.request(http.MethodHead, location, "", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "10", w.Header().Get("Upload-Offset"))
+
+	w = env.serve(env.request(http.MethodDelete, location, "", nil))
+	require.Equal(t, http.StatusNoContent, w.Code)
+
+	w = env.serve(env.request(http.MethodHead, location, "", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestUploadHandler_Options(t *testing.T) {
+	env := newTestEnv(t)
+	creatorID := uuid.New()
+
+	for _, path := range []string{"/api/upload", UploadPath + uuid.NewString()} {
+		w := env.serve(httptest.NewRequest(http.MethodOptions, path, nil))
+		require.Equal(t, http.StatusNoContent, w.Code)
+		require.Equal(t, tusVersion, w.Header().Get("Tus-Resumable"))
+		require.Equal(t, tusVersion, w.Header().Get("Tus-Version"))
+		require.Equal(t, tusExtension, w.Header().Get("Tus-Extension"))
+		require.Empty(t, w.Header().Get("Tus-Max-Size"))
+		require.NotEmpty(t, w.Header().Get("Access-Control-Allow-Methods"))
+	}
+
+	env.creatorClient.EXPECT().GetUploadLimit(gomock.Any(), &generatedCommon.UUIDMessage{Value: creatorID.String()}).
+		Return(&generated.UploadLimitMessage{MaxSize: 100}, nil)
+	w := env.serve(httptest.NewRequest(http.MethodOptions, "/api/upload?creator="+creatorID.String(), nil))
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Equal(t, "100", w.Header().Get("Tus-Max-Size"))
+
+	w = env.serve(httptest.NewRequest(http.MethodOptions, "/api/upload?creator=1", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestUploadHandler_AppendWithoutCSRF(t *testing.T) {
+	env := newTestEnv(t)
+	location := env.create(t, uuid.New(), "10")
+
+	r := env.request(http.MethodPatch, location, "01234", map[string]string{"Content-Type": offsetStream, "Upload-Offset": "0"})
+	r.Header.Del("X-CSRF-Token")
+	w := env.serve(r)
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Equal(t, "5", w.Header().Get("Upload-Offset"))
+
+	// в чужую загрузку кусок не дописывается
+	other := newTestEnv(t)
+	other.handler.uploads = env.handler.uploads
+	r = other.request(http.MethodPatch, location, "56789", map[string]string{"Content-Type": offsetStream, "Upload-Offset": "5"})
+	r.Header.Del("X-CSRF-Token")
+	w = other.serve(r)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	r = env.request(http.MethodDelete, location, "", nil)
+	r.Header.Del("X-CSRF-Token")
+	w = env.serve(r)
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestUploadHandler_Ownership(t *testing.T) {
+	env := newTestEnv(t)
+	location := env.create(t, uuid.New(), "10")
+
+	other := newTestEnv(t)
+	other.handler.uploads = env.handler.uploads
+	w := other.serve(other.request(http.MethodHead, location, "", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = env.serve(env.request(http.MethodHead, UploadPath+"not-uuid", "", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	env.handler.now = func() time.Time { return testNow.Add(2 * time.Hour) }
+	w = env.serve(env.request(http.MethodHead, location, "", nil))
+	require.Equal(t, http.StatusGone, w.Code)
+}
+
+func TestParseMetadata(t *testing.T) {
+	metadata, err := parseMetadata("filename d29ybGRfZG9taW5hdGlvbl9wbGFuLnBkZg==,is_confidential")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"filename": "world_domination_plan.pdf", "is_confidential": ""}, metadata)
+	require.Equal(t, "filename d29ybGRfZG9taW5hdGlvbl9wbGFuLnBkZg==,is_confidential", formatMetadata(metadata))
+
+	_, err = parseMetadata("a YQ==,a YQ==")
+	require.Error(t, err)
+	_, err = parseMetadata("a b c")
+	require.Error(t, err)
+}
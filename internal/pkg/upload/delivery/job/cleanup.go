@@ -0,0 +1,68 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload"
+	"go.uber.org/zap"
+	"os"
+	"time"
+)
+
+const defaultCleanupInterval = time.Hour
+
+// GetCleanupInterval reads UPLOAD_CLEANUP_INTERVAL (time.Duration string) from the environment.
+func GetCleanupInterval() (time.Duration, error) {
+	interval, flag := os.LookupEnv("UPLOAD_CLEANUP_INTERVAL")
+	if !flag {
+		return defaultCleanupInterval, nil
+	}
+	tmp, err := time.ParseDuration(interval)
+	if err != nil || tmp <= 0 {
+		return 0, errors.New("wrong UPLOAD_CLEANUP_INTERVAL value")
+	}
+	return tmp, nil
+}
+
+// CleanupJob периодически удаляет брошенные загрузки, срок которых истёк
+type CleanupJob struct {
+	uploads  upload.UploadStore
+	interval time.Duration
+	logger   *zap.SugaredLogger
+	now      func() time.Time
+}
+
+func NewCleanupJob(uploads upload.UploadStore, interval time.Duration, logger *zap.SugaredLogger) *CleanupJob {
+	return &CleanupJob{
+		uploads:  uploads,
+		interval: interval,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+func (j *CleanupJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	j.Process(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.Process(ctx)
+		}
+	}
+}
+
+func (j *CleanupJob) Process(ctx context.Context) int {
+	deleted, err := j.uploads.DeleteExpired(ctx, j.now())
+	if err != nil {
+		j.logger.Error(err)
+	}
+	if deleted > 0 {
+		j.logger.Infof("deleted %d expired uploads", deleted)
+	}
+	return deleted
+}
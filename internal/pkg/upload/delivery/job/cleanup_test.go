@@ -0,0 +1,73 @@
+package job
+
+import (
+	"context"
+	"errors"
+	mockUpload "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/upload/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestGetCleanupInterval(t *testing.T) {
+	tests := []struct {
+		name        string
+		interval    string
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{
+			name:     "OK",
+			interval: "15m",
+			expected: 15 * time.Minute,
+		},
+		{
+			name:        "WrongInterval",
+			interval:    "day",
+			expectedErr: true,
+		},
+		{
+			name:        "Negative",
+			interval:    "-1h",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			os.Setenv("UPLOAD_CLEANUP_INTERVAL", test.interval)
+			defer os.Unsetenv("UPLOAD_CLEANUP_INTERVAL")
+
+			interval, err := GetCleanupInterval()
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, interval)
+		})
+	}
+
+	interval, err := GetCleanupInterval()
+	require.NoError(t, err)
+	require.Equal(t, defaultCleanupInterval, interval)
+}
+
+func TestCleanupJob_Process(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	uploads := mockUpload.NewMockUploadStore(ctl)
+
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	j := NewCleanupJob(uploads, time.Hour, zap.NewNop().Sugar())
+	j.now = func() time.Time { return now }
+
+	uploads.EXPECT().DeleteExpired(gomock.Any(), now).Return(2, nil)
+	require.Equal(t, 2, j.Process(context.Background()))
+
+	uploads.EXPECT().DeleteExpired(gomock.Any(), now).Return(1, errors.New("test"))
+	require.Equal(t, 1, j.Process(context.Background()))
+}
@@ -0,0 +1,29 @@
+package upload
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/google/uuid"
+	"io"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./mocks/upload_mock.go -package=mock
+
+// UploadStore хранит докачиваемые загрузки. Ошибки: models.NotFound - загрузки нет,
+// models.OffsetMismatch - кусок не продолжает загруженные данные, models.TooLarge - данных больше, чем объявлено.
+type UploadStore interface {
+	Create(ctx context.Context, upload models.Upload) error
+	Get(ctx context.Context, id uuid.UUID) (models.Upload, error)
+	// Append дописывает кусок с позиции offset; size < 0 - размер куска заранее неизвестен.
+	// Когда загружен последний кусок, файл собирается целиком.
+	Append(ctx context.Context, upload models.Upload, offset int64, data io.Reader, size int64) (models.Upload, error)
+	// Open открывает собранный файл завершённой загрузки
+	Open(ctx context.Context, upload models.Upload) (io.ReadCloser, error)
+	// ReadHead читает первые n байт собранного файла, чтобы определить его тип
+	ReadHead(ctx context.Context, upload models.Upload, n int64) ([]byte, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// DeleteExpired удаляет загрузки, срок которых истёк к now, и возвращает их число
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+	TTL() time.Duration
+}
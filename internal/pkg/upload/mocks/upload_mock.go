@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockUploadStore is a mock of UploadStore interface.
+type MockUploadStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadStoreMockRecorder
+}
+
+// MockUploadStoreMockRecorder is the mock recorder for MockUploadStore.
+type MockUploadStoreMockRecorder struct {
+	mock *MockUploadStore
+}
+
+// NewMockUploadStore creates a new mock instance.
+func NewMockUploadStore(ctrl *gomock.Controller) *MockUploadStore {
+	mock := &MockUploadStore{ctrl: ctrl}
+	mock.recorder = &MockUploadStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadStore) EXPECT() *MockUploadStoreMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockUploadStore) Append(ctx context.Context, upload models.Upload, offset int64, data io.Reader, size int64) (models.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, upload, offset, data, size)
+	ret0, _ := ret[0].(models.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockUploadStoreMockRecorder) Append(ctx, upload, offset, data, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockUploadStore)(nil).Append), ctx, upload, offset, data, size)
+}
+
+// Create mocks base method.
+func (m *MockUploadStore) Create(ctx context.Context, upload models.Upload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, upload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUploadStoreMockRecorder) Create(ctx, upload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUploadStore)(nil).Create), ctx, upload)
+}
+
+// Delete mocks base method.
+func (m *MockUploadStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUploadStoreMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUploadStore)(nil).Delete), ctx, id)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockUploadStore) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockUploadStoreMockRecorder) DeleteExpired(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockUploadStore)(nil).DeleteExpired), ctx, now)
+}
+
+// Get mocks base method.
+func (m *MockUploadStore) Get(ctx context.Context, id uuid.UUID) (models.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUploadStoreMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUploadStore)(nil).Get), ctx, id)
+}
+
+// Open mocks base method.
+func (m *MockUploadStore) Open(ctx context.Context, upload models.Upload) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, upload)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockUploadStoreMockRecorder) Open(ctx, upload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockUploadStore)(nil).Open), ctx, upload)
+}
+
+// ReadHead mocks base method.
+func (m *MockUploadStore) ReadHead(ctx context.Context, upload models.Upload, n int64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHead", ctx, upload, n)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHead indicates an expected call of ReadHead.
+func (mr *MockUploadStoreMockRecorder) ReadHead(ctx, upload, n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHead", reflect.TypeOf((*MockUploadStore)(nil).ReadHead), ctx, upload, n)
+}
+
+// TTL mocks base method.
+func (m *MockUploadStore) TTL() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TTL")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// TTL indicates an expected call of TTL.
+func (mr *MockUploadStoreMockRecorder) TTL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockUploadStore)(nil).TTL))
+}
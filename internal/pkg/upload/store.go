@@ -0,0 +1,293 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+	"github.com/google/uuid"
+	"github.com/mailru/easyjson"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UploadsPrefix - общий префикс загрузок в хранилище, незавершённые загрузки удаляет CleanupJob
+const UploadsPrefix = "uploads/"
+
+const (
+	defaultUploadTTL = 24 * time.Hour
+
+	infoName = "info.json"
+	dataName = "data"
+	partsDir = "parts/"
+)
+
+// Store хранит загрузки прямо в хранилище файлов: рядом с описанием загрузки лежат куски,
+// имя куска - смещение, с которого он начинается. Кусок сохраняется только целиком, поэтому после обрыва
+// соединения клиент продолжает с начала неудавшегося куска. Последний кусок склеивает все куски в один файл.
+type Store struct {
+	store blob.BlobStore
+	ttl   time.Duration
+}
+
+func NewStore(store blob.BlobStore, ttl time.Duration) *Store {
+	return &Store{
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+// NewStoreFromEnv берёт время жизни незавершённой загрузки из UPLOAD_TTL (по умолчанию 24h)
+func NewStoreFromEnv(store blob.BlobStore) (*Store, error) {
+	ttl := defaultUploadTTL
+	if value, ok := os.LookupEnv("UPLOAD_TTL"); ok {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("wrong UPLOAD_TTL " + value)
+		}
+		ttl = parsed
+	}
+	return NewStore(store, ttl), nil
+}
+
+func (s *Store) TTL() time.Duration {
+	return s.ttl
+}
+
+func uploadPrefix(id uuid.UUID) string {
+	return UploadsPrefix + id.String() + "/"
+}
+
+func infoKey(id uuid.UUID) string {
+	return uploadPrefix(id) + infoName
+}
+
+func dataKey(id uuid.UUID) string {
+	return uploadPrefix(id) + dataName
+}
+
+func partKey(id uuid.UUID, offset int64) string {
+	// смещение дополняется нулями, чтобы куски сортировались по порядку
+	return fmt.Sprintf("%s%s%020d", uploadPrefix(id), partsDir, offset)
+}
+
+func (s *Store) Create(ctx context.Context, upload models.Upload) error {
+	if upload.Length < 0 {
+		return models.WrongData
+	}
+	data, err := easyjson.Marshal(&upload)
+	if err != nil {
+		return err
+	}
+	return s.store.Put(ctx, infoKey(upload.Id), bytes.NewReader(data), int64(len(data)), "application/json")
+}
+
+func (s *Store) Get(ctx context.Context, id uuid.UUID) (models.Upload, error) {
+	body, err := s.store.Get(ctx, infoKey(id))
+	if err != nil {
+		return models.Upload{}, err
+	}
+	defer body.Close()
+	var upload models.Upload
+	if err = easyjson.UnmarshalFromReader(body, &upload); err != nil {
+		return models.Upload{}, err
+	}
+
+	if _, err = s.store.Stat(ctx, dataKey(id)); err == nil {
+		upload.Offset = upload.Length
+		return upload, nil
+	} else if !errors.Is(err, models.NotFound) {
+		return models.Upload{}, err
+	}
+
+	parts, err := s.parts(ctx, id)
+	if err != nil {
+		return models.Upload{}, err
+	}
+	for _, part := range parts {
+		upload.Offset += part.Size
+	}
+	return upload, nil
+}
+
+// parts возвращает куски, которые продолжают друг друга без пропусков
+func (s *Store) parts(ctx context.Context, id uuid.UUID) ([]models.BlobInfo, error) {
+	blobs, err := s.store.List(ctx, uploadPrefix(id)+partsDir)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(blobs, func(i, j int) bool {
+		return blobs[i].Key < blobs[j].Key
+	})
+	parts := make([]models.BlobInfo, 0, len(blobs))
+	var offset int64
+	for _, part := range blobs {
+		partOffset, err := strconv.ParseInt(strings.TrimPrefix(part.Key, uploadPrefix(id)+partsDir), 10, 64)
+		if err != nil || partOffset != offset {
+			// остаток куска, который писался одновременно с другим
+			continue
+		}
+		parts = append(parts, part)
+		offset += part.Size
+	}
+	return parts, nil
+}
+
+func (s *Store) Append(ctx context.Context, upload models.Upload, offset int64, data io.Reader, size int64) (models.Upload, error) {
+	if offset != upload.Offset {
+		return upload, models.OffsetMismatch
+	}
+	remaining := upload.Length - upload.Offset
+	if size > remaining {
+		return upload, models.TooLarge
+	}
+	if size != 0 && remaining > 0 {
+		reader := &limitedReader{reader: data, remaining: remaining}
+		if err := s.store.Put(ctx, partKey(upload.Id, offset), reader, size, ""); err != nil {
+			if reader.exceeded {
+				return upload, models.TooLarge
+			}
+			return upload, err
+		}
+		upload.Offset += reader.read
+	}
+	if upload.IsComplete() {
+		if err := s.assemble(ctx, upload); err != nil {
+			return upload, err
+		}
+	}
+	return upload, nil
+}
+
+// assemble склеивает куски в один файл и удаляет их
+func (s *Store) assemble(ctx context.Context, upload models.Upload) error {
+	parts, err := s.parts(ctx, upload.Id)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(parts))
+	for _, part := range parts {
+		keys = append(keys, part.Key)
+	}
+	reader := &concatReader{ctx: ctx, store: s.store, keys: keys}
+	defer reader.Close()
+	if err = s.store.Put(ctx, dataKey(upload.Id), reader, upload.Length, upload.Metadata["filetype"]); err != nil {
+		return err
+	}
+	return blob.DeletePrefix(ctx, s.store, uploadPrefix(upload.Id)+partsDir)
+}
+
+func (s *Store) Open(ctx context.Context, upload models.Upload) (io.ReadCloser, error) {
+	return s.store.Get(ctx, dataKey(upload.Id))
+}
+
+func (s *Store) ReadHead(ctx context.Context, upload models.Upload, n int64) ([]byte, error) {
+	body, err := s.store.GetRange(ctx, dataKey(upload.Id), 0, n)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+func (s *Store) Delete(ctx context.Context, id uuid.UUID) error {
+	return blob.DeletePrefix(ctx, s.store, uploadPrefix(id))
+}
+
+func (s *Store) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	blobs, err := s.store.List(ctx, UploadsPrefix)
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, info := range blobs {
+		if !strings.HasSuffix(info.Key, "/"+infoName) {
+			continue
+		}
+		id, err := uuid.Parse(strings.TrimSuffix(strings.TrimPrefix(info.Key, UploadsPrefix), "/"+infoName))
+		if err != nil {
+			continue
+		}
+		upload, err := s.Get(ctx, id)
+		if errors.Is(err, models.NotFound) {
+			continue
+		}
+		if err != nil {
+			return deleted, err
+		}
+		if !upload.IsExpired(now) {
+			continue
+		}
+		if err = s.Delete(ctx, id); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// limitedReader не даёт дописать больше, чем осталось до объявленного размера файла
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+	read      int64
+	exceeded  bool
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if r.read > r.remaining {
+		r.exceeded = true
+		return n, models.TooLarge
+	}
+	return n, err
+}
+
+// concatReader читает файлы по очереди, открывая следующий, когда закончился предыдущий
+type concatReader struct {
+	ctx     context.Context
+	store   blob.BlobStore
+	keys    []string
+	current io.ReadCloser
+}
+
+func (r *concatReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.keys) == 0 {
+				return 0, io.EOF
+			}
+			body, err := r.store.Get(r.ctx, r.keys[0])
+			if err != nil {
+				return 0, err
+			}
+			r.current, r.keys = body, r.keys[1:]
+		}
+		n, err := r.current.Read(p)
+		if errors.Is(err, io.EOF) {
+			err = r.current.Close()
+			r.current = nil
+			if n > 0 || err != nil {
+				return n, err
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (r *concatReader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+	err := r.current.Close()
+	r.current = nil
+	return err
+}
@@ -0,0 +1,140 @@
+package upload
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/local"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T) (*Store, *local.Store) {
+	blobs, err := local.NewStore(filepath.Join(t.TempDir(), "images"))
+	require.NoError(t, err)
+	return NewStore(blobs, time.Hour), blobs
+}
+
+func newTestUpload(length int64, now time.Time) models.Upload {
+	return models.Upload{
+		Id:        uuid.New(),
+		UserId:    uuid.New(),
+		CreatorId: uuid.New(),
+		Length:    length,
+		Metadata:  map[string]string{models.UploadMetadataFilename: "video.mp4"},
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+}
+
+func TestStore_Append(t *testing.T) {
+	store, blobs := newTestStore(t)
+	ctx := context.Background()
+	upload := newTestUpload(10, time.Now().UTC().Truncate(time.Second))
+	require.NoError(t, store.Create(ctx, upload))
+
+	got, err := store.Get(ctx, upload.Id)
+	require.NoError(t, err)
+	require.Equal(t, upload.Id, got.Id)
+	require.Equal(t, int64(0), got.Offset)
+	require.Equal(t, "video.mp4", got.Metadata[models.UploadMetadataFilename])
+	require.True(t, upload.ExpiresAt.Equal(got.ExpiresAt))
+
+	got, err = store.Append(ctx, got, 0, strings.NewReader("0123"), 4)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), got.Offset)
+	require.False(t, got.IsComplete())
+
+	_, err = store.Append(ctx, got, 0, strings.NewReader("0123"), 4)
+	require.ErrorIs(t, err, models.OffsetMismatch)
+	_, err = store.Append(ctx, got, 4, strings.NewReader("4567890123"), 10)
+	require.ErrorIs(t, err, models.TooLarge)
+	_, err = store.Append(ctx, got, 4, strings.NewReader("4567890123"), -1)
+	require.ErrorIs(t, err, models.TooLarge)
+
+	// после обрыва соединения смещение считается заново по сохранённым кускам
+	got, err = store.Get(ctx, upload.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), got.Offset)
+
+	got, err = store.Append(ctx, got, 4, strings.NewReader("456789"), -1)
+	require.NoError(t, err)
+	require.True(t, got.IsComplete())
+
+	got, err = store.Get(ctx, upload.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), got.Offset)
+
+	body, err := store.Open(ctx, got)
+	require.NoError(t, err)
+	data, err := io.ReadAll(body)
+	require.NoError(t, body.Close())
+	require.NoError(t, err)
+	require.Equal(t, "0123456789", string(data))
+
+	head, err := store.ReadHead(ctx, got, 3)
+	require.NoError(t, err)
+	require.Equal(t, "012", string(head))
+
+	parts, err := blobs.List(ctx, uploadPrefix(upload.Id)+partsDir)
+	require.NoError(t, err)
+	require.Empty(t, parts)
+
+	require.NoError(t, store.Delete(ctx, upload.Id))
+	_, err = store.Get(ctx, upload.Id)
+	require.ErrorIs(t, err, models.NotFound)
+}
+
+func TestStore_EmptyUpload(t *testing.T) {
+	store, _ := newTestStore(t)
+	ctx := context.Background()
+	upload := newTestUpload(0, time.Now())
+	require.NoError(t, store.Create(ctx, upload))
+
+	got, err := store.Append(ctx, upload, 0, strings.NewReader(""), 0)
+	require.NoError(t, err)
+	require.True(t, got.IsComplete())
+
+	data, err := store.ReadHead(ctx, got, 512)
+	require.NoError(t, err)
+	require.Empty(t, data)
+}
+
+func TestStore_DeleteExpired(t *testing.T) {
+	store, _ := newTestStore(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	expired := newTestUpload(4, now.Add(-2*time.Hour))
+	active := newTestUpload(4, now)
+	require.NoError(t, store.Create(ctx, expired))
+	require.NoError(t, store.Create(ctx, active))
+	_, err := store.Append(ctx, expired, 0, strings.NewReader("01"), 2)
+	require.NoError(t, err)
+
+	deleted, err := store.DeleteExpired(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	_, err = store.Get(ctx, expired.Id)
+	require.ErrorIs(t, err, models.NotFound)
+	_, err = store.Get(ctx, active.Id)
+	require.NoError(t, err)
+}
+
+func TestNewStoreFromEnv(t *testing.T) {
+	_, blobs := newTestStore(t)
+
+	t.Setenv("UPLOAD_TTL", "2h")
+	store, err := NewStoreFromEnv(blobs)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Hour, store.TTL())
+
+	t.Setenv("UPLOAD_TTL", "soon")
+	_, err = NewStoreFromEnv(blobs)
+	require.Error(t, err)
+}
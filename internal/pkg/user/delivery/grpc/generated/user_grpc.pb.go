@@ -33,10 +33,20 @@ type UserServiceClient interface {
 	UpdatePassword(ctx context.Context, in *UpdatePasswordMessage, opts ...grpc.CallOption) (*proto.Empty, error)
 	UpdateProfileInfo(ctx context.Context, in *UpdateProfileInfoMessage, opts ...grpc.CallOption) (*proto.Empty, error)
 	Donate(ctx context.Context, in *DonateMessage, opts ...grpc.CallOption) (*DonateResponse, error)
+	AddDonateInfo(ctx context.Context, in *DonateInfoMessage, opts ...grpc.CallOption) (*proto.Empty, error)
 	BecomeCreator(ctx context.Context, in *BecameCreatorInfoMessage, opts ...grpc.CallOption) (*proto.UUIDResponse, error)
 	UserSubscriptions(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*SubscriptionsMessage, error)
 	UserFollows(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*FollowsMessage, error)
 	CheckIfCreator(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*CheckCreatorMessage, error)
+	UserPayments(ctx context.Context, in *PaymentsFilter, opts ...grpc.CallOption) (*PaymentsMessage, error)
+	GetPayment(ctx context.Context, in *UserPaymentMessage, opts ...grpc.CallOption) (*PaymentMessage, error)
+	AddGiftInfo(ctx context.Context, in *GiftDetails, opts ...grpc.CallOption) (*proto.Empty, error)
+	PayGift(ctx context.Context, in *PaymentInfo, opts ...grpc.CallOption) (*GiftInfo, error)
+	RedeemGift(ctx context.Context, in *RedeemGiftMessage, opts ...grpc.CallOption) (*GiftInfo, error)
+	GetGift(ctx context.Context, in *UserPaymentMessage, opts ...grpc.CallOption) (*GiftInfo, error)
+	ClaimPaymentEvent(ctx context.Context, in *PaymentEvent, opts ...grpc.CallOption) (*PaymentEventStatus, error)
+	FinishPaymentEvent(ctx context.Context, in *PaymentEventResult, opts ...grpc.CallOption) (*proto.Empty, error)
+	RefundPayment(ctx context.Context, in *Refund, opts ...grpc.CallOption) (*RefundMessage, error)
 }
 
 type userServiceClient struct {
@@ -137,6 +147,15 @@ func (c *userServiceClient) Donate(ctx context.Context, in *DonateMessage, opts
 	return out, nil
 }
 
+func (c *userServiceClient) AddDonateInfo(ctx context.Context, in *DonateInfoMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, "/UserService/AddDonateInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) BecomeCreator(ctx context.Context, in *BecameCreatorInfoMessage, opts ...grpc.CallOption) (*proto.UUIDResponse, error) {
 	out := new(proto.UUIDResponse)
 	err := c.cc.Invoke(ctx, "/UserService/BecomeCreator", in, out, opts...)
@@ -173,6 +192,87 @@ func (c *userServiceClient) CheckIfCreator(ctx context.Context, in *proto.UUIDMe
 	return out, nil
 }
 
+func (c *userServiceClient) UserPayments(ctx context.Context, in *PaymentsFilter, opts ...grpc.CallOption) (*PaymentsMessage, error) {
+	out := new(PaymentsMessage)
+	err := c.cc.Invoke(ctx, "/UserService/UserPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPayment(ctx context.Context, in *UserPaymentMessage, opts ...grpc.CallOption) (*PaymentMessage, error) {
+	out := new(PaymentMessage)
+	err := c.cc.Invoke(ctx, "/UserService/GetPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddGiftInfo(ctx context.Context, in *GiftDetails, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, "/UserService/AddGiftInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) PayGift(ctx context.Context, in *PaymentInfo, opts ...grpc.CallOption) (*GiftInfo, error) {
+	out := new(GiftInfo)
+	err := c.cc.Invoke(ctx, "/UserService/PayGift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RedeemGift(ctx context.Context, in *RedeemGiftMessage, opts ...grpc.CallOption) (*GiftInfo, error) {
+	out := new(GiftInfo)
+	err := c.cc.Invoke(ctx, "/UserService/RedeemGift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGift(ctx context.Context, in *UserPaymentMessage, opts ...grpc.CallOption) (*GiftInfo, error) {
+	out := new(GiftInfo)
+	err := c.cc.Invoke(ctx, "/UserService/GetGift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ClaimPaymentEvent(ctx context.Context, in *PaymentEvent, opts ...grpc.CallOption) (*PaymentEventStatus, error) {
+	out := new(PaymentEventStatus)
+	err := c.cc.Invoke(ctx, "/UserService/ClaimPaymentEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FinishPaymentEvent(ctx context.Context, in *PaymentEventResult, opts ...grpc.CallOption) (*proto.Empty, error) {
+	out := new(proto.Empty)
+	err := c.cc.Invoke(ctx, "/UserService/FinishPaymentEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RefundPayment(ctx context.Context, in *Refund, opts ...grpc.CallOption) (*RefundMessage, error) {
+	out := new(RefundMessage)
+	err := c.cc.Invoke(ctx, "/UserService/RefundPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -187,10 +287,20 @@ type UserServiceServer interface {
 	UpdatePassword(context.Context, *UpdatePasswordMessage) (*proto.Empty, error)
 	UpdateProfileInfo(context.Context, *UpdateProfileInfoMessage) (*proto.Empty, error)
 	Donate(context.Context, *DonateMessage) (*DonateResponse, error)
+	AddDonateInfo(context.Context, *DonateInfoMessage) (*proto.Empty, error)
 	BecomeCreator(context.Context, *BecameCreatorInfoMessage) (*proto.UUIDResponse, error)
 	UserSubscriptions(context.Context, *proto.UUIDMessage) (*SubscriptionsMessage, error)
 	UserFollows(context.Context, *proto.UUIDMessage) (*FollowsMessage, error)
 	CheckIfCreator(context.Context, *proto.UUIDMessage) (*CheckCreatorMessage, error)
+	UserPayments(context.Context, *PaymentsFilter) (*PaymentsMessage, error)
+	GetPayment(context.Context, *UserPaymentMessage) (*PaymentMessage, error)
+	AddGiftInfo(context.Context, *GiftDetails) (*proto.Empty, error)
+	PayGift(context.Context, *PaymentInfo) (*GiftInfo, error)
+	RedeemGift(context.Context, *RedeemGiftMessage) (*GiftInfo, error)
+	GetGift(context.Context, *UserPaymentMessage) (*GiftInfo, error)
+	ClaimPaymentEvent(context.Context, *PaymentEvent) (*PaymentEventStatus, error)
+	FinishPaymentEvent(context.Context, *PaymentEventResult) (*proto.Empty, error)
+	RefundPayment(context.Context, *Refund) (*RefundMessage, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -228,6 +338,9 @@ func (UnimplementedUserServiceServer) UpdateProfileInfo(context.Context, *Update
 func (UnimplementedUserServiceServer) Donate(context.Context, *DonateMessage) (*DonateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Donate not implemented")
 }
+func (UnimplementedUserServiceServer) AddDonateInfo(context.Context, *DonateInfoMessage) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDonateInfo not implemented")
+}
 func (UnimplementedUserServiceServer) BecomeCreator(context.Context, *BecameCreatorInfoMessage) (*proto.UUIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BecomeCreator not implemented")
 }
@@ -240,6 +353,33 @@ func (UnimplementedUserServiceServer) UserFollows(context.Context, *proto.UUIDMe
 func (UnimplementedUserServiceServer) CheckIfCreator(context.Context, *proto.UUIDMessage) (*CheckCreatorMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckIfCreator not implemented")
 }
+func (UnimplementedUserServiceServer) UserPayments(context.Context, *PaymentsFilter) (*PaymentsMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPayments not implemented")
+}
+func (UnimplementedUserServiceServer) GetPayment(context.Context, *UserPaymentMessage) (*PaymentMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (UnimplementedUserServiceServer) AddGiftInfo(context.Context, *GiftDetails) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGiftInfo not implemented")
+}
+func (UnimplementedUserServiceServer) PayGift(context.Context, *PaymentInfo) (*GiftInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayGift not implemented")
+}
+func (UnimplementedUserServiceServer) RedeemGift(context.Context, *RedeemGiftMessage) (*GiftInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemGift not implemented")
+}
+func (UnimplementedUserServiceServer) GetGift(context.Context, *UserPaymentMessage) (*GiftInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGift not implemented")
+}
+func (UnimplementedUserServiceServer) ClaimPaymentEvent(context.Context, *PaymentEvent) (*PaymentEventStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPaymentEvent not implemented")
+}
+func (UnimplementedUserServiceServer) FinishPaymentEvent(context.Context, *PaymentEventResult) (*proto.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishPaymentEvent not implemented")
+}
+func (UnimplementedUserServiceServer) RefundPayment(context.Context, *Refund) (*RefundMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPayment not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -433,6 +573,24 @@ func _UserService_Donate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_AddDonateInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DonateInfoMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddDonateInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/AddDonateInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddDonateInfo(ctx, req.(*DonateInfoMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_BecomeCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BecameCreatorInfoMessage)
 	if err := dec(in); err != nil {
@@ -505,6 +663,168 @@ func _UserService_CheckIfCreator_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UserPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentsFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/UserPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserPayments(ctx, req.(*PaymentsFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPaymentMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/GetPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPayment(ctx, req.(*UserPaymentMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddGiftInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GiftDetails)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddGiftInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/AddGiftInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddGiftInfo(ctx, req.(*GiftDetails))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_PayGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).PayGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/PayGift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).PayGift(ctx, req.(*PaymentInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RedeemGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemGiftMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RedeemGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/RedeemGift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RedeemGift(ctx, req.(*RedeemGiftMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPaymentMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/GetGift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGift(ctx, req.(*UserPaymentMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ClaimPaymentEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ClaimPaymentEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/ClaimPaymentEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ClaimPaymentEvent(ctx, req.(*PaymentEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FinishPaymentEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentEventResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FinishPaymentEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/FinishPaymentEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FinishPaymentEvent(ctx, req.(*PaymentEventResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Refund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/RefundPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefundPayment(ctx, req.(*Refund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -552,6 +872,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Donate",
 			Handler:    _UserService_Donate_Handler,
 		},
+		{
+			MethodName: "AddDonateInfo",
+			Handler:    _UserService_AddDonateInfo_Handler,
+		},
 		{
 			MethodName: "BecomeCreator",
 			Handler:    _UserService_BecomeCreator_Handler,
@@ -568,6 +892,42 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckIfCreator",
 			Handler:    _UserService_CheckIfCreator_Handler,
 		},
+		{
+			MethodName: "UserPayments",
+			Handler:    _UserService_UserPayments_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _UserService_GetPayment_Handler,
+		},
+		{
+			MethodName: "AddGiftInfo",
+			Handler:    _UserService_AddGiftInfo_Handler,
+		},
+		{
+			MethodName: "PayGift",
+			Handler:    _UserService_PayGift_Handler,
+		},
+		{
+			MethodName: "RedeemGift",
+			Handler:    _UserService_RedeemGift_Handler,
+		},
+		{
+			MethodName: "GetGift",
+			Handler:    _UserService_GetGift_Handler,
+		},
+		{
+			MethodName: "ClaimPaymentEvent",
+			Handler:    _UserService_ClaimPaymentEvent_Handler,
+		},
+		{
+			MethodName: "FinishPaymentEvent",
+			Handler:    _UserService_FinishPaymentEvent_Handler,
+		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _UserService_RefundPayment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -7,6 +7,7 @@ import (
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user"
 	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
 	"github.com/google/uuid"
+	"time"
 )
 
 //go:generate mockgen -source=./generated/user_grpc.pb.go -destination=../../mocks/user_grpc.go -package=mock
@@ -91,7 +92,7 @@ func (h GrpcUserHandler) Subscribe(ctx context.Context, in *generatedUser.Paymen
 		return &generatedUser.SubscriptionName{Error: err.Error()}, nil
 	}
 
-	subNotification, err := h.uc.Subscribe(ctx, paymentInfo, in.Money)
+	subNotification, err := h.uc.Subscribe(ctx, paymentInfo, models.MoneyFromProto(in.Money), models.MoneyFromProto(in.Paid), in.OperationID)
 	if err != nil {
 		return &generatedUser.SubscriptionName{Error: err.Error()}, nil
 	}
@@ -176,13 +177,61 @@ func (h GrpcUserHandler) Donate(ctx context.Context, in *generatedUser.DonateMes
 	if err != nil {
 		return &generatedUser.DonateResponse{Error: err.Error()}, nil
 	}
-	money, err := h.uc.Donate(ctx, models.Donate{
-		CreatorID:  creatorId,
-		MoneyCount: in.MoneyCount})
+	var paymentInfo uuid.UUID
+	if len(in.PaymentInfo) != 0 {
+		if paymentInfo, err = uuid.Parse(in.PaymentInfo); err != nil {
+			return &generatedUser.DonateResponse{Error: err.Error()}, nil
+		}
+	}
+	aim, err := h.uc.Donate(ctx, models.Donate{
+		CreatorID:   creatorId,
+		MoneyCount:  models.MoneyFromProto(in.MoneyCount),
+		OperationId: in.OperationID,
+		PaymentInfo: paymentInfo})
 	if err != nil {
 		return &generatedUser.DonateResponse{Error: err.Error()}, nil
 	}
-	return &generatedUser.DonateResponse{MoneyCount: money, Error: ""}, nil
+	if aim.Id == uuid.Nil {
+		return &generatedUser.DonateResponse{Error: ""}, nil
+	}
+	return &generatedUser.DonateResponse{
+		MoneyCount:     aim.MoneyGot.ToProto(),
+		AimID:          aim.Id.String(),
+		AimDescription: aim.Description,
+		AimReached:     aim.CompletedAt != nil,
+		Error:          ""}, nil
+}
+
+func (h GrpcUserHandler) AddDonateInfo(ctx context.Context, in *generatedUser.DonateInfoMessage) (*generatedCommon.Empty, error) {
+	paymentInfo, err := uuid.Parse(in.PaymentInfo)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	userId, err := uuid.Parse(in.UserID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	creatorId, err := uuid.Parse(in.CreatorID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	var aimId uuid.UUID
+	if len(in.AimID) != 0 {
+		if aimId, err = uuid.Parse(in.AimID); err != nil {
+			return &generatedCommon.Empty{Error: err.Error()}, nil
+		}
+	}
+	err = h.uc.AddDonateInfo(ctx, models.Donate{
+		AimId:       aimId,
+		PaymentInfo: paymentInfo,
+		UserId:      userId,
+		CreatorID:   creatorId,
+		Message:     in.Message,
+		IsAnonymous: in.IsAnonymous})
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	return &generatedCommon.Empty{Error: ""}, nil
 }
 
 func (h GrpcUserHandler) BecomeCreator(ctx context.Context, in *generatedUser.BecameCreatorInfoMessage) (*generatedCommon.UUIDResponse, error) {
@@ -215,7 +264,7 @@ func (h GrpcUserHandler) UserSubscriptions(ctx context.Context, in *generatedCom
 			Creator:      v.Creator.String(),
 			CreatorName:  v.CreatorName,
 			CreatorPhoto: v.CreatorPhoto.String(),
-			MonthCost:    v.MonthCost,
+			MonthCost:    v.MonthCost.ToProto(),
 			Title:        v.Title,
 			Description:  v.Description,
 		})
@@ -260,3 +309,198 @@ func (h GrpcUserHandler) UserFollows(ctx context.Context, in *generatedCommon.UU
 	followsProto.Error = ""
 	return &followsProto, nil
 }
+
+func paymentToProto(payment models.Payment) *generatedUser.Payment {
+	out := &generatedUser.Payment{
+		Id:             payment.Id.String(),
+		Type:           payment.Type,
+		CreatorID:      payment.CreatorId.String(),
+		CreatorName:    payment.CreatorName,
+		SubscriptionID: payment.SubscriptionId.String(),
+		Tier:           payment.Tier,
+		Money:          payment.Money.ToProto(),
+		MonthCount:     payment.MonthCount,
+		PaymentTime:    payment.PaymentTime.Format(time.RFC3339),
+		OperationID:    payment.OperationId,
+	}
+	if payment.PeriodStart != nil && payment.PeriodEnd != nil {
+		out.PeriodStart = payment.PeriodStart.Format(time.RFC3339)
+		out.PeriodEnd = payment.PeriodEnd.Format(time.RFC3339)
+	}
+	return out
+}
+
+func (h GrpcUserHandler) UserPayments(ctx context.Context, in *generatedUser.PaymentsFilter) (*generatedUser.PaymentsMessage, error) {
+	filter := models.PaymentsFilter{
+		Type:   in.Type,
+		Limit:  in.Limit,
+		Offset: in.Offset,
+	}
+	var err error
+	if filter.UserId, err = uuid.Parse(in.UserID); err != nil {
+		return &generatedUser.PaymentsMessage{Error: err.Error()}, nil
+	}
+	if len(in.CreatorID) != 0 {
+		if filter.CreatorId, err = uuid.Parse(in.CreatorID); err != nil {
+			return &generatedUser.PaymentsMessage{Error: err.Error()}, nil
+		}
+	}
+	if len(in.From) != 0 {
+		if filter.From, err = time.Parse(time.RFC3339, in.From); err != nil {
+			return &generatedUser.PaymentsMessage{Error: err.Error()}, nil
+		}
+	}
+	if len(in.To) != 0 {
+		if filter.To, err = time.Parse(time.RFC3339, in.To); err != nil {
+			return &generatedUser.PaymentsMessage{Error: err.Error()}, nil
+		}
+	}
+
+	payments, err := h.uc.UserPayments(ctx, filter)
+	if err != nil {
+		return &generatedUser.PaymentsMessage{Error: err.Error()}, nil
+	}
+	var paymentsProto generatedUser.PaymentsMessage
+	for _, v := range payments {
+		paymentsProto.Payments = append(paymentsProto.Payments, paymentToProto(v))
+	}
+	paymentsProto.Error = ""
+	return &paymentsProto, nil
+}
+
+func (h GrpcUserHandler) GetPayment(ctx context.Context, in *generatedUser.UserPaymentMessage) (*generatedUser.PaymentMessage, error) {
+	userId, err := uuid.Parse(in.UserID)
+	if err != nil {
+		return &generatedUser.PaymentMessage{Error: err.Error()}, nil
+	}
+	paymentId, err := uuid.Parse(in.PaymentID)
+	if err != nil {
+		return &generatedUser.PaymentMessage{Error: err.Error()}, nil
+	}
+	payment, err := h.uc.GetPayment(ctx, userId, paymentId)
+	if err != nil {
+		return &generatedUser.PaymentMessage{Error: err.Error()}, nil
+	}
+	return &generatedUser.PaymentMessage{Payment: paymentToProto(payment), Error: ""}, nil
+}
+
+func giftToProto(gift models.Gift) *generatedUser.GiftInfo {
+	return &generatedUser.GiftInfo{
+		GiftID:           gift.Id.String(),
+		SubscriptionID:   gift.SubscriptionId.String(),
+		SubscriptionName: gift.SubscriptionName,
+		CreatorID:        gift.CreatorId.String(),
+		BuyerID:          gift.BuyerId.String(),
+		RecipientID:      gift.RecipientId.String(),
+		MonthCount:       gift.MonthCount,
+		Code:             gift.Code,
+		IsPaid:           gift.IsPaid,
+		IsRedeemed:       gift.IsRedeemed,
+		Error:            "",
+	}
+}
+
+func (h GrpcUserHandler) AddGiftInfo(ctx context.Context, in *generatedUser.GiftDetails) (*generatedCommon.Empty, error) {
+	giftId, err := uuid.Parse(in.GiftID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	subId, err := uuid.Parse(in.SubscriptionID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	buyerId, err := uuid.Parse(in.BuyerID)
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	var recipientId uuid.UUID
+	if len(in.RecipientID) != 0 {
+		if recipientId, err = uuid.Parse(in.RecipientID); err != nil {
+			return &generatedCommon.Empty{Error: err.Error()}, nil
+		}
+	}
+	err = h.uc.AddGiftInfo(ctx, models.Gift{
+		Id:             giftId,
+		SubscriptionId: subId,
+		BuyerId:        buyerId,
+		RecipientId:    recipientId,
+		MonthCount:     in.MonthCount,
+	})
+	if err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	return &generatedCommon.Empty{Error: ""}, nil
+}
+
+func (h GrpcUserHandler) PayGift(ctx context.Context, in *generatedUser.PaymentInfo) (*generatedUser.GiftInfo, error) {
+	giftId, err := uuid.Parse(in.PaymentID)
+	if err != nil {
+		return &generatedUser.GiftInfo{Error: err.Error()}, nil
+	}
+	gift, err := h.uc.PayGift(ctx, giftId, models.MoneyFromProto(in.Money), models.MoneyFromProto(in.Paid), in.OperationID)
+	if err != nil {
+		return &generatedUser.GiftInfo{Error: err.Error()}, nil
+	}
+	return giftToProto(gift), nil
+}
+
+func (h GrpcUserHandler) RedeemGift(ctx context.Context, in *generatedUser.RedeemGiftMessage) (*generatedUser.GiftInfo, error) {
+	userId, err := uuid.Parse(in.UserID)
+	if err != nil {
+		return &generatedUser.GiftInfo{Error: err.Error()}, nil
+	}
+	gift, err := h.uc.RedeemGift(ctx, userId, in.Code)
+	if err != nil {
+		return &generatedUser.GiftInfo{Error: err.Error()}, nil
+	}
+	return giftToProto(gift), nil
+}
+
+func (h GrpcUserHandler) GetGift(ctx context.Context, in *generatedUser.UserPaymentMessage) (*generatedUser.GiftInfo, error) {
+	userId, err := uuid.Parse(in.UserID)
+	if err != nil {
+		return &generatedUser.GiftInfo{Error: err.Error()}, nil
+	}
+	giftId, err := uuid.Parse(in.PaymentID)
+	if err != nil {
+		return &generatedUser.GiftInfo{Error: err.Error()}, nil
+	}
+	gift, err := h.uc.GetGift(ctx, userId, giftId)
+	if err != nil {
+		return &generatedUser.GiftInfo{Error: err.Error()}, nil
+	}
+	return giftToProto(gift), nil
+}
+
+func (h GrpcUserHandler) ClaimPaymentEvent(ctx context.Context, in *generatedUser.PaymentEvent) (*generatedUser.PaymentEventStatus, error) {
+	var event models.PaymentEvent
+	if err := event.ProtoPaymentEventToModel(in); err != nil {
+		return &generatedUser.PaymentEventStatus{Error: err.Error()}, nil
+	}
+	status, err := h.uc.ClaimPaymentEvent(ctx, event)
+	if err != nil {
+		return &generatedUser.PaymentEventStatus{Error: err.Error()}, nil
+	}
+	return &generatedUser.PaymentEventStatus{Status: status}, nil
+}
+
+func (h GrpcUserHandler) FinishPaymentEvent(ctx context.Context, in *generatedUser.PaymentEventResult) (*generatedCommon.Empty, error) {
+	if err := h.uc.FinishPaymentEvent(ctx, in.OperationID, in.LastError); err != nil {
+		return &generatedCommon.Empty{Error: err.Error()}, nil
+	}
+	return &generatedCommon.Empty{}, nil
+}
+
+// RefundPayment - возврат платежа, вызывается администратором (AdminID проверяется в usecase)
+// и обработчиком уведомлений о чарджбэках
+func (h GrpcUserHandler) RefundPayment(ctx context.Context, in *generatedUser.Refund) (*generatedUser.RefundMessage, error) {
+	var refund models.Refund
+	if err := refund.ProtoRefundToModel(in); err != nil {
+		return &generatedUser.RefundMessage{Error: models.WrongData.Error()}, nil
+	}
+	refund, err := h.uc.RefundPayment(ctx, refund)
+	if err != nil {
+		return &generatedUser.RefundMessage{Error: err.Error()}, nil
+	}
+	return &generatedUser.RefundMessage{Refund: refund.ToProto()}, nil
+}
@@ -1,13 +1,17 @@
 package http
 
 import (
+	"bytes"
 	"context"
-	"crypto/sha1"
+	"errors"
 	"fmt"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	generatedCommon "github.com/go-park-mail-ru/2023_1_4from5/internal/models/proto"
 	generatedAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/grpc/generated"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/imaging"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payment"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/token"
 	generatedUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/utils"
@@ -15,11 +19,10 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
 	"go.uber.org/zap"
+	"html/template"
 	"io"
 	"net/http"
-	"os"
 	"strconv"
-	"strings"
 	"time"
 )
 
@@ -27,14 +30,18 @@ type UserHandler struct {
 	userClient      generatedUser.UserServiceClient
 	authClient      generatedAuth.AuthServiceClient
 	notificationApp notification.NotificationApp
+	paymentGateway  payment.PaymentGateway
+	store           blob.BlobStore
 	logger          *zap.SugaredLogger
 }
 
-func NewUserHandler(userClient generatedUser.UserServiceClient, auc generatedAuth.AuthServiceClient, na notification.NotificationApp, logger *zap.SugaredLogger) *UserHandler {
+func NewUserHandler(userClient generatedUser.UserServiceClient, auc generatedAuth.AuthServiceClient, na notification.NotificationApp, pg payment.PaymentGateway, store blob.BlobStore, logger *zap.SugaredLogger) *UserHandler {
 	return &UserHandler{
 		userClient:      userClient,
 		authClient:      auc,
 		notificationApp: na,
+		paymentGateway:  pg,
+		store:           store,
 		logger:          logger,
 	}
 }
@@ -88,6 +95,52 @@ func (h *UserHandler) UnsubscribeUserNotifications(w http.ResponseWriter, r *htt
 	utils.Response(w, http.StatusOK, nil)
 }
 
+func (h *UserHandler) SubscribeToPersonalNotifications(w http.ResponseWriter, r *http.Request) {
+	userInfo, err := token.ExtractJWTTokenMetadata(r)
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	token := models.NotificationToken{}
+	err = easyjson.UnmarshalFromReader(r.Body, &token)
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	err = h.notificationApp.AddUserToNotificationTopic(fmt.Sprintf("%s-%s", userInfo.Id, "personal"), token, context.Background())
+	if err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	utils.Response(w, http.StatusOK, nil)
+}
+
+func (h *UserHandler) UnsubscribeFromPersonalNotifications(w http.ResponseWriter, r *http.Request) {
+	userInfo, err := token.ExtractJWTTokenMetadata(r)
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	token := models.NotificationToken{}
+	err = easyjson.UnmarshalFromReader(r.Body, &token)
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	err = h.notificationApp.RemoveUserFromNotificationTopic(fmt.Sprintf("%s-%s", userInfo.Id, "personal"), token, context.Background())
+	if err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	utils.Response(w, http.StatusOK, nil)
+}
+
 func (h *UserHandler) Follow(w http.ResponseWriter, r *http.Request) {
 	userInfo, err := token.ExtractJWTTokenMetadata(r)
 	if err != nil {
@@ -271,7 +324,7 @@ func (h *UserHandler) UpdateProfilePhoto(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	file, fileTmp, err := r.FormFile("upload")
+	file, _, err := r.FormFile("upload")
 	if err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
@@ -280,16 +333,20 @@ func (h *UserHandler) UpdateProfilePhoto(w http.ResponseWriter, r *http.Request)
 	// проверка типа файла
 	buf, _ := io.ReadAll(file)
 	file.Close()
-	if file, err = fileTmp.Open(); err != nil {
-		h.logger.Error(err)
+	if http.DetectContentType(buf) != "image/jpeg" && http.DetectContentType(buf) != "image/png" && http.DetectContentType(buf) != "image/jpg" {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
-	if http.DetectContentType(buf) != "image/jpeg" && http.DetectContentType(buf) != "image/png" && http.DetectContentType(buf) != "image/jpg" {
+	images, err := imaging.Process(bytes.NewReader(buf), imaging.AvatarSpecs, imaging.Options{Flatten: true})
+	if errors.Is(err, models.WrongData) || errors.Is(err, models.Unsupported) {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
-	defer file.Close()
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
 
 	var oldName uuid.UUID
 	oldName, err = uuid.Parse(r.PostFormValue("path"))
@@ -300,7 +357,7 @@ func (h *UserHandler) UpdateProfilePhoto(w http.ResponseWriter, r *http.Request)
 	}
 
 	if oldName != uuid.Nil {
-		err = os.Remove(models.FolderPath + fmt.Sprintf("%s.jpg", oldName.String()))
+		err = imaging.DeletePhoto(r.Context(), h.store, oldName.String())
 		if err != nil {
 			h.logger.Error(err)
 			utils.Response(w, http.StatusBadRequest, nil)
@@ -321,15 +378,7 @@ func (h *UserHandler) UpdateProfilePhoto(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	f, err := os.Create(fmt.Sprintf("%s%s.jpg", models.FolderPath, name.Value))
-	if err != nil {
-		h.logger.Error(err)
-		utils.Response(w, http.StatusInternalServerError, nil)
-		return
-	}
-	defer f.Close()
-
-	if _, err = io.Copy(f, file); err != nil {
+	if _, err = imaging.SavePhoto(r.Context(), h.store, name.Value, images); err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
@@ -631,7 +680,7 @@ func (h *UserHandler) UserSubscriptions(w http.ResponseWriter, r *http.Request)
 			Creator:      creatorId,
 			CreatorPhoto: creatorPhoto,
 			CreatorName:  v.CreatorName,
-			MonthCost:    v.MonthCost,
+			MonthCost:    models.MoneyFromProto(v.MonthCost),
 			Title:        v.Title,
 			Description:  v.Description,
 		}
@@ -642,139 +691,183 @@ func (h *UserHandler) UserSubscriptions(w http.ResponseWriter, r *http.Request)
 	utils.Response(w, http.StatusOK, subs)
 }
 
-func GetPaymentStringMap() map[string]string {
-	result := map[string]string{
-		"notification_type": "",
-		"operation_id":      "",
-		"amount":            "",
-		"currency":          "",
-		"datetime":          "",
-		"sender":            "",
-		"codepro":           "",
-		"label":             "",
-	}
-	return result
-}
-
 func (h *UserHandler) Payment(w http.ResponseWriter, r *http.Request) {
-	err := r.ParseForm()
+	event, err := h.paymentGateway.ParseNotification(r)
+	if errors.Is(err, models.Forbbiden) {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
 	if err != nil {
-		h.logger.Error(err)
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
-	//check sha-1
-	paymentStringMap := GetPaymentStringMap()
-
-	sha1Hash := ""
-	for key, value := range r.Form {
-		if key == "sha1_hash" {
-			sha1Hash = value[0]
-		}
-		if _, ok := paymentStringMap[key]; ok {
-			paymentStringMap[key] = value[0]
-		}
-	}
 
-	paymentSecret, flag := os.LookupEnv("PAYMENT_SECRET")
-	if !flag {
+	// платёжная система повторяет уведомление, пока не получит 200,
+	// поэтому одна и та же операция должна быть проведена ровно один раз
+	claim, err := h.userClient.ClaimPaymentEvent(r.Context(), event.ToProto())
+	if err != nil {
 		h.logger.Error(err)
 		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
-	paymentString := strings.Join([]string{paymentStringMap["notification_type"],
-		paymentStringMap["operation_id"], paymentStringMap["amount"], paymentStringMap["currency"], paymentStringMap["datetime"], paymentStringMap["sender"],
-		paymentStringMap["codepro"], paymentSecret, paymentStringMap["label"]}, "&")
-
-	hash := sha1.New()
-	hash.Write([]byte(paymentString))
-	paymentStringSHA := hash.Sum(nil)
-	if fmt.Sprintf("%x", paymentStringSHA) != sha1Hash {
-		utils.Response(w, http.StatusForbidden, nil)
+	if claim.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
-
-	paymentInfo := models.PaymentDetails{}
-	str := strings.Split(paymentStringMap["label"], ";")
-	paymentInfo.Operation = str[0]
-	paymentInfo.CreatorId, err = uuid.Parse(str[1])
-	if err != nil {
-		utils.Response(w, http.StatusBadRequest, nil)
+	if claim.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
 		return
 	}
-	if tmp, err := strconv.ParseFloat(paymentStringMap["amount"], 32); err != nil {
-		utils.Response(w, http.StatusBadRequest, nil)
+	if claim.Status == models.PaymentEventProcessed {
+		utils.Response(w, http.StatusOK, nil)
+		return
+	}
+	if claim.Status != models.PaymentEventClaimed { // уведомление прямо сейчас обрабатывается другим запросом
+		utils.Response(w, http.StatusConflict, nil)
 		return
-	} else {
-		paymentInfo.Money = float32(tmp)
 	}
 
-	if paymentInfo.Operation == "subscribe" {
+	code, lastError := h.processPayment(r.Context(), event)
+	out, err := h.userClient.FinishPaymentEvent(r.Context(), &generatedUser.PaymentEventResult{
+		OperationID: event.OperationID,
+		LastError:   lastError,
+	})
+	if err == nil && out.Error != "" {
+		err = errors.New(out.Error)
+	}
+	if err != nil {
+		h.logger.Error(err)
+	}
 
-		out, err := h.userClient.Subscribe(r.Context(), &generatedUser.PaymentInfo{PaymentID: paymentInfo.CreatorId.String(),
-			Money: paymentInfo.Money})
+	utils.Response(w, code, nil)
+}
+
+// processPayment проводит захваченное уведомление о платеже.
+// Возвращает код ответа платёжной системе и текст ошибки, если провести платёж не удалось.
+func (h *UserHandler) processPayment(ctx context.Context, event models.PaymentEvent) (int, string) {
+	switch event.Kind {
+	case models.PaymentKindSubscribe:
+		out, err := h.userClient.Subscribe(ctx, &generatedUser.PaymentInfo{PaymentID: event.TargetID.String(),
+			Money: event.Money.ToProto(), Paid: event.Paid.ToProto(), OperationID: event.OperationID})
 
 		if err != nil {
 			h.logger.Error(err)
-			utils.Response(w, http.StatusInternalServerError, nil)
-			return
+			return http.StatusInternalServerError, err.Error()
 		}
 
-		if out.Error == models.InternalError.Error() {
-			utils.Response(w, http.StatusInternalServerError, nil)
-			return
+		// недоплата сохранена для возврата, повторять уведомление бесполезно
+		if out.Error == models.Underpaid.Error() {
+			return http.StatusOK, ""
 		}
 
 		if out.Error == models.WrongData.Error() {
-			utils.Response(w, http.StatusBadRequest, nil)
-			return
+			return http.StatusBadRequest, out.Error
+		}
+
+		if out.Error != "" {
+			return http.StatusInternalServerError, out.Error
 		}
 
 		_ = h.notificationApp.SendUserNotification(models.Notification{
 			Topic: fmt.Sprintf("%s-%s", out.CreatorID, "creator"),
 			Title: "Новая подписка",
 			Body:  fmt.Sprintf("На вас была оформлена подписка %s", out.Name),
-		}, r.Context())
-
-		utils.Response(w, http.StatusOK, nil)
-	} else if paymentInfo.Operation == "donate" {
-
-		newMoneyCount, err := h.userClient.Donate(r.Context(), &generatedUser.DonateMessage{
-			MoneyCount: paymentInfo.Money,
-			CreatorID:  paymentInfo.CreatorId.String()})
+		}, ctx)
+	case models.PaymentKindDonate:
+		var paymentInfo string
+		if event.PaymentInfo != uuid.Nil {
+			paymentInfo = event.PaymentInfo.String()
+		}
+		newMoneyCount, err := h.userClient.Donate(ctx, &generatedUser.DonateMessage{
+			MoneyCount:  event.Money.ToProto(),
+			CreatorID:   event.TargetID.String(),
+			OperationID: event.OperationID,
+			PaymentInfo: paymentInfo})
 
 		if err != nil {
 			h.logger.Error(err)
-			utils.Response(w, http.StatusInternalServerError, nil)
-			return
+			return http.StatusInternalServerError, err.Error()
 		}
 
 		if newMoneyCount.Error == models.WrongData.Error() {
-			utils.Response(w, http.StatusBadRequest, nil)
-			return
+			return http.StatusBadRequest, newMoneyCount.Error
 		}
 		if newMoneyCount.Error != "" {
-			utils.Response(w, http.StatusInternalServerError, nil)
-			return
+			return http.StatusInternalServerError, newMoneyCount.Error
 		}
 
 		_ = h.notificationApp.SendUserNotification(models.Notification{
-			Topic: fmt.Sprintf("%s-%s", paymentInfo.CreatorId.String(), "creator"),
+			Topic: fmt.Sprintf("%s-%s", event.TargetID.String(), "creator"),
 			Title: "Новый донат",
-			Body:  fmt.Sprintf("Вам пришёл новый донат на сумму %f", paymentInfo.Money),
-		}, r.Context())
+			Body:  fmt.Sprintf("Вам пришёл новый донат на сумму %s ₽", event.Money),
+		}, ctx)
+
+		if newMoneyCount.AimReached {
+			for _, topic := range []string{"user", "creator"} {
+				_ = h.notificationApp.SendUserNotification(models.Notification{
+					Topic: fmt.Sprintf("%s-%s", event.TargetID.String(), topic),
+					Title: "Цель достигнута",
+					Body:  fmt.Sprintf("Цель \"%s\" собрана", newMoneyCount.AimDescription),
+				}, ctx)
+			}
+		}
+	case models.PaymentKindGift:
+		out, err := h.userClient.PayGift(ctx, &generatedUser.PaymentInfo{PaymentID: event.TargetID.String(),
+			Money: event.Money.ToProto(), Paid: event.Paid.ToProto(), OperationID: event.OperationID})
 
-		utils.Response(w, http.StatusOK, nil)
-	} else {
-		utils.Response(w, http.StatusBadRequest, nil)
+		if err != nil {
+			h.logger.Error(err)
+			return http.StatusInternalServerError, err.Error()
+		}
+
+		if out.Error == models.Underpaid.Error() {
+			return http.StatusOK, ""
+		}
+
+		if out.Error == models.WrongData.Error() || out.Error == models.NotFound.Error() {
+			return http.StatusBadRequest, out.Error
+		}
+
+		if out.Error != "" {
+			return http.StatusInternalServerError, out.Error
+		}
+
+		h.notifyGift(ctx, out)
+	default:
+		return http.StatusBadRequest, models.WrongData.Error()
+	}
+	return http.StatusOK, ""
+}
+
+// notifyGift оповещает покупателя, получателя (если подарок уже активирован) и автора
+func (h *UserHandler) notifyGift(ctx context.Context, gift *generatedUser.GiftInfo) {
+	if gift.IsRedeemed {
+		_ = h.notificationApp.SendUserNotification(models.Notification{
+			Topic: fmt.Sprintf("%s-%s", gift.BuyerID, "personal"),
+			Title: "Подарок активирован",
+			Body:  fmt.Sprintf("Подаренная вами подписка %s активирована", gift.SubscriptionName),
+		}, ctx)
+		_ = h.notificationApp.SendUserNotification(models.Notification{
+			Topic: fmt.Sprintf("%s-%s", gift.RecipientID, "personal"),
+			Title: "Вам подарили подписку",
+			Body:  fmt.Sprintf("Вам подарили подписку %s на %d мес.", gift.SubscriptionName, gift.MonthCount),
+		}, ctx)
+		_ = h.notificationApp.SendUserNotification(models.Notification{
+			Topic: fmt.Sprintf("%s-%s", gift.CreatorID, "creator"),
+			Title: "Подарочная подписка",
+			Body:  fmt.Sprintf("Подписка %s была подарена", gift.SubscriptionName),
+		}, ctx)
+		return
 	}
+	_ = h.notificationApp.SendUserNotification(models.Notification{
+		Topic: fmt.Sprintf("%s-%s", gift.BuyerID, "personal"),
+		Title: "Подарок оплачен",
+		Body:  fmt.Sprintf("Код для активации подписки %s: %s", gift.SubscriptionName, gift.Code),
+	}, ctx)
 }
 
 func (h *UserHandler) AddPaymentInfo(w http.ResponseWriter, r *http.Request) {
 	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
-
-	//TODO: проверить соответствие количества денег(и вообще в идеале не класть его и считать из month_count, и вообще должен лететь токен киви какой-нибудь)
-
 	if err != nil {
 		utils.Response(w, http.StatusUnauthorized, nil)
 		return
@@ -831,7 +924,7 @@ func (h *UserHandler) AddPaymentInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if subscription.MonthCount <= 0 {
+	if !models.IsBillingPeriod(subscription.MonthCount) {
 		utils.Response(w, http.StatusBadRequest, nil)
 		return
 	}
@@ -915,7 +1008,7 @@ func (h *UserHandler) DeleteProfilePhoto(w http.ResponseWriter, r *http.Request)
 	}
 
 	if oldName != uuid.Nil {
-		err = os.Remove(models.FolderPath + fmt.Sprintf("%s.jpg", oldName.String()))
+		err = imaging.DeletePhoto(r.Context(), h.store, oldName.String())
 		if err != nil {
 			h.logger.Error(err)
 			utils.Response(w, http.StatusBadRequest, nil)
@@ -986,3 +1079,460 @@ func (h *UserHandler) UserFollows(w http.ResponseWriter, r *http.Request) {
 
 	utils.Response(w, http.StatusOK, follows)
 }
+
+func (h *UserHandler) UserPayments(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := generatedUser.PaymentsFilter{
+		UserID:    userDataJWT.Id.String(),
+		CreatorID: query.Get("creator"),
+		Type:      query.Get("type"),
+		From:      query.Get("from"),
+		To:        query.Get("to"),
+	}
+	if filter.CreatorID != "" {
+		if _, err = uuid.Parse(filter.CreatorID); err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+	}
+	for _, v := range []string{filter.From, filter.To} {
+		if v == "" {
+			continue
+		}
+		if _, err = time.Parse(time.RFC3339, v); err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+	}
+	if limit := query.Get("limit"); limit != "" {
+		if filter.Limit, err = strconv.ParseInt(limit, 10, 64); err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+	}
+	if offset := query.Get("offset"); offset != "" {
+		if filter.Offset, err = strconv.ParseInt(offset, 10, 64); err != nil {
+			utils.Response(w, http.StatusBadRequest, nil)
+			return
+		}
+	}
+
+	out, err := h.userClient.UserPayments(r.Context(), &filter)
+
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	payments := make([]models.Payment, len(out.Payments))
+
+	for i, v := range out.Payments {
+		if err = payments[i].ProtoPaymentToModel(v); err != nil {
+			utils.Response(w, http.StatusInternalServerError, nil)
+			return
+		}
+		payments[i].Sanitize()
+	}
+
+	utils.Response(w, http.StatusOK, payments)
+}
+
+var receiptTemplate = template.Must(template.New("receipt").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Чек {{.Payment.Id}}</title></head>
+<body>
+<h1>Чек об оплате</h1>
+<p>Автор: {{.Payment.CreatorName}}</p>
+{{if .Payment.Tier}}<p>Подписка: {{.Payment.Tier}}</p>{{end}}
+<p>Сумма: {{.Payment.Money}} ₽</p>
+<p>Дата оплаты: {{.Payment.PaymentTime.Format "02.01.2006 15:04"}}</p>
+{{if .PeriodStart}}<p>Период: {{.PeriodStart.Format "02.01.2006"}} — {{.PeriodEnd.Format "02.01.2006"}}</p>{{end}}
+<p>Номер операции: {{.Payment.OperationId}}</p>
+</body>
+</html>
+`))
+
+func (h *UserHandler) PaymentReceipt(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	paymentID, ok := mux.Vars(r)["payment-uuid"]
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	if _, err = uuid.Parse(paymentID); err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	out, err := h.userClient.GetPayment(r.Context(), &generatedUser.UserPaymentMessage{
+		UserID:    userDataJWT.Id.String(),
+		PaymentID: paymentID})
+
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	var payment models.Payment
+	if err = payment.ProtoPaymentToModel(out.Payment); err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	receipt := payment.Receipt()
+	if r.URL.Query().Get("format") != "html" {
+		receipt.Payment.Sanitize()
+		utils.Response(w, http.StatusOK, receipt)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if err = receiptTemplate.Execute(w, receipt); err != nil {
+		h.logger.Error(err)
+	}
+}
+
+func (h *UserHandler) AddGiftInfo(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	uv, err := h.authClient.CheckUserVersion(r.Context(), &generatedAuth.AccessDetails{
+		Login:       userDataJWT.Login,
+		Id:          userDataJWT.Id.String(),
+		UserVersion: userDataJWT.UserVersion,
+	})
+	if err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if len(uv.Error) != 0 {
+		utils.Cookie(w, "", "SSID")
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+	if r.Method == http.MethodGet {
+		tokenCSRF, err := token.GetCSRFToken(models.User{Login: userDataJWT.Login, Id: userDataJWT.Id, UserVersion: userDataJWT.UserVersion})
+		if err != nil {
+			utils.Response(w, http.StatusUnauthorized, nil)
+			return
+		}
+		utils.ResponseWithCSRF(w, tokenCSRF)
+		return
+	}
+
+	userDataCSRF, err := token.ExtractCSRFTokenMetadata(r)
+	if err != nil || *userDataCSRF != *userDataJWT {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+
+	subUUID, ok := mux.Vars(r)["sub-uuid"]
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	_, err = uuid.Parse(subUUID)
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	gift := models.Gift{}
+
+	err = easyjson.UnmarshalFromReader(r.Body, &gift)
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	gift.BuyerId = userDataJWT.Id
+	if !gift.IsValid() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	gift.Id = uuid.New()
+	giftDetails := &generatedUser.GiftDetails{
+		GiftID:         gift.Id.String(),
+		SubscriptionID: subUUID,
+		BuyerID:        gift.BuyerId.String(),
+		MonthCount:     gift.MonthCount}
+	if gift.RecipientId != uuid.Nil {
+		giftDetails.RecipientID = gift.RecipientId.String()
+	}
+
+	out, err := h.userClient.AddGiftInfo(r.Context(), giftDetails)
+
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	utils.Response(w, http.StatusOK, gift.Id)
+}
+
+func (h *UserHandler) RedeemGift(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	uv, err := h.authClient.CheckUserVersion(r.Context(), &generatedAuth.AccessDetails{
+		Login:       userDataJWT.Login,
+		Id:          userDataJWT.Id.String(),
+		UserVersion: userDataJWT.UserVersion,
+	})
+	if err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if len(uv.Error) != 0 {
+		utils.Cookie(w, "", "SSID")
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+	if r.Method == http.MethodGet {
+		tokenCSRF, err := token.GetCSRFToken(models.User{Login: userDataJWT.Login, Id: userDataJWT.Id, UserVersion: userDataJWT.UserVersion})
+		if err != nil {
+			utils.Response(w, http.StatusUnauthorized, nil)
+			return
+		}
+		utils.ResponseWithCSRF(w, tokenCSRF)
+		return
+	}
+
+	userDataCSRF, err := token.ExtractCSRFTokenMetadata(r)
+	if err != nil || *userDataCSRF != *userDataJWT {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+
+	code := models.GiftCode{}
+
+	err = easyjson.UnmarshalFromReader(r.Body, &code)
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	code.Normalize()
+
+	out, err := h.userClient.RedeemGift(r.Context(), &generatedUser.RedeemGiftMessage{
+		UserID: userDataJWT.Id.String(),
+		Code:   code.Code})
+
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.WrongData.Error() || out.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	h.notifyGift(r.Context(), out)
+
+	gift := models.Gift{}
+	if err = gift.ProtoGiftToModel(out); err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	gift.Code = ""
+	gift.Sanitize()
+
+	utils.Response(w, http.StatusOK, gift)
+}
+
+func (h *UserHandler) GetGift(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	giftUUID, ok := mux.Vars(r)["gift-uuid"]
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+	if _, err = uuid.Parse(giftUUID); err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	out, err := h.userClient.GetGift(r.Context(), &generatedUser.UserPaymentMessage{
+		UserID:    userDataJWT.Id.String(),
+		PaymentID: giftUUID})
+
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.NotFound.Error() {
+		utils.Response(w, http.StatusNotFound, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	gift := models.Gift{}
+	if err = gift.ProtoGiftToModel(out); err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	gift.Sanitize()
+
+	utils.Response(w, http.StatusOK, gift)
+}
+
+func (h *UserHandler) AddDonateInfo(w http.ResponseWriter, r *http.Request) {
+	userDataJWT, err := token.ExtractJWTTokenMetadata(r)
+
+	if err != nil {
+		utils.Response(w, http.StatusUnauthorized, nil)
+		return
+	}
+
+	uv, err := h.authClient.CheckUserVersion(r.Context(), &generatedAuth.AccessDetails{
+		Login:       userDataJWT.Login,
+		Id:          userDataJWT.Id.String(),
+		UserVersion: userDataJWT.UserVersion,
+	})
+	if err != nil {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+	if len(uv.Error) != 0 {
+		utils.Cookie(w, "", "SSID")
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+	if r.Method == http.MethodGet {
+		tokenCSRF, err := token.GetCSRFToken(models.User{Login: userDataJWT.Login, Id: userDataJWT.Id, UserVersion: userDataJWT.UserVersion})
+		if err != nil {
+			utils.Response(w, http.StatusUnauthorized, nil)
+			return
+		}
+		utils.ResponseWithCSRF(w, tokenCSRF)
+		return
+	}
+
+	userDataCSRF, err := token.ExtractCSRFTokenMetadata(r)
+	if err != nil || *userDataCSRF != *userDataJWT {
+		utils.Response(w, http.StatusForbidden, nil)
+		return
+	}
+
+	creatorUUID, ok := mux.Vars(r)["creator-uuid"]
+	if !ok {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	_, err = uuid.Parse(creatorUUID)
+	if err != nil {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	donateInfo := models.Donate{}
+
+	err = easyjson.UnmarshalFromReader(r.Body, &donateInfo)
+	if err != nil || !donateInfo.IsValid() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	donateInfo.PaymentInfo = uuid.New()
+	out, err := h.userClient.AddDonateInfo(r.Context(), &generatedUser.DonateInfoMessage{
+		PaymentInfo: donateInfo.PaymentInfo.String(),
+		UserID:      userDataJWT.Id.String(),
+		CreatorID:   creatorUUID,
+		Message:     donateInfo.Message,
+		IsAnonymous: donateInfo.IsAnonymous,
+		AimID:       donateInfo.AimId.String()})
+
+	if err != nil {
+		h.logger.Error(err)
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	if out.Error == models.WrongData.Error() {
+		utils.Response(w, http.StatusBadRequest, nil)
+		return
+	}
+
+	if out.Error != "" {
+		utils.Response(w, http.StatusInternalServerError, nil)
+		return
+	}
+
+	utils.Response(w, http.StatusOK, donateInfo.PaymentInfo)
+}
@@ -11,7 +11,9 @@ import (
 	generatedAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/delivery/grpc/generated"
 	mockAuth "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/mocks"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/auth/usecase"
+	mockBlob "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/blob/mocks"
 	mockNotification "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/mocks"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payment/fake"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/token"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/delivery/grpc/generated"
 	mock "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/mocks"
@@ -66,6 +68,7 @@ func TestNewUserHandler(t *testing.T) {
 	authClient := mockAuth.NewMockAuthServiceClient(ctl)
 	userClient := mock.NewMockUserServiceClient(ctl)
 	notify := mockNotification.NewMockNotificationApp(ctl)
+	store := mockBlob.NewMockBlobStore(ctl)
 
 	logger := zap.NewNop()
 
@@ -77,7 +80,7 @@ func TestNewUserHandler(t *testing.T) {
 	}(logger)
 	zapSugar := logger.Sugar()
 
-	testHandler := NewUserHandler(userClient, authClient, notify, zapSugar)
+	testHandler := NewUserHandler(userClient, authClient, notify, fake.NewGateway("test"), store, zapSugar)
 	if testHandler.userClient != userClient || testHandler.authClient != authClient {
 		t.Error("bad constructor")
 	}
@@ -123,7 +126,7 @@ func TestUserHandler_UserSubscriptions(t *testing.T) {
 							Creator:      uuid.New().String(),
 							CreatorName:  uuid.New().String(),
 							CreatorPhoto: uuid.New().String(),
-							MonthCost:    100,
+							MonthCost:    models.NewMoney(10000).ToProto(),
 							Title:        "test",
 							Description:  "test",
 						}},
@@ -156,7 +159,7 @@ func TestUserHandler_UserSubscriptions(t *testing.T) {
 							Creator:      uuid.New().String(),
 							CreatorName:  uuid.New().String(),
 							CreatorPhoto: uuid.New().String(),
-							MonthCost:    100,
+							MonthCost:    models.NewMoney(10000).ToProto(),
 							Title:        "test",
 							Description:  "test",
 						}},
@@ -1017,7 +1020,7 @@ func TestUserHandler_UpdateData(t *testing.T) {
 			expectedResponse: http.StatusInternalServerError,
 			mock: func() *http.Request {
 				r := httptest.NewRequest("PUT", "/updateData", bytes.NewReader(bodyPrepare(models.Donate{
-					MoneyCount: 100,
+					MoneyCount: models.NewMoney(10000),
 					CreatorID:  uuid.New(),
 				})))
 				setJWTToken(r, token)
@@ -1035,7 +1038,7 @@ func TestUserHandler_UpdateData(t *testing.T) {
 			expectedResponse: http.StatusForbidden,
 			mock: func() *http.Request {
 				r := httptest.NewRequest("PUT", "/updateData", bytes.NewReader(bodyPrepare(models.Donate{
-					MoneyCount: 100,
+					MoneyCount: models.NewMoney(10000),
 					CreatorID:  uuid.New(),
 				})))
 				setJWTToken(r, token)
@@ -1469,6 +1472,8 @@ func TestUserHandler_DeleteProfilePhoto(t *testing.T) {
 	token, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: id})
 	authClient := mockAuth.NewMockAuthServiceClient(ctl)
 	userClient := mock.NewMockUserServiceClient(ctl)
+	store := mockBlob.NewMockBlobStore(ctl)
+	imageID := uuid.New()
 
 	logger := zap.NewNop()
 
@@ -1597,12 +1602,54 @@ func TestUserHandler_DeleteProfilePhoto(t *testing.T) {
 
 			},
 		},
+		{
+			name:             "Err from store",
+			expectedResponse: http.StatusBadRequest,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("PUT", "/deletePhoto", nil)
+				setJWTToken(r, token)
+				setCSRFToken(r, tokenCSRF)
+				r = mux.SetURLVars(r, map[string]string{
+					"image-uuid": imageID.String(),
+				})
+				authClient.EXPECT().CheckUserVersion(gomock.Any(), gomock.Any()).Return(&generatedAuth.UserVersion{
+					UserVersion: int64(1),
+					Error:       "",
+				}, nil)
+				store.EXPECT().Delete(gomock.Any(), models.PhotoKey(imageID.String())).Return(errors.New("test"))
+				return r
+			},
+		},
+		{
+			name:             "OK",
+			expectedResponse: http.StatusOK,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("PUT", "/deletePhoto", nil)
+				setJWTToken(r, token)
+				setCSRFToken(r, tokenCSRF)
+				r = mux.SetURLVars(r, map[string]string{
+					"image-uuid": imageID.String(),
+				})
+				authClient.EXPECT().CheckUserVersion(gomock.Any(), gomock.Any()).Return(&generatedAuth.UserVersion{
+					UserVersion: int64(1),
+					Error:       "",
+				}, nil)
+				store.EXPECT().Delete(gomock.Any(), models.PhotoKey(imageID.String())).Return(nil)
+				store.EXPECT().List(gomock.Any(), models.RenditionPrefix(models.PhotoStorageId(imageID.String()))).Return([]models.BlobInfo{
+					{Key: models.RenditionKey(models.PhotoStorageId(imageID.String()), models.RenditionAvatar64, "jpg")},
+				}, nil)
+				store.EXPECT().Delete(gomock.Any(), models.RenditionKey(models.PhotoStorageId(imageID.String()), models.RenditionAvatar64, "jpg")).Return(nil)
+				userClient.EXPECT().DeletePhoto(gomock.Any(), &generatedCommon.UUIDMessage{Value: id.String()}).Return(&generatedCommon.Empty{}, nil)
+				return r
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			h := &UserHandler{
 				userClient: userClient,
 				authClient: authClient,
+				store:      store,
 				logger:     zapSugar,
 			}
 			w := httptest.NewRecorder()
@@ -2166,3 +2213,431 @@ func TestUserHandler_UpdateProfilePhoto2(t *testing.T) {
 		})
 	}
 }
+
+func TestUserHandler_UserPayments(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	token, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: uuid.New()})
+
+	userClient := mock.NewMockUserServiceClient(ctl)
+	creatorID := uuid.New().String()
+	payments := []*generated.Payment{
+		{
+			Id:             uuid.New().String(),
+			Type:           models.PaymentTypeSubscription,
+			CreatorID:      creatorID,
+			CreatorName:    "test",
+			SubscriptionID: uuid.New().String(),
+			Tier:           "test",
+			Money:          models.NewMoney(10000).ToProto(),
+			MonthCount:     1,
+			PaymentTime:    time.Now().Format(time.RFC3339),
+			OperationID:    "123",
+			PeriodStart:    time.Now().Format(time.RFC3339),
+			PeriodEnd:      time.Now().AddDate(0, 1, 0).Format(time.RFC3339),
+		},
+		{
+			Id:             uuid.New().String(),
+			Type:           models.PaymentTypeDonation,
+			CreatorID:      creatorID,
+			CreatorName:    "test",
+			SubscriptionID: uuid.Nil.String(),
+			Money:          models.NewMoney(500).ToProto(),
+			PaymentTime:    time.Now().Format(time.RFC3339),
+			OperationID:    "124",
+		},
+	}
+
+	logger := zap.NewNop()
+
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+
+	tests := []struct {
+		name             string
+		expectedResponse int
+		mock             func() *http.Request
+	}{
+		{
+			name:             "OK",
+			expectedResponse: http.StatusOK,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments?creator="+creatorID+"&type=subscription&limit=10&offset=0", nil)
+				setJWTToken(r, token)
+				userClient.EXPECT().
+					UserPayments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, in *generated.PaymentsFilter, opts ...interface{}) (*generated.PaymentsMessage, error) {
+						require.Equal(t, creatorID, in.CreatorID)
+						require.Equal(t, models.PaymentTypeSubscription, in.Type)
+						require.Equal(t, int64(10), in.Limit)
+						return &generated.PaymentsMessage{Payments: payments}, nil
+					})
+				return r
+			},
+		},
+		{
+			name:             "Unauthorized",
+			expectedResponse: http.StatusUnauthorized,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments", nil)
+				setJWTToken(r, "1")
+				return r
+			},
+		},
+		{
+			name:             "Wrong creator id",
+			expectedResponse: http.StatusBadRequest,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments?creator=1", nil)
+				setJWTToken(r, token)
+				return r
+			},
+		},
+		{
+			name:             "Wrong period",
+			expectedResponse: http.StatusBadRequest,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments?from=yesterday", nil)
+				setJWTToken(r, token)
+				return r
+			},
+		},
+		{
+			name:             "Wrong limit",
+			expectedResponse: http.StatusBadRequest,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments?limit=ten", nil)
+				setJWTToken(r, token)
+				return r
+			},
+		},
+		{
+			name:             "Wrong filter",
+			expectedResponse: http.StatusBadRequest,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments?type=refund", nil)
+				setJWTToken(r, token)
+				userClient.EXPECT().
+					UserPayments(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentsMessage{Error: models.WrongData.Error()}, nil)
+				return r
+			},
+		},
+		{
+			name:             "Internal error",
+			expectedResponse: http.StatusInternalServerError,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments", nil)
+				setJWTToken(r, token)
+				userClient.EXPECT().
+					UserPayments(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("test"))
+				return r
+			},
+		},
+		{
+			name:             "Wrong payment from service",
+			expectedResponse: http.StatusInternalServerError,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments", nil)
+				setJWTToken(r, token)
+				userClient.EXPECT().
+					UserPayments(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentsMessage{Payments: []*generated.Payment{{Id: "1"}}}, nil)
+				return r
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserHandler{
+				userClient: userClient,
+				logger:     zapSugar,
+			}
+			w := httptest.NewRecorder()
+			r := test.mock()
+
+			h.UserPayments(w, r)
+			require.Equal(t, test.expectedResponse, w.Code, fmt.Errorf("%s :  expected %d, got %d,",
+				test.name, test.expectedResponse, w.Code))
+		})
+	}
+}
+
+func TestUserHandler_PaymentReceipt(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	os.Setenv("TOKEN_SECRET", "TEST")
+	tkn := &usecase.Tokenator{}
+	token, _ := tkn.GetJWTToken(context.Background(), models.User{Login: testUser.Login, Id: uuid.New()})
+
+	userClient := mock.NewMockUserServiceClient(ctl)
+	paymentID := uuid.New().String()
+	payment := &generated.Payment{
+		Id:             paymentID,
+		Type:           models.PaymentTypeSubscription,
+		CreatorID:      uuid.New().String(),
+		CreatorName:    "test",
+		SubscriptionID: uuid.New().String(),
+		Tier:           "test",
+		Money:          models.NewMoney(10000).ToProto(),
+		MonthCount:     3,
+		PaymentTime:    time.Now().Format(time.RFC3339),
+		OperationID:    "123",
+		PeriodStart:    time.Now().Format(time.RFC3339),
+		PeriodEnd:      time.Now().AddDate(0, 3, 0).Format(time.RFC3339),
+	}
+
+	logger := zap.NewNop()
+
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+
+	tests := []struct {
+		name             string
+		expectedResponse int
+		mock             func() *http.Request
+	}{
+		{
+			name:             "OK",
+			expectedResponse: http.StatusOK,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments/"+paymentID+"/receipt", nil)
+				r = mux.SetURLVars(r, map[string]string{"payment-uuid": paymentID})
+				setJWTToken(r, token)
+				userClient.EXPECT().
+					GetPayment(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentMessage{Payment: payment, Error: ""}, nil)
+				return r
+			},
+		},
+		{
+			name:             "OK html",
+			expectedResponse: http.StatusOK,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments/"+paymentID+"/receipt?format=html", nil)
+				r = mux.SetURLVars(r, map[string]string{"payment-uuid": paymentID})
+				setJWTToken(r, token)
+				userClient.EXPECT().
+					GetPayment(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentMessage{Payment: payment, Error: ""}, nil)
+				return r
+			},
+		},
+		{
+			name:             "Unauthorized",
+			expectedResponse: http.StatusUnauthorized,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments/"+paymentID+"/receipt", nil)
+				setJWTToken(r, "1")
+				return r
+			},
+		},
+		{
+			name:             "Wrong payment id",
+			expectedResponse: http.StatusBadRequest,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments/1/receipt", nil)
+				r = mux.SetURLVars(r, map[string]string{"payment-uuid": "1"})
+				setJWTToken(r, token)
+				return r
+			},
+		},
+		{
+			name:             "NotFound",
+			expectedResponse: http.StatusNotFound,
+			mock: func() *http.Request {
+				r := httptest.NewRequest("GET", "/payments/"+paymentID+"/receipt", nil)
+				r = mux.SetURLVars(r, map[string]string{"payment-uuid": paymentID})
+				setJWTToken(r, token)
+				userClient.EXPECT().
+					GetPayment(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentMessage{Error: models.NotFound.Error()}, nil)
+				return r
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserHandler{
+				userClient: userClient,
+				logger:     zapSugar,
+			}
+			w := httptest.NewRecorder()
+			r := test.mock()
+
+			h.PaymentReceipt(w, r)
+			require.Equal(t, test.expectedResponse, w.Code, fmt.Errorf("%s :  expected %d, got %d,",
+				test.name, test.expectedResponse, w.Code))
+		})
+	}
+}
+
+func TestUserHandler_Payment(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	userClient := mock.NewMockUserServiceClient(ctl)
+	notify := mockNotification.NewMockNotificationApp(ctl)
+	signer := fake.NewSigner("secret")
+	event := models.PaymentEvent{
+		OperationID: "123",
+		Kind:        models.PaymentKindSubscribe,
+		TargetID:    uuid.New(),
+		Money:       models.NewMoney(10000),
+	}
+
+	logger := zap.NewNop()
+
+	defer func(logger *zap.Logger) {
+		err := logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+
+	tests := []struct {
+		name             string
+		expectedResponse int
+		mock             func() *http.Request
+	}{
+		{
+			name:             "OK",
+			expectedResponse: http.StatusOK,
+			mock: func() *http.Request {
+				userClient.EXPECT().
+					ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentEventStatus{Status: models.PaymentEventClaimed}, nil)
+				userClient.EXPECT().
+					Subscribe(gomock.Any(), gomock.Any()).
+					Return(&generated.SubscriptionName{CreatorID: uuid.New().String(), Name: "test"}, nil)
+				notify.EXPECT().
+					SendUserNotification(gomock.Any(), gomock.Any()).
+					Return(nil)
+				userClient.EXPECT().
+					FinishPaymentEvent(gomock.Any(), &generated.PaymentEventResult{OperationID: event.OperationID}).
+					Return(&generatedCommon.Empty{}, nil)
+				return signer.NewRequest("/payment", event)
+			},
+		},
+		{
+			name:             "Already processed",
+			expectedResponse: http.StatusOK,
+			mock: func() *http.Request {
+				userClient.EXPECT().
+					ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentEventStatus{Status: models.PaymentEventProcessed}, nil)
+				return signer.NewRequest("/payment", event)
+			},
+		},
+		{
+			name:             "In progress",
+			expectedResponse: http.StatusConflict,
+			mock: func() *http.Request {
+				userClient.EXPECT().
+					ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentEventStatus{Status: models.PaymentEventProcessing}, nil)
+				return signer.NewRequest("/payment", event)
+			},
+		},
+		{
+			name:             "Processing failed",
+			expectedResponse: http.StatusInternalServerError,
+			mock: func() *http.Request {
+				userClient.EXPECT().
+					ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentEventStatus{Status: models.PaymentEventClaimed}, nil)
+				userClient.EXPECT().
+					Subscribe(gomock.Any(), gomock.Any()).
+					Return(&generated.SubscriptionName{Error: models.InternalError.Error()}, nil)
+				userClient.EXPECT().
+					FinishPaymentEvent(gomock.Any(), &generated.PaymentEventResult{OperationID: event.OperationID, LastError: models.InternalError.Error()}).
+					Return(&generatedCommon.Empty{}, nil)
+				return signer.NewRequest("/payment", event)
+			},
+		},
+		{
+			name:             "Underpaid",
+			expectedResponse: http.StatusOK,
+			mock: func() *http.Request {
+				userClient.EXPECT().
+					ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+					Return(&generated.PaymentEventStatus{Status: models.PaymentEventClaimed}, nil)
+				userClient.EXPECT().
+					Subscribe(gomock.Any(), gomock.Any()).
+					Return(&generated.SubscriptionName{Error: models.Underpaid.Error()}, nil)
+				userClient.EXPECT().
+					FinishPaymentEvent(gomock.Any(), &generated.PaymentEventResult{OperationID: event.OperationID}).
+					Return(&generatedCommon.Empty{}, nil)
+				return signer.NewRequest("/payment", event)
+			},
+		},
+		{
+			name:             "Claim error",
+			expectedResponse: http.StatusInternalServerError,
+			mock: func() *http.Request {
+				userClient.EXPECT().
+					ClaimPaymentEvent(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("test"))
+				return signer.NewRequest("/payment", event)
+			},
+		},
+		{
+			name:             "Wrong signature",
+			expectedResponse: http.StatusForbidden,
+			mock: func() *http.Request {
+				return fake.NewSigner("wrong").NewRequest("/payment", event)
+			},
+		},
+		{
+			name:             "Refund label",
+			expectedResponse: http.StatusBadRequest,
+			mock: func() *http.Request {
+				// возвраты проводит сверка по истории операций, входящий платёж с меткой возврата не принимается
+				return signer.NewRequest("/payment", models.PaymentEvent{OperationID: "124", Kind: models.PaymentKindRefund,
+					TargetID: uuid.New(), Money: models.NewMoney(5000)})
+			},
+		},
+		{
+			name:             "Wrong data",
+			expectedResponse: http.StatusBadRequest,
+			mock: func() *http.Request {
+				return signer.NewRequest("/payment", models.PaymentEvent{OperationID: "123", Kind: "test", TargetID: uuid.New(), Money: models.NewMoney(100)})
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserHandler{
+				userClient:      userClient,
+				notificationApp: notify,
+				paymentGateway:  fake.NewGateway("secret"),
+				logger:          zapSugar,
+			}
+			w := httptest.NewRecorder()
+			r := test.mock()
+
+			h.Payment(w, r)
+			require.Equal(t, test.expectedResponse, w.Code, fmt.Errorf("%s :  expected %d, got %d,",
+				test.name, test.expectedResponse, w.Code))
+		})
+	}
+}
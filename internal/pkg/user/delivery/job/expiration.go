@@ -0,0 +1,161 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultReminderDays  = "7,3,1"
+	defaultCheckInterval = time.Hour
+)
+
+type ExpirationConfig struct {
+	ReminderDays  []int64
+	CheckInterval time.Duration
+}
+
+// GetExpirationConfig берёт из SUBSCRIPTION_REMINDER_DAYS, за сколько дней до окончания подписки напоминать
+// (через запятую, по умолчанию 7,3,1), а из SUBSCRIPTION_CHECK_INTERVAL - как часто проверять подписки (по умолчанию 1h)
+func GetExpirationConfig() (ExpirationConfig, error) {
+	config := ExpirationConfig{CheckInterval: defaultCheckInterval}
+
+	days, flag := os.LookupEnv("SUBSCRIPTION_REMINDER_DAYS")
+	if !flag {
+		days = defaultReminderDays
+	}
+	for _, v := range strings.Split(days, ",") {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		day, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil || day <= 0 {
+			return ExpirationConfig{}, errors.New("wrong SUBSCRIPTION_REMINDER_DAYS value")
+		}
+		config.ReminderDays = append(config.ReminderDays, day)
+	}
+
+	if interval, flag := os.LookupEnv("SUBSCRIPTION_CHECK_INTERVAL"); flag {
+		tmp, err := time.ParseDuration(interval)
+		if err != nil || tmp <= 0 {
+			return ExpirationConfig{}, errors.New("wrong SUBSCRIPTION_CHECK_INTERVAL value")
+		}
+		config.CheckInterval = tmp
+	}
+	return config, nil
+}
+
+type ExpirationJob struct {
+	uc              user.UserUsecase
+	notificationApp notification.NotificationApp
+	config          ExpirationConfig
+	logger          *zap.SugaredLogger
+}
+
+func NewExpirationJob(uc user.UserUsecase, na notification.NotificationApp, config ExpirationConfig, logger *zap.SugaredLogger) *ExpirationJob {
+	days := make([]int64, len(config.ReminderDays))
+	copy(days, config.ReminderDays)
+	// напоминаем с самого узкого окна, чтобы не слать несколько напоминаний за один проход
+	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+	config.ReminderDays = days
+
+	return &ExpirationJob{
+		uc:              uc,
+		notificationApp: na,
+		config:          config,
+		logger:          logger,
+	}
+}
+
+func (j *ExpirationJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.config.CheckInterval)
+	defer ticker.Stop()
+
+	j.Process(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.Process(ctx)
+		}
+	}
+}
+
+func (j *ExpirationJob) Process(ctx context.Context) {
+	// подписку, напоминание о которой не ушло, не берём в более широкие окна этого прохода
+	attempted := make(map[[2]uuid.UUID]bool)
+	for _, days := range j.config.ReminderDays {
+		subs, err := j.uc.RemindExpiring(ctx, days)
+		if err != nil {
+			j.logger.Error(err)
+			continue
+		}
+		for _, sub := range subs {
+			key := [2]uuid.UUID{sub.UserID, sub.SubscriptionID}
+			if attempted[key] {
+				continue
+			}
+			attempted[key] = true
+			if !j.send(ctx, models.Notification{
+				Topic: fmt.Sprintf("%s-%s", sub.UserID, "personal"),
+				Title: "Подписка скоро закончится",
+				Body:  fmt.Sprintf("Подписка %s закончится %s", sub.Title, sub.ExpireDate.Format("02.01.2006")),
+			}) {
+				continue
+			}
+			if err = j.uc.MarkReminded(ctx, sub, days); err != nil {
+				j.logger.Error(err)
+			}
+		}
+	}
+
+	expired, err := j.uc.ExpireSubscriptions(ctx)
+	if err != nil {
+		j.logger.Error(err)
+		return
+	}
+	for _, sub := range expired {
+		// каждому получателю уведомление отправляется, пока не дойдёт, и только один раз
+		notified := sub
+		if !sub.UserNotified {
+			notified.UserNotified = j.send(ctx, models.Notification{
+				Topic: fmt.Sprintf("%s-%s", sub.UserID, "personal"),
+				Title: "Подписка закончилась",
+				Body:  fmt.Sprintf("Подписка %s закончилась", sub.Title),
+			})
+		}
+		if !sub.CreatorNotified {
+			notified.CreatorNotified = j.send(ctx, models.Notification{
+				Topic: fmt.Sprintf("%s-%s", sub.CreatorID, "creator"),
+				Title: "Подписка закончилась",
+				Body:  fmt.Sprintf("У одного из подписчиков закончилась подписка %s", sub.Title),
+			})
+		}
+		if notified == sub {
+			continue
+		}
+		if err = j.uc.MarkExpiryNotified(ctx, notified); err != nil {
+			j.logger.Error(err)
+		}
+	}
+}
+
+func (j *ExpirationJob) send(ctx context.Context, notification models.Notification) bool {
+	if err := j.notificationApp.SendUserNotification(notification, ctx); err != nil {
+		j.logger.Errorf("send notification to %s: %s", notification.Topic, err)
+		return false
+	}
+	return true
+}
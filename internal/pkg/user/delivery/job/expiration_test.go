@@ -0,0 +1,135 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	mockNotification "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/notification/mocks"
+	mockUser "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestGetExpirationConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		days        string
+		interval    string
+		expected    ExpirationConfig
+		expectedErr bool
+	}{
+		{
+			name:     "OK",
+			days:     "1, 3,7",
+			interval: "30m",
+			expected: ExpirationConfig{ReminderDays: []int64{1, 3, 7}, CheckInterval: 30 * time.Minute},
+		},
+		{
+			name:        "WrongDays",
+			days:        "1,-3",
+			interval:    "30m",
+			expectedErr: true,
+		},
+		{
+			name:        "WrongInterval",
+			days:        "1",
+			interval:    "month",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			os.Setenv("SUBSCRIPTION_REMINDER_DAYS", test.days)
+			os.Setenv("SUBSCRIPTION_CHECK_INTERVAL", test.interval)
+			defer os.Unsetenv("SUBSCRIPTION_REMINDER_DAYS")
+			defer os.Unsetenv("SUBSCRIPTION_CHECK_INTERVAL")
+
+			config, err := GetExpirationConfig()
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, config)
+		})
+	}
+}
+
+func TestExpirationJob_Process(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	userUsecase := mockUser.NewMockUserUsecase(ctl)
+	notificationApp := mockNotification.NewMockNotificationApp(ctl)
+
+	expiring := models.ExpiringSubscription{UserID: uuid.New(), CreatorID: uuid.New(), Title: "Gold", ExpireDate: time.Now().Add(48 * time.Hour)}
+	expired := models.ExpiringSubscription{UserID: uuid.New(), CreatorID: uuid.New(), Title: "Silver", ExpireDate: time.Now()}
+
+	j := NewExpirationJob(userUsecase, notificationApp, ExpirationConfig{ReminderDays: []int64{7, 3}, CheckInterval: time.Hour}, zap.NewNop().Sugar())
+	require.Equal(t, []int64{3, 7}, j.config.ReminderDays)
+
+	gomock.InOrder(
+		userUsecase.EXPECT().RemindExpiring(gomock.Any(), int64(3)).Return([]models.ExpiringSubscription{expiring}, nil),
+		notificationApp.EXPECT().SendUserNotification(gomock.Any(), gomock.Any()).DoAndReturn(func(n models.Notification, _ context.Context) error {
+			require.Equal(t, expiring.UserID.String()+"-personal", n.Topic)
+			return nil
+		}),
+		userUsecase.EXPECT().MarkReminded(gomock.Any(), expiring, int64(3)).Return(nil),
+		userUsecase.EXPECT().RemindExpiring(gomock.Any(), int64(7)).Return([]models.ExpiringSubscription{}, nil),
+		userUsecase.EXPECT().ExpireSubscriptions(gomock.Any()).Return([]models.ExpiringSubscription{expired}, nil),
+	)
+	notificationApp.EXPECT().SendUserNotification(models.Notification{
+		Topic: expired.UserID.String() + "-personal",
+		Title: "Подписка закончилась",
+		Body:  "Подписка Silver закончилась",
+	}, gomock.Any()).Return(nil)
+	notificationApp.EXPECT().SendUserNotification(models.Notification{
+		Topic: expired.CreatorID.String() + "-creator",
+		Title: "Подписка закончилась",
+		Body:  "У одного из подписчиков закончилась подписка Silver",
+	}, gomock.Any()).Return(nil)
+	notified := expired
+	notified.UserNotified, notified.CreatorNotified = true, true
+	userUsecase.EXPECT().MarkExpiryNotified(gomock.Any(), notified).Return(nil)
+
+	j.Process(context.Background())
+}
+
+func TestExpirationJob_ProcessSendFailed(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	userUsecase := mockUser.NewMockUserUsecase(ctl)
+	notificationApp := mockNotification.NewMockNotificationApp(ctl)
+
+	expiring := models.ExpiringSubscription{UserID: uuid.New(), SubscriptionID: uuid.New(), CreatorID: uuid.New(), Title: "Gold", ExpireDate: time.Now().Add(48 * time.Hour)}
+	expired := models.ExpiringSubscription{UserID: uuid.New(), SubscriptionID: uuid.New(), CreatorID: uuid.New(), Title: "Silver", ExpireDate: time.Now()}
+
+	j := NewExpirationJob(userUsecase, notificationApp, ExpirationConfig{ReminderDays: []int64{7, 3}, CheckInterval: time.Hour}, zap.NewNop().Sugar())
+
+	// без отправленного уведомления подписка не отмечается и попадёт в следующий проход,
+	// а в более широкое окно этого прохода - нет
+	userUsecase.EXPECT().RemindExpiring(gomock.Any(), int64(3)).Return([]models.ExpiringSubscription{expiring}, nil)
+	userUsecase.EXPECT().RemindExpiring(gomock.Any(), int64(7)).Return([]models.ExpiringSubscription{expiring}, nil)
+	notificationApp.EXPECT().SendUserNotification(mockNotification.Topic(expiring.UserID.String()+"-personal"), gomock.Any()).Return(errors.New("test"))
+	userUsecase.EXPECT().ExpireSubscriptions(gomock.Any()).Return([]models.ExpiringSubscription{expired}, nil)
+	notificationApp.EXPECT().SendUserNotification(mockNotification.Topic(expired.UserID.String()+"-personal"), gomock.Any()).Return(nil)
+	notificationApp.EXPECT().SendUserNotification(mockNotification.Topic(expired.CreatorID.String()+"-creator"), gomock.Any()).Return(errors.New("test"))
+	// подписчик отмечается сразу, чтобы на следующем проходе уведомление ушло только автору
+	userNotified := expired
+	userNotified.UserNotified = true
+	userUsecase.EXPECT().MarkExpiryNotified(gomock.Any(), userNotified).Return(nil)
+
+	j.Process(context.Background())
+
+	userUsecase.EXPECT().RemindExpiring(gomock.Any(), int64(3)).Return([]models.ExpiringSubscription{}, nil)
+	userUsecase.EXPECT().RemindExpiring(gomock.Any(), int64(7)).Return([]models.ExpiringSubscription{}, nil)
+	userUsecase.EXPECT().ExpireSubscriptions(gomock.Any()).Return([]models.ExpiringSubscription{userNotified}, nil)
+	notificationApp.EXPECT().SendUserNotification(mockNotification.Topic(expired.CreatorID.String()+"-creator"), gomock.Any()).Return(errors.New("test"))
+
+	j.Process(context.Background())
+}
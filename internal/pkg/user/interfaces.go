@@ -14,15 +14,29 @@ type UserUsecase interface {
 	DeletePhoto(ctx context.Context, userId uuid.UUID) error
 	UpdatePassword(ctx context.Context, id uuid.UUID, password string) error
 	UpdateProfileInfo(ctx context.Context, profileInfo models.UpdateProfileInfo, id uuid.UUID) error
-	Donate(ctx context.Context, donateInfo models.Donate) (float32, error)
+	Donate(ctx context.Context, donateInfo models.Donate) (models.Aim, error)
+	AddDonateInfo(ctx context.Context, donateInfo models.Donate) error
 	CheckIfCreator(ctx context.Context, userId uuid.UUID) (uuid.UUID, bool, error)
 	BecomeCreator(ctx context.Context, creatorInfo models.BecameCreatorInfo, userId uuid.UUID) (uuid.UUID, error)
 	Follow(ctx context.Context, userId, creatorId uuid.UUID) error
-	Subscribe(ctx context.Context, paymentInfo uuid.UUID, money float32) (models.NotificationSubInfo, error)
+	Subscribe(ctx context.Context, paymentInfo uuid.UUID, money, paid models.Money, operationID string) (models.NotificationSubInfo, error)
 	Unfollow(ctx context.Context, userId, creatorId uuid.UUID) error
 	UserSubscriptions(ctx context.Context, userId uuid.UUID) ([]models.Subscription, error)
 	UserFollows(ctx context.Context, userId uuid.UUID) ([]models.Follow, error)
 	AddPaymentInfo(ctx context.Context, subscription models.SubscriptionDetails) error
+	RemindExpiring(ctx context.Context, daysBefore int64) ([]models.ExpiringSubscription, error)
+	ExpireSubscriptions(ctx context.Context) ([]models.ExpiringSubscription, error)
+	MarkReminded(ctx context.Context, sub models.ExpiringSubscription, daysBefore int64) error
+	MarkExpiryNotified(ctx context.Context, sub models.ExpiringSubscription) error
+	UserPayments(ctx context.Context, filter models.PaymentsFilter) ([]models.Payment, error)
+	GetPayment(ctx context.Context, userID, paymentID uuid.UUID) (models.Payment, error)
+	AddGiftInfo(ctx context.Context, gift models.Gift) error
+	PayGift(ctx context.Context, giftID uuid.UUID, money, paid models.Money, operationID string) (models.Gift, error)
+	RedeemGift(ctx context.Context, userID uuid.UUID, code string) (models.Gift, error)
+	GetGift(ctx context.Context, userID, giftID uuid.UUID) (models.Gift, error)
+	ClaimPaymentEvent(ctx context.Context, event models.PaymentEvent) (string, error)
+	FinishPaymentEvent(ctx context.Context, operationID, lastError string) error
+	RefundPayment(ctx context.Context, refund models.Refund) (models.Refund, error)
 }
 
 type UserRepo interface {
@@ -30,18 +44,35 @@ type UserRepo interface {
 	UpdateProfilePhoto(ctx context.Context, userID uuid.UUID, path uuid.UUID) error
 	UpdatePassword(ctx context.Context, id uuid.UUID, password string) error
 	UpdateProfileInfo(ctx context.Context, profileInfo models.UpdateProfileInfo, id uuid.UUID) error
-	Donate(ctx context.Context, donateInfo models.Donate) (float32, error)
+	Donate(ctx context.Context, donateInfo models.Donate) (models.Aim, error)
+	AddDonateInfo(ctx context.Context, donateInfo models.Donate) error
+	CheckDonateInfo(ctx context.Context, paymentInfo uuid.UUID) (models.Donate, error)
 	CheckIfCreator(ctx context.Context, userId uuid.UUID) (uuid.UUID, bool, error)
 	BecomeCreator(ctx context.Context, creatorInfo models.BecameCreatorInfo, userId uuid.UUID) (uuid.UUID, error)
 	Follow(ctx context.Context, userId, creatorId uuid.UUID) error
 	CheckIfFollow(ctx context.Context, userId, creatorId uuid.UUID) (bool, error)
-	Subscribe(ctx context.Context, subscription models.SubscriptionDetails) (models.NotificationSubInfo, error)
+	Subscribe(ctx context.Context, subscription models.SubscriptionDetails, money models.Money, operationID string) (models.NotificationSubInfo, error)
 	Unfollow(ctx context.Context, userId, creatorId uuid.UUID) error
 	UserSubscriptions(ctx context.Context, userId uuid.UUID) ([]models.Subscription, error)
 	DeletePhoto(ctx context.Context, userId uuid.UUID) error
 	UserFollows(ctx context.Context, userId uuid.UUID) ([]models.Follow, error)
 	AddPaymentInfo(ctx context.Context, subscription models.SubscriptionDetails) error
 	CheckPaymentInfo(ctx context.Context, paymentInfo uuid.UUID) (models.SubscriptionDetails, error)
-	UpdatePaymentInfo(ctx context.Context, money float32, paymentInfo uuid.UUID) error
+	SubscriptionBilling(ctx context.Context, subscriptionID uuid.UUID) (models.Subscription, error)
 	GetCreatorID(ctx context.Context, subscriptionID uuid.UUID) (uuid.UUID, error)
+	ExpiringSubscriptions(ctx context.Context, daysBefore int64) ([]models.ExpiringSubscription, error)
+	MarkReminded(ctx context.Context, sub models.ExpiringSubscription, daysBefore int64) error
+	ExpireSubscriptions(ctx context.Context) ([]models.ExpiringSubscription, error)
+	MarkExpiryNotified(ctx context.Context, sub models.ExpiringSubscription) error
+	UserPayments(ctx context.Context, filter models.PaymentsFilter) ([]models.Payment, error)
+	GetPayment(ctx context.Context, userID, paymentID uuid.UUID) (models.Payment, error)
+	AddGift(ctx context.Context, gift models.Gift) error
+	GetGift(ctx context.Context, giftID uuid.UUID) (models.Gift, error)
+	PayGift(ctx context.Context, gift models.Gift, money models.Money, operationID string) error
+	RedeemGift(ctx context.Context, userID uuid.UUID, code string) (models.Gift, error)
+	ClaimPaymentEvent(ctx context.Context, event models.PaymentEvent) (string, error)
+	FinishPaymentEvent(ctx context.Context, operationID, status, lastError string) error
+	AddUnmatchedPayment(ctx context.Context, payment models.UnmatchedPayment) error
+	IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error)
+	RefundPayment(ctx context.Context, refund models.Refund, transfer func(refund models.Refund, account string) (string, error)) (models.Refund, error)
 }
@@ -37,6 +37,46 @@ func (m *MockUserServiceClient) EXPECT() *MockUserServiceClientMockRecorder {
 	return m.recorder
 }
 
+// AddDonateInfo mocks base method.
+func (m *MockUserServiceClient) AddDonateInfo(ctx context.Context, in *generated.DonateInfoMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDonateInfo", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDonateInfo indicates an expected call of AddDonateInfo.
+func (mr *MockUserServiceClientMockRecorder) AddDonateInfo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDonateInfo", reflect.TypeOf((*MockUserServiceClient)(nil).AddDonateInfo), varargs...)
+}
+
+// AddGiftInfo mocks base method.
+func (m *MockUserServiceClient) AddGiftInfo(ctx context.Context, in *generated.GiftDetails, opts ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddGiftInfo", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGiftInfo indicates an expected call of AddGiftInfo.
+func (mr *MockUserServiceClientMockRecorder) AddGiftInfo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGiftInfo", reflect.TypeOf((*MockUserServiceClient)(nil).AddGiftInfo), varargs...)
+}
+
 // AddPaymentInfo mocks base method.
 func (m *MockUserServiceClient) AddPaymentInfo(ctx context.Context, in *generated.SubscriptionDetails, opts ...grpc.CallOption) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +137,26 @@ func (mr *MockUserServiceClientMockRecorder) CheckIfCreator(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfCreator", reflect.TypeOf((*MockUserServiceClient)(nil).CheckIfCreator), varargs...)
 }
 
+// ClaimPaymentEvent mocks base method.
+func (m *MockUserServiceClient) ClaimPaymentEvent(ctx context.Context, in *generated.PaymentEvent, opts ...grpc.CallOption) (*generated.PaymentEventStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClaimPaymentEvent", varargs...)
+	ret0, _ := ret[0].(*generated.PaymentEventStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPaymentEvent indicates an expected call of ClaimPaymentEvent.
+func (mr *MockUserServiceClientMockRecorder) ClaimPaymentEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPaymentEvent", reflect.TypeOf((*MockUserServiceClient)(nil).ClaimPaymentEvent), varargs...)
+}
+
 // DeletePhoto mocks base method.
 func (m *MockUserServiceClient) DeletePhoto(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +197,26 @@ func (mr *MockUserServiceClientMockRecorder) Donate(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Donate", reflect.TypeOf((*MockUserServiceClient)(nil).Donate), varargs...)
 }
 
+// FinishPaymentEvent mocks base method.
+func (m *MockUserServiceClient) FinishPaymentEvent(ctx context.Context, in *generated.PaymentEventResult, opts ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FinishPaymentEvent", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishPaymentEvent indicates an expected call of FinishPaymentEvent.
+func (mr *MockUserServiceClientMockRecorder) FinishPaymentEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPaymentEvent", reflect.TypeOf((*MockUserServiceClient)(nil).FinishPaymentEvent), varargs...)
+}
+
 // Follow mocks base method.
 func (m *MockUserServiceClient) Follow(ctx context.Context, in *generated.FollowMessage, opts ...grpc.CallOption) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +237,46 @@ func (mr *MockUserServiceClientMockRecorder) Follow(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockUserServiceClient)(nil).Follow), varargs...)
 }
 
+// GetGift mocks base method.
+func (m *MockUserServiceClient) GetGift(ctx context.Context, in *generated.UserPaymentMessage, opts ...grpc.CallOption) (*generated.GiftInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGift", varargs...)
+	ret0, _ := ret[0].(*generated.GiftInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGift indicates an expected call of GetGift.
+func (mr *MockUserServiceClientMockRecorder) GetGift(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGift", reflect.TypeOf((*MockUserServiceClient)(nil).GetGift), varargs...)
+}
+
+// GetPayment mocks base method.
+func (m *MockUserServiceClient) GetPayment(ctx context.Context, in *generated.UserPaymentMessage, opts ...grpc.CallOption) (*generated.PaymentMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPayment", varargs...)
+	ret0, _ := ret[0].(*generated.PaymentMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayment indicates an expected call of GetPayment.
+func (mr *MockUserServiceClientMockRecorder) GetPayment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayment", reflect.TypeOf((*MockUserServiceClient)(nil).GetPayment), varargs...)
+}
+
 // GetProfile mocks base method.
 func (m *MockUserServiceClient) GetProfile(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*generated.UserProfile, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +297,66 @@ func (mr *MockUserServiceClientMockRecorder) GetProfile(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockUserServiceClient)(nil).GetProfile), varargs...)
 }
 
+// PayGift mocks base method.
+func (m *MockUserServiceClient) PayGift(ctx context.Context, in *generated.PaymentInfo, opts ...grpc.CallOption) (*generated.GiftInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PayGift", varargs...)
+	ret0, _ := ret[0].(*generated.GiftInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayGift indicates an expected call of PayGift.
+func (mr *MockUserServiceClientMockRecorder) PayGift(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayGift", reflect.TypeOf((*MockUserServiceClient)(nil).PayGift), varargs...)
+}
+
+// RedeemGift mocks base method.
+func (m *MockUserServiceClient) RedeemGift(ctx context.Context, in *generated.RedeemGiftMessage, opts ...grpc.CallOption) (*generated.GiftInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RedeemGift", varargs...)
+	ret0, _ := ret[0].(*generated.GiftInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemGift indicates an expected call of RedeemGift.
+func (mr *MockUserServiceClientMockRecorder) RedeemGift(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemGift", reflect.TypeOf((*MockUserServiceClient)(nil).RedeemGift), varargs...)
+}
+
+// RefundPayment mocks base method.
+func (m *MockUserServiceClient) RefundPayment(ctx context.Context, in *generated.Refund, opts ...grpc.CallOption) (*generated.RefundMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefundPayment", varargs...)
+	ret0, _ := ret[0].(*generated.RefundMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundPayment indicates an expected call of RefundPayment.
+func (mr *MockUserServiceClientMockRecorder) RefundPayment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockUserServiceClient)(nil).RefundPayment), varargs...)
+}
+
 // Subscribe mocks base method.
 func (m *MockUserServiceClient) Subscribe(ctx context.Context, in *generated.PaymentInfo, opts ...grpc.CallOption) (*generated.SubscriptionName, error) {
 	m.ctrl.T.Helper()
@@ -297,6 +477,26 @@ func (mr *MockUserServiceClientMockRecorder) UserFollows(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollows", reflect.TypeOf((*MockUserServiceClient)(nil).UserFollows), varargs...)
 }
 
+// UserPayments mocks base method.
+func (m *MockUserServiceClient) UserPayments(ctx context.Context, in *generated.PaymentsFilter, opts ...grpc.CallOption) (*generated.PaymentsMessage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UserPayments", varargs...)
+	ret0, _ := ret[0].(*generated.PaymentsMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserPayments indicates an expected call of UserPayments.
+func (mr *MockUserServiceClientMockRecorder) UserPayments(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserPayments", reflect.TypeOf((*MockUserServiceClient)(nil).UserPayments), varargs...)
+}
+
 // UserSubscriptions mocks base method.
 func (m *MockUserServiceClient) UserSubscriptions(ctx context.Context, in *proto.UUIDMessage, opts ...grpc.CallOption) (*generated.SubscriptionsMessage, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +540,36 @@ func (m *MockUserServiceServer) EXPECT() *MockUserServiceServerMockRecorder {
 	return m.recorder
 }
 
+// AddDonateInfo mocks base method.
+func (m *MockUserServiceServer) AddDonateInfo(arg0 context.Context, arg1 *generated.DonateInfoMessage) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDonateInfo", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDonateInfo indicates an expected call of AddDonateInfo.
+func (mr *MockUserServiceServerMockRecorder) AddDonateInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDonateInfo", reflect.TypeOf((*MockUserServiceServer)(nil).AddDonateInfo), arg0, arg1)
+}
+
+// AddGiftInfo mocks base method.
+func (m *MockUserServiceServer) AddGiftInfo(arg0 context.Context, arg1 *generated.GiftDetails) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGiftInfo", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGiftInfo indicates an expected call of AddGiftInfo.
+func (mr *MockUserServiceServerMockRecorder) AddGiftInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGiftInfo", reflect.TypeOf((*MockUserServiceServer)(nil).AddGiftInfo), arg0, arg1)
+}
+
 // AddPaymentInfo mocks base method.
 func (m *MockUserServiceServer) AddPaymentInfo(arg0 context.Context, arg1 *generated.SubscriptionDetails) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -385,6 +615,21 @@ func (mr *MockUserServiceServerMockRecorder) CheckIfCreator(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfCreator", reflect.TypeOf((*MockUserServiceServer)(nil).CheckIfCreator), arg0, arg1)
 }
 
+// ClaimPaymentEvent mocks base method.
+func (m *MockUserServiceServer) ClaimPaymentEvent(arg0 context.Context, arg1 *generated.PaymentEvent) (*generated.PaymentEventStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPaymentEvent", arg0, arg1)
+	ret0, _ := ret[0].(*generated.PaymentEventStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPaymentEvent indicates an expected call of ClaimPaymentEvent.
+func (mr *MockUserServiceServerMockRecorder) ClaimPaymentEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPaymentEvent", reflect.TypeOf((*MockUserServiceServer)(nil).ClaimPaymentEvent), arg0, arg1)
+}
+
 // DeletePhoto mocks base method.
 func (m *MockUserServiceServer) DeletePhoto(arg0 context.Context, arg1 *proto.UUIDMessage) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +660,21 @@ func (mr *MockUserServiceServerMockRecorder) Donate(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Donate", reflect.TypeOf((*MockUserServiceServer)(nil).Donate), arg0, arg1)
 }
 
+// FinishPaymentEvent mocks base method.
+func (m *MockUserServiceServer) FinishPaymentEvent(arg0 context.Context, arg1 *generated.PaymentEventResult) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPaymentEvent", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishPaymentEvent indicates an expected call of FinishPaymentEvent.
+func (mr *MockUserServiceServerMockRecorder) FinishPaymentEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPaymentEvent", reflect.TypeOf((*MockUserServiceServer)(nil).FinishPaymentEvent), arg0, arg1)
+}
+
 // Follow mocks base method.
 func (m *MockUserServiceServer) Follow(arg0 context.Context, arg1 *generated.FollowMessage) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -430,6 +690,36 @@ func (mr *MockUserServiceServerMockRecorder) Follow(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockUserServiceServer)(nil).Follow), arg0, arg1)
 }
 
+// GetGift mocks base method.
+func (m *MockUserServiceServer) GetGift(arg0 context.Context, arg1 *generated.UserPaymentMessage) (*generated.GiftInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGift", arg0, arg1)
+	ret0, _ := ret[0].(*generated.GiftInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGift indicates an expected call of GetGift.
+func (mr *MockUserServiceServerMockRecorder) GetGift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGift", reflect.TypeOf((*MockUserServiceServer)(nil).GetGift), arg0, arg1)
+}
+
+// GetPayment mocks base method.
+func (m *MockUserServiceServer) GetPayment(arg0 context.Context, arg1 *generated.UserPaymentMessage) (*generated.PaymentMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayment", arg0, arg1)
+	ret0, _ := ret[0].(*generated.PaymentMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayment indicates an expected call of GetPayment.
+func (mr *MockUserServiceServerMockRecorder) GetPayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayment", reflect.TypeOf((*MockUserServiceServer)(nil).GetPayment), arg0, arg1)
+}
+
 // GetProfile mocks base method.
 func (m *MockUserServiceServer) GetProfile(arg0 context.Context, arg1 *proto.UUIDMessage) (*generated.UserProfile, error) {
 	m.ctrl.T.Helper()
@@ -445,6 +735,51 @@ func (mr *MockUserServiceServerMockRecorder) GetProfile(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockUserServiceServer)(nil).GetProfile), arg0, arg1)
 }
 
+// PayGift mocks base method.
+func (m *MockUserServiceServer) PayGift(arg0 context.Context, arg1 *generated.PaymentInfo) (*generated.GiftInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayGift", arg0, arg1)
+	ret0, _ := ret[0].(*generated.GiftInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayGift indicates an expected call of PayGift.
+func (mr *MockUserServiceServerMockRecorder) PayGift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayGift", reflect.TypeOf((*MockUserServiceServer)(nil).PayGift), arg0, arg1)
+}
+
+// RedeemGift mocks base method.
+func (m *MockUserServiceServer) RedeemGift(arg0 context.Context, arg1 *generated.RedeemGiftMessage) (*generated.GiftInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemGift", arg0, arg1)
+	ret0, _ := ret[0].(*generated.GiftInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemGift indicates an expected call of RedeemGift.
+func (mr *MockUserServiceServerMockRecorder) RedeemGift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemGift", reflect.TypeOf((*MockUserServiceServer)(nil).RedeemGift), arg0, arg1)
+}
+
+// RefundPayment mocks base method.
+func (m *MockUserServiceServer) RefundPayment(arg0 context.Context, arg1 *generated.Refund) (*generated.RefundMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundPayment", arg0, arg1)
+	ret0, _ := ret[0].(*generated.RefundMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundPayment indicates an expected call of RefundPayment.
+func (mr *MockUserServiceServerMockRecorder) RefundPayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockUserServiceServer)(nil).RefundPayment), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockUserServiceServer) Subscribe(arg0 context.Context, arg1 *generated.PaymentInfo) (*generated.SubscriptionName, error) {
 	m.ctrl.T.Helper()
@@ -535,6 +870,21 @@ func (mr *MockUserServiceServerMockRecorder) UserFollows(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollows", reflect.TypeOf((*MockUserServiceServer)(nil).UserFollows), arg0, arg1)
 }
 
+// UserPayments mocks base method.
+func (m *MockUserServiceServer) UserPayments(arg0 context.Context, arg1 *generated.PaymentsFilter) (*generated.PaymentsMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserPayments", arg0, arg1)
+	ret0, _ := ret[0].(*generated.PaymentsMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserPayments indicates an expected call of UserPayments.
+func (mr *MockUserServiceServerMockRecorder) UserPayments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserPayments", reflect.TypeOf((*MockUserServiceServer)(nil).UserPayments), arg0, arg1)
+}
+
 // UserSubscriptions mocks base method.
 func (m *MockUserServiceServer) UserSubscriptions(arg0 context.Context, arg1 *proto.UUIDMessage) (*generated.SubscriptionsMessage, error) {
 	m.ctrl.T.Helper()
@@ -36,6 +36,34 @@ func (m *MockUserUsecase) EXPECT() *MockUserUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AddDonateInfo mocks base method.
+func (m *MockUserUsecase) AddDonateInfo(ctx context.Context, donateInfo models.Donate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDonateInfo", ctx, donateInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDonateInfo indicates an expected call of AddDonateInfo.
+func (mr *MockUserUsecaseMockRecorder) AddDonateInfo(ctx, donateInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDonateInfo", reflect.TypeOf((*MockUserUsecase)(nil).AddDonateInfo), ctx, donateInfo)
+}
+
+// AddGiftInfo mocks base method.
+func (m *MockUserUsecase) AddGiftInfo(ctx context.Context, gift models.Gift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGiftInfo", ctx, gift)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGiftInfo indicates an expected call of AddGiftInfo.
+func (mr *MockUserUsecaseMockRecorder) AddGiftInfo(ctx, gift interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGiftInfo", reflect.TypeOf((*MockUserUsecase)(nil).AddGiftInfo), ctx, gift)
+}
+
 // AddPaymentInfo mocks base method.
 func (m *MockUserUsecase) AddPaymentInfo(ctx context.Context, subscription models.SubscriptionDetails) error {
 	m.ctrl.T.Helper()
@@ -81,6 +109,21 @@ func (mr *MockUserUsecaseMockRecorder) CheckIfCreator(ctx, userId interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfCreator", reflect.TypeOf((*MockUserUsecase)(nil).CheckIfCreator), ctx, userId)
 }
 
+// ClaimPaymentEvent mocks base method.
+func (m *MockUserUsecase) ClaimPaymentEvent(ctx context.Context, event models.PaymentEvent) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPaymentEvent", ctx, event)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPaymentEvent indicates an expected call of ClaimPaymentEvent.
+func (mr *MockUserUsecaseMockRecorder) ClaimPaymentEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPaymentEvent", reflect.TypeOf((*MockUserUsecase)(nil).ClaimPaymentEvent), ctx, event)
+}
+
 // DeletePhoto mocks base method.
 func (m *MockUserUsecase) DeletePhoto(ctx context.Context, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -96,10 +139,10 @@ func (mr *MockUserUsecaseMockRecorder) DeletePhoto(ctx, userId interface{}) *gom
 }
 
 // Donate mocks base method.
-func (m *MockUserUsecase) Donate(ctx context.Context, donateInfo models.Donate) (float32, error) {
+func (m *MockUserUsecase) Donate(ctx context.Context, donateInfo models.Donate) (models.Aim, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Donate", ctx, donateInfo)
-	ret0, _ := ret[0].(float32)
+	ret0, _ := ret[0].(models.Aim)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -110,6 +153,35 @@ func (mr *MockUserUsecaseMockRecorder) Donate(ctx, donateInfo interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Donate", reflect.TypeOf((*MockUserUsecase)(nil).Donate), ctx, donateInfo)
 }
 
+// ExpireSubscriptions mocks base method.
+func (m *MockUserUsecase) ExpireSubscriptions(ctx context.Context) ([]models.ExpiringSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireSubscriptions", ctx)
+	ret0, _ := ret[0].([]models.ExpiringSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireSubscriptions indicates an expected call of ExpireSubscriptions.
+func (mr *MockUserUsecaseMockRecorder) ExpireSubscriptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireSubscriptions", reflect.TypeOf((*MockUserUsecase)(nil).ExpireSubscriptions), ctx)
+}
+
+// FinishPaymentEvent mocks base method.
+func (m *MockUserUsecase) FinishPaymentEvent(ctx context.Context, operationID, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPaymentEvent", ctx, operationID, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishPaymentEvent indicates an expected call of FinishPaymentEvent.
+func (mr *MockUserUsecaseMockRecorder) FinishPaymentEvent(ctx, operationID, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPaymentEvent", reflect.TypeOf((*MockUserUsecase)(nil).FinishPaymentEvent), ctx, operationID, lastError)
+}
+
 // Follow mocks base method.
 func (m *MockUserUsecase) Follow(ctx context.Context, userId, creatorId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -124,6 +196,36 @@ func (mr *MockUserUsecaseMockRecorder) Follow(ctx, userId, creatorId interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockUserUsecase)(nil).Follow), ctx, userId, creatorId)
 }
 
+// GetGift mocks base method.
+func (m *MockUserUsecase) GetGift(ctx context.Context, userID, giftID uuid.UUID) (models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGift", ctx, userID, giftID)
+	ret0, _ := ret[0].(models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGift indicates an expected call of GetGift.
+func (mr *MockUserUsecaseMockRecorder) GetGift(ctx, userID, giftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGift", reflect.TypeOf((*MockUserUsecase)(nil).GetGift), ctx, userID, giftID)
+}
+
+// GetPayment mocks base method.
+func (m *MockUserUsecase) GetPayment(ctx context.Context, userID, paymentID uuid.UUID) (models.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayment", ctx, userID, paymentID)
+	ret0, _ := ret[0].(models.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayment indicates an expected call of GetPayment.
+func (mr *MockUserUsecaseMockRecorder) GetPayment(ctx, userID, paymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayment", reflect.TypeOf((*MockUserUsecase)(nil).GetPayment), ctx, userID, paymentID)
+}
+
 // GetProfile mocks base method.
 func (m *MockUserUsecase) GetProfile(ctx context.Context, userId uuid.UUID) (models.UserProfile, error) {
 	m.ctrl.T.Helper()
@@ -139,19 +241,107 @@ func (mr *MockUserUsecaseMockRecorder) GetProfile(ctx, userId interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockUserUsecase)(nil).GetProfile), ctx, userId)
 }
 
+// MarkExpiryNotified mocks base method.
+func (m *MockUserUsecase) MarkExpiryNotified(ctx context.Context, sub models.ExpiringSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkExpiryNotified", ctx, sub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkExpiryNotified indicates an expected call of MarkExpiryNotified.
+func (mr *MockUserUsecaseMockRecorder) MarkExpiryNotified(ctx, sub interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkExpiryNotified", reflect.TypeOf((*MockUserUsecase)(nil).MarkExpiryNotified), ctx, sub)
+}
+
+// MarkReminded mocks base method.
+func (m *MockUserUsecase) MarkReminded(ctx context.Context, sub models.ExpiringSubscription, daysBefore int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminded", ctx, sub, daysBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReminded indicates an expected call of MarkReminded.
+func (mr *MockUserUsecaseMockRecorder) MarkReminded(ctx, sub, daysBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminded", reflect.TypeOf((*MockUserUsecase)(nil).MarkReminded), ctx, sub, daysBefore)
+}
+
+// PayGift mocks base method.
+func (m *MockUserUsecase) PayGift(ctx context.Context, giftID uuid.UUID, money, paid models.Money, operationID string) (models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayGift", ctx, giftID, money, paid, operationID)
+	ret0, _ := ret[0].(models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayGift indicates an expected call of PayGift.
+func (mr *MockUserUsecaseMockRecorder) PayGift(ctx, giftID, money, paid, operationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayGift", reflect.TypeOf((*MockUserUsecase)(nil).PayGift), ctx, giftID, money, paid, operationID)
+}
+
+// RedeemGift mocks base method.
+func (m *MockUserUsecase) RedeemGift(ctx context.Context, userID uuid.UUID, code string) (models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemGift", ctx, userID, code)
+	ret0, _ := ret[0].(models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemGift indicates an expected call of RedeemGift.
+func (mr *MockUserUsecaseMockRecorder) RedeemGift(ctx, userID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemGift", reflect.TypeOf((*MockUserUsecase)(nil).RedeemGift), ctx, userID, code)
+}
+
+// RefundPayment mocks base method.
+func (m *MockUserUsecase) RefundPayment(ctx context.Context, refund models.Refund) (models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundPayment", ctx, refund)
+	ret0, _ := ret[0].(models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundPayment indicates an expected call of RefundPayment.
+func (mr *MockUserUsecaseMockRecorder) RefundPayment(ctx, refund interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockUserUsecase)(nil).RefundPayment), ctx, refund)
+}
+
+// RemindExpiring mocks base method.
+func (m *MockUserUsecase) RemindExpiring(ctx context.Context, daysBefore int64) ([]models.ExpiringSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemindExpiring", ctx, daysBefore)
+	ret0, _ := ret[0].([]models.ExpiringSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemindExpiring indicates an expected call of RemindExpiring.
+func (mr *MockUserUsecaseMockRecorder) RemindExpiring(ctx, daysBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemindExpiring", reflect.TypeOf((*MockUserUsecase)(nil).RemindExpiring), ctx, daysBefore)
+}
+
 // Subscribe mocks base method.
-func (m *MockUserUsecase) Subscribe(ctx context.Context, paymentInfo uuid.UUID, money float32) (models.NotificationSubInfo, error) {
+func (m *MockUserUsecase) Subscribe(ctx context.Context, paymentInfo uuid.UUID, money, paid models.Money, operationID string) (models.NotificationSubInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subscribe", ctx, paymentInfo, money)
+	ret := m.ctrl.Call(m, "Subscribe", ctx, paymentInfo, money, paid, operationID)
 	ret0, _ := ret[0].(models.NotificationSubInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Subscribe indicates an expected call of Subscribe.
-func (mr *MockUserUsecaseMockRecorder) Subscribe(ctx, paymentInfo, money interface{}) *gomock.Call {
+func (mr *MockUserUsecaseMockRecorder) Subscribe(ctx, paymentInfo, money, paid, operationID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUserUsecase)(nil).Subscribe), ctx, paymentInfo, money)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUserUsecase)(nil).Subscribe), ctx, paymentInfo, money, paid, operationID)
 }
 
 // Unfollow mocks base method.
@@ -226,6 +416,21 @@ func (mr *MockUserUsecaseMockRecorder) UserFollows(ctx, userId interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollows", reflect.TypeOf((*MockUserUsecase)(nil).UserFollows), ctx, userId)
 }
 
+// UserPayments mocks base method.
+func (m *MockUserUsecase) UserPayments(ctx context.Context, filter models.PaymentsFilter) ([]models.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserPayments", ctx, filter)
+	ret0, _ := ret[0].([]models.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserPayments indicates an expected call of UserPayments.
+func (mr *MockUserUsecaseMockRecorder) UserPayments(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserPayments", reflect.TypeOf((*MockUserUsecase)(nil).UserPayments), ctx, filter)
+}
+
 // UserSubscriptions mocks base method.
 func (m *MockUserUsecase) UserSubscriptions(ctx context.Context, userId uuid.UUID) ([]models.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +469,34 @@ func (m *MockUserRepo) EXPECT() *MockUserRepoMockRecorder {
 	return m.recorder
 }
 
+// AddDonateInfo mocks base method.
+func (m *MockUserRepo) AddDonateInfo(ctx context.Context, donateInfo models.Donate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDonateInfo", ctx, donateInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDonateInfo indicates an expected call of AddDonateInfo.
+func (mr *MockUserRepoMockRecorder) AddDonateInfo(ctx, donateInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDonateInfo", reflect.TypeOf((*MockUserRepo)(nil).AddDonateInfo), ctx, donateInfo)
+}
+
+// AddGift mocks base method.
+func (m *MockUserRepo) AddGift(ctx context.Context, gift models.Gift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGift", ctx, gift)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGift indicates an expected call of AddGift.
+func (mr *MockUserRepoMockRecorder) AddGift(ctx, gift interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGift", reflect.TypeOf((*MockUserRepo)(nil).AddGift), ctx, gift)
+}
+
 // AddPaymentInfo mocks base method.
 func (m *MockUserRepo) AddPaymentInfo(ctx context.Context, subscription models.SubscriptionDetails) error {
 	m.ctrl.T.Helper()
@@ -278,6 +511,20 @@ func (mr *MockUserRepoMockRecorder) AddPaymentInfo(ctx, subscription interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPaymentInfo", reflect.TypeOf((*MockUserRepo)(nil).AddPaymentInfo), ctx, subscription)
 }
 
+// AddUnmatchedPayment mocks base method.
+func (m *MockUserRepo) AddUnmatchedPayment(ctx context.Context, payment models.UnmatchedPayment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUnmatchedPayment", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUnmatchedPayment indicates an expected call of AddUnmatchedPayment.
+func (mr *MockUserRepoMockRecorder) AddUnmatchedPayment(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUnmatchedPayment", reflect.TypeOf((*MockUserRepo)(nil).AddUnmatchedPayment), ctx, payment)
+}
+
 // BecomeCreator mocks base method.
 func (m *MockUserRepo) BecomeCreator(ctx context.Context, creatorInfo models.BecameCreatorInfo, userId uuid.UUID) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -293,6 +540,21 @@ func (mr *MockUserRepoMockRecorder) BecomeCreator(ctx, creatorInfo, userId inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BecomeCreator", reflect.TypeOf((*MockUserRepo)(nil).BecomeCreator), ctx, creatorInfo, userId)
 }
 
+// CheckDonateInfo mocks base method.
+func (m *MockUserRepo) CheckDonateInfo(ctx context.Context, paymentInfo uuid.UUID) (models.Donate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDonateInfo", ctx, paymentInfo)
+	ret0, _ := ret[0].(models.Donate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckDonateInfo indicates an expected call of CheckDonateInfo.
+func (mr *MockUserRepoMockRecorder) CheckDonateInfo(ctx, paymentInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDonateInfo", reflect.TypeOf((*MockUserRepo)(nil).CheckDonateInfo), ctx, paymentInfo)
+}
+
 // CheckIfCreator mocks base method.
 func (m *MockUserRepo) CheckIfCreator(ctx context.Context, userId uuid.UUID) (uuid.UUID, bool, error) {
 	m.ctrl.T.Helper()
@@ -339,6 +601,21 @@ func (mr *MockUserRepoMockRecorder) CheckPaymentInfo(ctx, paymentInfo interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPaymentInfo", reflect.TypeOf((*MockUserRepo)(nil).CheckPaymentInfo), ctx, paymentInfo)
 }
 
+// ClaimPaymentEvent mocks base method.
+func (m *MockUserRepo) ClaimPaymentEvent(ctx context.Context, event models.PaymentEvent) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPaymentEvent", ctx, event)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPaymentEvent indicates an expected call of ClaimPaymentEvent.
+func (mr *MockUserRepoMockRecorder) ClaimPaymentEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPaymentEvent", reflect.TypeOf((*MockUserRepo)(nil).ClaimPaymentEvent), ctx, event)
+}
+
 // DeletePhoto mocks base method.
 func (m *MockUserRepo) DeletePhoto(ctx context.Context, userId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -354,10 +631,10 @@ func (mr *MockUserRepoMockRecorder) DeletePhoto(ctx, userId interface{}) *gomock
 }
 
 // Donate mocks base method.
-func (m *MockUserRepo) Donate(ctx context.Context, donateInfo models.Donate) (float32, error) {
+func (m *MockUserRepo) Donate(ctx context.Context, donateInfo models.Donate) (models.Aim, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Donate", ctx, donateInfo)
-	ret0, _ := ret[0].(float32)
+	ret0, _ := ret[0].(models.Aim)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -368,6 +645,50 @@ func (mr *MockUserRepoMockRecorder) Donate(ctx, donateInfo interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Donate", reflect.TypeOf((*MockUserRepo)(nil).Donate), ctx, donateInfo)
 }
 
+// ExpireSubscriptions mocks base method.
+func (m *MockUserRepo) ExpireSubscriptions(ctx context.Context) ([]models.ExpiringSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireSubscriptions", ctx)
+	ret0, _ := ret[0].([]models.ExpiringSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireSubscriptions indicates an expected call of ExpireSubscriptions.
+func (mr *MockUserRepoMockRecorder) ExpireSubscriptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireSubscriptions", reflect.TypeOf((*MockUserRepo)(nil).ExpireSubscriptions), ctx)
+}
+
+// ExpiringSubscriptions mocks base method.
+func (m *MockUserRepo) ExpiringSubscriptions(ctx context.Context, daysBefore int64) ([]models.ExpiringSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpiringSubscriptions", ctx, daysBefore)
+	ret0, _ := ret[0].([]models.ExpiringSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpiringSubscriptions indicates an expected call of ExpiringSubscriptions.
+func (mr *MockUserRepoMockRecorder) ExpiringSubscriptions(ctx, daysBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpiringSubscriptions", reflect.TypeOf((*MockUserRepo)(nil).ExpiringSubscriptions), ctx, daysBefore)
+}
+
+// FinishPaymentEvent mocks base method.
+func (m *MockUserRepo) FinishPaymentEvent(ctx context.Context, operationID, status, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPaymentEvent", ctx, operationID, status, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishPaymentEvent indicates an expected call of FinishPaymentEvent.
+func (mr *MockUserRepoMockRecorder) FinishPaymentEvent(ctx, operationID, status, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPaymentEvent", reflect.TypeOf((*MockUserRepo)(nil).FinishPaymentEvent), ctx, operationID, status, lastError)
+}
+
 // Follow mocks base method.
 func (m *MockUserRepo) Follow(ctx context.Context, userId, creatorId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -397,6 +718,36 @@ func (mr *MockUserRepoMockRecorder) GetCreatorID(ctx, subscriptionID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorID", reflect.TypeOf((*MockUserRepo)(nil).GetCreatorID), ctx, subscriptionID)
 }
 
+// GetGift mocks base method.
+func (m *MockUserRepo) GetGift(ctx context.Context, giftID uuid.UUID) (models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGift", ctx, giftID)
+	ret0, _ := ret[0].(models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGift indicates an expected call of GetGift.
+func (mr *MockUserRepoMockRecorder) GetGift(ctx, giftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGift", reflect.TypeOf((*MockUserRepo)(nil).GetGift), ctx, giftID)
+}
+
+// GetPayment mocks base method.
+func (m *MockUserRepo) GetPayment(ctx context.Context, userID, paymentID uuid.UUID) (models.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayment", ctx, userID, paymentID)
+	ret0, _ := ret[0].(models.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayment indicates an expected call of GetPayment.
+func (mr *MockUserRepoMockRecorder) GetPayment(ctx, userID, paymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayment", reflect.TypeOf((*MockUserRepo)(nil).GetPayment), ctx, userID, paymentID)
+}
+
 // GetUserProfile mocks base method.
 func (m *MockUserRepo) GetUserProfile(ctx context.Context, id uuid.UUID) (models.UserProfile, error) {
 	m.ctrl.T.Helper()
@@ -412,19 +763,121 @@ func (mr *MockUserRepoMockRecorder) GetUserProfile(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockUserRepo)(nil).GetUserProfile), ctx, id)
 }
 
+// IsAdmin mocks base method.
+func (m *MockUserRepo) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockUserRepoMockRecorder) IsAdmin(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockUserRepo)(nil).IsAdmin), ctx, userID)
+}
+
+// MarkExpiryNotified mocks base method.
+func (m *MockUserRepo) MarkExpiryNotified(ctx context.Context, sub models.ExpiringSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkExpiryNotified", ctx, sub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkExpiryNotified indicates an expected call of MarkExpiryNotified.
+func (mr *MockUserRepoMockRecorder) MarkExpiryNotified(ctx, sub interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkExpiryNotified", reflect.TypeOf((*MockUserRepo)(nil).MarkExpiryNotified), ctx, sub)
+}
+
+// MarkReminded mocks base method.
+func (m *MockUserRepo) MarkReminded(ctx context.Context, sub models.ExpiringSubscription, daysBefore int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminded", ctx, sub, daysBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReminded indicates an expected call of MarkReminded.
+func (mr *MockUserRepoMockRecorder) MarkReminded(ctx, sub, daysBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminded", reflect.TypeOf((*MockUserRepo)(nil).MarkReminded), ctx, sub, daysBefore)
+}
+
+// PayGift mocks base method.
+func (m *MockUserRepo) PayGift(ctx context.Context, gift models.Gift, money models.Money, operationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayGift", ctx, gift, money, operationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PayGift indicates an expected call of PayGift.
+func (mr *MockUserRepoMockRecorder) PayGift(ctx, gift, money, operationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayGift", reflect.TypeOf((*MockUserRepo)(nil).PayGift), ctx, gift, money, operationID)
+}
+
+// RedeemGift mocks base method.
+func (m *MockUserRepo) RedeemGift(ctx context.Context, userID uuid.UUID, code string) (models.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemGift", ctx, userID, code)
+	ret0, _ := ret[0].(models.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemGift indicates an expected call of RedeemGift.
+func (mr *MockUserRepoMockRecorder) RedeemGift(ctx, userID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemGift", reflect.TypeOf((*MockUserRepo)(nil).RedeemGift), ctx, userID, code)
+}
+
+// RefundPayment mocks base method.
+func (m *MockUserRepo) RefundPayment(ctx context.Context, refund models.Refund, transfer func(models.Refund, string) (string, error)) (models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundPayment", ctx, refund, transfer)
+	ret0, _ := ret[0].(models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundPayment indicates an expected call of RefundPayment.
+func (mr *MockUserRepoMockRecorder) RefundPayment(ctx, refund, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockUserRepo)(nil).RefundPayment), ctx, refund, transfer)
+}
+
 // Subscribe mocks base method.
-func (m *MockUserRepo) Subscribe(ctx context.Context, subscription models.SubscriptionDetails) (models.NotificationSubInfo, error) {
+func (m *MockUserRepo) Subscribe(ctx context.Context, subscription models.SubscriptionDetails, money models.Money, operationID string) (models.NotificationSubInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subscribe", ctx, subscription)
+	ret := m.ctrl.Call(m, "Subscribe", ctx, subscription, money, operationID)
 	ret0, _ := ret[0].(models.NotificationSubInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Subscribe indicates an expected call of Subscribe.
-func (mr *MockUserRepoMockRecorder) Subscribe(ctx, subscription interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) Subscribe(ctx, subscription, money, operationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUserRepo)(nil).Subscribe), ctx, subscription, money, operationID)
+}
+
+// SubscriptionBilling mocks base method.
+func (m *MockUserRepo) SubscriptionBilling(ctx context.Context, subscriptionID uuid.UUID) (models.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscriptionBilling", ctx, subscriptionID)
+	ret0, _ := ret[0].(models.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscriptionBilling indicates an expected call of SubscriptionBilling.
+func (mr *MockUserRepoMockRecorder) SubscriptionBilling(ctx, subscriptionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUserRepo)(nil).Subscribe), ctx, subscription)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionBilling", reflect.TypeOf((*MockUserRepo)(nil).SubscriptionBilling), ctx, subscriptionID)
 }
 
 // Unfollow mocks base method.
@@ -455,20 +908,6 @@ func (mr *MockUserRepoMockRecorder) UpdatePassword(ctx, id, password interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepo)(nil).UpdatePassword), ctx, id, password)
 }
 
-// UpdatePaymentInfo mocks base method.
-func (m *MockUserRepo) UpdatePaymentInfo(ctx context.Context, money float32, paymentInfo uuid.UUID) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePaymentInfo", ctx, money, paymentInfo)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpdatePaymentInfo indicates an expected call of UpdatePaymentInfo.
-func (mr *MockUserRepoMockRecorder) UpdatePaymentInfo(ctx, money, paymentInfo interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentInfo", reflect.TypeOf((*MockUserRepo)(nil).UpdatePaymentInfo), ctx, money, paymentInfo)
-}
-
 // UpdateProfileInfo mocks base method.
 func (m *MockUserRepo) UpdateProfileInfo(ctx context.Context, profileInfo models.UpdateProfileInfo, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -512,6 +951,21 @@ func (mr *MockUserRepoMockRecorder) UserFollows(ctx, userId interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserFollows", reflect.TypeOf((*MockUserRepo)(nil).UserFollows), ctx, userId)
 }
 
+// UserPayments mocks base method.
+func (m *MockUserRepo) UserPayments(ctx context.Context, filter models.PaymentsFilter) ([]models.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserPayments", ctx, filter)
+	ret0, _ := ret[0].([]models.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserPayments indicates an expected call of UserPayments.
+func (mr *MockUserRepoMockRecorder) UserPayments(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserPayments", reflect.TypeOf((*MockUserRepo)(nil).UserPayments), ctx, filter)
+}
+
 // UserSubscriptions mocks base method.
 func (m *MockUserRepo) UserSubscriptions(ctx context.Context, userId uuid.UUID) ([]models.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ import (
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"time"
 )
 
 const (
@@ -16,22 +17,56 @@ const (
 	UpdateProfilePhoto   = `UPDATE "user" SET profile_photo = $1 WHERE user_id = $2;`
 	UpdatePassword       = `UPDATE "user" SET password_hash = $1, user_version = user_version+1 WHERE user_id = $2;`
 	UpdateProfileInfo    = `UPDATE "user" SET login = $1, display_name = $2 WHERE user_id = $3;`
-	UpdateAuthorAimMoney = `UPDATE "creator" SET money_got = money_got + $1 WHERE creator_id = $2 RETURNING money_got;`
-	AddDonate            = `INSERT INTO "donation"(creator_id, money_count) VALUES ($1, $2);`
+	CheckDonationCreator = `SELECT creator_id FROM "creator" WHERE creator_id = $1;`
+	DonationAim          = `SELECT aim_id FROM "aim" WHERE creator_id = $1 AND completed_at IS NULL AND (deadline IS NULL OR deadline > now()) ORDER BY aim_id = $2 DESC, position, created_at LIMIT 1;`
+	UpdateAimMoney       = `UPDATE "aim" SET money_got = money_got + $1, completed_at = CASE WHEN money_got + $1 >= money_needed THEN now() END WHERE aim_id = $2 RETURNING description, money_needed, money_got, completed_at;`
+	AddDonate            = `INSERT INTO "donation"(creator_id, money_count, operation_id, user_id, is_anonymous, message, aim_id) VALUES ($1, $2, $3, nullif($4, '00000000-0000-0000-0000-000000000000'::uuid), $5, nullif($6, ''), nullif($7, '00000000-0000-0000-0000-000000000000'::uuid));`
+	AddDonateInfo        = `INSERT INTO "donation_info" (payment_info, user_id, creator_id, message, is_anonymous, aim_id) VALUES ($1, $2, $3, nullif($4, ''), $5, nullif($6, '00000000-0000-0000-0000-000000000000'::uuid));`
+	CheckDonateInfo      = `SELECT user_id, creator_id, coalesce(message, ''), is_anonymous, coalesce(aim_id, '00000000-0000-0000-0000-000000000000'::uuid) FROM "donation_info" WHERE payment_info = $1;`
+	DeleteDonateInfo     = `DELETE FROM "donation_info" WHERE payment_info = $1;`
 	BecameCreator        = `INSERT INTO "creator"(creator_id, user_id, name, description) VALUES ($1, $2, $3, $4);`
 	Follow               = `INSERT INTO "follow" (user_id, creator_id) VALUES ($1, $2);`
 	Unfollow             = `DELETE FROM "follow" WHERE user_id = $1 AND creator_id = $2;`
 	CheckIfFollow        = `SELECT user_id FROM "follow" WHERE user_id = $1 AND creator_id = $2;`
-	UpdateSubscription   = `UPDATE "user_subscription" SET expire_date = expire_date + $1 * INTERVAL '1 MONTH' WHERE user_id = $2 AND subscription_id = $3 RETURNING user_id;`
-	Subscribe            = `INSERT INTO "user_subscription" VALUES ($1, $2, now() + $3 * INTERVAL '1 MONTH');`
+	EnsureFollow         = `INSERT INTO "follow" (user_id, creator_id) SELECT $1, $2 WHERE NOT EXISTS (SELECT 1 FROM "follow" WHERE user_id = $1 AND creator_id = $2);`
+	UpdateSubscription   = `UPDATE "user_subscription" us SET expire_date = p.period_start + $1 * INTERVAL '1 MONTH', is_expired = false, reminded_before = null, user_notified = false, creator_notified = false FROM (SELECT greatest(expire_date, now()) AS period_start FROM "user_subscription" WHERE user_id = $2 AND subscription_id = $3 FOR UPDATE) p WHERE us.user_id = $2 AND us.subscription_id = $3 RETURNING p.period_start, us.expire_date;`
+	Subscribe            = `INSERT INTO "user_subscription" VALUES ($1, $2, now() + $3 * INTERVAL '1 MONTH') RETURNING now(), expire_date;`
+	SetPaymentPeriod     = `UPDATE "user_payments" SET period_start = $1, period_end = $2 WHERE payment_id = $3;`
 	CheckIfSubExists     = `SELECT title, creator_id FROM subscription WHERE subscription_id = $1;`
-	AddPaymentInfo       = `INSERT INTO "user_payments" (user_id, subscription_id, payment_timestamp, month_count, payment_info, money) VALUES ($1, $2, now(), $3, $4, 0);`
-	CheckPaymentInfo     = `SELECT user_id, subscription_id, month_count FROM "user_payments" WHERE payment_info = $1;`
-	UpdatePaymentInfo    = `UPDATE "user_payments" SET money = $1 WHERE payment_info = $2`
+	AddPaymentInfo       = `INSERT INTO "user_payments" (payment_id, user_id, subscription_id, payment_timestamp, month_count, payment_info, money) VALUES ($4, $1, $2, now(), $3, $4::text, 0);`
+	CheckPaymentInfo     = `SELECT user_id, subscription_id, month_count FROM "user_payments" WHERE payment_id = $1;`
+	UpdatePaymentInfo    = `UPDATE "user_payments" SET money = $1, operation_id = $2, payment_timestamp = now() WHERE payment_id = $3 AND money = 0 RETURNING payment_id;`
 	UserSubscriptions    = `SELECT us.subscription_id, c.creator_id, name, profile_photo, month_cost, title, subscription.description FROM "subscription" join user_subscription us on subscription.subscription_id = us.subscription_id join creator c on c.creator_id = subscription.creator_id WHERE us.user_id = $1;`
 	DeletePhoto          = `UPDATE "user" SET profile_photo = null WHERE user_id = $1`
 	GetCreatorIDFromSub  = `SELECT creator_id FROM subscription WHERE subscription_id = $1 `
 	FollowsList          = `SELECT c.creator_id, name, profile_photo, description FROM "follow" join creator c on c.creator_id = follow.creator_id WHERE follow.user_id = $1;`
+	ExpiringSubs         = `SELECT us.user_id, us.subscription_id, s.creator_id, s.title, us.expire_date FROM "user_subscription" us join subscription s on s.subscription_id = us.subscription_id WHERE NOT us.is_expired AND us.expire_date > now() AND us.expire_date <= now() + $1 * INTERVAL '1 DAY' AND (us.reminded_before IS NULL OR us.reminded_before > $1);`
+	MarkReminded         = `UPDATE "user_subscription" SET reminded_before = $1 WHERE user_id = $2 AND subscription_id = $3;`
+	userPayments         = `SELECT payment_id, payment_type, creator_id, name, subscription_id, title, money, month_count, payment_timestamp, operation_id, period_start, period_end FROM (SELECT up.payment_id, 'subscription' AS payment_type, s.creator_id, c.name, s.subscription_id, s.title, up.money, up.month_count, up.payment_timestamp, coalesce(up.operation_id, '') AS operation_id, up.period_start, up.period_end FROM "user_payments" up join subscription s on s.subscription_id = up.subscription_id join creator c on c.creator_id = s.creator_id WHERE up.user_id = $1 AND up.money > 0 UNION ALL SELECT d.donation_id, 'donation', d.creator_id, c.name, '00000000-0000-0000-0000-000000000000'::uuid, '', d.money_count, 0, d.donation_date, coalesce(d.operation_id, ''), NULL::timestamp, NULL::timestamp FROM "donation" d join creator c on c.creator_id = d.creator_id WHERE d.user_id = $1 UNION ALL SELECT g.gift_id, 'gift', s.creator_id, c.name, s.subscription_id, s.title, g.money, g.month_count, g.paid_at, coalesce(g.operation_id, ''), NULL::timestamp, NULL::timestamp FROM "gift" g join subscription s on s.subscription_id = g.subscription_id join creator c on c.creator_id = s.creator_id WHERE g.buyer_id = $1 AND g.paid_at IS NOT NULL) AS p `
+	UserPayments         = userPayments + `WHERE ($2 = '00000000-0000-0000-0000-000000000000'::uuid OR creator_id = $2) AND ($3 = '' OR payment_type = $3) AND payment_timestamp BETWEEN $4 AND $5 ORDER BY payment_timestamp DESC LIMIT $6 OFFSET $7;`
+	GetPayment           = userPayments + `WHERE payment_id = $2;`
+	AddGift              = `INSERT INTO "gift" (gift_id, buyer_id, recipient_id, subscription_id, month_count) VALUES ($1, $2, nullif($3, '00000000-0000-0000-0000-000000000000'::uuid), $4, $5);`
+	GetGift              = `SELECT g.gift_id, g.subscription_id, s.title, s.creator_id, g.buyer_id, coalesce(g.redeemed_by, g.recipient_id, '00000000-0000-0000-0000-000000000000'::uuid), g.month_count, coalesce(g.code, ''), g.paid_at IS NOT NULL, g.redeemed_by IS NOT NULL FROM "gift" g join subscription s on s.subscription_id = g.subscription_id WHERE g.gift_id = $1;`
+	PayGift              = `UPDATE "gift" SET money = $1, operation_id = $2, code = nullif($3, ''), paid_at = now(), redeemed_by = recipient_id, redeemed_at = CASE WHEN recipient_id IS NULL THEN NULL ELSE now() END WHERE gift_id = $4 AND paid_at IS NULL RETURNING gift_id;`
+	RedeemGift           = `UPDATE "gift" g SET redeemed_by = $1, redeemed_at = now() FROM subscription s WHERE s.subscription_id = g.subscription_id AND g.code = $2 AND g.paid_at IS NOT NULL AND g.redeemed_by IS NULL RETURNING g.gift_id, g.subscription_id, s.title, s.creator_id, g.buyer_id, g.redeemed_by, g.month_count, g.code;`
+	ExpireSubs           = `WITH expired AS (UPDATE "user_subscription" us SET is_expired = true FROM subscription s WHERE s.subscription_id = us.subscription_id AND NOT us.is_expired AND us.expire_date <= now() RETURNING us.user_id, us.subscription_id, s.creator_id, s.title, us.expire_date) SELECT user_id, subscription_id, creator_id, title, expire_date, false, false FROM expired UNION ALL SELECT us.user_id, us.subscription_id, s.creator_id, s.title, us.expire_date, us.user_notified, us.creator_notified FROM "user_subscription" us join subscription s on s.subscription_id = us.subscription_id WHERE us.is_expired AND NOT (us.user_notified AND us.creator_notified);`
+	MarkExpiryNotified   = `UPDATE "user_subscription" SET user_notified = user_notified OR $1, creator_notified = creator_notified OR $2 WHERE user_id = $3 AND subscription_id = $4;`
+	ClaimPaymentEvent    = `INSERT INTO "payment_event" (operation_id, provider, kind, target_id, money, sender) VALUES ($1, $2, $3, $4, $5, nullif($6, '')) ON CONFLICT (operation_id) DO UPDATE SET status = 'processing', attempts = payment_event.attempts + 1, last_error = NULL, updated_at = now() WHERE payment_event.status = 'failed' OR (payment_event.status = 'processing' AND payment_event.updated_at < now() - INTERVAL '5 MINUTE') RETURNING status;`
+	PaymentEventStatus   = `SELECT status FROM "payment_event" WHERE operation_id = $1;`
+	FinishPaymentEvent   = `UPDATE "payment_event" SET status = $1, last_error = nullif($2, ''), updated_at = now() WHERE operation_id = $3 AND status = 'processing';`
+	ProcessPaymentEvent  = `UPDATE "payment_event" SET status = 'processed', last_error = NULL, updated_at = now() WHERE operation_id = $1 AND status = 'processing' RETURNING operation_id;`
+	AddUnmatchedPayment  = `INSERT INTO "unmatched_payment" (operation_id, kind, target_id, money, paid, price, reason) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (operation_id) DO NOTHING;`
+	SubscriptionBilling  = `SELECT s.month_cost, coalesce(o.month_count, 0), coalesce(o.price, 0), coalesce(o.discount_percent, 0) FROM "subscription" s left join subscription_billing_option o on o.subscription_id = s.subscription_id WHERE s.subscription_id = $1;`
+	RefundByOperation    = `SELECT refund_id, payment_type, payment_id, creator_id, coalesce(user_id, '00000000-0000-0000-0000-000000000000'::uuid), money, coalesce(reason, ''), source, coalesce(operation_id, ''), created_at FROM "refund" WHERE operation_id = $1;`
+	LockSubPayment       = `SELECT up.user_id, up.subscription_id, s.creator_id, up.month_count, up.money, coalesce(pe.sender, '') FROM "user_payments" up join subscription s on s.subscription_id = up.subscription_id left join payment_event pe on pe.operation_id = up.operation_id WHERE up.payment_id = $1 AND up.money > 0 FOR UPDATE OF up;`
+	LockDonation         = `SELECT coalesce(d.user_id, '00000000-0000-0000-0000-000000000000'::uuid), d.creator_id, d.money_count, coalesce(d.aim_id, '00000000-0000-0000-0000-000000000000'::uuid), coalesce(pe.sender, '') FROM "donation" d left join payment_event pe on pe.operation_id = d.operation_id WHERE d.donation_id = $1 FOR UPDATE OF d;`
+	LockGift             = `SELECT g.buyer_id, s.creator_id, g.money, g.redeemed_by IS NOT NULL, coalesce(pe.sender, '') FROM "gift" g join subscription s on s.subscription_id = g.subscription_id left join payment_event pe on pe.operation_id = g.operation_id WHERE g.gift_id = $1 AND g.paid_at IS NOT NULL FOR UPDATE OF g;`
+	RefundedMoney        = `SELECT coalesce(sum(money), 0) FROM "refund" WHERE payment_id = $1;`
+	AddRefund            = `INSERT INTO "refund" (payment_type, payment_id, creator_id, user_id, money, reason, source, operation_id) VALUES ($1, $2, $3, nullif($4, '00000000-0000-0000-0000-000000000000'::uuid), $5, nullif($6, ''), $7, nullif($8, '')) RETURNING refund_id, created_at;`
+	ShortenSubscription  = `UPDATE "user_subscription" SET expire_date = expire_date - $1 * INTERVAL '1 MONTH', is_expired = expire_date - $1 * INTERVAL '1 MONTH' <= now(), user_notified = expire_date - $1 * INTERVAL '1 MONTH' <= now(), creator_notified = expire_date - $1 * INTERVAL '1 MONTH' <= now() WHERE user_id = $2 AND subscription_id = $3;`
+	IsAdmin              = `SELECT is_admin FROM "user" WHERE user_id = $1;`
+	RevokeGift           = `UPDATE "gift" SET code = NULL WHERE gift_id = $1;`
+	RefundAimMoney       = `UPDATE "aim" SET money_got = greatest(money_got - $1, 0), completed_at = CASE WHEN money_got - $1 >= money_needed THEN completed_at END WHERE aim_id = $2;`
 )
 
 type UserRepo struct {
@@ -153,56 +188,109 @@ func (ur *UserRepo) CheckPaymentInfo(ctx context.Context, paymentInfo uuid.UUID)
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return models.SubscriptionDetails{}, models.NotFound
 	}
+	subscription.PaymentInfo = paymentInfo
 	return subscription, nil
 }
 
-func (ur *UserRepo) UpdatePaymentInfo(ctx context.Context, money float32, paymentInfo uuid.UUID) error {
-	row := ur.db.QueryRowContext(ctx, UpdatePaymentInfo, money, paymentInfo)
-	if err := row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
+// SubscriptionBilling возвращает цену уровня за месяц и его варианты оплаты на несколько месяцев
+func (ur *UserRepo) SubscriptionBilling(ctx context.Context, subscriptionID uuid.UUID) (models.Subscription, error) {
+	subscription := models.Subscription{Id: subscriptionID}
+	rows, err := ur.db.QueryContext(ctx, SubscriptionBilling, subscriptionID)
+	if err != nil {
 		ur.logger.Error(err)
-		return models.InternalError
+		return models.Subscription{}, models.InternalError
 	}
-	return nil
+	defer rows.Close()
+	found := false
+	for rows.Next() {
+		var option models.BillingOption
+		if err = rows.Scan(&subscription.MonthCost, &option.MonthCount, &option.Price, &option.DiscountPercent); err != nil {
+			ur.logger.Error(err)
+			return models.Subscription{}, models.InternalError
+		}
+		found = true
+		if option.MonthCount != 0 {
+			subscription.BillingOptions = append(subscription.BillingOptions, option)
+		}
+	}
+	if !found {
+		return models.Subscription{}, models.NotFound
+	}
+	return subscription, nil
 }
 
-func (ur *UserRepo) Subscribe(ctx context.Context, subscription models.SubscriptionDetails) (models.NotificationSubInfo, error) {
+// Subscribe проводит оплату подписки: в одной транзакции записывает платёж, продлевает подписку
+// и отмечает уведомление о платеже обработанным, поэтому повтор уведомления её второй раз не продлит
+func (ur *UserRepo) Subscribe(ctx context.Context, subscription models.SubscriptionDetails, money models.Money, operationID string) (models.NotificationSubInfo, error) {
 	tx, err := ur.db.BeginTx(ctx, nil)
 	if err != nil {
 		ur.logger.Error(err)
 		return models.NotificationSubInfo{}, models.InternalError
 	}
+	var paymentID uuid.UUID
+	row := tx.QueryRowContext(ctx, UpdatePaymentInfo, money, operationID, subscription.PaymentInfo)
+	if err = row.Scan(&paymentID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		_ = tx.Rollback()
+		return models.NotificationSubInfo{}, models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) { // подписка по этой записи уже оплачена
+		_ = tx.Rollback()
+		return models.NotificationSubInfo{}, models.WrongData
+	}
+	subNotification, err := ur.extendSubscription(ctx, tx, subscription)
+	if err != nil {
+		_ = tx.Rollback()
+		return models.NotificationSubInfo{}, err
+	}
+	if err = ur.processPaymentEvent(ctx, tx, operationID); err != nil {
+		_ = tx.Rollback()
+		return models.NotificationSubInfo{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		ur.logger.Error(err)
+		return models.NotificationSubInfo{}, models.InternalError
+	}
+
+	return subNotification, nil
+}
+
+// extendSubscription подписывает пользователя на автора и продлевает (или создаёт) подписку в транзакции tx
+func (ur *UserRepo) extendSubscription(ctx context.Context, tx *sql.Tx, subscription models.SubscriptionDetails) (models.NotificationSubInfo, error) {
+	if _, err := tx.ExecContext(ctx, EnsureFollow, subscription.UserID, subscription.CreatorId); err != nil {
+		ur.logger.Error(err)
+		return models.NotificationSubInfo{}, models.InternalError
+	}
 	// если подписка уже есть обновляем expire date
-	row := ur.db.QueryRowContext(ctx, UpdateSubscription, subscription.MonthCount, subscription.UserID, subscription.Id)
-	var userIDtmp uuid.UUID
+	row := tx.QueryRowContext(ctx, UpdateSubscription, subscription.MonthCount, subscription.UserID, subscription.Id)
+	var periodStart, periodEnd time.Time
 	var subNotification models.NotificationSubInfo
-	if err := row.Scan(&userIDtmp); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if err := row.Scan(&periodStart, &periodEnd); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		ur.logger.Error(err)
-		_ = tx.Rollback()
 		return models.NotificationSubInfo{}, models.InternalError
 	} else if errors.Is(err, sql.ErrNoRows) { // если нет, то добавляем о ней запись
-		row = ur.db.QueryRowContext(ctx, CheckIfSubExists, subscription.Id)
+		row = tx.QueryRowContext(ctx, CheckIfSubExists, subscription.Id)
 		if err = row.Scan(&subNotification.SubscriptionName, &subNotification.CreatorID); err != nil && !errors.Is(err, sql.ErrNoRows) {
 			ur.logger.Error(err)
-			_ = tx.Rollback()
 			return models.NotificationSubInfo{}, models.InternalError
 		} else if errors.Is(err, sql.ErrNoRows) { // такой подписки нет
-			_ = tx.Rollback()
 			return models.NotificationSubInfo{}, models.WrongData
 		}
 
-		row = ur.db.QueryRowContext(ctx, Subscribe, subscription.UserID, subscription.Id, subscription.MonthCount)
-		if err = row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		row = tx.QueryRowContext(ctx, Subscribe, subscription.UserID, subscription.Id, subscription.MonthCount)
+		if err = row.Scan(&periodStart, &periodEnd); err != nil {
 			ur.logger.Error(err)
-			_ = tx.Rollback()
 			return models.NotificationSubInfo{}, models.InternalError
 		}
 	}
 
-	if err = tx.Commit(); err != nil {
-		ur.logger.Error(err)
-		return models.NotificationSubInfo{}, models.InternalError
+	// период оплаченной подписки попадает в чек; у подарков записи об оплате нет
+	if subscription.PaymentInfo != uuid.Nil {
+		if _, err := tx.ExecContext(ctx, SetPaymentPeriod, periodStart, periodEnd, subscription.PaymentInfo); err != nil {
+			ur.logger.Error(err)
+			return models.NotificationSubInfo{}, models.InternalError
+		}
 	}
-
 	return subNotification, nil
 }
 
@@ -224,32 +312,73 @@ func (ur *UserRepo) UpdateProfileInfo(ctx context.Context, profileInfo models.Up
 	return nil
 }
 
-func (ur *UserRepo) Donate(ctx context.Context, donateInfo models.Donate) (float32, error) {
+// Donate сохраняет донат и зачисляет его на выбранную цель автора (или на первую активную).
+// Возвращает цель, на которую ушли деньги; если активных целей нет - пустую цель.
+func (ur *UserRepo) Donate(ctx context.Context, donateInfo models.Donate) (models.Aim, error) {
 	tx, err := ur.db.BeginTx(ctx, nil)
 	if err != nil {
 		ur.logger.Error(err)
-		return 0, models.InternalError
+		return models.Aim{}, models.InternalError
 	}
-	var newMoney float32
-	row := tx.QueryRowContext(ctx, UpdateAuthorAimMoney, donateInfo.MoneyCount, donateInfo.CreatorID)
-
-	if err = row.Scan(&newMoney); err != nil && !errors.Is(sql.ErrNoRows, err) {
+	aim := models.Aim{Creator: donateInfo.CreatorID}
+	row := tx.QueryRowContext(ctx, CheckDonationCreator, donateInfo.CreatorID)
+	if err = row.Scan(&aim.Creator); err != nil && !errors.Is(sql.ErrNoRows, err) {
 		ur.logger.Error(err)
 		_ = tx.Rollback()
-		return 0, models.InternalError
+		return models.Aim{}, models.InternalError
 	} else if errors.Is(sql.ErrNoRows, err) {
 		_ = tx.Rollback()
-		return 0, models.WrongData
+		return models.Aim{}, models.WrongData
 	}
 
-	tx.QueryRowContext(ctx, AddDonate, donateInfo.CreatorID, donateInfo.MoneyCount)
+	row = tx.QueryRowContext(ctx, DonationAim, donateInfo.CreatorID, donateInfo.AimId)
+	if err = row.Scan(&aim.Id); err != nil && !errors.Is(sql.ErrNoRows, err) {
+		ur.logger.Error(err)
+		_ = tx.Rollback()
+		return models.Aim{}, models.InternalError
+	}
+
+	if aim.Id != uuid.Nil {
+		var completedAt sql.NullTime
+		row = tx.QueryRowContext(ctx, UpdateAimMoney, donateInfo.MoneyCount, aim.Id)
+		if err = row.Scan(&aim.Description, &aim.MoneyNeeded, &aim.MoneyGot, &completedAt); err != nil {
+			ur.logger.Error(err)
+			_ = tx.Rollback()
+			return models.Aim{}, models.InternalError
+		}
+		if completedAt.Valid {
+			aim.CompletedAt = &completedAt.Time
+		}
+	}
+
+	row = tx.QueryRowContext(ctx, AddDonate, donateInfo.CreatorID, donateInfo.MoneyCount, donateInfo.OperationId,
+		donateInfo.UserId, donateInfo.IsAnonymous, donateInfo.Message, aim.Id)
+	if err = row.Scan(); err != nil && !errors.Is(sql.ErrNoRows, err) {
+		ur.logger.Error(err)
+		_ = tx.Rollback()
+		return models.Aim{}, models.InternalError
+	}
+
+	if donateInfo.PaymentInfo != uuid.Nil {
+		row = tx.QueryRowContext(ctx, DeleteDonateInfo, donateInfo.PaymentInfo)
+		if err = row.Scan(); err != nil && !errors.Is(sql.ErrNoRows, err) {
+			ur.logger.Error(err)
+			_ = tx.Rollback()
+			return models.Aim{}, models.InternalError
+		}
+	}
+
+	if err = ur.processPaymentEvent(ctx, tx, donateInfo.OperationId); err != nil {
+		_ = tx.Rollback()
+		return models.Aim{}, err
+	}
 
 	if err = tx.Commit(); err != nil {
 		ur.logger.Error(err)
-		return 0, models.InternalError
+		return models.Aim{}, models.InternalError
 	}
 
-	return newMoney, nil
+	return aim, nil
 }
 
 func (ur *UserRepo) CheckIfCreator(ctx context.Context, userId uuid.UUID) (uuid.UUID, bool, error) {
@@ -305,3 +434,438 @@ func (ur *UserRepo) UserFollows(ctx context.Context, userId uuid.UUID) ([]models
 	}
 	return follows, nil
 }
+
+func (ur *UserRepo) ExpiringSubscriptions(ctx context.Context, daysBefore int64) ([]models.ExpiringSubscription, error) {
+	subs := make([]models.ExpiringSubscription, 0)
+	rows, err := ur.db.QueryContext(ctx, ExpiringSubs, daysBefore)
+	if err != nil && !errors.Is(sql.ErrNoRows, err) {
+		ur.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var sub models.ExpiringSubscription
+		err = rows.Scan(&sub.UserID, &sub.SubscriptionID, &sub.CreatorID, &sub.Title, &sub.ExpireDate)
+		if err != nil {
+			ur.logger.Error(err)
+			return nil, models.InternalError
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+func (ur *UserRepo) MarkReminded(ctx context.Context, sub models.ExpiringSubscription, daysBefore int64) error {
+	row := ur.db.QueryRowContext(ctx, MarkReminded, daysBefore, sub.UserID, sub.SubscriptionID)
+	if err := row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+// MarkExpiryNotified отмечает, кто из получателей уже получил уведомление об окончании подписки;
+// отметки не снимаются
+func (ur *UserRepo) MarkExpiryNotified(ctx context.Context, sub models.ExpiringSubscription) error {
+	if _, err := ur.db.ExecContext(ctx, MarkExpiryNotified, sub.UserNotified, sub.CreatorNotified, sub.UserID, sub.SubscriptionID); err != nil {
+		ur.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+// ExpireSubscriptions отмечает закончившиеся подписки и возвращает их вместе с теми,
+// об окончании которых ещё не удалось сообщить
+func (ur *UserRepo) ExpireSubscriptions(ctx context.Context) ([]models.ExpiringSubscription, error) {
+	subs := make([]models.ExpiringSubscription, 0)
+	rows, err := ur.db.QueryContext(ctx, ExpireSubs)
+	if err != nil && !errors.Is(sql.ErrNoRows, err) {
+		ur.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var sub models.ExpiringSubscription
+		err = rows.Scan(&sub.UserID, &sub.SubscriptionID, &sub.CreatorID, &sub.Title, &sub.ExpireDate, &sub.UserNotified, &sub.CreatorNotified)
+		if err != nil {
+			ur.logger.Error(err)
+			return nil, models.InternalError
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+func (ur *UserRepo) UserPayments(ctx context.Context, filter models.PaymentsFilter) ([]models.Payment, error) {
+	payments := make([]models.Payment, 0)
+	rows, err := ur.db.QueryContext(ctx, UserPayments, filter.UserId, filter.CreatorId, filter.Type, filter.From, filter.To, filter.Limit, filter.Offset)
+	if err != nil && !errors.Is(sql.ErrNoRows, err) {
+		ur.logger.Error(err)
+		return nil, models.InternalError
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var payment models.Payment
+		err = rows.Scan(&payment.Id, &payment.Type, &payment.CreatorId, &payment.CreatorName, &payment.SubscriptionId,
+			&payment.Tier, &payment.Money, &payment.MonthCount, &payment.PaymentTime, &payment.OperationId, &payment.PeriodStart, &payment.PeriodEnd)
+		if err != nil {
+			ur.logger.Error(err)
+			return nil, models.InternalError
+		}
+		payments = append(payments, payment)
+	}
+	return payments, nil
+}
+
+func (ur *UserRepo) GetPayment(ctx context.Context, userID, paymentID uuid.UUID) (models.Payment, error) {
+	var payment models.Payment
+	row := ur.db.QueryRowContext(ctx, GetPayment, userID, paymentID)
+	if err := row.Scan(&payment.Id, &payment.Type, &payment.CreatorId, &payment.CreatorName, &payment.SubscriptionId,
+		&payment.Tier, &payment.Money, &payment.MonthCount, &payment.PaymentTime, &payment.OperationId, &payment.PeriodStart, &payment.PeriodEnd); err != nil && !errors.Is(sql.ErrNoRows, err) {
+		ur.logger.Error(err)
+		return models.Payment{}, models.InternalError
+	} else if errors.Is(sql.ErrNoRows, err) {
+		return models.Payment{}, models.NotFound
+	}
+	return payment, nil
+}
+
+func (ur *UserRepo) AddGift(ctx context.Context, gift models.Gift) error {
+	row := ur.db.QueryRowContext(ctx, AddGift, gift.Id, gift.BuyerId, gift.RecipientId, gift.SubscriptionId, gift.MonthCount)
+	if err := row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+func (ur *UserRepo) GetGift(ctx context.Context, giftID uuid.UUID) (models.Gift, error) {
+	var gift models.Gift
+	row := ur.db.QueryRowContext(ctx, GetGift, giftID)
+	if err := row.Scan(&gift.Id, &gift.SubscriptionId, &gift.SubscriptionName, &gift.CreatorId, &gift.BuyerId, &gift.RecipientId,
+		&gift.MonthCount, &gift.Code, &gift.IsPaid, &gift.IsRedeemed); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		return models.Gift{}, models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) {
+		return models.Gift{}, models.NotFound
+	}
+	return gift, nil
+}
+
+// PayGift отмечает подарок оплаченным и уведомление о платеже обработанным;
+// подарок с получателем в той же транзакции оформляет ему подписку
+func (ur *UserRepo) PayGift(ctx context.Context, gift models.Gift, money models.Money, operationID string) error {
+	tx, err := ur.db.BeginTx(ctx, nil)
+	if err != nil {
+		ur.logger.Error(err)
+		return models.InternalError
+	}
+	var tmp uuid.UUID
+	row := tx.QueryRowContext(ctx, PayGift, money, operationID, gift.Code, gift.Id)
+	if err = row.Scan(&tmp); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) { // подарок уже оплачен
+		_ = tx.Rollback()
+		return models.WrongData
+	}
+
+	if gift.RecipientId != uuid.Nil {
+		if _, err = ur.extendSubscription(ctx, tx, models.SubscriptionDetails{
+			CreatorId:  gift.CreatorId,
+			Id:         gift.SubscriptionId,
+			UserID:     gift.RecipientId,
+			MonthCount: gift.MonthCount,
+		}); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	if err = ur.processPaymentEvent(ctx, tx, operationID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		ur.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+// RedeemGift активирует подарок по коду и в той же транзакции оформляет подписку пользователю
+func (ur *UserRepo) RedeemGift(ctx context.Context, userID uuid.UUID, code string) (models.Gift, error) {
+	tx, err := ur.db.BeginTx(ctx, nil)
+	if err != nil {
+		ur.logger.Error(err)
+		return models.Gift{}, models.InternalError
+	}
+	var gift models.Gift
+	row := tx.QueryRowContext(ctx, RedeemGift, userID, code)
+	if err = row.Scan(&gift.Id, &gift.SubscriptionId, &gift.SubscriptionName, &gift.CreatorId, &gift.BuyerId, &gift.RecipientId,
+		&gift.MonthCount, &gift.Code); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		_ = tx.Rollback()
+		return models.Gift{}, models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		return models.Gift{}, models.NotFound
+	}
+
+	if _, err = ur.extendSubscription(ctx, tx, models.SubscriptionDetails{
+		CreatorId:  gift.CreatorId,
+		Id:         gift.SubscriptionId,
+		UserID:     userID,
+		MonthCount: gift.MonthCount,
+	}); err != nil {
+		_ = tx.Rollback()
+		return models.Gift{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		ur.logger.Error(err)
+		return models.Gift{}, models.InternalError
+	}
+	gift.IsPaid = true
+	gift.IsRedeemed = true
+	return gift, nil
+}
+
+func (ur *UserRepo) AddDonateInfo(ctx context.Context, donateInfo models.Donate) error {
+	row := ur.db.QueryRowContext(ctx, AddDonateInfo, donateInfo.PaymentInfo, donateInfo.UserId, donateInfo.CreatorID, donateInfo.Message, donateInfo.IsAnonymous, donateInfo.AimId)
+	if err := row.Scan(); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+func (ur *UserRepo) CheckDonateInfo(ctx context.Context, paymentInfo uuid.UUID) (models.Donate, error) {
+	donateInfo := models.Donate{PaymentInfo: paymentInfo}
+	row := ur.db.QueryRowContext(ctx, CheckDonateInfo, paymentInfo)
+	if err := row.Scan(&donateInfo.UserId, &donateInfo.CreatorID, &donateInfo.Message, &donateInfo.IsAnonymous, &donateInfo.AimId); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		return models.Donate{}, models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) {
+		return models.Donate{}, models.NotFound
+	}
+	return donateInfo, nil
+}
+
+// ClaimPaymentEvent захватывает обработку уведомления о платеже.
+// Возвращает models.PaymentEventClaimed, если обработку нужно выполнить, иначе - текущий статус события
+// (уведомление уже обработано или прямо сейчас обрабатывается другим запросом).
+// Упавшее или зависшее дольше 5 минут событие захватывается повторно; проведённый платёж
+// отмечает событие обработанным в своей транзакции, поэтому повторный захват его второй раз не проведёт.
+func (ur *UserRepo) ClaimPaymentEvent(ctx context.Context, event models.PaymentEvent) (string, error) {
+	var status string
+	row := ur.db.QueryRowContext(ctx, ClaimPaymentEvent, event.OperationID, event.Provider, event.Kind, event.TargetID, event.Money, event.Sender)
+	if err := row.Scan(&status); err == nil {
+		return models.PaymentEventClaimed, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		return "", models.InternalError
+	}
+
+	row = ur.db.QueryRowContext(ctx, PaymentEventStatus, event.OperationID)
+	if err := row.Scan(&status); err != nil {
+		ur.logger.Error(err)
+		return "", models.InternalError
+	}
+	return status, nil
+}
+
+// processPaymentEvent отмечает захваченное уведомление обработанным в транзакции, которая проводит платёж.
+// Если уведомление уже обработано другим запросом, транзакцию нужно откатить - models.WrongData.
+func (ur *UserRepo) processPaymentEvent(ctx context.Context, tx *sql.Tx, operationID string) error {
+	var tmp string
+	row := tx.QueryRowContext(ctx, ProcessPaymentEvent, operationID)
+	if err := row.Scan(&tmp); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		return models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) {
+		return models.WrongData
+	}
+	return nil
+}
+
+// AddUnmatchedPayment сохраняет платёж, который не удалось провести, и в той же транзакции
+// отмечает уведомление обработанным: деньги уже пришли, повторная обработка их не проведёт
+func (ur *UserRepo) AddUnmatchedPayment(ctx context.Context, payment models.UnmatchedPayment) error {
+	tx, err := ur.db.BeginTx(ctx, nil)
+	if err != nil {
+		ur.logger.Error(err)
+		return models.InternalError
+	}
+
+	if _, err = tx.ExecContext(ctx, AddUnmatchedPayment, payment.OperationID, payment.Kind, payment.TargetID, payment.Money,
+		payment.Paid, payment.Price, payment.Reason); err != nil {
+		ur.logger.Error(err)
+		_ = tx.Rollback()
+		return models.InternalError
+	}
+	if err = ur.processPaymentEvent(ctx, tx, payment.OperationID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		ur.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+func (ur *UserRepo) FinishPaymentEvent(ctx context.Context, operationID, status, lastError string) error {
+	if _, err := ur.db.ExecContext(ctx, FinishPaymentEvent, status, lastError, operationID); err != nil {
+		ur.logger.Error(err)
+		return models.InternalError
+	}
+	return nil
+}
+
+func (ur *UserRepo) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var isAdmin bool
+	row := ur.db.QueryRowContext(ctx, IsAdmin, userID)
+	if err := row.Scan(&isAdmin); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		return false, models.InternalError
+	}
+	return isAdmin, nil
+}
+
+// RefundPayment возвращает платёж за подписку или донат целиком или частично, а неактивированный подарок - только целиком.
+// Баланс автора, комиссия и статистика откатываются триггером на таблице refund,
+// подписка сокращается пропорционально сумме возврата, донат списывается с цели автора, код подарка аннулируется.
+// Повторный возврат с тем же operation_id возвращает уже сохранённый возврат.
+// Если transfer не nil, он переводит деньги на счёт плательщика, пока платёж заблокирован, и возвращает
+// идентификатор операции, с которым записывается возврат; без счёта плательщика возврат не проводится - models.WrongData.
+func (ur *UserRepo) RefundPayment(ctx context.Context, refund models.Refund, transfer func(refund models.Refund, account string) (string, error)) (models.Refund, error) {
+	tx, err := ur.db.BeginTx(ctx, nil)
+	if err != nil {
+		ur.logger.Error(err)
+		return models.Refund{}, models.InternalError
+	}
+
+	if len(refund.OperationID) != 0 {
+		var existing models.Refund
+		row := tx.QueryRowContext(ctx, RefundByOperation, refund.OperationID)
+		if err = row.Scan(&existing.Id, &existing.PaymentType, &existing.PaymentId, &existing.CreatorId, &existing.UserId, &existing.Money,
+			&existing.Reason, &existing.Source, &existing.OperationID, &existing.CreatedAt); err == nil {
+			_ = tx.Rollback()
+			return existing, nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			ur.logger.Error(err)
+			_ = tx.Rollback()
+			return models.Refund{}, models.InternalError
+		}
+	}
+
+	var (
+		subscriptionID, aimID uuid.UUID
+		monthCount            int64
+		paid                  models.Money
+		redeemed              bool
+		sender                string
+	)
+	refund.PaymentType = models.PaymentTypeSubscription
+	row := tx.QueryRowContext(ctx, LockSubPayment, refund.PaymentId)
+	if err = row.Scan(&refund.UserId, &subscriptionID, &refund.CreatorId, &monthCount, &paid, &sender); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ur.logger.Error(err)
+		_ = tx.Rollback()
+		return models.Refund{}, models.InternalError
+	} else if errors.Is(err, sql.ErrNoRows) { // не подписка - ищем донат
+		refund.PaymentType = models.PaymentTypeDonation
+		row = tx.QueryRowContext(ctx, LockDonation, refund.PaymentId)
+		if err = row.Scan(&refund.UserId, &refund.CreatorId, &paid, &aimID, &sender); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			ur.logger.Error(err)
+			_ = tx.Rollback()
+			return models.Refund{}, models.InternalError
+		} else if errors.Is(err, sql.ErrNoRows) { // не донат - ищем оплаченный подарок
+			refund.PaymentType = models.PaymentTypeGift
+			row = tx.QueryRowContext(ctx, LockGift, refund.PaymentId)
+			if err = row.Scan(&refund.UserId, &refund.CreatorId, &paid, &redeemed, &sender); err != nil && !errors.Is(err, sql.ErrNoRows) {
+				ur.logger.Error(err)
+				_ = tx.Rollback()
+				return models.Refund{}, models.InternalError
+			} else if errors.Is(err, sql.ErrNoRows) {
+				_ = tx.Rollback()
+				return models.Refund{}, models.NotFound
+			}
+		}
+	}
+	// активированный подарок уже стал подпиской получателя, её покупатель вернуть не может
+	if redeemed {
+		_ = tx.Rollback()
+		return models.Refund{}, models.WrongData
+	}
+
+	var refunded models.Money
+	row = tx.QueryRowContext(ctx, RefundedMoney, refund.PaymentId)
+	if err = row.Scan(&refunded); err != nil {
+		ur.logger.Error(err)
+		_ = tx.Rollback()
+		return models.Refund{}, models.InternalError
+	}
+	// вернуть можно не больше, чем было заплачено, и в той же валюте
+	total, err := refunded.Add(refund.Money)
+	if err != nil {
+		_ = tx.Rollback()
+		return models.Refund{}, models.WrongData
+	}
+	if overpaid, err := paid.Less(total); err != nil || overpaid {
+		_ = tx.Rollback()
+		return models.Refund{}, models.WrongData
+	}
+	if partial, _ := total.Less(paid); partial && refund.PaymentType == models.PaymentTypeGift {
+		_ = tx.Rollback()
+		return models.Refund{}, models.WrongData
+	}
+
+	if transfer != nil {
+		if len(sender) == 0 {
+			_ = tx.Rollback()
+			return models.Refund{}, models.WrongData
+		}
+		if refund.OperationID, err = transfer(refund, sender); err != nil {
+			_ = tx.Rollback()
+			return models.Refund{}, err
+		}
+	}
+
+	row = tx.QueryRowContext(ctx, AddRefund, refund.PaymentType, refund.PaymentId, refund.CreatorId, refund.UserId, refund.Money,
+		refund.Reason, refund.Source, refund.OperationID)
+	if err = row.Scan(&refund.Id, &refund.CreatedAt); err != nil {
+		ur.logger.Error(err)
+		_ = tx.Rollback()
+		return models.Refund{}, models.InternalError
+	}
+
+	if refund.PaymentType == models.PaymentTypeSubscription {
+		months := float64(monthCount) * float64(refund.Money.Amount) / float64(paid.Amount)
+		if _, err = tx.ExecContext(ctx, ShortenSubscription, months, refund.UserId, subscriptionID); err != nil {
+			ur.logger.Error(err)
+			_ = tx.Rollback()
+			return models.Refund{}, models.InternalError
+		}
+	} else if refund.PaymentType == models.PaymentTypeGift {
+		if _, err = tx.ExecContext(ctx, RevokeGift, refund.PaymentId); err != nil {
+			ur.logger.Error(err)
+			_ = tx.Rollback()
+			return models.Refund{}, models.InternalError
+		}
+	} else if aimID != uuid.Nil {
+		if _, err = tx.ExecContext(ctx, RefundAimMoney, refund.Money, aimID); err != nil {
+			ur.logger.Error(err)
+			_ = tx.Rollback()
+			return models.Refund{}, models.InternalError
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		ur.logger.Error(err)
+		return models.Refund{}, models.InternalError
+	}
+	return refund, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"testing"
+	"time"
 )
 
 var userID = uuid.New()
@@ -196,3 +197,807 @@ func TestUserRepo_UpdateProfileInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRepo_Subscribe(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewUserRepo(db, zapSugar)
+	subscription := models.SubscriptionDetails{CreatorId: uuid.New(), Id: uuid.New(), UserID: userID, MonthCount: 3, PaymentInfo: uuid.New()}
+	start := time.Now().AddDate(0, 1, 0)
+	end := start.AddDate(0, 3, 0)
+
+	expectPayment := func() {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE "user_payments" SET money`).
+			WithArgs(models.NewMoney(30000), "1", subscription.PaymentInfo).WillReturnRows(sqlmock.NewRows([]string{"payment_id"}).AddRow(subscription.PaymentInfo))
+		mock.ExpectExec(`INSERT INTO "follow"`).
+			WithArgs(userID, subscription.CreatorId).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	expectExtend := func() {
+		mock.ExpectQuery(`UPDATE "user_subscription" us SET expire_date`).
+			WithArgs(int64(3), userID, subscription.Id).WillReturnRows(sqlmock.NewRows([]string{"period_start", "expire_date"}).AddRow(start, end))
+		mock.ExpectExec(`UPDATE "user_payments" SET period_start`).
+			WithArgs(start, end, subscription.PaymentInfo).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "Extend",
+			mock: func() {
+				expectPayment()
+				expectExtend()
+				mock.ExpectQuery(`UPDATE "payment_event" SET status = 'processed'`).
+					WithArgs("1").WillReturnRows(sqlmock.NewRows([]string{"operation_id"}).AddRow("1"))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "New",
+			mock: func() {
+				expectPayment()
+				mock.ExpectQuery(`UPDATE "user_subscription" us SET expire_date`).
+					WithArgs(int64(3), userID, subscription.Id).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`SELECT title, creator_id FROM subscription`).
+					WithArgs(subscription.Id).WillReturnRows(sqlmock.NewRows([]string{"title", "creator_id"}).AddRow("test", subscription.CreatorId))
+				mock.ExpectQuery(`INSERT INTO "user_subscription"`).
+					WithArgs(userID, subscription.Id, int64(3)).WillReturnRows(sqlmock.NewRows([]string{"now", "expire_date"}).AddRow(start, end))
+				mock.ExpectExec(`UPDATE "user_payments" SET period_start`).
+					WithArgs(start, end, subscription.PaymentInfo).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`UPDATE "payment_event" SET status = 'processed'`).
+					WithArgs("1").WillReturnRows(sqlmock.NewRows([]string{"operation_id"}).AddRow("1"))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Already paid",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "user_payments" SET money`).
+					WithArgs(models.NewMoney(30000), "1", subscription.PaymentInfo).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Event already processed",
+			mock: func() {
+				expectPayment()
+				expectExtend()
+				mock.ExpectQuery(`UPDATE "payment_event" SET status = 'processed'`).
+					WithArgs("1").WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "No subscription",
+			mock: func() {
+				expectPayment()
+				mock.ExpectQuery(`UPDATE "user_subscription" us SET expire_date`).
+					WithArgs(int64(3), userID, subscription.Id).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`SELECT title, creator_id FROM subscription`).
+					WithArgs(subscription.Id).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Period error",
+			mock: func() {
+				expectPayment()
+				mock.ExpectQuery(`UPDATE "user_subscription" us SET expire_date`).
+					WithArgs(int64(3), userID, subscription.Id).WillReturnRows(sqlmock.NewRows([]string{"period_start", "expire_date"}).AddRow(start, end))
+				mock.ExpectExec(`UPDATE "user_payments" SET period_start`).
+					WithArgs(start, end, subscription.PaymentInfo).WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			_, err := r.Subscribe(context.Background(), subscription, models.NewMoney(30000), "1")
+			assert.Equal(t, test.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepo_PayGift(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewUserRepo(db, zapSugar)
+	codeGift := models.Gift{Id: uuid.New(), SubscriptionId: uuid.New(), CreatorId: uuid.New(), MonthCount: 1, Code: "CODE"}
+	directGift := models.Gift{Id: codeGift.Id, SubscriptionId: codeGift.SubscriptionId, CreatorId: codeGift.CreatorId, RecipientId: userID, MonthCount: 1}
+	start := time.Now()
+	end := start.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name        string
+		gift        models.Gift
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "Ok",
+			gift: codeGift,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "gift" SET money`).
+					WithArgs(models.NewMoney(10000), "1", "CODE", codeGift.Id).WillReturnRows(sqlmock.NewRows([]string{"gift_id"}).AddRow(codeGift.Id))
+				mock.ExpectQuery(`UPDATE "payment_event" SET status = 'processed'`).
+					WithArgs("1").WillReturnRows(sqlmock.NewRows([]string{"operation_id"}).AddRow("1"))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Ok recipient",
+			gift: directGift,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "gift" SET money`).
+					WithArgs(models.NewMoney(10000), "1", "", directGift.Id).WillReturnRows(sqlmock.NewRows([]string{"gift_id"}).AddRow(directGift.Id))
+				mock.ExpectExec(`INSERT INTO "follow"`).
+					WithArgs(userID, directGift.CreatorId).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`UPDATE "user_subscription" us SET expire_date`).
+					WithArgs(int64(1), userID, directGift.SubscriptionId).WillReturnRows(sqlmock.NewRows([]string{"period_start", "expire_date"}).AddRow(start, end))
+				mock.ExpectQuery(`UPDATE "payment_event" SET status = 'processed'`).
+					WithArgs("1").WillReturnRows(sqlmock.NewRows([]string{"operation_id"}).AddRow("1"))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Subscription error",
+			gift: directGift,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "gift" SET money`).
+					WithArgs(models.NewMoney(10000), "1", "", directGift.Id).WillReturnRows(sqlmock.NewRows([]string{"gift_id"}).AddRow(directGift.Id))
+				mock.ExpectExec(`INSERT INTO "follow"`).
+					WithArgs(userID, directGift.CreatorId).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`UPDATE "user_subscription" us SET expire_date`).
+					WithArgs(int64(1), userID, directGift.SubscriptionId).WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+		{
+			name: "Event already processed",
+			gift: codeGift,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "gift" SET money`).
+					WithArgs(models.NewMoney(10000), "1", "CODE", codeGift.Id).WillReturnRows(sqlmock.NewRows([]string{"gift_id"}).AddRow(codeGift.Id))
+				mock.ExpectQuery(`UPDATE "payment_event" SET status = 'processed'`).
+					WithArgs("1").WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Already paid",
+			gift: codeGift,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "gift" SET money`).
+					WithArgs(models.NewMoney(10000), "1", "CODE", codeGift.Id).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "InternalError",
+			gift: codeGift,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "gift" SET money`).
+					WithArgs(models.NewMoney(10000), "1", "CODE", codeGift.Id).WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			err := r.PayGift(context.Background(), test.gift, models.NewMoney(10000), "1")
+			assert.Equal(t, test.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepo_RedeemGift(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewUserRepo(db, zapSugar)
+	gift := models.Gift{Id: uuid.New(), SubscriptionId: uuid.New(), SubscriptionName: "test", CreatorId: uuid.New(), BuyerId: uuid.New(),
+		RecipientId: userID, MonthCount: 2, Code: "CODE"}
+	giftRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"gift_id", "subscription_id", "title", "creator_id", "buyer_id", "redeemed_by", "month_count", "code"}).
+			AddRow(gift.Id, gift.SubscriptionId, gift.SubscriptionName, gift.CreatorId, gift.BuyerId, gift.RecipientId, gift.MonthCount, gift.Code)
+	}
+	start := time.Now()
+	end := start.AddDate(0, 2, 0)
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "Ok",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "gift" g SET redeemed_by`).WithArgs(userID, "CODE").WillReturnRows(giftRows())
+				mock.ExpectExec(`INSERT INTO "follow"`).
+					WithArgs(userID, gift.CreatorId).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`UPDATE "user_subscription" us SET expire_date`).
+					WithArgs(int64(2), userID, gift.SubscriptionId).WillReturnRows(sqlmock.NewRows([]string{"period_start", "expire_date"}).AddRow(start, end))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Subscription error",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "gift" g SET redeemed_by`).WithArgs(userID, "CODE").WillReturnRows(giftRows())
+				mock.ExpectExec(`INSERT INTO "follow"`).
+					WithArgs(userID, gift.CreatorId).WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+		{
+			name: "NotFound",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE "gift" g SET redeemed_by`).WithArgs(userID, "CODE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			_, err := r.RedeemGift(context.Background(), userID, "CODE")
+			assert.Equal(t, test.expectedErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepo_ClaimPaymentEvent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewUserRepo(db, zapSugar)
+	event := models.PaymentEvent{Provider: "fake", OperationID: "1", Kind: models.PaymentKindSubscribe, TargetID: uuid.New(), Money: models.NewMoney(10000),
+		Sender: "41001000040"}
+
+	tests := []struct {
+		name           string
+		mock           func()
+		expectedStatus string
+		expectedErr    error
+	}{
+		{
+			name: "Claimed",
+			mock: func() {
+				mock.ExpectQuery(`INSERT INTO "payment_event"`).
+					WithArgs(event.OperationID, event.Provider, event.Kind, event.TargetID, event.Money, event.Sender).
+					WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(models.PaymentEventProcessing))
+			},
+			expectedStatus: models.PaymentEventClaimed,
+		},
+		{
+			name: "Already processed",
+			mock: func() {
+				mock.ExpectQuery(`INSERT INTO "payment_event"`).
+					WithArgs(event.OperationID, event.Provider, event.Kind, event.TargetID, event.Money, event.Sender).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`SELECT status FROM "payment_event"`).
+					WithArgs(event.OperationID).
+					WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(models.PaymentEventProcessed))
+			},
+			expectedStatus: models.PaymentEventProcessed,
+		},
+		{
+			name: "InternalError",
+			mock: func() {
+				mock.ExpectQuery(`INSERT INTO "payment_event"`).
+					WithArgs(event.OperationID, event.Provider, event.Kind, event.TargetID, event.Money, event.Sender).
+					WillReturnError(errors.New("test"))
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			status, err := r.ClaimPaymentEvent(context.Background(), event)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedStatus, status)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepo_FinishPaymentEvent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewUserRepo(db, zapSugar)
+
+	mock.ExpectExec(`UPDATE "payment_event" SET status`).
+		WithArgs(models.PaymentEventProcessed, "", "1").WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, r.FinishPaymentEvent(context.Background(), "1", models.PaymentEventProcessed, ""))
+
+	mock.ExpectExec(`UPDATE "payment_event" SET status`).
+		WithArgs(models.PaymentEventFailed, "test", "1").WillReturnError(errors.New("test"))
+	assert.Equal(t, models.InternalError, r.FinishPaymentEvent(context.Background(), "1", models.PaymentEventFailed, "test"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepo_AddUnmatchedPayment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewUserRepo(db, zapSugar)
+	payment := models.UnmatchedPayment{OperationID: "1", Kind: models.PaymentKindSubscribe, TargetID: uuid.New(),
+		Money: models.NewMoney(9700), Paid: models.NewMoney(10000), Price: models.NewMoney(20000), Reason: models.UnmatchedReasonUnderpaid}
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "OK",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO "unmatched_payment"`).
+					WithArgs(payment.OperationID, payment.Kind, payment.TargetID, payment.Money, payment.Paid, payment.Price, payment.Reason).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(`UPDATE "payment_event" SET status = 'processed'`).
+					WithArgs(payment.OperationID).
+					WillReturnRows(sqlmock.NewRows([]string{"operation_id"}).AddRow(payment.OperationID))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Already processed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO "unmatched_payment"`).
+					WithArgs(payment.OperationID, payment.Kind, payment.TargetID, payment.Money, payment.Paid, payment.Price, payment.Reason).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`UPDATE "payment_event" SET status = 'processed'`).
+					WithArgs(payment.OperationID).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "InternalError",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO "unmatched_payment"`).
+					WithArgs(payment.OperationID, payment.Kind, payment.TargetID, payment.Money, payment.Paid, payment.Price, payment.Reason).
+					WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			assert.Equal(t, test.expectedErr, r.AddUnmatchedPayment(context.Background(), payment))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepo_RefundPayment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewUserRepo(db, zapSugar)
+	paymentID := uuid.New()
+	creatorID := uuid.New()
+	subscriptionID := uuid.New()
+	aimID := uuid.New()
+	refundID := uuid.New()
+	adminID := uuid.New()
+	refund := models.Refund{PaymentId: paymentID, Money: models.NewMoney(5000), Reason: "chargeback", Source: models.RefundSourceProvider, OperationID: "1"}
+	adminRefund := models.Refund{PaymentId: paymentID, Money: models.NewMoney(5000), Reason: "test", Source: models.RefundSourceAdmin, AdminId: adminID}
+
+	tests := []struct {
+		name           string
+		refund         models.Refund
+		transfer       func(refund models.Refund, account string) (string, error)
+		mock           func()
+		expectedRefund models.Refund
+		expectedErr    error
+	}{
+		{
+			name: "Ok subscription",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT refund_id`).WithArgs("1").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "subscription_id", "creator_id", "month_count", "money", "sender"}).
+						AddRow(userID, subscriptionID, creatorID, 2, 10000, ""))
+				mock.ExpectQuery(`SELECT coalesce\(sum\(money\), 0\) FROM "refund"`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+				mock.ExpectQuery(`INSERT INTO "refund"`).
+					WithArgs(models.PaymentTypeSubscription, paymentID, creatorID, userID, models.NewMoney(5000), "chargeback", models.RefundSourceProvider, "1").
+					WillReturnRows(sqlmock.NewRows([]string{"refund_id", "created_at"}).AddRow(refundID, time.Time{}))
+				mock.ExpectExec(`UPDATE "user_subscription" SET expire_date = expire_date -`).WithArgs(1.0, userID, subscriptionID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedRefund: models.Refund{Id: refundID, PaymentId: paymentID, PaymentType: models.PaymentTypeSubscription, CreatorId: creatorID,
+				UserId: userID, Money: models.NewMoney(5000), Reason: "chargeback", Source: models.RefundSourceProvider, OperationID: "1"},
+		},
+		{
+			name: "Ok donation",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT refund_id`).WithArgs("1").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "donation" d`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "creator_id", "money_count", "aim_id", "sender"}).
+						AddRow(uuid.Nil, creatorID, 5000, aimID, ""))
+				mock.ExpectQuery(`SELECT coalesce\(sum\(money\), 0\) FROM "refund"`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+				mock.ExpectQuery(`INSERT INTO "refund"`).
+					WithArgs(models.PaymentTypeDonation, paymentID, creatorID, uuid.Nil, models.NewMoney(5000), "chargeback", models.RefundSourceProvider, "1").
+					WillReturnRows(sqlmock.NewRows([]string{"refund_id", "created_at"}).AddRow(refundID, time.Time{}))
+				mock.ExpectExec(`UPDATE "aim" SET money_got`).WithArgs(models.NewMoney(5000), aimID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedRefund: models.Refund{Id: refundID, PaymentId: paymentID, PaymentType: models.PaymentTypeDonation, CreatorId: creatorID,
+				Money: models.NewMoney(5000), Reason: "chargeback", Source: models.RefundSourceProvider, OperationID: "1"},
+		},
+		{
+			name: "Already refunded",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT refund_id`).WithArgs("1").
+					WillReturnRows(sqlmock.NewRows([]string{"refund_id", "payment_type", "payment_id", "creator_id", "user_id", "money", "reason", "source", "operation_id", "created_at"}).
+						AddRow(refundID, models.PaymentTypeDonation, paymentID, creatorID, uuid.Nil, 5000, "chargeback", models.RefundSourceProvider, "1", time.Time{}))
+				mock.ExpectRollback()
+			},
+			expectedRefund: models.Refund{Id: refundID, PaymentId: paymentID, PaymentType: models.PaymentTypeDonation, CreatorId: creatorID,
+				Money: models.NewMoney(5000), Reason: "chargeback", Source: models.RefundSourceProvider, OperationID: "1"},
+		},
+		{
+			name: "Exceeds payment",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT refund_id`).WithArgs("1").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "subscription_id", "creator_id", "month_count", "money", "sender"}).
+						AddRow(userID, subscriptionID, creatorID, 1, 10000, ""))
+				mock.ExpectQuery(`SELECT coalesce\(sum\(money\), 0\) FROM "refund"`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(6000))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Not found",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT refund_id`).WithArgs("1").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "donation" d`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "gift" g`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: models.NotFound,
+		},
+		{
+			name: "Ok gift",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT refund_id`).WithArgs("1").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "donation" d`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "gift" g`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"buyer_id", "creator_id", "money", "redeemed", "sender"}).
+						AddRow(userID, creatorID, 5000, false, ""))
+				mock.ExpectQuery(`SELECT coalesce\(sum\(money\), 0\) FROM "refund"`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+				mock.ExpectQuery(`INSERT INTO "refund"`).
+					WithArgs(models.PaymentTypeGift, paymentID, creatorID, userID, models.NewMoney(5000), "chargeback", models.RefundSourceProvider, "1").
+					WillReturnRows(sqlmock.NewRows([]string{"refund_id", "created_at"}).AddRow(refundID, time.Time{}))
+				mock.ExpectExec(`UPDATE "gift" SET code = NULL`).WithArgs(paymentID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedRefund: models.Refund{Id: refundID, PaymentId: paymentID, PaymentType: models.PaymentTypeGift, CreatorId: creatorID,
+				UserId: userID, Money: models.NewMoney(5000), Reason: "chargeback", Source: models.RefundSourceProvider, OperationID: "1"},
+		},
+		{
+			name: "Redeemed gift",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT refund_id`).WithArgs("1").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "donation" d`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "gift" g`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"buyer_id", "creator_id", "money", "redeemed", "sender"}).
+						AddRow(userID, creatorID, 5000, true, ""))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name: "Partial gift",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT refund_id`).WithArgs("1").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "donation" d`).WithArgs(paymentID).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM "gift" g`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"buyer_id", "creator_id", "money", "redeemed", "sender"}).
+						AddRow(userID, creatorID, 10000, false, ""))
+				mock.ExpectQuery(`SELECT coalesce\(sum\(money\), 0\) FROM "refund"`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name:   "Ok admin transfer",
+			refund: adminRefund,
+			transfer: func(refund models.Refund, account string) (string, error) {
+				assert.Equal(t, "41001000040", account)
+				assert.Equal(t, models.PaymentTypeSubscription, refund.PaymentType)
+				return "2", nil
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "subscription_id", "creator_id", "month_count", "money", "sender"}).
+						AddRow(userID, subscriptionID, creatorID, 2, 10000, "41001000040"))
+				mock.ExpectQuery(`SELECT coalesce\(sum\(money\), 0\) FROM "refund"`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+				mock.ExpectQuery(`INSERT INTO "refund"`).
+					WithArgs(models.PaymentTypeSubscription, paymentID, creatorID, userID, models.NewMoney(5000), "test", models.RefundSourceAdmin, "2").
+					WillReturnRows(sqlmock.NewRows([]string{"refund_id", "created_at"}).AddRow(refundID, time.Time{}))
+				mock.ExpectExec(`UPDATE "user_subscription" SET expire_date = expire_date -`).WithArgs(1.0, userID, subscriptionID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedRefund: models.Refund{Id: refundID, PaymentId: paymentID, PaymentType: models.PaymentTypeSubscription, CreatorId: creatorID,
+				UserId: userID, Money: models.NewMoney(5000), Reason: "test", Source: models.RefundSourceAdmin, OperationID: "2", AdminId: adminID},
+		},
+		{
+			name:   "Admin transfer without payer account",
+			refund: adminRefund,
+			transfer: func(refund models.Refund, account string) (string, error) {
+				t.Error("transfer without account")
+				return "", nil
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "subscription_id", "creator_id", "month_count", "money", "sender"}).
+						AddRow(userID, subscriptionID, creatorID, 2, 10000, ""))
+				mock.ExpectQuery(`SELECT coalesce\(sum\(money\), 0\) FROM "refund"`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.WrongData,
+		},
+		{
+			name:   "Admin transfer failed",
+			refund: adminRefund,
+			transfer: func(refund models.Refund, account string) (string, error) {
+				return "", models.InternalError
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`FROM "user_payments" up`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "subscription_id", "creator_id", "month_count", "money", "sender"}).
+						AddRow(userID, subscriptionID, creatorID, 2, 10000, "41001000040"))
+				mock.ExpectQuery(`SELECT coalesce\(sum\(money\), 0\) FROM "refund"`).WithArgs(paymentID).
+					WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+		{
+			name: "InternalError",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT refund_id`).WithArgs("1").WillReturnError(errors.New("test"))
+				mock.ExpectRollback()
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.refund.PaymentId == uuid.Nil {
+				test.refund = refund
+			}
+			test.mock()
+			result, err := r.RefundPayment(context.Background(), test.refund, test.transfer)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedRefund, result)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepo_SubscriptionBilling(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	logger := zap.NewNop()
+	defer func(logger *zap.Logger) {
+		err = logger.Sync()
+		if err != nil {
+			return
+		}
+	}(logger)
+	zapSugar := logger.Sugar()
+	r := NewUserRepo(db, zapSugar)
+	subscriptionID := uuid.New()
+
+	tests := []struct {
+		name        string
+		mock        func()
+		expectedRes models.Subscription
+		expectedErr error
+	}{
+		{
+			name: "Ok",
+			mock: func() {
+				mock.ExpectQuery(`FROM "subscription" s left join subscription_billing_option`).WithArgs(subscriptionID).
+					WillReturnRows(sqlmock.NewRows([]string{"month_cost", "month_count", "price", "discount_percent"}).
+						AddRow(int64(30000), 3, 0, 10).AddRow(int64(30000), 12, 250000, 0))
+			},
+			expectedRes: models.Subscription{Id: subscriptionID, MonthCost: models.NewMoney(30000), BillingOptions: []models.BillingOption{
+				{MonthCount: 3, Price: models.NewMoney(0), DiscountPercent: 10},
+				{MonthCount: 12, Price: models.NewMoney(250000)},
+			}},
+		},
+		{
+			name: "Ok without options",
+			mock: func() {
+				mock.ExpectQuery(`FROM "subscription" s left join subscription_billing_option`).WithArgs(subscriptionID).
+					WillReturnRows(sqlmock.NewRows([]string{"month_cost", "month_count", "price", "discount_percent"}).AddRow(int64(30000), 0, 0, 0))
+			},
+			expectedRes: models.Subscription{Id: subscriptionID, MonthCost: models.NewMoney(30000)},
+		},
+		{
+			name: "Not found",
+			mock: func() {
+				mock.ExpectQuery(`FROM "subscription" s left join subscription_billing_option`).WithArgs(subscriptionID).
+					WillReturnRows(sqlmock.NewRows([]string{"month_cost", "month_count", "price", "discount_percent"}))
+			},
+			expectedErr: models.NotFound,
+		},
+		{
+			name: "InternalError",
+			mock: func() {
+				mock.ExpectQuery(`FROM "subscription" s left join subscription_billing_option`).WithArgs(subscriptionID).
+					WillReturnError(errors.New("test"))
+			},
+			expectedErr: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.mock()
+			result, err := r.SubscriptionBilling(context.Background(), subscriptionID)
+			assert.Equal(t, test.expectedErr, err)
+			assert.Equal(t, test.expectedRes, result)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,21 +2,30 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"os"
+	"strings"
+	"time"
+	"unicode"
 )
 
 type UserUsecase struct {
-	repo   user.UserRepo
-	logger *zap.SugaredLogger
+	repo           user.UserRepo
+	refundProvider payout.RefundProvider
+	logger         *zap.SugaredLogger
 }
 
-func NewUserUsecase(repo user.UserRepo, logger *zap.SugaredLogger) *UserUsecase {
+func NewUserUsecase(repo user.UserRepo, refundProvider payout.RefundProvider, logger *zap.SugaredLogger) *UserUsecase {
 	return &UserUsecase{
-		repo:   repo,
-		logger: logger,
+		repo:           repo,
+		refundProvider: refundProvider,
+		logger:         logger,
 	}
 }
 
@@ -42,7 +51,9 @@ func (uc *UserUsecase) Unfollow(ctx context.Context, userId, creatorId uuid.UUID
 	return uc.repo.Unfollow(ctx, userId, creatorId)
 }
 
-func (uc *UserUsecase) Subscribe(ctx context.Context, paymentInfo uuid.UUID, money float32) (models.NotificationSubInfo, error) {
+// Subscribe проводит оплату подписки: money зачислено платформе, paid списано с плательщика.
+// Если paid меньше цены, платёж сохраняется для возврата или сверки - models.Underpaid.
+func (uc *UserUsecase) Subscribe(ctx context.Context, paymentInfo uuid.UUID, money, paid models.Money, operationID string) (models.NotificationSubInfo, error) {
 	subscription, err := uc.repo.CheckPaymentInfo(ctx, paymentInfo)
 	if err != nil {
 		return models.NotificationSubInfo{}, err
@@ -51,25 +62,60 @@ func (uc *UserUsecase) Subscribe(ctx context.Context, paymentInfo uuid.UUID, mon
 	if err != nil {
 		return models.NotificationSubInfo{}, err
 	}
-	err = uc.repo.UpdatePaymentInfo(ctx, money, paymentInfo)
+	price, err := uc.subscriptionPrice(ctx, subscription)
 	if err != nil {
 		return models.NotificationSubInfo{}, err
 	}
-	if isFollowing, err := uc.repo.CheckIfFollow(ctx, subscription.UserID, subscription.CreatorId); err == models.InternalError {
+	// платёж меньше цены выбранного периода или в другой валюте не активирует подписку
+	if err = uc.checkPaid(ctx, models.UnmatchedPayment{OperationID: operationID, Kind: models.PaymentKindSubscribe,
+		TargetID: paymentInfo, Money: money, Paid: paid, Price: price}); err != nil {
 		return models.NotificationSubInfo{}, err
-	} else if !isFollowing {
-		err = uc.repo.Follow(ctx, subscription.UserID, subscription.CreatorId)
-		if err == models.InternalError {
-			return models.NotificationSubInfo{}, err
-		}
 	}
-	return uc.repo.Subscribe(ctx, subscription)
+	return uc.repo.Subscribe(ctx, subscription, money, operationID)
+}
+
+// checkPaid проверяет, что плательщик заплатил не меньше цены. Иначе платёж сохраняется
+// как неразобранный, чтобы его можно было вернуть, и возвращается models.Underpaid
+func (uc *UserUsecase) checkPaid(ctx context.Context, payment models.UnmatchedPayment) error {
+	underpaid, err := payment.Paid.Less(payment.Price)
+	if err == nil && !underpaid {
+		return nil
+	}
+	payment.Reason = models.UnmatchedReasonUnderpaid
+	if err != nil {
+		payment.Reason = models.UnmatchedReasonCurrency
+	}
+	if err = uc.repo.AddUnmatchedPayment(ctx, payment); err != nil {
+		return err
+	}
+	uc.logger.Errorf("payment %s for %s %s is unmatched: paid %s, price %s", payment.OperationID, payment.Kind,
+		payment.TargetID, payment.Paid, payment.Price)
+	return models.Underpaid
 }
 
 func (uc *UserUsecase) AddPaymentInfo(ctx context.Context, subscription models.SubscriptionDetails) error {
+	if _, err := uc.subscriptionPrice(ctx, subscription); err != nil {
+		return err
+	}
 	return uc.repo.AddPaymentInfo(ctx, subscription)
 }
 
+// subscriptionPrice возвращает цену оплаты уровня на выбранное число месяцев;
+// если у уровня нет такого варианта оплаты - models.WrongData
+func (uc *UserUsecase) subscriptionPrice(ctx context.Context, subscription models.SubscriptionDetails) (models.Money, error) {
+	tier, err := uc.repo.SubscriptionBilling(ctx, subscription.Id)
+	if err == models.NotFound {
+		return models.Money{}, models.WrongData
+	} else if err != nil {
+		return models.Money{}, err
+	}
+	price, ok := tier.Price(subscription.MonthCount)
+	if !ok {
+		return models.Money{}, models.WrongData
+	}
+	return price, nil
+}
+
 func (uc *UserUsecase) GetProfile(ctx context.Context, userId uuid.UUID) (models.UserProfile, error) {
 	return uc.repo.GetUserProfile(ctx, userId)
 }
@@ -91,10 +137,75 @@ func (uc *UserUsecase) UpdateProfileInfo(ctx context.Context, profileInfo models
 	return uc.repo.UpdateProfileInfo(ctx, profileInfo, id)
 }
 
-func (uc *UserUsecase) Donate(ctx context.Context, donateInfo models.Donate) (float32, error) {
+func (uc *UserUsecase) Donate(ctx context.Context, donateInfo models.Donate) (models.Aim, error) {
+	if donateInfo.PaymentInfo != uuid.Nil {
+		info, err := uc.repo.CheckDonateInfo(ctx, donateInfo.PaymentInfo)
+		if err == models.NotFound {
+			return models.Aim{}, models.WrongData
+		} else if err != nil {
+			return models.Aim{}, err
+		}
+		if info.CreatorID != donateInfo.CreatorID {
+			return models.Aim{}, models.WrongData
+		}
+		donateInfo.UserId = info.UserId
+		donateInfo.Message = info.Message
+		donateInfo.IsAnonymous = info.IsAnonymous
+		donateInfo.AimId = info.AimId
+	}
 	return uc.repo.Donate(ctx, donateInfo)
 }
 
+func (uc *UserUsecase) AddDonateInfo(ctx context.Context, donateInfo models.Donate) error {
+	donateInfo.Message = strings.TrimSpace(donateInfo.Message)
+	if !donateInfo.IsValid() {
+		return models.WrongData
+	}
+	donateInfo.Message = moderateMessage(donateInfo.Message)
+	return uc.repo.AddDonateInfo(ctx, donateInfo)
+}
+
+// moderateMessage скрывает слова из DONATION_BANNED_WORDS (через запятую) в сообщении к донату.
+// Слова сравниваются без учёта регистра по рунам и только целиком: "ад" не скрывается в "адрес".
+func moderateMessage(message string) string {
+	words, flag := os.LookupEnv("DONATION_BANNED_WORDS")
+	if !flag || len(message) == 0 {
+		return message
+	}
+	runes := []rune(message)
+	// руны приводятся к нижнему регистру по одной, чтобы позиции совпадали с исходным текстом
+	lower := lowerRunes(message)
+	for _, word := range strings.Split(words, ",") {
+		banned := lowerRunes(strings.TrimSpace(word))
+		if len(banned) == 0 {
+			continue
+		}
+		for i := 0; i+len(banned) <= len(lower); i++ {
+			end := i + len(banned)
+			if string(lower[i:end]) != string(banned) || (i > 0 && isWordRune(lower[i-1])) || (end < len(lower) && isWordRune(lower[end])) {
+				continue
+			}
+			for j := i; j < end; j++ {
+				runes[j] = '*'
+			}
+			i = end - 1
+		}
+	}
+	return string(runes)
+}
+
+func lowerRunes(text string) []rune {
+	runes := []rune(text)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
 func (uc *UserUsecase) BecomeCreator(ctx context.Context, creatorInfo models.BecameCreatorInfo, userId uuid.UUID) (uuid.UUID, error) {
 	return uc.repo.BecomeCreator(ctx, creatorInfo, userId)
 }
@@ -110,3 +221,196 @@ func (uc *UserUsecase) DeletePhoto(ctx context.Context, userId uuid.UUID) error
 func (uc *UserUsecase) UserFollows(ctx context.Context, userId uuid.UUID) ([]models.Follow, error) {
 	return uc.repo.UserFollows(ctx, userId)
 }
+
+// RemindExpiring returns subscriptions that expire within daysBefore days and
+// haven't been reminded about within this window yet. They are marked as reminded
+// by MarkReminded once the reminder is sent.
+func (uc *UserUsecase) RemindExpiring(ctx context.Context, daysBefore int64) ([]models.ExpiringSubscription, error) {
+	if daysBefore <= 0 {
+		return nil, models.WrongData
+	}
+	return uc.repo.ExpiringSubscriptions(ctx, daysBefore)
+}
+
+func (uc *UserUsecase) MarkReminded(ctx context.Context, sub models.ExpiringSubscription, daysBefore int64) error {
+	if daysBefore <= 0 {
+		return models.WrongData
+	}
+	return uc.repo.MarkReminded(ctx, sub, daysBefore)
+}
+
+// ExpireSubscriptions returns expired subscriptions whose subscriber or creator hasn't been notified yet,
+// each recipient is marked by MarkExpiryNotified once their notification is sent.
+func (uc *UserUsecase) ExpireSubscriptions(ctx context.Context) ([]models.ExpiringSubscription, error) {
+	return uc.repo.ExpireSubscriptions(ctx)
+}
+
+func (uc *UserUsecase) MarkExpiryNotified(ctx context.Context, sub models.ExpiringSubscription) error {
+	return uc.repo.MarkExpiryNotified(ctx, sub)
+}
+
+func (uc *UserUsecase) UserPayments(ctx context.Context, filter models.PaymentsFilter) ([]models.Payment, error) {
+	if !filter.IsValid() {
+		return nil, models.WrongData
+	}
+	if filter.Limit == 0 {
+		filter.Limit = models.DefaultPaymentsLimit
+	}
+	if filter.To.IsZero() {
+		filter.To = time.Now()
+	}
+	return uc.repo.UserPayments(ctx, filter)
+}
+
+func (uc *UserUsecase) GetPayment(ctx context.Context, userID, paymentID uuid.UUID) (models.Payment, error) {
+	return uc.repo.GetPayment(ctx, userID, paymentID)
+}
+
+const (
+	giftCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	giftCodeLength   = 12
+)
+
+func generateGiftCode() (string, error) {
+	buf := make([]byte, giftCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i := range buf {
+		buf[i] = giftCodeAlphabet[int(buf[i])%len(giftCodeAlphabet)]
+	}
+	return string(buf), nil
+}
+
+func (uc *UserUsecase) AddGiftInfo(ctx context.Context, gift models.Gift) error {
+	if !gift.IsValid() {
+		return models.WrongData
+	}
+	creatorID, err := uc.repo.GetCreatorID(ctx, gift.SubscriptionId)
+	if err != nil {
+		return err
+	}
+	if creatorID == uuid.Nil {
+		return models.WrongData
+	}
+	if _, err = uc.subscriptionPrice(ctx, models.SubscriptionDetails{Id: gift.SubscriptionId, MonthCount: gift.MonthCount}); err != nil {
+		return err
+	}
+	return uc.repo.AddGift(ctx, gift)
+}
+
+// PayGift отмечает подарок оплаченным: если получатель указан, подписка сразу оформляется на него,
+// иначе генерируется код для активации. Недоплата сохраняется для возврата - models.Underpaid.
+func (uc *UserUsecase) PayGift(ctx context.Context, giftID uuid.UUID, money, paid models.Money, operationID string) (models.Gift, error) {
+	gift, err := uc.repo.GetGift(ctx, giftID)
+	if err != nil {
+		return models.Gift{}, err
+	}
+	if gift.IsPaid {
+		return models.Gift{}, models.WrongData
+	}
+	price, err := uc.subscriptionPrice(ctx, models.SubscriptionDetails{Id: gift.SubscriptionId, MonthCount: gift.MonthCount})
+	if err != nil {
+		return models.Gift{}, err
+	}
+	// недоплаченный подарок или оплаченный в другой валюте не оплачивается
+	if err = uc.checkPaid(ctx, models.UnmatchedPayment{OperationID: operationID, Kind: models.PaymentKindGift,
+		TargetID: giftID, Money: money, Paid: paid, Price: price}); err != nil {
+		return models.Gift{}, err
+	}
+
+	if gift.RecipientId == uuid.Nil {
+		if gift.Code, err = generateGiftCode(); err != nil {
+			uc.logger.Error(err)
+			return models.Gift{}, models.InternalError
+		}
+	}
+	if err = uc.repo.PayGift(ctx, gift, money, operationID); err != nil {
+		return models.Gift{}, err
+	}
+	gift.IsPaid = true
+	gift.IsRedeemed = gift.RecipientId != uuid.Nil
+	return gift, nil
+}
+
+func (uc *UserUsecase) RedeemGift(ctx context.Context, userID uuid.UUID, code string) (models.Gift, error) {
+	if len(code) != giftCodeLength {
+		return models.Gift{}, models.WrongData
+	}
+	return uc.repo.RedeemGift(ctx, userID, code)
+}
+
+func (uc *UserUsecase) GetGift(ctx context.Context, userID, giftID uuid.UUID) (models.Gift, error) {
+	gift, err := uc.repo.GetGift(ctx, giftID)
+	if err != nil {
+		return models.Gift{}, err
+	}
+	if gift.BuyerId != userID && gift.RecipientId != userID {
+		return models.Gift{}, models.NotFound
+	}
+	if gift.BuyerId != userID { // код видит только покупатель
+		gift.Code = ""
+	}
+	return gift, nil
+}
+
+func (uc *UserUsecase) ClaimPaymentEvent(ctx context.Context, event models.PaymentEvent) (string, error) {
+	if !event.IsValid() {
+		return "", models.WrongData
+	}
+	return uc.repo.ClaimPaymentEvent(ctx, event)
+}
+
+// FinishPaymentEvent завершает обработку уведомления: без ошибки событие считается обработанным,
+// с ошибкой - упавшим, и повторное уведомление от платёжной системы будет обработано заново.
+// Оплату подписки, доната и подарка репозиторий отмечает обработанной в той же транзакции,
+// поэтому сбой здесь после проведённого платежа не приведёт к его повтору.
+func (uc *UserUsecase) FinishPaymentEvent(ctx context.Context, operationID, lastError string) error {
+	if len(operationID) == 0 {
+		return models.WrongData
+	}
+	status := models.PaymentEventProcessed
+	if len(lastError) != 0 {
+		status = models.PaymentEventFailed
+	}
+	return uc.repo.FinishPaymentEvent(ctx, operationID, status, lastError)
+}
+
+// RefundPayment возвращает платёж за подписку, донат или неактивированный подарок по решению администратора
+// или записывает возврат, найденный сверкой в истории операций платёжной системы.
+// Возврат от имени администратора проходит, только если AdminId - действительно администратор,
+// и сам переводит деньги плательщику; если перевод ушёл, а возврат не записался, его запишет сверка.
+func (uc *UserUsecase) RefundPayment(ctx context.Context, refund models.Refund) (models.Refund, error) {
+	if !refund.IsValid() {
+		return models.Refund{}, models.WrongData
+	}
+	if refund.Source == models.RefundSourceAdmin {
+		isAdmin, err := uc.repo.IsAdmin(ctx, refund.AdminId)
+		if err != nil {
+			return models.Refund{}, err
+		}
+		if !isAdmin {
+			return models.Refund{}, models.Forbbiden
+		}
+		// операцию возврату от администратора назначает перевод
+		refund.OperationID = ""
+		return uc.repo.RefundPayment(ctx, refund, func(refund models.Refund, account string) (string, error) {
+			return uc.transferRefund(ctx, refund, account)
+		})
+	}
+	return uc.repo.RefundPayment(ctx, refund, nil)
+}
+
+// transferRefund переводит возврат плательщику: отказ платёжной системы - models.WrongData,
+// незавершённый перевод и другие ошибки - models.InternalError
+func (uc *UserUsecase) transferRefund(ctx context.Context, refund models.Refund, account string) (string, error) {
+	operationID, err := uc.refundProvider.Refund(ctx, refund, account)
+	if err == nil {
+		return operationID, nil
+	}
+	uc.logger.Errorf("refund of payment %s: %v", refund.PaymentId, err)
+	if errors.Is(err, models.PayoutRejected) {
+		return "", models.WrongData
+	}
+	return "", models.InternalError
+}
@@ -2,14 +2,17 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/go-park-mail-ru/2023_1_4from5/internal/models"
+	"github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/payout/fake"
 	mock "github.com/go-park-mail-ru/2023_1_4from5/internal/pkg/user/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -62,7 +65,7 @@ func TestNewUserUsecase(t *testing.T) {
 		}
 	}(logger)
 	zapSugar := logger.Sugar()
-	testusecase := NewUserUsecase(mockUserRepo, zapSugar)
+	testusecase := NewUserUsecase(mockUserRepo, fake.NewProvider(), zapSugar)
 	if testusecase.repo != mockUserRepo {
 		t.Error("bad constructor")
 	}
@@ -272,7 +275,7 @@ func TestUserUsecase_Donate(t *testing.T) {
 		{
 			name: "OK",
 			mock: func() {
-				mockUserRepo.EXPECT().Donate(gomock.Any(), gomock.Any()).Return(float32(10.0), nil)
+				mockUserRepo.EXPECT().Donate(gomock.Any(), gomock.Any()).Return(models.Aim{MoneyGot: models.NewMoney(1000)}, nil)
 			},
 			expectedStatusCode: nil,
 		},
@@ -386,3 +389,599 @@ func TestUserUsecase_UserFollows(t *testing.T) {
 		})
 	}
 }
+
+func TestUserUsecase_RemindExpiring(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockUserRepo := mock.NewMockUserRepo(ctl)
+	sub := models.ExpiringSubscription{UserID: uuid.New(), SubscriptionID: uuid.New()}
+
+	tests := []struct {
+		name               string
+		daysBefore         int64
+		mock               func()
+		expectedStatusCode error
+	}{
+		{
+			name:       "OK",
+			daysBefore: 3,
+			mock: func() {
+				mockUserRepo.EXPECT().ExpiringSubscriptions(gomock.Any(), int64(3)).Return([]models.ExpiringSubscription{sub}, nil)
+			},
+			expectedStatusCode: nil,
+		},
+		{
+			name:               "WrongData",
+			daysBefore:         0,
+			mock:               func() {},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:       "InternalError",
+			daysBefore: 3,
+			mock: func() {
+				mockUserRepo.EXPECT().ExpiringSubscriptions(gomock.Any(), int64(3)).Return(nil, models.InternalError)
+			},
+			expectedStatusCode: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserUsecase{
+				repo: mockUserRepo,
+			}
+			test.mock()
+			_, err := h.RemindExpiring(context.Background(), test.daysBefore)
+			require.Equal(t, test.expectedStatusCode, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedStatusCode, err))
+		})
+	}
+}
+
+func TestUserUsecase_UserPayments(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockUserRepo := mock.NewMockUserRepo(ctl)
+
+	tests := []struct {
+		name               string
+		filter             models.PaymentsFilter
+		mock               func()
+		expectedStatusCode error
+	}{
+		{
+			name:   "OK",
+			filter: models.PaymentsFilter{UserId: testUser.Id, Type: models.PaymentTypeDonation},
+			mock: func() {
+				mockUserRepo.EXPECT().UserPayments(gomock.Any(), gomock.Any()).Return([]models.Payment{}, nil)
+			},
+			expectedStatusCode: nil,
+		},
+		{
+			name:               "WrongType",
+			filter:             models.PaymentsFilter{UserId: testUser.Id, Type: "test"},
+			mock:               func() {},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:               "WrongLimit",
+			filter:             models.PaymentsFilter{UserId: testUser.Id, Limit: models.MaxPaymentsLimit + 1},
+			mock:               func() {},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:   "InternalError",
+			filter: models.PaymentsFilter{UserId: testUser.Id},
+			mock: func() {
+				mockUserRepo.EXPECT().UserPayments(gomock.Any(), gomock.Any()).Return(nil, models.InternalError)
+			},
+			expectedStatusCode: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserUsecase{
+				repo: mockUserRepo,
+			}
+			test.mock()
+			_, err := h.UserPayments(context.Background(), test.filter)
+			require.Equal(t, test.expectedStatusCode, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedStatusCode, err))
+		})
+	}
+}
+
+func TestUserUsecase_PayGift(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockUserRepo := mock.NewMockUserRepo(ctl)
+	giftID := uuid.New()
+	codeGift := models.Gift{Id: giftID, SubscriptionId: uuid.New(), CreatorId: uuid.New(), BuyerId: uuid.New(), MonthCount: 1}
+	directGift := codeGift
+	directGift.RecipientId = uuid.New()
+	paidGift := codeGift
+	paidGift.IsPaid = true
+	tier := models.Subscription{Id: codeGift.SubscriptionId, MonthCost: models.NewMoney(30000)}
+
+	tests := []struct {
+		name               string
+		money              models.Money
+		paid               models.Money
+		mock               func()
+		expectedStatusCode error
+	}{
+		{
+			name:  "OK code",
+			money: models.NewMoney(30000),
+			paid:  models.NewMoney(30000),
+			mock: func() {
+				mockUserRepo.EXPECT().GetGift(gomock.Any(), giftID).Return(codeGift, nil)
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), codeGift.SubscriptionId).Return(tier, nil)
+				mockUserRepo.EXPECT().PayGift(gomock.Any(), gomock.Any(), models.NewMoney(30000), "1").
+					DoAndReturn(func(ctx context.Context, gift models.Gift, money models.Money, operationID string) error {
+						require.Len(t, gift.Code, giftCodeLength)
+						return nil
+					})
+			},
+			expectedStatusCode: nil,
+		},
+		{
+			name:  "OK recipient",
+			money: models.NewMoney(30000),
+			paid:  models.NewMoney(30000),
+			mock: func() {
+				mockUserRepo.EXPECT().GetGift(gomock.Any(), giftID).Return(directGift, nil)
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), codeGift.SubscriptionId).Return(tier, nil)
+				mockUserRepo.EXPECT().PayGift(gomock.Any(), directGift, models.NewMoney(30000), "1").Return(nil)
+			},
+			expectedStatusCode: nil,
+		},
+		{
+			name:  "OK provider fee",
+			money: models.NewMoney(29100),
+			paid:  models.NewMoney(30000),
+			mock: func() {
+				mockUserRepo.EXPECT().GetGift(gomock.Any(), giftID).Return(directGift, nil)
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), codeGift.SubscriptionId).Return(tier, nil)
+				mockUserRepo.EXPECT().PayGift(gomock.Any(), directGift, models.NewMoney(29100), "1").Return(nil)
+			},
+			expectedStatusCode: nil,
+		},
+		{
+			name:  "Underpaid",
+			money: models.NewMoney(9700),
+			paid:  models.NewMoney(10000),
+			mock: func() {
+				mockUserRepo.EXPECT().GetGift(gomock.Any(), giftID).Return(codeGift, nil)
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), codeGift.SubscriptionId).Return(tier, nil)
+				mockUserRepo.EXPECT().AddUnmatchedPayment(gomock.Any(), models.UnmatchedPayment{OperationID: "1",
+					Kind: models.PaymentKindGift, TargetID: giftID, Money: models.NewMoney(9700), Paid: models.NewMoney(10000),
+					Price: models.NewMoney(30000), Reason: models.UnmatchedReasonUnderpaid}).Return(nil)
+			},
+			expectedStatusCode: models.Underpaid,
+		},
+		{
+			name:  "Already paid",
+			money: models.NewMoney(30000),
+			paid:  models.NewMoney(30000),
+			mock: func() {
+				mockUserRepo.EXPECT().GetGift(gomock.Any(), giftID).Return(paidGift, nil)
+			},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:  "NotFound",
+			money: models.NewMoney(30000),
+			paid:  models.NewMoney(30000),
+			mock: func() {
+				mockUserRepo.EXPECT().GetGift(gomock.Any(), giftID).Return(models.Gift{}, models.NotFound)
+			},
+			expectedStatusCode: models.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserUsecase{
+				repo:   mockUserRepo,
+				logger: zap.NewNop().Sugar(),
+			}
+			test.mock()
+			_, err := h.PayGift(context.Background(), giftID, test.money, test.paid, "1")
+			require.Equal(t, test.expectedStatusCode, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedStatusCode, err))
+		})
+	}
+}
+
+func TestUserUsecase_AddDonateInfo(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockUserRepo := mock.NewMockUserRepo(ctl)
+	os.Setenv("DONATION_BANNED_WORDS", "плохое,bad")
+	defer os.Unsetenv("DONATION_BANNED_WORDS")
+
+	tests := []struct {
+		name               string
+		donate             models.Donate
+		mock               func()
+		expectedStatusCode error
+	}{
+		{
+			name:   "OK",
+			donate: models.Donate{Message: "  Очень Плохое видео, BAD!  "},
+			mock: func() {
+				mockUserRepo.EXPECT().AddDonateInfo(gomock.Any(), models.Donate{Message: "Очень ****** видео, ***!"}).Return(nil)
+			},
+			expectedStatusCode: nil,
+		},
+		{
+			name:   "Only whole words",
+			donate: models.Donate{Message: "Неплохое badminton, bad-плохое"},
+			mock: func() {
+				mockUserRepo.EXPECT().AddDonateInfo(gomock.Any(), models.Donate{Message: "Неплохое badminton, ***-******"}).Return(nil)
+			},
+			expectedStatusCode: nil,
+		},
+		{
+			name:               "Too long message",
+			donate:             models.Donate{Message: strings.Repeat("a", models.DonateMessageMaxLength+1)},
+			mock:               func() {},
+			expectedStatusCode: models.WrongData,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserUsecase{
+				repo: mockUserRepo,
+			}
+			test.mock()
+			err := h.AddDonateInfo(context.Background(), test.donate)
+			require.Equal(t, test.expectedStatusCode, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedStatusCode, err))
+		})
+	}
+}
+
+func TestUserUsecase_ClaimPaymentEvent(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockUserRepo := mock.NewMockUserRepo(ctl)
+	event := models.PaymentEvent{OperationID: "1", Kind: models.PaymentKindDonate, TargetID: uuid.New(), Money: models.NewMoney(10000), Paid: models.NewMoney(10000)}
+
+	tests := []struct {
+		name               string
+		event              models.PaymentEvent
+		mock               func()
+		expectedStatus     string
+		expectedStatusCode error
+	}{
+		{
+			name:  "OK",
+			event: event,
+			mock: func() {
+				mockUserRepo.EXPECT().ClaimPaymentEvent(gomock.Any(), event).Return(models.PaymentEventClaimed, nil)
+			},
+			expectedStatus: models.PaymentEventClaimed,
+		},
+		{
+			name:  "Already processed",
+			event: event,
+			mock: func() {
+				mockUserRepo.EXPECT().ClaimPaymentEvent(gomock.Any(), event).Return(models.PaymentEventProcessed, nil)
+			},
+			expectedStatus: models.PaymentEventProcessed,
+		},
+		{
+			name:               "WrongData",
+			event:              models.PaymentEvent{Kind: models.PaymentKindDonate, TargetID: uuid.New(), Money: models.NewMoney(10000), Paid: models.NewMoney(10000)},
+			mock:               func() {},
+			expectedStatusCode: models.WrongData,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserUsecase{
+				repo: mockUserRepo,
+			}
+			test.mock()
+			status, err := h.ClaimPaymentEvent(context.Background(), test.event)
+			require.Equal(t, test.expectedStatusCode, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedStatusCode, err))
+			require.Equal(t, test.expectedStatus, status)
+		})
+	}
+}
+
+func TestUserUsecase_FinishPaymentEvent(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockUserRepo := mock.NewMockUserRepo(ctl)
+
+	tests := []struct {
+		name               string
+		lastError          string
+		mock               func()
+		expectedStatusCode error
+	}{
+		{
+			name: "Processed",
+			mock: func() {
+				mockUserRepo.EXPECT().FinishPaymentEvent(gomock.Any(), "1", models.PaymentEventProcessed, "").Return(nil)
+			},
+		},
+		{
+			name:      "Failed",
+			lastError: models.InternalError.Error(),
+			mock: func() {
+				mockUserRepo.EXPECT().FinishPaymentEvent(gomock.Any(), "1", models.PaymentEventFailed, models.InternalError.Error()).Return(nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserUsecase{
+				repo: mockUserRepo,
+			}
+			test.mock()
+			err := h.FinishPaymentEvent(context.Background(), "1", test.lastError)
+			require.Equal(t, test.expectedStatusCode, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedStatusCode, err))
+		})
+	}
+}
+
+func TestUserUsecase_RefundPayment(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockUserRepo := mock.NewMockUserRepo(ctl)
+	provider := fake.NewProvider()
+	adminID := uuid.New()
+	refund := models.Refund{PaymentId: uuid.New(), Money: models.NewMoney(5000), Source: models.RefundSourceAdmin, Reason: "test", AdminId: adminID}
+	chargeback := models.Refund{PaymentId: uuid.New(), Money: models.NewMoney(5000), Source: models.RefundSourceProvider, OperationID: "op-1"}
+	// repo вызывает перевод, пока платёж заблокирован, и записывает возврат с операцией перевода
+	transfer := func(_ context.Context, refund models.Refund, transfer func(models.Refund, string) (string, error)) (models.Refund, error) {
+		operationID, err := transfer(refund, "41001000040")
+		if err != nil {
+			return models.Refund{}, err
+		}
+		refund.OperationID = operationID
+		return refund, nil
+	}
+
+	tests := []struct {
+		name               string
+		refund             models.Refund
+		mock               func()
+		expectedStatusCode error
+	}{
+		{
+			name:   "OK",
+			refund: refund,
+			mock: func() {
+				mockUserRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(true, nil)
+				mockUserRepo.EXPECT().RefundPayment(gomock.Any(), refund, gomock.Not(gomock.Nil())).DoAndReturn(transfer)
+			},
+		},
+		{
+			name:   "Transfer rejected",
+			refund: refund,
+			mock: func() {
+				provider.FailRequests(models.PayoutRejected)
+				mockUserRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(true, nil)
+				mockUserRepo.EXPECT().RefundPayment(gomock.Any(), refund, gomock.Not(gomock.Nil())).DoAndReturn(transfer)
+			},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:   "Transfer failed",
+			refund: refund,
+			mock: func() {
+				provider.FailRequests(errors.New("test"))
+				mockUserRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(true, nil)
+				mockUserRepo.EXPECT().RefundPayment(gomock.Any(), refund, gomock.Not(gomock.Nil())).DoAndReturn(transfer)
+			},
+			expectedStatusCode: models.InternalError,
+		},
+		{
+			name:   "Provider refund",
+			refund: chargeback,
+			mock: func() {
+				mockUserRepo.EXPECT().RefundPayment(gomock.Any(), chargeback, gomock.Nil()).Return(chargeback, nil)
+			},
+		},
+		{
+			name:   "Not admin",
+			refund: refund,
+			mock: func() {
+				mockUserRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(false, nil)
+			},
+			expectedStatusCode: models.Forbbiden,
+		},
+		{
+			name:               "Admin refund without admin",
+			refund:             models.Refund{PaymentId: uuid.New(), Money: models.NewMoney(5000), Source: models.RefundSourceAdmin},
+			mock:               func() {},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:   "Exceeds payment",
+			refund: refund,
+			mock: func() {
+				mockUserRepo.EXPECT().IsAdmin(gomock.Any(), adminID).Return(true, nil)
+				mockUserRepo.EXPECT().RefundPayment(gomock.Any(), refund, gomock.Any()).Return(models.Refund{}, models.WrongData)
+			},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:               "Provider refund without operation",
+			refund:             models.Refund{PaymentId: uuid.New(), Money: models.NewMoney(5000), Source: models.RefundSourceProvider},
+			mock:               func() {},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:               "Wrong money",
+			refund:             models.Refund{PaymentId: uuid.New(), Source: models.RefundSourceAdmin, AdminId: adminID},
+			mock:               func() {},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:               "Long reason",
+			refund:             models.Refund{PaymentId: uuid.New(), Money: models.NewMoney(5000), Source: models.RefundSourceAdmin, Reason: strings.Repeat("а", 201), AdminId: adminID},
+			mock:               func() {},
+			expectedStatusCode: models.WrongData,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserUsecase{
+				repo:           mockUserRepo,
+				refundProvider: provider,
+				logger:         zap.NewNop().Sugar(),
+			}
+			provider.FailRequests(nil)
+			test.mock()
+			result, err := h.RefundPayment(context.Background(), test.refund)
+			require.Equal(t, test.expectedStatusCode, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedStatusCode, err))
+			if err == nil {
+				require.NotEmpty(t, result.OperationID)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_AddPaymentInfo(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockUserRepo := mock.NewMockUserRepo(ctl)
+	subscriptionID := uuid.New()
+	tier := models.Subscription{Id: subscriptionID, MonthCost: models.NewMoney(30000), BillingOptions: []models.BillingOption{{MonthCount: 3, DiscountPercent: 10}}}
+
+	tests := []struct {
+		name               string
+		monthCount         int64
+		mock               func(subscription models.SubscriptionDetails)
+		expectedStatusCode error
+	}{
+		{
+			name:       "OK",
+			monthCount: 3,
+			mock: func(subscription models.SubscriptionDetails) {
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), subscriptionID).Return(tier, nil)
+				mockUserRepo.EXPECT().AddPaymentInfo(gomock.Any(), subscription).Return(nil)
+			},
+		},
+		{
+			name:       "No billing option",
+			monthCount: 6,
+			mock: func(subscription models.SubscriptionDetails) {
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), subscriptionID).Return(tier, nil)
+			},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:       "No subscription",
+			monthCount: 1,
+			mock: func(subscription models.SubscriptionDetails) {
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), subscriptionID).Return(models.Subscription{}, models.NotFound)
+			},
+			expectedStatusCode: models.WrongData,
+		},
+		{
+			name:       "InternalError",
+			monthCount: 1,
+			mock: func(subscription models.SubscriptionDetails) {
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), subscriptionID).Return(models.Subscription{}, models.InternalError)
+			},
+			expectedStatusCode: models.InternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserUsecase{
+				repo: mockUserRepo,
+			}
+			subscription := models.SubscriptionDetails{Id: subscriptionID, UserID: uuid.New(), MonthCount: test.monthCount, PaymentInfo: uuid.New()}
+			test.mock(subscription)
+			err := h.AddPaymentInfo(context.Background(), subscription)
+			require.Equal(t, test.expectedStatusCode, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedStatusCode, err))
+		})
+	}
+}
+
+func TestUserUsecase_Subscribe(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	mockUserRepo := mock.NewMockUserRepo(ctl)
+	paymentInfo := uuid.New()
+	creatorID := uuid.New()
+	subscription := models.SubscriptionDetails{Id: uuid.New(), UserID: uuid.New(), MonthCount: 3}
+	tier := models.Subscription{Id: subscription.Id, MonthCost: models.NewMoney(30000), BillingOptions: []models.BillingOption{{MonthCount: 3, DiscountPercent: 10}}}
+
+	tests := []struct {
+		name               string
+		money              models.Money
+		paid               models.Money
+		mock               func()
+		expectedStatusCode error
+	}{
+		{
+			name:  "OK",
+			money: models.NewMoney(81000),
+			paid:  models.NewMoney(81000),
+			mock: func() {
+				mockUserRepo.EXPECT().CheckPaymentInfo(gomock.Any(), paymentInfo).Return(subscription, nil)
+				mockUserRepo.EXPECT().GetCreatorID(gomock.Any(), subscription.Id).Return(creatorID, nil)
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), subscription.Id).Return(tier, nil)
+				mockUserRepo.EXPECT().Subscribe(gomock.Any(), gomock.Any(), models.NewMoney(81000), "1").Return(models.NotificationSubInfo{CreatorID: creatorID}, nil)
+			},
+		},
+		{
+			name:  "Provider fee",
+			money: models.NewMoney(78570),
+			paid:  models.NewMoney(81000),
+			mock: func() {
+				mockUserRepo.EXPECT().CheckPaymentInfo(gomock.Any(), paymentInfo).Return(subscription, nil)
+				mockUserRepo.EXPECT().GetCreatorID(gomock.Any(), subscription.Id).Return(creatorID, nil)
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), subscription.Id).Return(tier, nil)
+				mockUserRepo.EXPECT().Subscribe(gomock.Any(), gomock.Any(), models.NewMoney(78570), "1").Return(models.NotificationSubInfo{CreatorID: creatorID}, nil)
+			},
+		},
+		{
+			name:  "Paid less than price",
+			money: models.NewMoney(77600),
+			paid:  models.NewMoney(80000),
+			mock: func() {
+				mockUserRepo.EXPECT().CheckPaymentInfo(gomock.Any(), paymentInfo).Return(subscription, nil)
+				mockUserRepo.EXPECT().GetCreatorID(gomock.Any(), subscription.Id).Return(creatorID, nil)
+				mockUserRepo.EXPECT().SubscriptionBilling(gomock.Any(), subscription.Id).Return(tier, nil)
+				mockUserRepo.EXPECT().AddUnmatchedPayment(gomock.Any(), models.UnmatchedPayment{OperationID: "1",
+					Kind: models.PaymentKindSubscribe, TargetID: paymentInfo, Money: models.NewMoney(77600),
+					Paid: models.NewMoney(80000), Price: models.NewMoney(81000), Reason: models.UnmatchedReasonUnderpaid}).Return(nil)
+			},
+			expectedStatusCode: models.Underpaid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := &UserUsecase{
+				repo:   mockUserRepo,
+				logger: zap.NewNop().Sugar(),
+			}
+			test.mock()
+			_, err := h.Subscribe(context.Background(), paymentInfo, test.money, test.paid, "1")
+			require.Equal(t, test.expectedStatusCode, err, fmt.Errorf("%s :  expected %e, got %e,",
+				test.name, test.expectedStatusCode, err))
+		})
+	}
+}